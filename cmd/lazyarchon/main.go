@@ -1,12 +1,24 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"runtime/debug"
+	"strings"
+	"syscall"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/cli"
+	"github.com/yousfisaad/lazyarchon/v2/internal/logging"
+	"github.com/yousfisaad/lazyarchon/v2/internal/metrics"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/terminal"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui"
 )
 
@@ -18,18 +30,30 @@ var (
 )
 
 func main() {
+	// Subcommands (lazyarchon list ...) print and exit without starting the TUI
+	if len(os.Args) > 1 && cli.IsCommand(os.Args[1]) {
+		os.Exit(runCommand(os.Args[1], os.Args[2:]))
+	}
+
 	// Define CLI flags
 	var (
-		version  = flag.Bool("version", false, "Show version information")
-		help     = flag.Bool("help", false, "Show help message")
-		debug    = flag.Bool("debug", false, "Enable debug mode with verbose logging")
-		logFile  = flag.String("log-file", "", "Path to log file (default: /tmp/lazyarchon.log)")
-		logLevel = flag.String("log-level", "", "Log level: debug, info, warn, error (default: info, or debug if --debug)")
+		version   = flag.Bool("version", false, "Show version information")
+		help      = flag.Bool("help", false, "Show help message")
+		debug     = flag.Bool("debug", false, "Enable debug mode with verbose logging")
+		logFile   = flag.String("log-file", "", "Path to log file (default: "+logging.DefaultLogFile+")")
+		logLevel  = flag.String("log-level", "", "Log level: debug, info, warn, error (default: info, or debug if --debug)")
+		record    = flag.String("record-http", "", "Record API responses to this directory")
+		replay    = flag.String("replay-http", "", "Serve API responses from a directory recorded with --record-http")
+		safeMode  = flag.Bool("safe-mode", false, "Start with defaults, no saved state and read-only access")
+		pickFirst = flag.Bool("projects", false, "Start in project selection mode")
 	)
 
 	// Parse flags
 	flag.Parse()
 
+	// A panic before the UI takes over the terminal would otherwise leave no hint
+	defer recoverStartupPanic(*safeMode)
+
 	// Handle version flag
 	if *version {
 		printVersion()
@@ -46,25 +70,126 @@ func main() {
 	cfg, err := config.Load()
 	if err != nil {
 		// TODO: use slog instead of print
-		fmt.Println("error while loading configs -> using default configs")
+		fmt.Printf("error while loading configs: %v -> using default configs\n", err)
 	}
 
 	// Override config with CLI flags
 	applyDebugFlags(cfg, *debug, *logFile, *logLevel)
+	if err := applyHTTPCaptureFlags(cfg, *record, *replay); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if *safeMode {
+		cfg = config.SafeMode(cfg)
+		// Printed before the alternate screen, so it is still there after quitting
+		fmt.Fprintf(os.Stderr, "Safe mode: disabled %s\n", strings.Join(ui.SafeModeDisabled, ", "))
+	}
+	if *pickFirst {
+		cfg.UI.Display.StartInProjectMode = true
+	}
+
+	// Detected before the model builds its styles: legacy Windows consoles
+	// get ASCII borders because their fonts lack the box-drawing glyphs
+	caps := terminal.Detect(os.Stdout, os.Getenv)
+	styling.SetASCIIBorders(caps.LegacyConsole)
 
 	// Create the UI model with simple constructor
 	mainModel := ui.NewModel(cfg)
 
+	// Background services stopped once the UI exits
+	var shutdown shutdownHooks
+	if addr := cfg.GetMetricsListen(); addr != "" {
+		collector := metrics.NewCollector(metrics.BuildInfo{Version: Version, Commit: Commit}, cfg.GetMetricsMaxFeatures())
+		server, err := metrics.Listen(addr, collector)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Metrics disabled: %v\n", err)
+		} else {
+			mainModel.AttachMetrics(collector)
+			shutdown.add(server.Shutdown)
+		}
+	}
+
+	// All output goes through one writer so window title, progress and clipboard
+	// sequences never interleave with rendered frames
+	output := terminal.NewOutput(os.Stdout, caps)
+	mainModel.AttachClipboard(output)
+	if cfg.IsTerminalTitleEnabled() && caps.CanSetTitle() {
+		mainModel.AttachTerminal(output)
+		output.SaveTitle()
+	}
+
 	// Initialize the Bubble Tea application
 	// Pass pointer since Model.Update() uses pointer receiver to maintain component references
-	bubbleteaProgram := tea.NewProgram(&mainModel, tea.WithAltScreen())
+	bubbleteaProgram := tea.NewProgram(&mainModel, tea.WithAltScreen(), tea.WithOutput(output))
 
-	if _, err := bubbleteaProgram.Run(); err != nil {
+	_, err = bubbleteaProgram.Run()
+	mainModel.CancelLoads() // Quitting mid-load abandons the requests
+	restoreTerminal(cfg, output)
+	mainModel.FlushSlowFrames()
+	mainModel.LogFrameSummary()
+	shutdown.run()
+	if err != nil {
 		fmt.Printf("Error running application: %v\n", err)
+		if errors.Is(err, tea.ErrProgramPanic) && !*safeMode {
+			printSafeModeHint()
+		}
 		os.Exit(1)
 	}
 }
 
+// runCommand runs a subcommand with the same configuration the TUI would load
+func runCommand(name string, args []string) int {
+	cfg, err := config.Load()
+	if err != nil {
+		// stdout stays clean for scripts reading the command output
+		fmt.Fprintf(os.Stderr, "error while loading configs: %v -> using default configs\n", err)
+	}
+
+	// Ctrl+C ends "watch" cleanly
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := archon.NewClient(cfg.GetServerURL(), cfg.GetAPIKey())
+	client.SetAPIKeySource(cfg.ResolveAPIKey)
+	client.SetCacheTTL(cfg.GetCacheTTL())
+
+	return cli.Run(cli.Env{
+		Config:  cfg,
+		Client:  client,
+		Stdout:  os.Stdout,
+		Stderr:  os.Stderr,
+		Context: ctx,
+	}, name, args)
+}
+
+// recoverStartupPanic reports a panic during startup with a hint to try safe mode
+func recoverStartupPanic(safeMode bool) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "lazyarchon failed to start: %v\n\n%s\n", r, debug.Stack())
+	if !safeMode {
+		printSafeModeHint()
+	}
+	os.Exit(1)
+}
+
+// printSafeModeHint suggests safe mode after a crash
+func printSafeModeHint() {
+	fmt.Fprintln(os.Stderr, "If this keeps happening, try \"lazyarchon --safe-mode\": it starts with default")
+	fmt.Fprintln(os.Stderr, "settings and skips saved state, so you can still reach your tasks (read-only).")
+}
+
+// restoreTerminal clears the progress indicator and brings back the saved window title
+func restoreTerminal(cfg *config.Config, output *terminal.Output) {
+	if !cfg.IsTerminalTitleEnabled() {
+		return
+	}
+	output.SetBusy(false)
+	output.RestoreTitle()
+}
+
 func printVersion() {
 	fmt.Printf("LazyArchon %s\n", Version)
 	fmt.Printf("Commit: %s\n", Commit)
@@ -74,17 +199,32 @@ func printVersion() {
 func printHelp() {
 	fmt.Printf("LazyArchon %s - Terminal UI for Archon project management\n\n", Version)
 	fmt.Printf("Usage:\n")
-	fmt.Printf("  lazyarchon [flags]\n\n")
+	fmt.Printf("  lazyarchon [flags]\n")
+	fmt.Printf("  lazyarchon <command> [command flags]\n\n")
+	fmt.Printf("Commands:\n")
+	fmt.Printf("  config schema    Print the JSON Schema of the config file, for editor autocomplete\n")
+	fmt.Printf("  list             Print tasks and exit (--project, --status, --feature, --sort, --all, --columns, --csv, --json)\n")
+	fmt.Printf("  set-status ID S  Set the status of a task (full ID or unique prefix) and print it (--json)\n")
+	fmt.Printf("  watch            Print task changes as they happen until Ctrl+C (list filters, --interval, --json)\n\n")
 	fmt.Printf("Flags:\n")
 	fmt.Printf("  -help            Show this help message\n")
 	fmt.Printf("  -version         Show version information\n")
 	fmt.Printf("  -debug           Enable debug mode with verbose logging\n")
-	fmt.Printf("  -log-file PATH   Custom log file path (default: /tmp/lazyarchon.log)\n")
-	fmt.Printf("  -log-level LEVEL Set log level: debug, info, warn, error (default: info)\n\n")
+	fmt.Printf("  -log-file PATH   Custom log file path (default: %s)\n", logging.DefaultLogFile)
+	fmt.Printf("  -log-level LEVEL Set log level: debug, info, warn, error (default: info)\n")
+	fmt.Printf("  -record-http DIR Record API responses to DIR (API key is never written)\n")
+	fmt.Printf("  -replay-http DIR Run offline from a recording; edits stay in memory\n")
+	fmt.Printf("  -safe-mode       Start with defaults, skip saved state and disable task changes\n")
+	fmt.Printf("  -projects        Start in project selection mode\n\n")
 	fmt.Printf("Examples:\n")
 	fmt.Printf("  lazyarchon --debug                    # Enable debug mode\n")
 	fmt.Printf("  lazyarchon --log-level warn           # Show warnings and errors only\n")
-	fmt.Printf("  lazyarchon --debug --log-file ~/app.log  # Debug with custom log file\n\n")
+	fmt.Printf("  lazyarchon --debug --log-file ~/app.log  # Debug with custom log file\n")
+	fmt.Printf("  lazyarchon --record-http ./demo       # Capture real server data\n")
+	fmt.Printf("  lazyarchon --replay-http ./demo       # Demo offline from the capture\n")
+	fmt.Printf("  lazyarchon --safe-mode                # Start even when a config or state file is broken\n")
+	fmt.Printf("  lazyarchon list --project Web --status doing --json  # Tasks in progress, for scripts\n")
+	fmt.Printf("  lazyarchon config schema > ~/.config/lazyarchon/config.schema.json  # Schema for your editor\n\n")
 	fmt.Printf("Visit https://github.com/yousfisaad/lazyarchon for more information.\n")
 }
 
@@ -119,3 +259,24 @@ func applyDebugFlags(cfg *config.Config, debug bool, logFile string, logLevel st
 		}
 	}
 }
+
+// applyHTTPCaptureFlags validates --record-http / --replay-http and stores them in the config
+func applyHTTPCaptureFlags(cfg *config.Config, recordDir, replayDir string) error {
+	if recordDir != "" && replayDir != "" {
+		return fmt.Errorf("--record-http and --replay-http cannot be used together")
+	}
+
+	if replayDir != "" {
+		info, err := os.Stat(replayDir)
+		if err != nil {
+			return fmt.Errorf("cannot replay HTTP capture: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("cannot replay HTTP capture: %s is not a directory", replayDir)
+		}
+	}
+
+	cfg.Development.RecordHTTPDir = recordDir
+	cfg.Development.ReplayHTTPDir = replayDir
+	return nil
+}
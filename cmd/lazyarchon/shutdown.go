@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// shutdownTimeout bounds how long all shutdown hooks may take together
+const shutdownTimeout = 2 * time.Second
+
+// shutdownHooks stops background services when the program exits
+type shutdownHooks []func(ctx context.Context) error
+
+// add registers a hook; hooks run in reverse order of registration
+func (h *shutdownHooks) add(hook func(ctx context.Context) error) {
+	*h = append(*h, hook)
+}
+
+// run calls every hook, reporting failures on stderr
+func (h shutdownHooks) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	for i := len(h) - 1; i >= 0; i-- {
+		if err := h[i](ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Shutdown: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package archon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// cacheEntry is a stored GET response body with what revalidates it
+type cacheEntry struct {
+	body   []byte
+	header http.Header
+	etag   string    // Sent back as If-None-Match once the entry is stale ("" = none)
+	stored time.Time // When the server last confirmed the body
+}
+
+// SetCacheTTL enables the response cache for ListTasks and ListProjects:
+// responses younger than ttl are served without a request, and older ones are
+// revalidated with If-None-Match when the server sent an ETag, so a 304 reuses
+// the stored body. 0 disables the cache and drops what it holds.
+func (c *Client) SetCacheTTL(ttl time.Duration) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	c.cacheTTL = ttl
+	c.cache = nil
+	c.cacheGen++
+}
+
+// InvalidateCache drops every cached response, so the next list request goes
+// to the server. Task mutations call it; callers use it to force a fresh load.
+// Responses to requests sent before the call are not stored once they arrive,
+// so a list racing a mutation cannot bring the old data back into the cache.
+func (c *Client) InvalidateCache() {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	c.cache = nil
+	c.cacheGen++
+}
+
+// cachedEntry returns the entry stored for fullURL, whether it is fresh, and
+// the cache generation a request sent now belongs to. ok is false when the
+// cache is disabled or holds nothing for fullURL.
+func (c *Client) cachedEntry(fullURL string) (entry cacheEntry, fresh, ok bool, gen uint64) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	gen = c.cacheGen
+	if c.cacheTTL <= 0 {
+		return cacheEntry{}, false, false, gen
+	}
+	entry, ok = c.cache[fullURL]
+	if !ok {
+		return cacheEntry{}, false, false, gen
+	}
+	if time.Since(entry.stored) < c.cacheTTL {
+		return entry, true, true, gen
+	}
+	if entry.etag == "" {
+		delete(c.cache, fullURL) // Stale and cannot be revalidated
+		return cacheEntry{}, false, false, gen
+	}
+	return entry, false, true, gen
+}
+
+// storeCached records a successful response body for fullURL, unless the
+// cache was invalidated since the request was sent in generation gen
+func (c *Client) storeCached(fullURL string, entry cacheEntry, gen uint64) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	if c.cacheTTL <= 0 || gen != c.cacheGen {
+		return
+	}
+	if c.cache == nil {
+		c.cache = make(map[string]cacheEntry)
+	}
+	c.cache[fullURL] = entry
+}
+
+// doCached is do for GET requests that may be answered from the cache. Only
+// bodies that parsed are stored, so a malformed response is never replayed.
+func (c *Client) doCached(ctx context.Context, op, path string, v interface{}) error {
+	fullURL := c.baseURL + path
+	entry, fresh, ok, gen := c.cachedEntry(fullURL)
+	if fresh {
+		if c.logger != nil {
+			c.logger.Debug("Serving response from cache", "url", fullURL)
+		}
+		return c.parseCached(op, path, entry, v)
+	}
+
+	var header http.Header
+	if ok {
+		header = http.Header{"If-None-Match": []string{entry.etag}}
+	}
+	resp, err := c.makeRequestWithHeader(ctx, "GET", path, nil, header)
+	if err != nil {
+		return c.requestError(op, "GET", path, nil, err)
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		entry.stored = time.Now()
+		c.storeCached(fullURL, entry, gen)
+		return c.parseCached(op, path, entry, v)
+	}
+
+	// Keep a copy of the body as parseResponse reads it
+	var body bytes.Buffer
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(resp.Body, &body), resp.Body}
+	if err := c.parseResponse(resp, v); err != nil {
+		return c.requestError(op, "GET", path, resp, err)
+	}
+	if resp.StatusCode == http.StatusOK {
+		c.storeCached(fullURL, cacheEntry{
+			body:   body.Bytes(),
+			header: resp.Header.Clone(),
+			etag:   resp.Header.Get("ETag"),
+			stored: time.Now(),
+		}, gen)
+	}
+	return nil
+}
+
+// parseCached decodes a cached body into v as if it had just arrived
+func (c *Client) parseCached(op, path string, entry cacheEntry, v interface{}) error {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     entry.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(entry.body)),
+	}
+	if err := c.parseResponse(resp, v); err != nil {
+		return c.requestError(op, "GET", path, resp, err)
+	}
+	return nil
+}
@@ -2,21 +2,53 @@ package archon
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"sync"
 	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 )
 
 // Common errors
 var (
 	ErrTaskNotFound    = errors.New("task not found")
 	ErrProjectNotFound = errors.New("project not found")
+	ErrForbidden       = errors.New("permission denied")
+	ErrUnauthorized    = errors.New("unauthorized: API key missing, invalid or expired")
+	ErrNotSupported    = errors.New("not supported by the server")
+
+	// ErrMalformedResponse marks a response body that was cut off, did not
+	// decode or lacked its payload. Nothing from such a response is returned.
+	ErrMalformedResponse = errors.New("malformed response")
 )
 
+// RequestError records which client operation failed, the URL it called and
+// the HTTP status it got (0 when no response arrived). It wraps the cause, so
+// errors.Is still matches the errors above.
+type RequestError struct {
+	Op         string // Client operation, e.g. "update task"
+	Method     string
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s: %s %s: %v", e.Op, e.Method, e.URL, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
 // Logger interface for optional logging in Client
 type Logger interface {
 	Debug(msg string, args ...interface{})
@@ -32,8 +64,20 @@ type Logger interface {
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
-	apiKey     string
-	logger     Logger // Optional logger for debug mode
+	logger     Logger               // Optional logger for debug mode
+	clockSkew  *clock.SkewEstimator // Optional estimator fed from response Date headers
+
+	// Commands run concurrently, so the key is guarded: it changes when a 401
+	// makes the client re-resolve it, or when the user enters a new one
+	keyMu     sync.Mutex
+	apiKey    string
+	keySource func() (string, error) // Re-resolves the key after a 401 (nil = no retry)
+
+	// Optional response cache for list requests, keyed by URL (see SetCacheTTL)
+	cacheMu  sync.Mutex
+	cacheTTL time.Duration
+	cache    map[string]cacheEntry
+	cacheGen uint64 // Bumped by InvalidateCache; responses requested before it are not stored
 }
 
 // NewClient creates a new Archon API client
@@ -53,9 +97,74 @@ func (c *Client) SetLogger(logger Logger) {
 	c.logger = logger
 }
 
-// makeRequest makes an HTTP request to the Archon API
-func (c *Client) makeRequest(method, path string, body interface{}) (*http.Response, error) {
-	startTime := time.Now()
+// SetTransport replaces the HTTP transport, e.g. to record or replay traffic
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.httpClient.Transport = transport
+}
+
+// SetAPIKey replaces the API key sent with subsequent requests
+func (c *Client) SetAPIKey(apiKey string) {
+	c.keyMu.Lock()
+	c.apiKey = apiKey
+	c.keyMu.Unlock()
+	c.InvalidateCache() // Another key may see other tasks
+}
+
+// SetAPIKeySource sets where the key is re-read from when a request is
+// rejected with 401 (e.g. after the key was rotated). The request is retried
+// once, and only when the source returns a different key.
+func (c *Client) SetAPIKeySource(source func() (string, error)) {
+	c.keyMu.Lock()
+	defer c.keyMu.Unlock()
+	c.keySource = source
+}
+
+// currentAPIKey returns the key to send
+func (c *Client) currentAPIKey() string {
+	c.keyMu.Lock()
+	defer c.keyMu.Unlock()
+	return c.apiKey
+}
+
+// refreshAPIKey re-resolves the key after rejected was refused with 401.
+// Returns true when the request should be retried with a new key: another
+// request already replaced it, or the source returned a different one.
+func (c *Client) refreshAPIKey(rejected string) bool {
+	c.keyMu.Lock()
+	defer c.keyMu.Unlock()
+	if c.apiKey != rejected {
+		return true // Refreshed meanwhile by a concurrent request
+	}
+	if c.keySource == nil {
+		return false
+	}
+
+	apiKey, err := c.keySource()
+	if err != nil || apiKey == "" || apiKey == rejected {
+		if c.logger != nil {
+			c.logger.Info("API key rejected and no new key found", "error", err)
+		}
+		return false
+	}
+	c.apiKey = apiKey
+	if c.logger != nil {
+		c.logger.Info("API key rejected, retrying with re-resolved key")
+	}
+	return true
+}
+
+// SetClockSkewEstimator sets the estimator that successful responses report server time to
+func (c *Client) SetClockSkewEstimator(estimator *clock.SkewEstimator) {
+	c.clockSkew = estimator
+}
+
+// makeRequest makes an HTTP request to the Archon API; cancelling ctx aborts it
+func (c *Client) makeRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	return c.makeRequestWithHeader(ctx, method, path, body, nil)
+}
+
+// makeRequestWithHeader is makeRequest with extra request headers (nil = none)
+func (c *Client) makeRequestWithHeader(ctx context.Context, method, path string, body interface{}, header http.Header) (*http.Response, error) {
 	fullURL := c.baseURL + path
 
 	var reqBody io.Reader
@@ -83,7 +192,29 @@ func (c *Client) makeRequest(method, path string, body interface{}) (*http.Respo
 		c.logger.LogHTTPRequest(method, fullURL, logArgs...)
 	}
 
-	req, err := http.NewRequest(method, fullURL, reqBody)
+	apiKey := c.currentAPIKey()
+	resp, err := c.send(ctx, method, fullURL, reqBody, apiKey, header)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !c.refreshAPIKey(apiKey) {
+		return resp, err
+	}
+
+	// Retry once with the new key, unless the caller gave up while it was
+	// re-resolved; a second 401 is returned to the caller
+	resp.Body.Close()
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	if len(bodyBytes) > 0 {
+		reqBody = bytes.NewBuffer(bodyBytes)
+	}
+	return c.send(ctx, method, fullURL, reqBody, c.currentAPIKey(), header)
+}
+
+// send performs one HTTP request authenticated with apiKey
+func (c *Client) send(ctx context.Context, method, fullURL string, reqBody io.Reader, apiKey string, header http.Header) (*http.Response, error) {
+	startTime := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 	if err != nil {
 		if c.logger != nil {
 			c.logger.Error("Failed to create HTTP request", "error", err, "method", method, "url", fullURL)
@@ -92,9 +223,12 @@ func (c *Client) makeRequest(method, path string, body interface{}) (*http.Respo
 	}
 
 	// Set headers
+	for name, values := range header {
+		req.Header[name] = values
+	}
 	req.Header.Set("Content-Type", "application/json")
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -112,16 +246,65 @@ func (c *Client) makeRequest(method, path string, body interface{}) (*http.Respo
 		c.logger.LogHTTPResponse(method, fullURL, resp.StatusCode, duration)
 	}
 
+	c.observeServerTime(resp, startTime, startTime.Add(duration))
+
 	return resp, nil
 }
 
-// parseResponse parses the HTTP response into the given structure
+// observeServerTime feeds the response's Date header to the clock skew estimator
+func (c *Client) observeServerTime(resp *http.Response, sent, received time.Time) {
+	if c.clockSkew == nil || resp.StatusCode >= 400 {
+		return
+	}
+	serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return // Servers are not required to send Date
+	}
+	c.clockSkew.Observe(serverTime, sent, received)
+}
+
+// requestError wraps err with the operation, URL and status of a request;
+// resp is nil when the request got no response
+func (c *Client) requestError(op, method, path string, resp *http.Response, err error) error {
+	reqErr := &RequestError{Op: op, Method: method, URL: c.baseURL + path, Err: err}
+	if resp != nil {
+		reqErr.StatusCode = resp.StatusCode
+	}
+	return reqErr
+}
+
+// do makes a request and parses its response into v, wrapping any error
+// with the request's context
+func (c *Client) do(ctx context.Context, op, method, path string, body, v interface{}) error {
+	resp, err := c.makeRequest(ctx, method, path, body)
+	if err != nil {
+		return c.requestError(op, method, path, nil, err)
+	}
+	if err := c.parseResponse(resp, v); err != nil {
+		return c.requestError(op, method, path, resp, err)
+	}
+	return nil
+}
+
+// parseResponse parses the HTTP response into the given structure.
+// Response types carrying ResponseMeta also receive the header metadata.
+// On error v may be partially filled and must be discarded whole; callers
+// return nil rather than anything decoded from it.
 func (c *Client) parseResponse(resp *http.Response, v interface{}) error { //nolint:varnamelen // v is idiomatic for interface{} values
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("error reading response body: %w", err)
+		// The body stopped mid-stream (connection closed before Content-Length)
+		return fmt.Errorf("%w: error reading response body: %w", ErrMalformedResponse, err)
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("%w (status %d): %s", ErrUnauthorized, resp.StatusCode, string(body))
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w (status %d): %s", ErrForbidden, resp.StatusCode, string(body))
 	}
 
 	if resp.StatusCode >= 400 {
@@ -129,17 +312,200 @@ func (c *Client) parseResponse(resp *http.Response, v interface{}) error { //nol
 	}
 
 	if err := json.Unmarshal(body, v); err != nil {
-		return fmt.Errorf("error unmarshaling response: %w", err)
+		return fmt.Errorf("%w: error unmarshaling response: %w", ErrMalformedResponse, err)
+	}
+
+	// encoding/json keeps the last of repeated keys, which can swap a real
+	// payload for a null or empty one
+	if err := checkDuplicateKeys(body); err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedResponse, err)
+	}
+
+	if checked, ok := v.(payloadChecker); ok {
+		if err := checked.checkPayload(); err != nil {
+			return fmt.Errorf("%w: %w", ErrMalformedResponse, err)
+		}
+	}
+
+	if carrier, ok := v.(metaCarrier); ok {
+		carrier.setMeta(ParseResponseMeta(resp.Header, time.Now()))
 	}
 
 	return nil
 }
 
+// checkDuplicateKeys returns an error when any object in the JSON document
+// body has the same key twice. body must already be valid JSON.
+func checkDuplicateKeys(body []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	type frame struct {
+		keys      map[string]bool // nil for arrays
+		expectKey bool            // Next string token in an object is a key
+	}
+	var stack []frame
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		top := len(stack) - 1
+		if top >= 0 && stack[top].keys != nil {
+			if stack[top].expectKey {
+				if key, ok := token.(string); ok {
+					if stack[top].keys[key] {
+						return fmt.Errorf("duplicate key %q", key)
+					}
+					stack[top].keys[key] = true
+					stack[top].expectKey = false
+					continue
+				}
+			} else {
+				stack[top].expectKey = true // This token is (or starts) the value
+			}
+		}
+
+		switch token {
+		case json.Delim('{'):
+			stack = append(stack, frame{keys: map[string]bool{}, expectKey: true})
+		case json.Delim('['):
+			stack = append(stack, frame{})
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// DefaultPageSize is the number of tasks ListTasks asks for, and the page
+// size of ListTasksPaged when no limit is given
+const DefaultPageSize = 100
+
+// ListTasksOptions selects the tasks of one ListTasksPaged page
+type ListTasksOptions struct {
+	ProjectID     *string
+	Status        *string
+	IncludeClosed bool
+	Offset        int // Tasks to skip
+	Limit         int // Page size; 0 uses DefaultPageSize
+}
+
 // ListTasks retrieves all tasks from the API
 func (c *Client) ListTasks(projectID *string, status *string, includeClosed bool) (*TasksResponse, error) {
-	path := "/api/tasks"
+	return c.ListTasksContext(context.Background(), projectID, status, includeClosed)
+}
+
+// ListTasksContext is ListTasks, abandoned when ctx is cancelled
+func (c *Client) ListTasksContext(ctx context.Context, projectID *string, status *string, includeClosed bool) (*TasksResponse, error) {
+	params := listTasksParams(projectID, status, includeClosed)
+	params.Add("per_page", strconv.Itoa(DefaultPageSize))
+
+	// The API response contains tasks in a "tasks" field
+	var tasksResp TasksResponse
+	if err := c.doCached(ctx, "list tasks", "/api/tasks?"+params.Encode(), &tasksResp); err != nil {
+		return nil, err
+	}
+
+	return &tasksResp, nil
+}
+
+// ListTasksPaged retrieves one page of tasks, so large projects can be
+// loaded as they are scrolled. The page is asked for both by offset/limit and
+// by page/per_page (1-based), for servers numbering pages. Total is taken from
+// the body's "total" or "total_count" or the X-Total-Count header; when the
+// server sends none of them and no "has_more", a full page is taken to mean
+// more tasks follow. Each page is a request of its own: a failed page can be
+// asked for again without reloading the others.
+func (c *Client) ListTasksPaged(opts ListTasksOptions) (*TasksResponse, error) {
+	return c.ListTasksPagedContext(context.Background(), opts)
+}
+
+// ListTasksPagedContext is ListTasksPaged, abandoned when ctx is cancelled
+func (c *Client) ListTasksPagedContext(ctx context.Context, opts ListTasksOptions) (*TasksResponse, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	params := listTasksParams(opts.ProjectID, opts.Status, opts.IncludeClosed)
+	params.Add("limit", strconv.Itoa(limit))
+	params.Add("offset", strconv.Itoa(opts.Offset))
+	params.Add("per_page", strconv.Itoa(limit)) // Keeps servers capping per_page from cutting the page short
+	params.Add("page", strconv.Itoa(opts.Offset/limit+1))
+
+	var tasksResp TasksResponse
+	if err := c.do(ctx, "list tasks", "GET", "/api/tasks?"+params.Encode(), nil, &tasksResp); err != nil {
+		return nil, err
+	}
+	tasksResp.setPage(opts.Offset, limit)
+
+	return &tasksResp, nil
+}
+
+// ListTasksPage retrieves page (1-based) of the open tasks, perPage at a
+// time; perPage 0 uses DefaultPageSize. It is ListTasksPaged addressed by page
+// number instead of offset.
+func (c *Client) ListTasksPage(projectID *string, status *string, page, perPage int) (*TasksResponse, error) {
+	return c.ListTasksPageContext(context.Background(), projectID, status, page, perPage)
+}
+
+// ListTasksPageContext is ListTasksPage, abandoned when ctx is cancelled
+func (c *Client) ListTasksPageContext(ctx context.Context, projectID *string, status *string, page, perPage int) (*TasksResponse, error) {
+	if perPage <= 0 {
+		perPage = DefaultPageSize
+	}
+	return c.ListTasksPagedContext(ctx, ListTasksOptions{
+		ProjectID: projectID,
+		Status:    status,
+		Offset:    (max(page, 1) - 1) * perPage,
+		Limit:     perPage,
+	})
+}
 
-	// Add query parameters for filtering
+// ListTasksAll loads the tasks matching opts a page at a time, from
+// opts.Offset on, handing each page to onPage as it arrives so callers can
+// show the first tasks before the last are loaded. Tasks repeated across pages
+// are handed over once. It stops after the last page, or after a page adding
+// no new task (a server ignoring the paging parameters); an error from a
+// request or from onPage stops it and is returned.
+func (c *Client) ListTasksAll(opts ListTasksOptions, onPage func(page *TasksResponse) error) error {
+	return c.ListTasksAllContext(context.Background(), opts, onPage)
+}
+
+// ListTasksAllContext is ListTasksAll, stopping before the next page once ctx
+// is cancelled and returning ctx's error
+func (c *Client) ListTasksAllContext(ctx context.Context, opts ListTasksOptions, onPage func(page *TasksResponse) error) error {
+	seen := make(map[string]bool)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page, err := c.ListTasksPagedContext(ctx, opts)
+		if err != nil {
+			return err
+		}
+		received := len(page.Tasks)
+		page.Tasks = slices.DeleteFunc(page.Tasks, func(task Task) bool {
+			repeated := seen[task.ID]
+			seen[task.ID] = true
+			return repeated
+		})
+		if received > 0 && len(page.Tasks) == 0 {
+			return nil
+		}
+		if err := onPage(page); err != nil {
+			return err
+		}
+		if !page.HasMore || received == 0 {
+			return nil
+		}
+		opts.Offset += received
+	}
+}
+
+// listTasksParams encodes the task list filters shared by ListTasks and ListTasksPaged
+func listTasksParams(projectID *string, status *string, includeClosed bool) url.Values {
 	params := url.Values{}
 	if projectID != nil {
 		params.Add("project_id", *projectID)
@@ -150,21 +516,42 @@ func (c *Client) ListTasks(projectID *string, status *string, includeClosed bool
 	if includeClosed {
 		params.Add("include_closed", "true")
 	}
-	params.Add("per_page", "100")
+	return params
+}
 
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+// SearchTasks asks the server for the tasks matching query, optionally within
+// one project. Servers without a search endpoint (404, 405 or 501) return
+// ErrNotSupported.
+func (c *Client) SearchTasks(query string, projectID *string) (*TasksResponse, error) {
+	return c.SearchTasksContext(context.Background(), query, projectID)
+}
+
+// SearchTasksContext is SearchTasks, abandoned when ctx is cancelled
+func (c *Client) SearchTasksContext(ctx context.Context, query string, projectID *string) (*TasksResponse, error) {
+	params := url.Values{}
+	params.Add("q", query)
+	if projectID != nil {
+		params.Add("project_id", *projectID)
 	}
+	params.Add("include_closed", "true")
+	params.Add("per_page", "100")
 
-	resp, err := c.makeRequest("GET", path, nil)
+	path := "/api/tasks/search?" + params.Encode()
+	resp, err := c.makeRequest(ctx, "GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, c.requestError("search tasks", "GET", path, nil, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		resp.Body.Close()
+		return nil, c.requestError("search tasks", "GET", path, resp,
+			fmt.Errorf("task search is %w (status %d)", ErrNotSupported, resp.StatusCode))
 	}
 
-	// Parse the API response which contains tasks in a "tasks" field
 	var tasksResp TasksResponse
 	if err := c.parseResponse(resp, &tasksResp); err != nil {
-		return nil, err
+		return nil, c.requestError("search tasks", "GET", path, resp, err)
 	}
 
 	return &tasksResp, nil
@@ -172,15 +559,32 @@ func (c *Client) ListTasks(projectID *string, status *string, includeClosed bool
 
 // GetTask retrieves a specific task by ID
 func (c *Client) GetTask(taskID string) (*TaskResponse, error) {
+	return c.GetTaskContext(context.Background(), taskID)
+}
+
+// GetTaskContext is GetTask, abandoned when ctx is cancelled
+func (c *Client) GetTaskContext(ctx context.Context, taskID string) (*TaskResponse, error) {
 	path := "/api/tasks/" + taskID
 
-	resp, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
+	var taskResp TaskResponse
+	if err := c.do(ctx, "get task", "GET", path, nil, &taskResp); err != nil {
+		return nil, taskNotFound(err)
 	}
 
+	return &taskResp, nil
+}
+
+// CreateTask creates a task; the response holds it with its new ID
+func (c *Client) CreateTask(req CreateTaskRequest) (*TaskResponse, error) {
+	return c.CreateTaskContext(context.Background(), req)
+}
+
+// CreateTaskContext is CreateTask, abandoned when ctx is cancelled. A task
+// cancelled after the request was sent may still have been created.
+func (c *Client) CreateTaskContext(ctx context.Context, req CreateTaskRequest) (*TaskResponse, error) {
+	defer c.InvalidateCache() // After the change, so lists racing it are not kept
 	var taskResp TaskResponse
-	if err := c.parseResponse(resp, &taskResp); err != nil {
+	if err := c.do(ctx, "create task", "POST", "/api/tasks", req, &taskResp); err != nil {
 		return nil, err
 	}
 
@@ -189,53 +593,78 @@ func (c *Client) GetTask(taskID string) (*TaskResponse, error) {
 
 // UpdateTask updates an existing task
 func (c *Client) UpdateTask(taskID string, updates UpdateTaskRequest) (*TaskResponse, error) {
-	path := "/api/tasks/" + taskID
+	return c.UpdateTaskContext(context.Background(), taskID, updates)
+}
 
-	resp, err := c.makeRequest("PUT", path, updates)
-	if err != nil {
-		return nil, err
-	}
+// UpdateTaskContext is UpdateTask, abandoned when ctx is cancelled. An update
+// cancelled after the request was sent may still have been applied.
+func (c *Client) UpdateTaskContext(ctx context.Context, taskID string, updates UpdateTaskRequest) (*TaskResponse, error) {
+	path := "/api/tasks/" + taskID
+	defer c.InvalidateCache()
 
 	var taskResp TaskResponse
-	if err := c.parseResponse(resp, &taskResp); err != nil {
-		return nil, err
+	if err := c.do(ctx, "update task", "PUT", path, updates, &taskResp); err != nil {
+		return nil, taskNotFound(err)
 	}
 
 	return &taskResp, nil
 }
 
+// taskNotFound turns the 404 of a request for one task into ErrTaskNotFound,
+// like DeleteTaskContext reports it
+func taskNotFound(err error) error {
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) && reqErr.StatusCode == http.StatusNotFound {
+		reqErr.Err = fmt.Errorf("%w (status %d)", ErrTaskNotFound, reqErr.StatusCode)
+	}
+	return err
+}
+
 // DeleteTask deletes/archives a task
 func (c *Client) DeleteTask(taskID string) error {
+	return c.DeleteTaskContext(context.Background(), taskID)
+}
+
+// DeleteTaskContext is DeleteTask, abandoned when ctx is cancelled
+func (c *Client) DeleteTaskContext(ctx context.Context, taskID string) error {
 	path := "/api/tasks/" + taskID
+	defer c.InvalidateCache()
 
-	resp, err := c.makeRequest("DELETE", path, nil)
+	resp, err := c.makeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
-		return err
+		return c.requestError("delete task", "DELETE", path, nil, err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return ErrTaskNotFound
+	var cause error
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		cause = fmt.Errorf("%w (status %d)", ErrTaskNotFound, resp.StatusCode)
+	case resp.StatusCode == http.StatusUnauthorized:
+		cause = fmt.Errorf("%w (status %d)", ErrUnauthorized, resp.StatusCode)
+	case resp.StatusCode == http.StatusForbidden:
+		cause = fmt.Errorf("%w (status %d)", ErrForbidden, resp.StatusCode)
+	case resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented:
+		cause = fmt.Errorf("deleting tasks is %w (status %d)", ErrNotSupported, resp.StatusCode)
+	case resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK:
+		cause = fmt.Errorf("failed to delete task: status %d", resp.StatusCode)
+	default:
+		return nil
 	}
-
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete task: status %d", resp.StatusCode)
-	}
-
-	return nil
+	return c.requestError("delete task", "DELETE", path, resp, cause)
 }
 
 // ListProjects retrieves all projects from the API
 func (c *Client) ListProjects() (*ProjectsResponse, error) {
-	path := "/api/projects"
+	return c.ListProjectsContext(context.Background())
+}
 
-	resp, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListProjectsContext is ListProjects, abandoned when ctx is cancelled
+func (c *Client) ListProjectsContext(ctx context.Context) (*ProjectsResponse, error) {
+	path := "/api/projects"
 
 	var projectsResp ProjectsResponse
-	if err := c.parseResponse(resp, &projectsResp); err != nil {
+	if err := c.doCached(ctx, "list projects", path, &projectsResp); err != nil {
 		return nil, err
 	}
 
@@ -244,15 +673,15 @@ func (c *Client) ListProjects() (*ProjectsResponse, error) {
 
 // GetProject retrieves a specific project by ID
 func (c *Client) GetProject(projectID string) (*ProjectResponse, error) {
-	path := "/api/projects/" + projectID
+	return c.GetProjectContext(context.Background(), projectID)
+}
 
-	resp, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		return nil, err
-	}
+// GetProjectContext is GetProject, abandoned when ctx is cancelled
+func (c *Client) GetProjectContext(ctx context.Context, projectID string) (*ProjectResponse, error) {
+	path := "/api/projects/" + projectID
 
 	var projectResp ProjectResponse
-	if err := c.parseResponse(resp, &projectResp); err != nil {
+	if err := c.do(ctx, "get project", "GET", path, nil, &projectResp); err != nil {
 		return nil, err
 	}
 
@@ -261,14 +690,20 @@ func (c *Client) GetProject(projectID string) (*ProjectResponse, error) {
 
 // HealthCheck checks if the API is accessible
 func (c *Client) HealthCheck() error {
-	resp, err := c.makeRequest("GET", "/health", nil)
+	return c.HealthCheckContext(context.Background())
+}
+
+// HealthCheckContext is HealthCheck, abandoned when ctx is cancelled
+func (c *Client) HealthCheckContext(ctx context.Context) error {
+	resp, err := c.makeRequest(ctx, "GET", "/health", nil)
 	if err != nil {
-		return err
+		return c.requestError("health check", "GET", "/health", nil, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("health check failed with status: %d", resp.StatusCode)
+		return c.requestError("health check", "GET", "/health", resp,
+			fmt.Errorf("health check failed with status: %d", resp.StatusCode))
 	}
 
 	return nil
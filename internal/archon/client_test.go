@@ -1,8 +1,23 @@
 package archon
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 )
 
 func TestNewClient(t *testing.T) {
@@ -174,6 +189,38 @@ func TestClient_GetTask(t *testing.T) {
 	})
 }
 
+func TestClient_TaskNotFound(t *testing.T) {
+	server := SetupMockServerWithData()
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+
+	tests := []struct {
+		name string
+		call func() error
+		op   string
+	}{
+		{"get", func() error { _, err := client.GetTask("deleted"); return err }, "get task"},
+		{"update", func() error {
+			_, err := client.UpdateTask("deleted", UpdateTaskRequest{Status: stringPtr("done")})
+			return err
+		}, "update task"},
+		{"delete", func() error { return client.DeleteTask("deleted") }, "delete task"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, ErrTaskNotFound) {
+				t.Fatalf("Expected ErrTaskNotFound, got %v", err)
+			}
+			var reqErr *RequestError
+			if !errors.As(err, &reqErr) || reqErr.Op != tt.op || reqErr.StatusCode != http.StatusNotFound {
+				t.Errorf("Expected a %s RequestError with status 404, got %v", tt.op, err)
+			}
+		})
+	}
+}
+
 func TestClient_UpdateTask(t *testing.T) {
 	server := SetupMockServerWithData()
 	defer server.Close()
@@ -271,6 +318,36 @@ func TestClient_UpdateTask(t *testing.T) {
 	}
 }
 
+func TestClient_CreateTask(t *testing.T) {
+	server := SetupMockServerWithData()
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+
+	resp, err := client.CreateTask(CreateTaskRequest{
+		Title:       "New Task",
+		Description: "Created from the TUI",
+		ProjectID:   "project-1",
+		Status:      "todo",
+		Priority:    60,
+		Feature:     stringPtr("ui"),
+	})
+	AssertNoError(t, err)
+
+	if resp.Task.ID == "" {
+		t.Fatal("Expected the created task to have an ID")
+	}
+
+	// The task is on the server with every field sent
+	getResp, err := client.GetTask(resp.Task.ID)
+	AssertNoError(t, err)
+	task := getResp.Task
+	if task.Title != "New Task" || task.Description != "Created from the TUI" || task.ProjectID != "project-1" ||
+		task.Status != "todo" || task.TaskOrder != 60 || task.Feature == nil || *task.Feature != "ui" {
+		t.Errorf("Expected the created task on the server, got %+v", task)
+	}
+}
+
 func TestClient_ListProjects(t *testing.T) {
 	server := SetupMockServerWithData()
 	defer server.Close()
@@ -454,6 +531,579 @@ func TestClient_ContentType(t *testing.T) {
 	}
 }
 
+func TestClient_Forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "project is read-only", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key")
+
+	t.Run("update returns ErrForbidden", func(t *testing.T) {
+		_, err := client.UpdateTask("task-1", UpdateTaskRequest{Status: stringPtr("doing")})
+		if !errors.Is(err, ErrForbidden) {
+			t.Fatalf("Expected ErrForbidden, got %v", err)
+		}
+		AssertErrorContains(t, err, "403")
+	})
+
+	t.Run("delete returns ErrForbidden", func(t *testing.T) {
+		err := client.DeleteTask("task-1")
+		if !errors.Is(err, ErrForbidden) {
+			t.Fatalf("Expected ErrForbidden, got %v", err)
+		}
+	})
+}
+
+func TestClient_DeleteNotSupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL, "test-key").DeleteTask("task-1")
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+	AssertErrorContains(t, err, "405")
+}
+
+func TestClient_RequestErrorContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/tasks":
+			http.Error(w, "expired", http.StatusUnauthorized)
+		case r.Method == http.MethodPut:
+			http.Error(w, "read-only", http.StatusForbidden)
+		default:
+			http.Error(w, "gone", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+
+	tests := []struct {
+		name   string
+		call   func() error
+		op     string
+		method string
+		path   string
+		status int
+		want   error
+	}{
+		{"list", func() error { _, err := client.ListTasks(nil, nil, false); return err },
+			"list tasks", "GET", "/api/tasks", 401, ErrUnauthorized},
+		{"update", func() error {
+			_, err := client.UpdateTask("t1", UpdateTaskRequest{Status: stringPtr("done")})
+			return err
+		}, "update task", "PUT", "/api/tasks/t1", 403, ErrForbidden},
+		{"delete", func() error { return client.DeleteTask("t1") }, "delete task", "DELETE", "/api/tasks/t1", 404, ErrTaskNotFound},
+		{"search", func() error { _, err := client.SearchTasks("login", nil); return err },
+			"search tasks", "GET", "/api/tasks/search", 404, ErrNotSupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Expected %v to match %v", err, tt.want)
+			}
+			var reqErr *RequestError
+			if !errors.As(err, &reqErr) {
+				t.Fatalf("Expected a RequestError, got %T", err)
+			}
+			if reqErr.Op != tt.op || reqErr.Method != tt.method || reqErr.StatusCode != tt.status ||
+				!strings.HasPrefix(reqErr.URL, server.URL+tt.path) {
+				t.Errorf("Expected %s %s %s%s with status %d, got %+v", tt.op, tt.method, server.URL, tt.path, tt.status, reqErr)
+			}
+			AssertErrorContains(t, err, tt.op+": "+tt.method+" "+server.URL+tt.path)
+		})
+	}
+
+	t.Run("no response", func(t *testing.T) {
+		_, err := NewClient("http://127.0.0.1:1", "test-key").GetProject("p1")
+		var reqErr *RequestError
+		if !errors.As(err, &reqErr) || reqErr.Op != "get project" || reqErr.StatusCode != 0 {
+			t.Errorf("Expected a get project error without a status, got %v", err)
+		}
+	})
+}
+
+func TestClient_ListTasksPaged(t *testing.T) {
+	var header, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("limit") != "2" || query.Get("offset") != "4" || query.Get("per_page") != "2" || query.Get("page") != "3" ||
+			query.Get("include_closed") != "true" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		if header != "" {
+			w.Header().Set(HeaderTotalCount, header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+	twoTasks := `[{"id": "t5", "title": "Five", "status": "todo"}, {"id": "t6", "title": "Six", "status": "todo"}]`
+
+	tests := []struct {
+		name        string
+		header      string
+		body        string
+		wantTotal   int
+		wantHasMore bool
+	}{
+		{"total header", "7", `{"tasks": ` + twoTasks + `}`, 7, true},
+		{"last page by total", "6", `{"tasks": ` + twoTasks + `}`, 6, false},
+		{"body fields", "", `{"tasks": ` + twoTasks + `, "total": 9, "has_more": true}`, 9, true},
+		{"total_count field", "", `{"tasks": ` + twoTasks + `, "total_count": 6}`, 6, false},
+		{"full page without total", "", `{"tasks": ` + twoTasks + `}`, 0, true},
+		{"short page without total", "", `{"tasks": [{"id": "t5", "title": "Five", "status": "todo"}]}`, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, body = tt.header, tt.body
+			resp, err := client.ListTasksPaged(ListTasksOptions{IncludeClosed: true, Offset: 4, Limit: 2})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if resp.Offset != 4 || resp.Total != tt.wantTotal || resp.HasMore != tt.wantHasMore {
+				t.Errorf("Expected offset 4, total %d, has more %v; got %d, %d, %v",
+					tt.wantTotal, tt.wantHasMore, resp.Offset, resp.Total, resp.HasMore)
+			}
+		})
+	}
+}
+
+func TestClient_ListTasksPage(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tasks": [{"id": "t5", "title": "Five", "status": "todo"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+	projectID, status := "p1", "todo"
+
+	resp, err := client.ListTasksPage(&projectID, &status, 3, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if query.Get("page") != "3" || query.Get("per_page") != "2" || query.Get("offset") != "4" ||
+		query.Get("project_id") != "p1" || query.Get("status") != "todo" {
+		t.Errorf("Expected page 3 of 2 for p1/todo, got %v", query)
+	}
+	if resp.Offset != 4 || len(resp.Tasks) != 1 {
+		t.Errorf("Expected the page at offset 4, got %+v", resp)
+	}
+
+	if _, err := client.ListTasksPage(nil, nil, 0, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if query.Get("page") != "1" || query.Get("per_page") != strconv.Itoa(DefaultPageSize) {
+		t.Errorf("Expected the first page at the default size, got %v", query)
+	}
+}
+
+func TestClient_ListTasksAll(t *testing.T) {
+	all := []string{"t1", "t2", "t3", "t4", "t5"}
+	ignoreOffset := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if ignoreOffset {
+			offset = 0
+		}
+		var tasks []Task
+		for _, id := range all[min(offset, len(all)):min(offset+2, len(all))] {
+			tasks = append(tasks, Task{ID: id, Title: id, Status: "todo"})
+		}
+		w.Header().Set(HeaderTotalCount, strconv.Itoa(len(all)))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TasksResponse{Tasks: tasks})
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+
+	collect := func(onPage func(page *TasksResponse) error) ([][]string, error) {
+		var pages [][]string
+		err := client.ListTasksAll(ListTasksOptions{Limit: 2}, func(page *TasksResponse) error {
+			var ids []string
+			for _, task := range page.Tasks {
+				ids = append(ids, task.ID)
+			}
+			pages = append(pages, ids)
+			return onPage(page)
+		})
+		return pages, err
+	}
+	keepGoing := func(*TasksResponse) error { return nil }
+
+	pages, err := collect(keepGoing)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := [][]string{{"t1", "t2"}, {"t3", "t4"}, {"t5"}}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("Expected pages %v, got %v", want, pages)
+	}
+
+	stop := errors.New("stop")
+	pages, err = collect(func(*TasksResponse) error { return stop })
+	if !errors.Is(err, stop) || len(pages) != 1 {
+		t.Errorf("Expected the callback's error after one page, got %v after %d", err, len(pages))
+	}
+
+	// Cancelling stops before the next page is requested
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pages = nil
+	err = client.ListTasksAllContext(ctx, ListTasksOptions{Limit: 2}, func(page *TasksResponse) error {
+		pages = append(pages, nil)
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || len(pages) != 1 {
+		t.Errorf("Expected cancellation after one page, got %v after %d", err, len(pages))
+	}
+
+	// A server ignoring the offset repeats the first page; that ends the loop
+	ignoreOffset = true
+	pages, err = collect(keepGoing)
+	if err != nil || len(pages) != 1 {
+		t.Errorf("Expected one page from a server ignoring the offset, got %v (%v)", pages, err)
+	}
+}
+
+func TestClient_ContextCancel(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/api/projects" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		<-r.Context().Done() // Hang until the client gives up
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "old-key")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	_, err := client.ListTasksContext(ctx, nil, nil, true)
+	var reqErr *RequestError
+	if !errors.Is(err, context.Canceled) || !errors.As(err, &reqErr) || reqErr.Op != "list tasks" {
+		t.Errorf("Expected a cancelled list tasks request, got %v", err)
+	}
+
+	// Cancelled while the key is re-resolved after a 401: no retry
+	requests.Store(0)
+	ctx, cancel = context.WithCancel(context.Background())
+	client.SetAPIKeySource(func() (string, error) {
+		cancel()
+		return "new-key", nil
+	})
+	if _, err := client.ListProjectsContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the cancellation reported, got %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected no retry after cancelling, got %d requests", n)
+	}
+
+	// Search and health checks take a context too
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.SearchTasksContext(ctx, "login", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the search cancelled, got %v", err)
+	}
+	if err := client.HealthCheckContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the health check cancelled, got %v", err)
+	}
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	var gets, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"task": {"id": "t1", "title": "One", "status": "doing"}}`))
+			return
+		}
+		gets++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tasks": [{"id": "t1", "title": "One", "status": "todo"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+
+	listOne := func() {
+		t.Helper()
+		resp, err := client.ListTasks(nil, nil, false)
+		if err != nil || len(resp.Tasks) != 1 || resp.Tasks[0].ID != "t1" {
+			t.Fatalf("Expected task t1, got %+v (err %v)", resp, err)
+		}
+	}
+
+	// Disabled by default: every call asks the server
+	listOne()
+	listOne()
+	if gets != 2 {
+		t.Fatalf("Expected 2 requests without a cache, got %d", gets)
+	}
+
+	client.SetCacheTTL(time.Hour)
+	gets = 0
+	listOne()
+	listOne()
+	if gets != 1 {
+		t.Errorf("Expected a fresh entry to be served from the cache, got %d requests", gets)
+	}
+
+	// A stale entry is revalidated, and a 304 reuses its body
+	client.cacheMu.Lock()
+	for key, entry := range client.cache {
+		entry.stored = time.Now().Add(-2 * time.Hour)
+		client.cache[key] = entry
+	}
+	client.cacheMu.Unlock()
+	listOne()
+	if gets != 2 || notModified != 1 {
+		t.Errorf("Expected one revalidation answered with 304, got %d requests and %d 304s", gets, notModified)
+	}
+	listOne()
+	if gets != 2 {
+		t.Errorf("Expected the revalidated entry to be fresh again, got %d requests", gets)
+	}
+
+	// Mutations drop the cache
+	if _, err := client.UpdateTask("t1", UpdateTaskRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	listOne()
+	if gets != 3 || notModified != 1 {
+		t.Errorf("Expected a full request after an update, got %d requests and %d 304s", gets, notModified)
+	}
+
+	client.InvalidateCache()
+	listOne()
+	if gets != 4 {
+		t.Errorf("Expected a request after InvalidateCache, got %d requests", gets)
+	}
+}
+
+func TestClient_ResponseCacheRacingUpdate(t *testing.T) {
+	var mu sync.Mutex
+	status, gets := "todo", 0
+	arrived, release := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != "GET" {
+			status = "doing"
+			_, _ = w.Write([]byte(`{"task": {"id": "t1", "title": "One", "status": "doing"}}`))
+			return
+		}
+		gets++
+		body := `{"tasks": [{"id": "t1", "title": "One", "status": "` + status + `"}]}`
+		if gets == 1 {
+			// Hold the first list until the update went through
+			mu.Unlock()
+			close(arrived)
+			<-release
+			mu.Lock()
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+	client.SetCacheTTL(time.Hour)
+
+	done := make(chan error)
+	go func() {
+		_, err := client.ListTasks(nil, nil, false)
+		done <- err
+	}()
+	<-arrived
+	if _, err := client.UpdateTask("t1", UpdateTaskRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The list sent before the update must not be served afterwards
+	resp, err := client.ListTasks(nil, nil, false)
+	if err != nil || len(resp.Tasks) != 1 || resp.Tasks[0].Status != "doing" {
+		t.Errorf("Expected the updated task, got %+v (err %v)", resp, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if gets != 2 {
+		t.Errorf("Expected the reload to reach the server, got %d requests", gets)
+	}
+}
+
+func TestClient_SearchTasks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tasks/search" || r.URL.Query().Get("q") != "login bug" || r.URL.Query().Get("project_id") != "p1" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tasks": [{"id": "t1", "title": "Fix login bug", "status": "todo"}], "count": 1}`))
+	}))
+	defer server.Close()
+
+	project := "p1"
+	resp, err := NewClient(server.URL, "test-key").SearchTasks("login bug", &project)
+	if err != nil || len(resp.Tasks) != 1 || resp.Tasks[0].ID != "t1" {
+		t.Fatalf("Expected the matching task, got %+v (err %v)", resp, err)
+	}
+}
+
+func TestClient_SearchNotSupported(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "test-key").SearchTasks("login", nil)
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+	AssertErrorContains(t, err, "404")
+}
+
+func TestClient_UnauthorizedRetriesWithFreshKey(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seen = append(seen, auth)
+		if auth != "Bearer rotated-key" {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPut && len(body) == 0 {
+			http.Error(w, "empty body on retry", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(TaskResponse{Task: Task{ID: "task-1", Status: "doing"}})
+	}))
+	defer server.Close()
+
+	t.Run("retries once with the re-resolved key", func(t *testing.T) {
+		seen = nil
+		client := NewClient(server.URL, "old-key")
+		calls := 0
+		client.SetAPIKeySource(func() (string, error) {
+			calls++
+			return "rotated-key", nil
+		})
+
+		if _, err := client.UpdateTask("task-1", UpdateTaskRequest{Status: stringPtr("doing")}); err != nil {
+			t.Fatalf("Expected the retry to succeed, got %v", err)
+		}
+		if calls != 1 || len(seen) != 2 || seen[1] != "Bearer rotated-key" {
+			t.Errorf("Expected one re-resolution and one retry, got %d calls and requests %v", calls, seen)
+		}
+
+		// The new key is kept for later requests
+		seen = nil
+		if _, err := client.GetTask("task-1"); err != nil || len(seen) != 1 {
+			t.Errorf("Expected a single request with the kept key, got %v (%v)", seen, err)
+		}
+	})
+
+	t.Run("no retry when the key did not change", func(t *testing.T) {
+		seen = nil
+		client := NewClient(server.URL, "old-key")
+		client.SetAPIKeySource(func() (string, error) { return "old-key", nil })
+
+		_, err := client.GetTask("task-1")
+		if !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("Expected ErrUnauthorized, got %v", err)
+		}
+		AssertErrorContains(t, err, "401")
+		if len(seen) != 1 {
+			t.Errorf("Expected no retry, got requests %v", seen)
+		}
+	})
+
+	t.Run("no retry when the source fails", func(t *testing.T) {
+		seen = nil
+		client := NewClient(server.URL, "old-key")
+		client.SetAPIKeySource(func() (string, error) { return "", errors.New("command failed") })
+
+		if err := client.DeleteTask("task-1"); !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("Expected ErrUnauthorized, got %v", err)
+		}
+		if len(seen) != 1 {
+			t.Errorf("Expected no retry, got requests %v", seen)
+		}
+	})
+
+	t.Run("a rejected fresh key is not retried again", func(t *testing.T) {
+		seen = nil
+		client := NewClient(server.URL, "old-key")
+		next := 0
+		client.SetAPIKeySource(func() (string, error) {
+			next++
+			return fmt.Sprintf("wrong-key-%d", next), nil
+		})
+
+		if _, err := client.GetTask("task-1"); !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("Expected ErrUnauthorized, got %v", err)
+		}
+		if len(seen) != 2 || next != 1 {
+			t.Errorf("Expected exactly one retry, got %d resolutions and requests %v", next, seen)
+		}
+	})
+
+	t.Run("without a source", func(t *testing.T) {
+		seen = nil
+		client := NewClient(server.URL, "old-key")
+		if _, err := client.ListProjects(); !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("Expected ErrUnauthorized, got %v", err)
+		}
+		if len(seen) != 1 {
+			t.Errorf("Expected no retry, got requests %v", seen)
+		}
+	})
+}
+
+func TestProject_IsReadOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		expected bool
+	}{
+		{"read permission", `{"id":"p1","permission":"read"}`, true},
+		{"viewer permission", `{"id":"p1","permission":"viewer"}`, true},
+		{"write permission", `{"id":"p1","permission":"write"}`, false},
+		{"permission not reported", `{"id":"p1"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var project Project
+			if err := json.Unmarshal([]byte(tt.payload), &project); err != nil {
+				t.Fatalf("Unexpected unmarshal error: %v", err)
+			}
+			if project.IsReadOnly() != tt.expected {
+				t.Errorf("Expected IsReadOnly() = %v for %s", tt.expected, tt.payload)
+			}
+		})
+	}
+}
+
 // Benchmark tests
 
 func BenchmarkClient_ListTasks(b *testing.B) {
@@ -511,4 +1161,121 @@ func BenchmarkClient_UpdateTask(b *testing.B) {
 	}
 }
 
+func TestClient_ObservesServerClockSkew(t *testing.T) {
+	serverNow := time.Now().Add(-40 * time.Minute) // Local clock 40 minutes fast
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", serverNow.UTC().Format(http.TimeFormat))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"tasks": []}`))
+	}))
+	defer server.Close()
+
+	estimator := clock.NewSkewEstimator(clock.DefaultSkewThreshold)
+	client := NewClient(server.URL, "test-key")
+	client.SetClockSkewEstimator(estimator)
+
+	// Error responses are not trusted as clock samples
+	status = http.StatusInternalServerError
+	_, _ = client.ListTasks(nil, nil, true)
+	if estimator.Significant() {
+		t.Fatal("Expected error responses to be ignored")
+	}
+
+	status = http.StatusOK
+	_, err := client.ListTasks(nil, nil, true)
+	AssertNoError(t, err)
+
+	// Date has second resolution, so allow a little slack around 40m
+	if skew := estimator.Skew(); skew < 39*time.Minute || skew > 41*time.Minute {
+		t.Errorf("Expected roughly 40m skew, got %v", skew)
+	}
+}
+
 // Helper function is defined in test_fixtures.go
+
+// TestClient_MalformedResponses serves damaged bodies from every read and
+// update endpoint and checks that nothing decoded from them is returned
+func TestClient_MalformedResponses(t *testing.T) {
+	server := SetupMockServerWithData()
+	defer server.Close()
+	client := NewClient(server.URL, "test-key")
+
+	taskID := SampleTasks()[0].ID
+	projectID := SampleProjects()[0].ID
+	done := TaskStatusDone
+	endpoints := []struct {
+		name     string
+		endpoint string
+		key      string // Payload key of the response
+		call     func() (any, error)
+	}{
+		{"ListTasks", "tasks", "tasks", func() (any, error) { return client.ListTasks(nil, nil, true) }},
+		{"GetTask", "task_by_id", "task", func() (any, error) { return client.GetTask(taskID) }},
+		{"UpdateTask", "task_by_id", "task", func() (any, error) {
+			return client.UpdateTask(taskID, UpdateTaskRequest{Status: &done})
+		}},
+		{"ListProjects", "projects", "projects", func() (any, error) { return client.ListProjects() }},
+		{"GetProject", "project_by_id", "project", func() (any, error) { return client.GetProject(projectID) }},
+	}
+	faults := []struct {
+		name    string
+		corrupt func(key string) Corruption
+	}{
+		{"truncated", func(string) Corruption { return TruncatedBody }},
+		{"half-written", func(string) Corruption { return HalfWrittenBody }},
+		{"duplicated key", DuplicatedKey},
+		{"wrong type", func(string) Corruption { return WrongType("id") }},
+	}
+
+	for _, endpoint := range endpoints {
+		for _, fault := range faults {
+			t.Run(endpoint.name+"/"+fault.name, func(t *testing.T) {
+				server.SetCorruption(endpoint.endpoint, fault.corrupt(endpoint.key))
+				defer server.SetCorruption(endpoint.endpoint, nil)
+
+				resp, err := endpoint.call()
+				if !errors.Is(err, ErrMalformedResponse) {
+					t.Fatalf("Expected ErrMalformedResponse, got %v", err)
+				}
+				if value := reflect.ValueOf(resp); !value.IsNil() {
+					t.Errorf("Expected no response, got %+v", resp)
+				}
+			})
+		}
+	}
+
+	// Intact responses, including empty lists, still decode
+	if resp, err := client.ListTasks(nil, nil, true); err != nil || len(resp.Tasks) == 0 {
+		t.Errorf("Expected tasks after the faults, got %v, %v", resp, err)
+	}
+	empty := NewMockServer()
+	defer empty.Close()
+	if resp, err := NewClient(empty.URL, "test-key").ListTasks(nil, nil, true); err != nil || len(resp.Tasks) != 0 {
+		t.Errorf("Expected an empty list to be valid, got %v, %v", resp, err)
+	}
+}
+
+func TestCheckDuplicateKeys(t *testing.T) {
+	valid := []string{
+		`{"tasks":[{"id":"a","status":"todo"},{"id":"b","status":"done"}],"count":2}`,
+		`{"task":{"id":"a","sources":[{"url":"x"},{"url":"y"}]},"id":"outer"}`,
+		`[]`,
+	}
+	for _, body := range valid {
+		if err := checkDuplicateKeys([]byte(body)); err != nil {
+			t.Errorf("checkDuplicateKeys(%s) = %v, want nil", body, err)
+		}
+	}
+
+	duplicated := []string{
+		`{"tasks":[],"tasks":null}`,
+		`{"tasks":[{"id":"a","id":""}]}`,
+		`{"task":{"sources":[{"url":"x","url":"y"}]}}`,
+	}
+	for _, body := range duplicated {
+		if err := checkDuplicateKeys([]byte(body)); err == nil {
+			t.Errorf("checkDuplicateKeys(%s) = nil, want an error", body)
+		}
+	}
+}
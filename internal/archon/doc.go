@@ -31,6 +31,40 @@
 //		// Handle task not found specifically
 //	}
 //
+// Every error from a Client method is a *RequestError naming the operation,
+// URL and HTTP status, and wrapping the typed error underneath:
+//
+//	var reqErr *archon.RequestError
+//	if errors.As(err, &reqErr) {
+//		log.Printf("%s failed with status %d", reqErr.Op, reqErr.StatusCode)
+//	}
+//
+// # Response Cache
+//
+// Polling clients can reuse list responses instead of refetching them:
+//
+//	client.SetCacheTTL(5 * time.Second)
+//
+// ListTasks and ListProjects then answer from the cache for the TTL and
+// revalidate older responses with If-None-Match. Creating, updating or
+// deleting a task drops the cache; InvalidateCache drops it on demand.
+//
+// # Cancellation
+//
+// Every request method has a Context variant (ListTasksContext,
+// GetTaskContext, UpdateTaskContext, SearchTasksContext, HealthCheckContext,
+// ...) whose request is abandoned when the context is cancelled:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	response, err := client.ListTasksContext(ctx, nil, nil, true)
+//	if errors.Is(err, context.Canceled) {
+//		// A newer load replaced this one
+//	}
+//
+// The retry after a 401 is skipped once the context is done. A cancelled
+// create, update or delete may still have reached the server.
+//
 // # Thread Safety
 //
 // The client implementation is thread-safe and can be used concurrently
@@ -0,0 +1,140 @@
+package archon
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Response metadata headers sent by the Archon API
+const (
+	HeaderTotalCount         = "X-Total-Count"
+	HeaderLink               = "Link"
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+	HeaderRequestID          = "X-Request-ID"
+)
+
+// resetEpochThreshold separates X-RateLimit-Reset values given as Unix
+// timestamps from values given as seconds until reset
+const resetEpochThreshold = 1_000_000_000
+
+// ResponseMeta is the metadata the API sends in response headers.
+// Zero values mean the header was absent.
+type ResponseMeta struct {
+	TotalCount int        // X-Total-Count: items across all pages
+	HasTotal   bool       // Whether X-Total-Count was sent (0 is a valid total)
+	NextCursor string     // Cursor of the rel="next" Link, if any
+	PrevCursor string     // Cursor of the rel="prev" Link, if any
+	RateLimit  *RateLimit // Rate-limit state; nil when not sent
+	RequestID  string     // X-Request-ID, for correlating with server logs
+	ServerTime time.Time  // Date header
+}
+
+// RateLimit is the rate-limit state reported with a response
+type RateLimit struct {
+	Limit     int       // Requests allowed per window; 0 when not sent
+	Remaining int       // Requests left in the current window
+	Reset     time.Time // When the window resets; zero when not sent
+}
+
+// Low reports whether at most a fifth of the window's requests are left.
+// Without a known limit, ten or fewer remaining requests count as low.
+func (r *RateLimit) Low() bool {
+	if r == nil {
+		return false
+	}
+	if r.Limit > 0 {
+		return r.Remaining*5 <= r.Limit
+	}
+	return r.Remaining <= 10
+}
+
+// ParseResponseMeta reads response metadata from headers.
+// received anchors relative reset times when the server sends no Date header.
+func ParseResponseMeta(header http.Header, received time.Time) ResponseMeta {
+	meta := ResponseMeta{RequestID: header.Get(HeaderRequestID)}
+
+	if serverTime, err := http.ParseTime(header.Get("Date")); err == nil {
+		meta.ServerTime = serverTime
+	}
+	if total, err := strconv.Atoi(header.Get(HeaderTotalCount)); err == nil && total >= 0 {
+		meta.TotalCount, meta.HasTotal = total, true
+	}
+	meta.NextCursor, meta.PrevCursor = parseLinkCursors(header.Get(HeaderLink))
+
+	remaining, err := strconv.Atoi(header.Get(HeaderRateLimitRemaining))
+	if err != nil {
+		return meta
+	}
+	rateLimit := &RateLimit{Remaining: remaining}
+	if limit, err := strconv.Atoi(header.Get(HeaderRateLimitLimit)); err == nil {
+		rateLimit.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(header.Get(HeaderRateLimitReset), 10, 64); err == nil && reset >= 0 {
+		if reset >= resetEpochThreshold {
+			rateLimit.Reset = time.Unix(reset, 0)
+		} else {
+			anchor := received
+			if !meta.ServerTime.IsZero() {
+				anchor = meta.ServerTime
+			}
+			rateLimit.Reset = anchor.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	meta.RateLimit = rateLimit
+	return meta
+}
+
+// parseLinkCursors extracts the next and prev cursors from an RFC 8288 Link header,
+// e.g. `</api/tasks?cursor=abc>; rel="next"`. The cursor is the link's "cursor"
+// query parameter, falling back to "page"; links carrying neither are ignored.
+func parseLinkCursors(header string) (next, prev string) {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		parsed, err := url.Parse(target[1 : len(target)-1])
+		if err != nil {
+			continue
+		}
+		cursor := parsed.Query().Get("cursor")
+		if cursor == "" {
+			cursor = parsed.Query().Get("page")
+		}
+		if cursor == "" {
+			continue
+		}
+
+		for _, param := range parts[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || !strings.EqualFold(name, "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+				switch strings.ToLower(rel) {
+				case "next":
+					next = cursor
+				case "prev", "previous":
+					prev = cursor
+				}
+			}
+		}
+	}
+	return next, prev
+}
+
+// metaCarrier is implemented by response types that carry ResponseMeta
+type metaCarrier interface {
+	setMeta(meta ResponseMeta)
+}
+
+func (r *TasksResponse) setMeta(meta ResponseMeta)    { r.Meta = meta }
+func (r *TaskResponse) setMeta(meta ResponseMeta)     { r.Meta = meta }
+func (r *ProjectsResponse) setMeta(meta ResponseMeta) { r.Meta = meta }
+func (r *ProjectResponse) setMeta(meta ResponseMeta)  { r.Meta = meta }
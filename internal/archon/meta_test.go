@@ -0,0 +1,85 @@
+package archon
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseResponseMeta(t *testing.T) {
+	serverTime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	header.Set("Date", serverTime.Format(http.TimeFormat))
+	header.Set(HeaderTotalCount, "250")
+	header.Set(HeaderLink, `</api/tasks?cursor=c3>; rel="next", </api/tasks?cursor=c1>; rel="prev", </api/tasks>; rel="first"`)
+	header.Set(HeaderRateLimitLimit, "100")
+	header.Set(HeaderRateLimitRemaining, "15")
+	header.Set(HeaderRateLimitReset, "30")
+	header.Set(HeaderRequestID, "req-42")
+
+	meta := ParseResponseMeta(header, time.Now())
+
+	if !meta.HasTotal || meta.TotalCount != 250 {
+		t.Errorf("Expected total count 250, got %d (present %v)", meta.TotalCount, meta.HasTotal)
+	}
+	if meta.NextCursor != "c3" || meta.PrevCursor != "c1" {
+		t.Errorf("Expected cursors c3/c1, got %q/%q", meta.NextCursor, meta.PrevCursor)
+	}
+	if meta.RequestID != "req-42" || !meta.ServerTime.Equal(serverTime) {
+		t.Errorf("Unexpected request ID or server time: %+v", meta)
+	}
+	if meta.RateLimit == nil || meta.RateLimit.Limit != 100 || meta.RateLimit.Remaining != 15 {
+		t.Fatalf("Expected rate limit 15/100, got %+v", meta.RateLimit)
+	}
+	// Relative resets are anchored to the server's Date
+	if !meta.RateLimit.Reset.Equal(serverTime.Add(30 * time.Second)) {
+		t.Errorf("Expected reset 30s after server time, got %v", meta.RateLimit.Reset)
+	}
+	if !meta.RateLimit.Low() {
+		t.Error("Expected 15 of 100 remaining to count as low")
+	}
+}
+
+func TestParseResponseMeta_Absent(t *testing.T) {
+	meta := ParseResponseMeta(http.Header{}, time.Now())
+
+	if meta.HasTotal || meta.NextCursor != "" || meta.RateLimit != nil || !meta.ServerTime.IsZero() {
+		t.Errorf("Expected empty metadata without headers, got %+v", meta)
+	}
+	if meta.RateLimit.Low() {
+		t.Error("Expected an unknown rate limit not to count as low")
+	}
+}
+
+func TestParseResponseMeta_EpochReset(t *testing.T) {
+	header := http.Header{}
+	header.Set(HeaderRateLimitRemaining, "3")
+	header.Set(HeaderRateLimitReset, "1740830400")
+
+	meta := ParseResponseMeta(header, time.Now())
+	if meta.RateLimit == nil || !meta.RateLimit.Reset.Equal(time.Unix(1740830400, 0)) {
+		t.Errorf("Expected Unix timestamp reset, got %+v", meta.RateLimit)
+	}
+}
+
+func TestClient_ResponseMeta(t *testing.T) {
+	server := SetupMockServerWithData()
+	defer server.Close()
+	server.SetRateLimit(10)
+
+	client := NewClient(server.URL, "test-key")
+	tasks, err := client.ListTasks(nil, nil, true)
+	AssertNoError(t, err)
+	projects, err := client.ListProjects()
+	AssertNoError(t, err)
+
+	if !tasks.Meta.HasTotal || tasks.Meta.TotalCount != len(tasks.Tasks) {
+		t.Errorf("Expected total count %d, got %+v", len(tasks.Tasks), tasks.Meta)
+	}
+	if tasks.Meta.RequestID == "" || tasks.Meta.ServerTime.IsZero() {
+		t.Errorf("Expected request ID and server time, got %+v", tasks.Meta)
+	}
+	if projects.Meta.RateLimit == nil || projects.Meta.RateLimit.Remaining != 8 || projects.Meta.RateLimit.Limit != 10 {
+		t.Errorf("Expected 8/10 remaining after two requests, got %+v", projects.Meta.RateLimit)
+	}
+}
@@ -3,6 +3,7 @@ package archon
 import (
 	"fmt"
 	"sync"
+	"time"
 )
 
 // MockClient provides a test implementation of ClientInterface
@@ -13,7 +14,9 @@ type MockClient struct {
 	// Method call recording
 	ListTasksCalls    []ListTasksCall
 	GetTaskCalls      []GetTaskCall
+	CreateTaskCalls   []CreateTaskRequest
 	UpdateTaskCalls   []UpdateTaskCall
+	DeleteTaskCalls   []string
 	ListProjectsCalls []ListProjectsCall
 	GetProjectCalls   []GetProjectCall
 	HealthCheckCalls  []HealthCheckCall
@@ -23,8 +26,12 @@ type MockClient struct {
 	ListTasksError       error
 	GetTaskResponse      *TaskResponse
 	GetTaskError         error
+	CreateTaskResponse   *TaskResponse
+	CreateTaskError      error
 	UpdateTaskResponse   *TaskResponse
 	UpdateTaskError      error
+	UpdateTaskErrors     map[string]error // Per task ID, checked before UpdateTaskError
+	DeleteTaskError      error
 	ListProjectsResponse *ProjectsResponse
 	ListProjectsError    error
 	GetProjectResponse   *ProjectResponse
@@ -59,22 +66,43 @@ type GetProjectCall struct {
 
 type HealthCheckCall struct{}
 
+// MockResponseMeta returns representative header metadata for mock responses:
+// a total count, a request ID and a rate limit with most of the window left
+func MockResponseMeta(total int) ResponseMeta {
+	return ResponseMeta{
+		TotalCount: total,
+		HasTotal:   true,
+		RequestID:  "mock-request",
+		RateLimit: &RateLimit{
+			Limit:     MockRateLimit,
+			Remaining: MockRateLimit - 1,
+			Reset:     time.Now().Add(mockRateLimitWindow * time.Second),
+		},
+		ServerTime: time.Now(),
+	}
+}
+
 // NewMockClient creates a new mock client with default successful responses
 func NewMockClient() *MockClient {
 	return &MockClient{
 		ListTasksResponse: &TasksResponse{
 			Tasks: []Task{},
 			Count: 0,
+			Meta:  MockResponseMeta(0),
 		},
 		GetTaskResponse: &TaskResponse{
 			Task: Task{},
 		},
+		CreateTaskResponse: &TaskResponse{
+			Task: Task{},
+		},
 		UpdateTaskResponse: &TaskResponse{
 			Task: Task{},
 		},
 		ListProjectsResponse: &ProjectsResponse{
 			Projects: []Project{},
 			Count:    0,
+			Meta:     MockResponseMeta(0),
 		},
 		GetProjectResponse: &ProjectResponse{
 			Project: Project{},
@@ -119,6 +147,21 @@ func (m *MockClient) GetTask(taskID string) (*TaskResponse, error) {
 	return m.GetTaskResponse, nil
 }
 
+// CreateTask mock implementation
+func (m *MockClient) CreateTask(req CreateTaskRequest) (*TaskResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Record the call
+	m.CreateTaskCalls = append(m.CreateTaskCalls, req)
+
+	// Return configured response/error
+	if m.CreateTaskError != nil {
+		return nil, m.CreateTaskError
+	}
+	return m.CreateTaskResponse, nil
+}
+
 // UpdateTask mock implementation
 func (m *MockClient) UpdateTask(taskID string, updates UpdateTaskRequest) (*TaskResponse, error) {
 	m.mu.Lock()
@@ -131,12 +174,26 @@ func (m *MockClient) UpdateTask(taskID string, updates UpdateTaskRequest) (*Task
 	})
 
 	// Return configured response/error
+	if err := m.UpdateTaskErrors[taskID]; err != nil {
+		return nil, err
+	}
 	if m.UpdateTaskError != nil {
 		return nil, m.UpdateTaskError
 	}
 	return m.UpdateTaskResponse, nil
 }
 
+// DeleteTask mock implementation
+func (m *MockClient) DeleteTask(taskID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Record the call
+	m.DeleteTaskCalls = append(m.DeleteTaskCalls, taskID)
+
+	return m.DeleteTaskError
+}
+
 // ListProjects mock implementation
 func (m *MockClient) ListProjects() (*ProjectsResponse, error) {
 	m.mu.Lock()
@@ -199,6 +256,14 @@ func (m *MockClient) SetGetTaskResponse(response *TaskResponse, err error) {
 	m.GetTaskError = err
 }
 
+// SetCreateTaskResponse configures the response for CreateTask calls
+func (m *MockClient) SetCreateTaskResponse(response *TaskResponse, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.CreateTaskResponse = response
+	m.CreateTaskError = err
+}
+
 // SetUpdateTaskResponse configures the response for UpdateTask calls
 func (m *MockClient) SetUpdateTaskResponse(response *TaskResponse, err error) {
 	m.mu.Lock()
@@ -207,6 +272,28 @@ func (m *MockClient) SetUpdateTaskResponse(response *TaskResponse, err error) {
 	m.UpdateTaskError = err
 }
 
+// SetUpdateTaskErrorFor makes UpdateTask calls for one task fail with err;
+// a nil err lets them succeed again
+func (m *MockClient) SetUpdateTaskErrorFor(taskID string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		delete(m.UpdateTaskErrors, taskID)
+		return
+	}
+	if m.UpdateTaskErrors == nil {
+		m.UpdateTaskErrors = make(map[string]error)
+	}
+	m.UpdateTaskErrors[taskID] = err
+}
+
+// SetDeleteTaskError configures the error for DeleteTask calls
+func (m *MockClient) SetDeleteTaskError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.DeleteTaskError = err
+}
+
 // SetListProjectsResponse configures the response for ListProjects calls
 func (m *MockClient) SetListProjectsResponse(response *ProjectsResponse, err error) {
 	m.mu.Lock()
@@ -246,6 +333,13 @@ func (m *MockClient) GetGetTaskCallCount() int {
 	return len(m.GetTaskCalls)
 }
 
+// GetCreateTaskCallCount returns the number of CreateTask calls made
+func (m *MockClient) GetCreateTaskCallCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.CreateTaskCalls)
+}
+
 // GetUpdateTaskCallCount returns the number of UpdateTask calls made
 func (m *MockClient) GetUpdateTaskCallCount() int {
 	m.mu.RLock()
@@ -253,6 +347,25 @@ func (m *MockClient) GetUpdateTaskCallCount() int {
 	return len(m.UpdateTaskCalls)
 }
 
+// GetDeleteTaskCallCount returns the number of DeleteTask calls made
+func (m *MockClient) GetDeleteTaskCallCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.DeleteTaskCalls)
+}
+
+// LastUpdateTaskCall returns the last update sent for a task, if any
+func (m *MockClient) LastUpdateTaskCall(taskID string) (UpdateTaskRequest, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for i := len(m.UpdateTaskCalls) - 1; i >= 0; i-- {
+		if m.UpdateTaskCalls[i].TaskID == taskID {
+			return m.UpdateTaskCalls[i].Updates, true
+		}
+	}
+	return UpdateTaskRequest{}, false
+}
+
 // GetListProjectsCallCount returns the number of ListProjects calls made
 func (m *MockClient) GetListProjectsCallCount() int {
 	m.mu.RLock()
@@ -282,7 +395,9 @@ func (m *MockClient) Reset() {
 	// Clear all call recordings
 	m.ListTasksCalls = nil
 	m.GetTaskCalls = nil
+	m.CreateTaskCalls = nil
 	m.UpdateTaskCalls = nil
+	m.DeleteTaskCalls = nil
 	m.ListProjectsCalls = nil
 	m.GetProjectCalls = nil
 	m.HealthCheckCalls = nil
@@ -292,8 +407,12 @@ func (m *MockClient) Reset() {
 	m.ListTasksError = nil
 	m.GetTaskResponse = &TaskResponse{Task: Task{}}
 	m.GetTaskError = nil
+	m.CreateTaskResponse = &TaskResponse{Task: Task{}}
+	m.CreateTaskError = nil
 	m.UpdateTaskResponse = &TaskResponse{Task: Task{}}
 	m.UpdateTaskError = nil
+	m.UpdateTaskErrors = nil
+	m.DeleteTaskError = nil
 	m.ListProjectsResponse = &ProjectsResponse{Projects: []Project{}, Count: 0}
 	m.ListProjectsError = nil
 	m.GetProjectResponse = &ProjectResponse{Project: Project{}}
@@ -2,10 +2,14 @@
 package archon
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -23,13 +27,24 @@ type MockServer struct {
 	requests []RecordedRequest
 
 	// Behavior configuration
-	simulateErrors map[string]error // endpoint -> error mapping
-	responseDelays map[string]int   // endpoint -> delay in milliseconds
-	healthStatus   int              // HTTP status for health endpoint
+	simulateErrors map[string]error      // endpoint -> error mapping
+	corruptions    map[string]Corruption // endpoint -> corrupted body writer
+	responseDelays map[string]int        // endpoint -> delay in milliseconds
+	healthStatus   int                   // HTTP status for health endpoint
 	nextTaskID     int
 	nextProjectID  int
+
+	// Response metadata headers
+	rateLimit    int // Requests allowed per window (X-RateLimit-Limit)
+	requestCount int // Requests served, used for X-Request-ID and X-RateLimit-Remaining
 }
 
+// MockRateLimit is the per-window request limit the mock server reports by default
+const MockRateLimit = 1000
+
+// mockRateLimitWindow is the reset delay reported in X-RateLimit-Reset, in seconds
+const mockRateLimitWindow = 60
+
 // RecordedRequest captures details about requests made to the mock server
 type RecordedRequest struct {
 	Method   string
@@ -46,24 +61,49 @@ func NewMockServer() *MockServer {
 		projects:       make(map[string]Project),
 		requests:       make([]RecordedRequest, 0),
 		simulateErrors: make(map[string]error),
+		corruptions:    make(map[string]Corruption),
 		responseDelays: make(map[string]int),
 		healthStatus:   http.StatusOK,
 		nextTaskID:     1,
 		nextProjectID:  1,
+		rateLimit:      MockRateLimit,
 	}
 
 	// Create the HTTP test server
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", server.handleHealth)
-	mux.HandleFunc("/api/tasks", server.handleTasks)
-	mux.HandleFunc("/api/tasks/", server.handleTaskByID)
-	mux.HandleFunc("/api/projects", server.handleProjects)
-	mux.HandleFunc("/api/projects/", server.handleProjectByID)
+	mux.HandleFunc("/api/tasks", server.corruptible("tasks", server.handleTasks))
+	mux.HandleFunc("/api/tasks/", server.corruptible("task_by_id", server.handleTaskByID))
+	mux.HandleFunc("/api/projects", server.corruptible("projects", server.handleProjects))
+	mux.HandleFunc("/api/projects/", server.corruptible("project_by_id", server.handleProjectByID))
 
-	server.Server = httptest.NewServer(mux)
+	server.Server = httptest.NewServer(server.withMetaHeaders(mux))
 	return server
 }
 
+// withMetaHeaders adds the request ID and rate-limit headers the real API sends
+func (s *MockServer) withMetaHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requestCount++
+		count, limit := s.requestCount, s.rateLimit
+		s.mu.Unlock()
+
+		w.Header().Set(HeaderRequestID, fmt.Sprintf("mock-%d", count))
+		w.Header().Set(HeaderRateLimitLimit, strconv.Itoa(limit))
+		w.Header().Set(HeaderRateLimitRemaining, strconv.Itoa(max(limit-count, 0)))
+		w.Header().Set(HeaderRateLimitReset, strconv.Itoa(mockRateLimitWindow))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SetRateLimit changes the per-window request limit reported in rate-limit headers
+func (s *MockServer) SetRateLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+}
+
 // writeJSONResponse writes a JSON response with error handling
 func (s *MockServer) writeJSONResponse(w http.ResponseWriter, data interface{}) {
 	if err := json.NewEncoder(w).Encode(data); err != nil {
@@ -229,6 +269,15 @@ func (s *MockServer) handleListTasks(w http.ResponseWriter, r *http.Request) {
 
 		tasks = append(tasks, task)
 	}
+	total := len(tasks)
+
+	// Page by offset/limit when asked, in ID order so pages do not overlap
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		slices.SortFunc(tasks, func(a, b Task) int { return strings.Compare(a.ID, b.ID) })
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		offset = min(max(offset, 0), total)
+		tasks = tasks[offset:min(offset+limit, total)]
+	}
 
 	response := TasksResponse{
 		Tasks: tasks,
@@ -236,6 +285,7 @@ func (s *MockServer) handleListTasks(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(HeaderTotalCount, strconv.Itoa(total))
 	s.writeJSONResponse(w, response)
 }
 
@@ -342,6 +392,7 @@ func (s *MockServer) handleListProjects(w http.ResponseWriter, _ /* r */ *http.R
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(HeaderTotalCount, strconv.Itoa(len(projects)))
 	s.writeJSONResponse(w, response)
 }
 
@@ -450,6 +501,91 @@ func (s *MockServer) ClearSimulatedErrors() {
 	s.simulateErrors = make(map[string]error)
 }
 
+// Corruption writes a damaged version of a successful JSON response body, to
+// test how the client copes with servers failing mid-response
+type Corruption func(w http.ResponseWriter, body []byte)
+
+// SetCorruption makes successful responses of endpoint ("tasks",
+// "task_by_id", "projects", "project_by_id") go out through corrupt; nil
+// restores them
+func (s *MockServer) SetCorruption(endpoint string, corrupt Corruption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if corrupt == nil {
+		delete(s.corruptions, endpoint)
+		return
+	}
+	s.corruptions[endpoint] = corrupt
+}
+
+// corruptible runs handler, passing its successful responses through the
+// endpoint's corruption when one is set
+func (s *MockServer) corruptible(endpoint string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.RLock()
+		corrupt := s.corruptions[endpoint]
+		s.mu.RUnlock()
+		if corrupt == nil {
+			handler(w, r)
+			return
+		}
+
+		recorder := httptest.NewRecorder()
+		handler(recorder, r)
+		for key, values := range recorder.Header() {
+			w.Header()[key] = values
+		}
+		if recorder.Code >= http.StatusBadRequest {
+			w.WriteHeader(recorder.Code)
+			_, _ = w.Write(recorder.Body.Bytes())
+			return
+		}
+		corrupt(w, recorder.Body.Bytes())
+	}
+}
+
+// TruncatedBody sends the first half of the body as a complete response
+func TruncatedBody(w http.ResponseWriter, body []byte) {
+	_, _ = w.Write(body[:len(body)/2])
+}
+
+// HalfWrittenBody announces the full body length but stops halfway, as a
+// server dying mid-response does; the client sees the connection close early
+func HalfWrittenBody(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	_, _ = w.Write(body[:len(body)/2])
+}
+
+// DuplicatedKey repeats key at the end of the top-level object with a null
+// value; JSON decoders keep the last occurrence
+func DuplicatedKey(key string) Corruption {
+	return func(w http.ResponseWriter, body []byte) {
+		body = bytes.TrimSpace(body)
+		end := bytes.LastIndexByte(body, '}')
+		if end < 0 {
+			_, _ = w.Write(body)
+			return
+		}
+		_, _ = w.Write(append(append(body[:end:end], fmt.Sprintf(",%q:null", key)...), body[end:]...))
+	}
+}
+
+// WrongType replaces the first string value of field with a number
+func WrongType(field string) Corruption {
+	pattern := regexp.MustCompile(`"` + regexp.QuoteMeta(field) + `":"(?:[^"\\]|\\.)*"`)
+	return func(w http.ResponseWriter, body []byte) {
+		replaced := false
+		body = pattern.ReplaceAllFunc(body, func(match []byte) []byte {
+			if replaced {
+				return match
+			}
+			replaced = true
+			return []byte(fmt.Sprintf("%q:42", field))
+		})
+		_, _ = w.Write(body)
+	}
+}
+
 // SetHealthStatus configures the HTTP status returned by the health endpoint
 func (s *MockServer) SetHealthStatus(status int) {
 	s.mu.Lock()
@@ -509,8 +645,11 @@ func (s *MockServer) Reset() {
 	s.projects = make(map[string]Project)
 	s.requests = make([]RecordedRequest, 0)
 	s.simulateErrors = make(map[string]error)
+	s.corruptions = make(map[string]Corruption)
 	s.responseDelays = make(map[string]int)
 	s.healthStatus = http.StatusOK
 	s.nextTaskID = 1
 	s.nextProjectID = 1
+	s.rateLimit = MockRateLimit
+	s.requestCount = 0
 }
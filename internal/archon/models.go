@@ -1,6 +1,7 @@
 package archon
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -53,6 +54,25 @@ type Project struct {
 	Features    map[string]interface{} `json:"features"`
 	Data        map[string]interface{} `json:"data"`
 	Pinned      bool                   `json:"pinned"`
+	Permission  string                 `json:"permission,omitempty"` // Caller's access level (empty when not reported)
+}
+
+// Project permission levels reported by the API
+const (
+	ProjectPermissionRead  = "read"
+	ProjectPermissionWrite = "write"
+	ProjectPermissionAdmin = "admin"
+)
+
+// IsReadOnly reports whether the API marked this project as read-only for the caller.
+// Projects without permission metadata are treated as writable (optimistic default).
+func (p Project) IsReadOnly() bool {
+	switch strings.ToLower(p.Permission) {
+	case ProjectPermissionRead, "read_only", "readonly", "viewer":
+		return true
+	default:
+		return false
+	}
 }
 
 // Task represents an Archon task
@@ -66,6 +86,7 @@ type Task struct {
 	Assignee     string        `json:"assignee"`
 	TaskOrder    int           `json:"task_order"`
 	Feature      *string       `json:"feature"`
+	Tags         []string      `json:"tags,omitempty"` // Free-form labels; a task has at most one feature but any number of tags
 	Sources      []Source      `json:"sources"`
 	CodeExamples []CodeExample `json:"code_examples"`
 	Archived     bool          `json:"archived"`
@@ -73,6 +94,10 @@ type Task struct {
 	ArchivedBy   *string       `json:"archived_by"`
 	CreatedAt    FlexibleTime  `json:"created_at"`
 	UpdatedAt    FlexibleTime  `json:"updated_at"`
+
+	// Set by SanitizeTask when the payload was altered for display
+	Raw    *Task    `json:"-"` // Payload as received
+	Issues []string `json:"-"` // What was altered (Issue* constants)
 }
 
 // Document represents an Archon document
@@ -110,6 +135,32 @@ type TasksResponse struct {
 	Page    int    `json:"page"`
 	PerPage int    `json:"per_page"`
 	Error   string `json:"error,omitempty"`
+
+	// Paging, filled in by ListTasksPaged
+	Total      int  `json:"total,omitempty"`       // Tasks across all pages; 0 when the server did not say
+	TotalCount int  `json:"total_count,omitempty"` // Total as some servers name it; folded into Total
+	Offset     int  `json:"offset,omitempty"`      // Position of the first task of this page
+	HasMore    bool `json:"has_more,omitempty"`    // More tasks follow this page
+
+	Meta ResponseMeta `json:"-"` // Response header metadata
+}
+
+// setPage fills in the paging fields of a page requested at offset with limit
+func (r *TasksResponse) setPage(offset, limit int) {
+	r.Offset = offset
+	if r.Total == 0 {
+		r.Total = r.TotalCount
+	}
+	if r.Total == 0 && r.Meta.HasTotal {
+		r.Total = r.Meta.TotalCount
+	}
+	switch {
+	case r.HasMore:
+	case r.Total > 0:
+		r.HasMore = offset+len(r.Tasks) < r.Total
+	default:
+		r.HasMore = len(r.Tasks) >= limit
+	}
 }
 
 // TaskResponse represents the API response for a single task
@@ -119,6 +170,8 @@ type TaskResponse struct {
 	TaskID  string `json:"task_id"`
 	Message string `json:"message"`
 	Error   string `json:"error,omitempty"`
+
+	Meta ResponseMeta `json:"-"` // Response header metadata
 }
 
 // ProjectsResponse represents the API response for listing projects
@@ -127,6 +180,8 @@ type ProjectsResponse struct {
 	Projects []Project `json:"projects"`
 	Count    int       `json:"count"`
 	Error    string    `json:"error,omitempty"`
+
+	Meta ResponseMeta `json:"-"` // Response header metadata
 }
 
 // ProjectResponse represents the API response for a single project
@@ -136,6 +191,53 @@ type ProjectResponse struct {
 	ProjectID string  `json:"project_id"`
 	Message   string  `json:"message"`
 	Error     string  `json:"error,omitempty"`
+
+	Meta ResponseMeta `json:"-"` // Response header metadata
+}
+
+// payloadChecker is implemented by responses that can tell a body that
+// decoded but lacks its payload (e.g. "tasks" missing or null) from a real
+// empty result
+type payloadChecker interface {
+	checkPayload() error
+}
+
+func (r *TasksResponse) checkPayload() error {
+	if r.Tasks == nil {
+		return errors.New("no tasks in response")
+	}
+	return nil
+}
+
+func (r *TaskResponse) checkPayload() error {
+	if r.Task.ID == "" {
+		return errors.New("no task in response")
+	}
+	return nil
+}
+
+func (r *ProjectsResponse) checkPayload() error {
+	if r.Projects == nil {
+		return errors.New("no projects in response")
+	}
+	return nil
+}
+
+func (r *ProjectResponse) checkPayload() error {
+	if r.Project.ID == "" {
+		return errors.New("no project in response")
+	}
+	return nil
+}
+
+// CreateTaskRequest represents a request to create a task
+type CreateTaskRequest struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	ProjectID   string  `json:"project_id"`
+	Status      string  `json:"status,omitempty"`
+	Priority    int     `json:"task_order"` // Archon's task_order
+	Feature     *string `json:"feature,omitempty"`
 }
 
 // UpdateTaskRequest represents a request to update a task
@@ -0,0 +1,139 @@
+package replay
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// jsonObject keeps unknown fields intact while patching captured tasks
+type jsonObject = map[string]json.RawMessage
+
+// overlay holds task changes made during replay; the capture on disk is never modified
+type overlay struct {
+	mu      sync.Mutex
+	known   map[string]jsonObject // Tasks as captured, learned from replayed responses
+	patches map[string]jsonObject // Fields changed by updates, keyed by task ID
+	deleted map[string]bool       // Tasks removed by deletions
+	now     func() time.Time
+}
+
+func newOverlay() *overlay {
+	return &overlay{
+		known:   make(map[string]jsonObject),
+		patches: make(map[string]jsonObject),
+		deleted: make(map[string]bool),
+		now:     time.Now,
+	}
+}
+
+// update merges fields into the task's patch and returns the patched task.
+// Only tasks already served from the capture can be updated.
+func (o *overlay) update(taskID string, fields jsonObject) (jsonObject, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	base, found := o.known[taskID]
+	if !found || o.deleted[taskID] {
+		return nil, false
+	}
+
+	patch := o.patches[taskID]
+	if patch == nil {
+		patch = make(jsonObject)
+		o.patches[taskID] = patch
+	}
+	for name, value := range fields {
+		patch[name] = value
+	}
+	if stamp, err := json.Marshal(o.now().UTC().Format(time.RFC3339)); err == nil {
+		patch["updated_at"] = stamp
+	}
+
+	return merge(base, patch), true
+}
+
+// remove hides the task from all later responses
+func (o *overlay) remove(taskID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.deleted[taskID] = true
+}
+
+// apply rewrites a captured response body with the overlay's changes.
+// Handles task lists ("tasks") and single tasks ("task"); other bodies pass through.
+func (o *overlay) apply(body []byte) (int, []byte) {
+	var top jsonObject
+	if err := json.Unmarshal(body, &top); err != nil {
+		return http.StatusOK, body
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if raw, ok := top["tasks"]; ok {
+		var tasks []jsonObject
+		if err := json.Unmarshal(raw, &tasks); err != nil {
+			return http.StatusOK, body
+		}
+		visible := make([]jsonObject, 0, len(tasks))
+		for _, task := range tasks {
+			if task, keep := o.patch(task); keep {
+				visible = append(visible, task)
+			}
+		}
+		top["tasks"] = mustMarshal(visible)
+		if _, ok := top["count"]; ok {
+			top["count"] = mustMarshal(len(visible))
+		}
+	}
+
+	if raw, ok := top["task"]; ok {
+		var task jsonObject
+		if err := json.Unmarshal(raw, &task); err == nil {
+			patched, keep := o.patch(task)
+			if !keep {
+				return http.StatusNotFound, errorBody("task deleted during replay")
+			}
+			top["task"] = mustMarshal(patched)
+		}
+	}
+
+	return http.StatusOK, mustMarshal(top)
+}
+
+// patch remembers a captured task and applies its changes; keep is false for deleted tasks.
+// Callers must hold o.mu.
+func (o *overlay) patch(task jsonObject) (jsonObject, bool) {
+	var id string
+	if err := json.Unmarshal(task["id"], &id); err != nil || id == "" {
+		return task, true
+	}
+	o.known[id] = task
+	if o.deleted[id] {
+		return nil, false
+	}
+	if patch := o.patches[id]; patch != nil {
+		return merge(task, patch), true
+	}
+	return task, true
+}
+
+// merge returns base with patch fields applied, leaving both inputs unchanged
+func merge(base, patch jsonObject) jsonObject {
+	merged := make(jsonObject, len(base)+len(patch))
+	for name, value := range base {
+		merged[name] = value
+	}
+	for name, value := range patch {
+		merged[name] = value
+	}
+	return merged
+}
+
+// mustMarshal encodes values that cannot fail to marshal (decoded JSON and ints)
+func mustMarshal(value interface{}) json.RawMessage {
+	data, _ := json.Marshal(value) //nolint:errchkjson // Inputs were decoded from valid JSON
+	return data
+}
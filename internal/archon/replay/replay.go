@@ -0,0 +1,299 @@
+// Package replay records Archon API traffic to disk and serves it back later,
+// so lazyarchon can run offline against a capture of a real server.
+//
+// Both sides are http.RoundTripper implementations and plug into any
+// http.Client. Captures hold one JSON file per request, named by a hash of
+// the method, path and canonical query. Credentials are never written: request
+// headers are not recorded and secret query parameters are dropped from keys.
+//
+// During replay, task updates and deletions are accepted and kept in an
+// in-memory overlay that is applied to every replayed response.
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrNotRecorded is returned during replay for requests missing from the capture
+var ErrNotRecorded = errors.New("request not in HTTP capture")
+
+// secretParams are query parameters never written to capture keys
+var secretParams = []string{"api_key", "apikey", "token", "access_token"}
+
+// Entry is one captured request/response pair as stored on disk
+type Entry struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`     // Canonical query: sorted, secrets removed
+	Status int             `json:"status"`              // Response status code
+	Body   json.RawMessage `json:"body,omitempty"`      // JSON response bodies, indented for diffs
+	Text   string          `json:"body_text,omitempty"` // Non-JSON response bodies verbatim
+}
+
+// responseBody returns the captured body bytes
+func (e *Entry) responseBody() []byte {
+	if len(e.Body) > 0 {
+		return e.Body
+	}
+	return []byte(e.Text)
+}
+
+// canonicalQuery sorts parameters and removes secrets so keys are stable and safe to share
+func canonicalQuery(query url.Values) string {
+	clean := url.Values{}
+	for name, values := range query {
+		clean[name] = values
+	}
+	for _, name := range secretParams {
+		clean.Del(name)
+	}
+	return clean.Encode() // Encode sorts by key
+}
+
+// fileName returns the content-addressed capture file for a request
+func fileName(method, path, query string) string {
+	sum := sha256.Sum256([]byte(method + " " + path + "?" + query))
+	slug := strings.Trim(strings.NewReplacer("/", "_", ".", "_").Replace(path), "_")
+	if slug == "" {
+		slug = "root"
+	}
+	return fmt.Sprintf("%s_%s_%s.json", method, slug, hex.EncodeToString(sum[:])[:16])
+}
+
+// Recorder forwards requests to the next transport and writes each response to the capture directory
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+	mu   sync.Mutex // Serializes writes so concurrent loads cannot interleave files
+}
+
+// NewRecorder creates a recorder writing to dir; a nil next uses http.DefaultTransport
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create capture directory: %w", err)
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response for capture: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := Entry{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  canonicalQuery(req.URL.Query()),
+		Status: resp.StatusCode,
+	}
+	var indented bytes.Buffer
+	if json.Valid(body) && json.Indent(&indented, body, "", "  ") == nil {
+		entry.Body = indented.Bytes()
+	} else {
+		entry.Text = string(body)
+	}
+
+	if err := r.write(&entry); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// write stores the entry, replacing an earlier capture of the same request
+func (r *Recorder) write(entry *Entry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode capture: %w", err)
+	}
+	data = append(data, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	path := filepath.Join(r.dir, fileName(entry.Method, entry.Path, entry.Query))
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write capture: %w", err)
+	}
+	return nil
+}
+
+// AppendEvent appends v as one JSON line to the named file in the capture directory
+func (r *Recorder) AppendEvent(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode capture event: %w", err)
+	}
+	data = append(data, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, err := os.OpenFile(filepath.Join(r.dir, name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open capture event log: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		return fmt.Errorf("failed to write capture event: %w", err)
+	}
+	return nil
+}
+
+// Replayer serves responses from a capture directory without touching the network
+type Replayer struct {
+	dir     string
+	overlay *overlay
+}
+
+// NewReplayer creates a replayer for the capture in dir
+func NewReplayer(dir string) (*Replayer, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open capture directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("capture path is not a directory: %s", dir)
+	}
+	return &Replayer{dir: dir, overlay: newOverlay()}, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return r.replay(req)
+	default:
+		return r.mutate(req)
+	}
+}
+
+// replay serves a captured response with overlay changes applied
+func (r *Replayer) replay(req *http.Request) (*http.Response, error) {
+	query := canonicalQuery(req.URL.Query())
+	data, err := os.ReadFile(filepath.Join(r.dir, fileName(req.Method, req.URL.Path, query)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, requestTarget(req.URL.Path, query))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capture: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse capture for %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	status, body := entry.Status, entry.responseBody()
+	if len(entry.Body) > 0 && status < http.StatusBadRequest {
+		status, body = r.overlay.apply(body)
+	}
+	return newResponse(req, status, body), nil
+}
+
+// mutate applies task updates and deletions to the overlay instead of the server
+func (r *Replayer) mutate(req *http.Request) (*http.Response, error) {
+	taskID, ok := taskIDFromPath(req.URL.Path)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s (only task updates and deletions are simulated)",
+			ErrNotRecorded, req.Method, req.URL.Path)
+	}
+
+	switch req.Method {
+	case http.MethodPut, http.MethodPatch:
+		var fields map[string]json.RawMessage
+		if req.Body != nil {
+			if err := json.NewDecoder(req.Body).Decode(&fields); err != nil {
+				return newResponse(req, http.StatusBadRequest, errorBody("invalid update body")), nil
+			}
+		}
+		task, found := r.overlay.update(taskID, fields)
+		if !found {
+			return newResponse(req, http.StatusNotFound, errorBody("task not found in capture")), nil
+		}
+		body, err := json.Marshal(map[string]interface{}{
+			"success": true,
+			"task":    task,
+			"task_id": taskID,
+			"message": "Task updated (replay)",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode replayed update: %w", err)
+		}
+		return newResponse(req, http.StatusOK, body), nil
+
+	case http.MethodDelete:
+		r.overlay.remove(taskID)
+		return newResponse(req, http.StatusOK, []byte(`{"success":true}`)), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, req.URL.Path)
+	}
+}
+
+// taskIDFromPath extracts the ID from /api/tasks/{id}
+func taskIDFromPath(path string) (string, bool) {
+	const prefix = "/api/tasks/"
+	if !strings.HasPrefix(path, prefix) {
+		return "", false
+	}
+	id := strings.TrimPrefix(path, prefix)
+	if id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+// requestTarget formats path and query for error messages
+func requestTarget(path, query string) string {
+	if query == "" {
+		return path
+	}
+	return path + "?" + query
+}
+
+// errorBody builds a minimal API error payload
+func errorBody(message string) []byte {
+	body, _ := json.Marshal(map[string]string{"error": message})
+	return body
+}
+
+// newResponse builds a JSON response for req
+func newResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,179 @@
+package replay
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+const tasksJSON = `{"success": true, "count": 2, "tasks": [
+	{"id": "t1", "project_id": "p1", "title": "Write docs", "status": "todo", "task_order": 10, "extra": {"kept": true}},
+	{"id": "t2", "project_id": "p1", "title": "Fix login", "status": "doing", "task_order": 20}
+]}`
+
+// recordFixture captures a task listing from a fake server and returns the capture directory
+func recordFixture(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(tasksJSON))
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "capture")
+	recorder, err := NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatalf("Unexpected recorder error: %v", err)
+	}
+
+	client := archon.NewClient(server.URL, "secret-key")
+	client.SetTransport(recorder)
+	if _, err := client.ListTasks(nil, nil, true); err != nil {
+		t.Fatalf("Unexpected list error while recording: %v", err)
+	}
+	return dir
+}
+
+// replayClient returns a client served entirely from dir
+func replayClient(t *testing.T, dir string) *archon.Client {
+	t.Helper()
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatalf("Unexpected replayer error: %v", err)
+	}
+	// The base URL is never contacted during replay
+	client := archon.NewClient("http://capture.invalid", "other-key")
+	client.SetTransport(replayer)
+	return client
+}
+
+func TestRecordReplayRoundTrip(t *testing.T) {
+	dir := recordFixture(t)
+
+	files, err := os.ReadDir(dir)
+	if err != nil || len(files) != 1 {
+		t.Fatalf("Expected one capture file, got %d (err %v)", len(files), err)
+	}
+	first, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(first), "secret-key") {
+		t.Error("Expected API key to be stripped from the capture")
+	}
+	if !strings.HasPrefix(files[0].Name(), "GET_api_tasks_") {
+		t.Errorf("Expected readable capture name, got %s", files[0].Name())
+	}
+
+	// Recording the same request again yields identical bytes
+	again := recordFixture(t)
+	second, err := os.ReadFile(filepath.Join(again, files[0].Name()))
+	if err != nil || string(second) != string(first) {
+		t.Errorf("Expected deterministic capture, got differing files (err %v)", err)
+	}
+
+	tasks, err := replayClient(t, dir).ListTasks(nil, nil, true)
+	if err != nil {
+		t.Fatalf("Unexpected replay error: %v", err)
+	}
+	if len(tasks.Tasks) != 2 || tasks.Tasks[1].Title != "Fix login" {
+		t.Errorf("Expected captured tasks, got %+v", tasks.Tasks)
+	}
+}
+
+func TestRecorderAppendEvent(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, view := range []string{"tasks", "help-modal"} {
+		if err := recorder.AppendEvent("events.jsonl", map[string]string{"view": view}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "events.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "{\"view\":\"tasks\"}\n{\"view\":\"help-modal\"}\n" {
+		t.Errorf("Expected one JSON line per event, got %q", data)
+	}
+}
+
+func TestReplayUnknownRequest(t *testing.T) {
+	client := replayClient(t, recordFixture(t))
+
+	projectID := "p1"
+	_, err := client.ListTasks(&projectID, nil, true)
+	if !errors.Is(err, ErrNotRecorded) {
+		t.Fatalf("Expected ErrNotRecorded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "project_id=p1") {
+		t.Errorf("Expected error to name the missing request, got %v", err)
+	}
+
+	if _, err := client.ListProjects(); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("Expected ErrNotRecorded for projects, got %v", err)
+	}
+}
+
+func TestReplayMutationOverlay(t *testing.T) {
+	dir := recordFixture(t)
+	client := replayClient(t, dir)
+
+	// Updates are only possible for tasks the capture has served
+	status := archon.TaskStatusDone
+	if _, err := client.UpdateTask("t1", archon.UpdateTaskRequest{Status: &status}); err == nil {
+		t.Error("Expected update of an unseen task to fail")
+	}
+
+	if _, err := client.ListTasks(nil, nil, true); err != nil {
+		t.Fatalf("Unexpected replay error: %v", err)
+	}
+	updated, err := client.UpdateTask("t1", archon.UpdateTaskRequest{Status: &status})
+	if err != nil {
+		t.Fatalf("Unexpected update error: %v", err)
+	}
+	if updated.Task.Status != status || updated.Task.Title != "Write docs" {
+		t.Errorf("Expected merged task in update response, got %+v", updated.Task)
+	}
+
+	if err := client.DeleteTask("t2"); err != nil {
+		t.Fatalf("Unexpected delete error: %v", err)
+	}
+
+	tasks, err := client.ListTasks(nil, nil, true)
+	if err != nil {
+		t.Fatalf("Unexpected replay error: %v", err)
+	}
+	if len(tasks.Tasks) != 1 || tasks.Count != 1 {
+		t.Fatalf("Expected deleted task to be hidden, got %+v", tasks.Tasks)
+	}
+	if tasks.Tasks[0].Status != status || tasks.Tasks[0].UpdatedAt.IsZero() {
+		t.Errorf("Expected overlay status and timestamp, got %+v", tasks.Tasks[0])
+	}
+
+	// The capture itself is never modified
+	if fresh, err := replayClient(t, dir).ListTasks(nil, nil, true); err != nil || len(fresh.Tasks) != 2 {
+		t.Errorf("Expected untouched capture for a new replay, got %+v (err %v)", fresh, err)
+	}
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	query := map[string][]string{
+		"per_page": {"100"},
+		"api_key":  {"secret"},
+		"a":        {"1"},
+	}
+	if got := canonicalQuery(query); got != "a=1&per_page=100" {
+		t.Errorf("Expected sorted query without secrets, got %q", got)
+	}
+}
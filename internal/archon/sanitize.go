@@ -0,0 +1,313 @@
+package archon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SanitizeLimits bounds the task text kept for display. Zero disables a limit.
+type SanitizeLimits struct {
+	MaxTitleLength       int // Runes kept in titles
+	MaxDescriptionLength int // Bytes kept in descriptions
+	MaxFeatureLength     int // Runes allowed in a feature or tag before it is flagged
+}
+
+// Default sanitize limits
+const (
+	DefaultMaxTitleLength       = 200
+	DefaultMaxDescriptionLength = 64 * 1024
+	DefaultMaxFeatureLength     = 64
+)
+
+// DefaultSanitizeLimits returns the limits used when none are configured
+func DefaultSanitizeLimits() SanitizeLimits {
+	return SanitizeLimits{
+		MaxTitleLength:       DefaultMaxTitleLength,
+		MaxDescriptionLength: DefaultMaxDescriptionLength,
+		MaxFeatureLength:     DefaultMaxFeatureLength,
+	}
+}
+
+// Issues recorded on tasks whose payload had to be altered for display
+const (
+	IssueControlCharacters    = "control characters"
+	IssueInvalidUTF8          = "invalid UTF-8"
+	IssueTitleTruncated       = "title truncated"
+	IssueDescriptionTruncated = "description truncated"
+	IssueMalformedFeature     = "malformed feature"
+	IssueMalformedTags        = "malformed tags"
+)
+
+// descriptionTruncatedNote is appended to descriptions cut for display
+const descriptionTruncatedNote = "\n\n… (description truncated for display)"
+
+// SanitizeTasks sanitizes every task; see SanitizeTask
+func SanitizeTasks(tasks []Task, limits SanitizeLimits) []Task {
+	for i := range tasks {
+		tasks[i] = SanitizeTask(tasks[i], limits)
+	}
+	return tasks
+}
+
+// ValidateTasks splits tasks into the ones fit to display and the reasons
+// the others were dropped. Sanitizing can repair text but not a task the UI
+// cannot key or place: one without an ID or with a status outside the
+// todo/doing/review/done workflow.
+func ValidateTasks(tasks []Task) (valid []Task, dropped []string) {
+	valid = make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		switch {
+		case task.ID == "":
+			dropped = append(dropped, fmt.Sprintf("task %q has no ID", task.Title))
+		case !IsKnownStatus(task.Status):
+			dropped = append(dropped, fmt.Sprintf("task %s has unknown status %q", task.ID, task.Status))
+		default:
+			valid = append(valid, task)
+		}
+	}
+	return valid, dropped
+}
+
+// DedupeTasks keeps one task per ID, in the place the ID first appeared: the
+// most recently updated copy, or the first one when they were updated at the
+// same time. Tasks without an ID are left to ValidateTasks. Returns the IDs
+// that appeared more than once, in order.
+func DedupeTasks(tasks []Task) (unique []Task, duplicates []string) {
+	index := make(map[string]int, len(tasks))
+	unique = make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.ID == "" {
+			unique = append(unique, task)
+			continue
+		}
+		i, seen := index[task.ID]
+		if !seen {
+			index[task.ID] = len(unique)
+			unique = append(unique, task)
+			continue
+		}
+		if !slices.Contains(duplicates, task.ID) {
+			duplicates = append(duplicates, task.ID)
+		}
+		if task.UpdatedAt.After(unique[i].UpdatedAt.Time) {
+			unique[i] = task
+		}
+	}
+	return unique, duplicates
+}
+
+// IsKnownStatus reports whether status is one of the workflow statuses
+func IsKnownStatus(status string) bool {
+	switch status {
+	case TaskStatusTodo, TaskStatusDoing, TaskStatusReview, TaskStatusDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// SanitizeTask makes task text safe to render: control characters are removed
+// (descriptions keep newlines and tabs), newlines are normalized, titles and
+// descriptions are capped and features with disallowed characters are cleaned
+// and flagged. When anything changed, the untouched payload is kept in Raw and
+// the reasons in Issues. Sanitizing an already sanitized task starts again from Raw.
+func SanitizeTask(task Task, limits SanitizeLimits) Task {
+	if task.Raw != nil {
+		task = *task.Raw
+	}
+	original := task
+
+	var issues issueSet
+	task.Title = issues.truncateRunes(issues.cleanLine(task.Title), limits.MaxTitleLength, IssueTitleTruncated)
+	task.Description = issues.cleanText(task.Description)
+	if limits.MaxDescriptionLength > 0 && len(task.Description) > limits.MaxDescriptionLength {
+		task.Description = truncateBytes(task.Description, limits.MaxDescriptionLength) + descriptionTruncatedNote
+		issues.add(IssueDescriptionTruncated)
+	}
+	task.Status = issues.cleanLine(task.Status)
+	task.Assignee = issues.cleanLine(task.Assignee)
+
+	if task.Feature != nil {
+		feature := sanitizeFeature(*task.Feature, limits.MaxFeatureLength)
+		if feature != *task.Feature {
+			issues.add(IssueMalformedFeature)
+			task.Feature = &feature
+		}
+	}
+	if tags := sanitizeTags(task.Tags, limits.MaxFeatureLength); !slices.Equal(tags, task.Tags) {
+		issues.add(IssueMalformedTags)
+		task.Tags = tags
+	}
+
+	if !sanitizeChanged(original, task) {
+		return original
+	}
+	task.Raw = &original
+	task.Issues = issues
+	return task
+}
+
+// sanitizeChanged reports whether sanitizing altered any text field.
+// Newline normalization changes text without being an issue worth flagging.
+func sanitizeChanged(original, sanitized Task) bool {
+	return original.Title != sanitized.Title ||
+		original.Description != sanitized.Description ||
+		original.Status != sanitized.Status ||
+		original.Assignee != sanitized.Assignee ||
+		original.Feature != sanitized.Feature ||
+		!slices.Equal(original.Tags, sanitized.Tags)
+}
+
+// sanitizeFeature removes disallowed characters from a feature name and caps its length
+func sanitizeFeature(feature string, maxLength int) string {
+	var ignored issueSet
+	cleaned := strings.Join(strings.Fields(ignored.cleanLine(feature)), " ")
+	return ignored.truncateRunes(cleaned, maxLength, "")
+}
+
+// sanitizeTags cleans every tag like a feature, dropping the ones left empty.
+// The input is returned as is when nothing changed.
+func sanitizeTags(tags []string, maxLength int) []string {
+	var cleaned []string
+	for i, tag := range tags {
+		clean := sanitizeFeature(tag, maxLength)
+		if cleaned == nil && clean == tag && clean != "" {
+			continue
+		}
+		if cleaned == nil {
+			cleaned = append(make([]string, 0, len(tags)), tags[:i]...)
+		}
+		if clean != "" {
+			cleaned = append(cleaned, clean)
+		}
+	}
+	if cleaned == nil {
+		return tags
+	}
+	return cleaned
+}
+
+// issueSet collects sanitize issues without duplicates, in the order found
+type issueSet []string
+
+func (s *issueSet) add(issue string) {
+	for _, existing := range *s {
+		if existing == issue {
+			return
+		}
+	}
+	*s = append(*s, issue)
+}
+
+// cleanLine removes control characters; line breaks and tabs become spaces
+func (s *issueSet) cleanLine(value string) string {
+	return s.clean(value, false)
+}
+
+// cleanText removes control characters except newlines and tabs, normalizing CRLF and CR to LF
+func (s *issueSet) cleanText(value string) string {
+	if strings.Contains(value, "\r") {
+		value = strings.ReplaceAll(value, "\r\n", "\n")
+		value = strings.ReplaceAll(value, "\r", "\n")
+	}
+	return s.clean(value, true)
+}
+
+func (s *issueSet) clean(value string, multiline bool) string {
+	if !needsCleaning(value, multiline) {
+		return value
+	}
+	if !utf8.ValidString(value) {
+		s.add(IssueInvalidUTF8)
+		value = strings.ToValidUTF8(value, "�")
+	}
+
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, r := range value {
+		switch {
+		case r == '\n' || r == '\t':
+			if multiline {
+				b.WriteRune(r)
+			} else {
+				b.WriteByte(' ')
+			}
+		case r == '\r':
+			b.WriteByte(' ')
+		case isDisallowed(r):
+			s.add(IssueControlCharacters)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// needsCleaning reports whether value contains anything clean would change
+func needsCleaning(value string, multiline bool) bool {
+	for _, r := range value {
+		switch {
+		case r == utf8.RuneError:
+			return true
+		case r == '\n' || r == '\t':
+			if !multiline {
+				return true
+			}
+		case r == '\r' || isDisallowed(r):
+			return true
+		}
+	}
+	return false
+}
+
+// isDisallowed reports characters that corrupt terminal layout: C0/C1 controls
+// (including the ESC of escape sequences) and bidirectional overrides
+func isDisallowed(r rune) bool {
+	return unicode.IsControl(r) || unicode.Is(unicode.Bidi_Control, r)
+}
+
+// truncateRunes caps value at limit runes with an ellipsis, recording issue when it does
+func (s *issueSet) truncateRunes(value string, limit int, issue string) string {
+	if limit <= 0 || utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	if issue != "" {
+		s.add(issue)
+	}
+	runes := []rune(value)
+	return string(runes[:max(limit-1, 0)]) + "…"
+}
+
+// truncateBytes cuts value to at most limit bytes without splitting a UTF-8 sequence
+func truncateBytes(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	for limit > 0 && !utf8.RuneStart(value[limit]) {
+		limit--
+	}
+	return value[:limit]
+}
+
+// Original returns the task as received from the API, before sanitizing.
+// Use it for editing, copying and exporting where the full value matters.
+func (t Task) Original() Task {
+	if t.Raw != nil {
+		return *t.Raw
+	}
+	return t
+}
+
+// IsMalformed reports whether the payload contained data that had to be removed
+// or rewritten for display; truncating long text alone does not count
+func (t Task) IsMalformed() bool {
+	for _, issue := range t.Issues {
+		if issue != IssueTitleTruncated && issue != IssueDescriptionTruncated {
+			return true
+		}
+	}
+	return false
+}
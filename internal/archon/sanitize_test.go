@@ -0,0 +1,195 @@
+package archon
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// hostileStrings are payloads misbehaving agents have been seen to send
+var hostileStrings = []string{
+	"",
+	"plain title",
+	"\x1b[31mred\x1b[0m and \x1b]0;pwned\x07 title",
+	"line one\r\nline two\rline three\n",
+	"tab\tseparated\x00null\x7fdel\u0085nel",
+	"bidi ‮evil‬ override",
+	"broken utf8 \xff\xfe\xc3",
+	"emoji 👨‍👩‍👧 and wide 漢字",
+	strings.Repeat("long ", 400),
+	strings.Repeat("\x1b", 1000),
+}
+
+func TestSanitizeTask(t *testing.T) {
+	feature := "auth\nservice"
+	task := SanitizeTask(Task{
+		ID:          "t1",
+		Title:       "Fix \x1b[31mlogin\x1b[0m\nnow",
+		Description: "Steps:\r\n1. run\x00\r\n2. \tcheck",
+		Assignee:    "agent\x07",
+		Feature:     &feature,
+		Tags:        []string{"db", "ur\ngent", " "},
+	}, DefaultSanitizeLimits())
+
+	if task.Title != "Fix [31mlogin[0m now" {
+		t.Errorf("Unexpected title %q", task.Title)
+	}
+	if task.Description != "Steps:\n1. run\n2. \tcheck" {
+		t.Errorf("Unexpected description %q", task.Description)
+	}
+	if task.Assignee != "agent" || task.Feature == nil || *task.Feature != "auth service" {
+		t.Errorf("Unexpected assignee %q or feature %v", task.Assignee, task.Feature)
+	}
+	if !slices.Equal(task.Tags, []string{"db", "ur gent"}) {
+		t.Errorf("Expected tags cleaned and blank ones dropped, got %q", task.Tags)
+	}
+	if !slices.Equal(task.Issues, []string{IssueControlCharacters, IssueMalformedFeature, IssueMalformedTags}) || !task.IsMalformed() {
+		t.Errorf("Unexpected issues %v", task.Issues)
+	}
+
+	original := task.Original()
+	if original.Title != "Fix \x1b[31mlogin\x1b[0m\nnow" || *original.Feature != "auth\nservice" || len(original.Tags) != 3 || original.Raw != nil {
+		t.Errorf("Expected the raw payload to be retained, got %+v", original)
+	}
+}
+
+func TestSanitizeTask_Clean(t *testing.T) {
+	feature := "auth"
+	task := Task{ID: "t1", Title: "Ship 漢字 👨‍👩‍👧", Description: "# Plan\n\n- [ ] step\twith tab", Feature: &feature, Tags: []string{"db", "needs review"}}
+
+	got := SanitizeTask(task, DefaultSanitizeLimits())
+	if got.Raw != nil || got.Issues != nil || got.Title != task.Title || got.Description != task.Description {
+		t.Errorf("Expected clean task to pass through unchanged, got %+v", got)
+	}
+
+	// Newline normalization alone keeps the raw payload but is not malformed
+	got = SanitizeTask(Task{Description: "a\r\nb"}, DefaultSanitizeLimits())
+	if got.Description != "a\nb" || got.Raw == nil || got.IsMalformed() {
+		t.Errorf("Expected normalized description without issues, got %+v", got)
+	}
+}
+
+func TestSanitizeTask_Limits(t *testing.T) {
+	limits := SanitizeLimits{MaxTitleLength: 10, MaxDescriptionLength: 8, MaxFeatureLength: 4}
+	feature := "authentication"
+	task := SanitizeTask(Task{
+		Title:       "漢字漢字漢字漢字漢字漢字",
+		Description: "ééééé", // 10 bytes
+		Feature:     &feature,
+	}, limits)
+
+	if task.Title != "漢字漢字漢字漢字漢…" {
+		t.Errorf("Expected title capped at 10 runes, got %q", task.Title)
+	}
+	if !strings.HasPrefix(task.Description, "éééé\n") || !strings.Contains(task.Description, "truncated") {
+		t.Errorf("Expected description cut on a rune boundary, got %q", task.Description)
+	}
+	if *task.Feature != "aut…" {
+		t.Errorf("Expected feature capped, got %q", *task.Feature)
+	}
+	if !slices.Equal(task.Issues, []string{IssueTitleTruncated, IssueDescriptionTruncated, IssueMalformedFeature}) {
+		t.Errorf("Unexpected issues %v", task.Issues)
+	}
+	if task.Original().Title != "漢字漢字漢字漢字漢字漢字" {
+		t.Error("Expected the full title to be kept for editing and export")
+	}
+
+	// Truncation alone is not malformed data
+	if SanitizeTask(Task{Title: strings.Repeat("x", 20)}, limits).IsMalformed() {
+		t.Error("Expected a long title not to be flagged as malformed")
+	}
+}
+
+func TestSanitizeTask_Idempotent(t *testing.T) {
+	task := SanitizeTask(Task{Title: "a\x1bb"}, DefaultSanitizeLimits())
+	again := SanitizeTask(task, DefaultSanitizeLimits())
+	if again.Title != "ab" || again.Raw == nil || again.Raw.Title != "a\x1bb" || len(again.Issues) != 1 {
+		t.Errorf("Expected re-sanitizing to start from the raw payload, got %+v", again)
+	}
+}
+
+func FuzzSanitizeTask(f *testing.F) {
+	for _, s := range hostileStrings {
+		f.Add(s, s, s)
+	}
+	limits := SanitizeLimits{MaxTitleLength: 50, MaxDescriptionLength: 1024, MaxFeatureLength: 16}
+
+	f.Fuzz(func(t *testing.T, title, description, feature string) {
+		task := SanitizeTask(Task{Title: title, Description: description, Feature: &feature, Assignee: title}, limits)
+
+		for name, value := range map[string]string{"title": task.Title, "assignee": task.Assignee, "feature": *task.Feature} {
+			if !utf8.ValidString(value) || strings.ContainsFunc(value, isDisallowed) {
+				t.Fatalf("%s %q still contains invalid or control characters", name, value)
+			}
+		}
+		if n := utf8.RuneCountInString(task.Title); n > limits.MaxTitleLength {
+			t.Fatalf("Title has %d runes, limit %d", n, limits.MaxTitleLength)
+		}
+		if utf8.RuneCountInString(*task.Feature) > limits.MaxFeatureLength {
+			t.Fatalf("Feature %q exceeds the limit", *task.Feature)
+		}
+		if len(task.Description) > limits.MaxDescriptionLength+len(descriptionTruncatedNote) {
+			t.Fatalf("Description has %d bytes", len(task.Description))
+		}
+		if !utf8.ValidString(task.Description) || strings.ContainsFunc(task.Description, func(r rune) bool {
+			return r != '\n' && r != '\t' && isDisallowed(r)
+		}) {
+			t.Fatalf("Description %q still contains invalid or control characters", task.Description)
+		}
+		if original := task.Original(); original.Title != title || original.Description != description {
+			t.Fatal("Expected the original payload to be preserved")
+		}
+	})
+}
+
+func TestValidateTasks(t *testing.T) {
+	tasks := []Task{
+		{ID: "a", Title: "Fine", Status: TaskStatusTodo},
+		{ID: "", Title: "No ID", Status: TaskStatusTodo},
+		{ID: "c", Title: "No status"},
+		{ID: "d", Title: "Odd status", Status: "blocked"},
+		{ID: "e", Title: "Done", Status: TaskStatusDone},
+	}
+
+	valid, dropped := ValidateTasks(tasks)
+	if len(valid) != 2 || valid[0].ID != "a" || valid[1].ID != "e" {
+		t.Errorf("Expected a and e kept, got %+v", valid)
+	}
+	if len(dropped) != 3 || !strings.Contains(dropped[2], `"blocked"`) {
+		t.Errorf("Expected three reasons, got %q", dropped)
+	}
+}
+
+func TestDedupeTasks(t *testing.T) {
+	at := func(hour int) FlexibleTime {
+		return FlexibleTime{Time: time.Date(2025, 3, 10, hour, 0, 0, 0, time.UTC)}
+	}
+	tasks := []Task{
+		{ID: "a", Title: "Old a", UpdatedAt: at(9)},
+		{ID: "b", Title: "First b", UpdatedAt: at(9)},
+		{ID: "a", Title: "New a", UpdatedAt: at(11)},
+		{ID: "b", Title: "Second b", UpdatedAt: at(9)},
+		{ID: "c", Title: "Only c"},
+		{ID: "a", Title: "Older a", UpdatedAt: at(8)},
+		{Title: "No ID"},
+		{Title: "No ID either"},
+	}
+
+	unique, duplicates := DedupeTasks(tasks)
+	var titles []string
+	for _, task := range unique {
+		titles = append(titles, task.Title)
+	}
+	if got := strings.Join(titles, ","); got != "New a,First b,Only c,No ID,No ID either" {
+		t.Errorf("Expected the latest copy in the first place, the first on a tie, got %s", got)
+	}
+	if got := strings.Join(duplicates, ","); got != "a,b" {
+		t.Errorf("Expected a and b reported once each, got %s", got)
+	}
+
+	if unique, duplicates := DedupeTasks(unique); len(unique) != 5 || duplicates != nil {
+		t.Errorf("Expected unique tasks untouched, got %d tasks, %v", len(unique), duplicates)
+	}
+}
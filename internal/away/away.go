@@ -0,0 +1,219 @@
+// Package away computes the "while you were away" digest shown at startup.
+//
+// At every task load lazyarchon records a Baseline: when tasks were last seen
+// and each task's status and assignee at that moment. On the next start, if
+// the gap since the baseline exceeds a threshold, Compute compares it with the
+// freshly loaded tasks and lists what needs the user's attention. Changes made
+// while lazyarchon was closed are, by construction, someone else's.
+package away
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// SchemaVersion is the baseline format written by this build
+const SchemaVersion = 1
+
+// ErrUnsupportedVersion is returned when a baseline was written with another schema version
+var ErrUnsupportedVersion = errors.New("unsupported away baseline version")
+
+// Kind classifies a digest item
+type Kind string
+
+const (
+	KindAssigned Kind = "assigned" // Task newly assigned to the current user
+	KindStatus   Kind = "status"   // The user's task moved to review or done
+	KindNew      Kind = "new"      // New task in the default project
+)
+
+// kindOrder is the order items are listed in
+var kindOrder = map[Kind]int{KindAssigned: 0, KindStatus: 1, KindNew: 2}
+
+// TaskState is what the baseline remembers about a task
+type TaskState struct {
+	Status   string `json:"status"`
+	Assignee string `json:"assignee,omitempty"`
+}
+
+// Baseline is the persisted record of the last time tasks were seen
+type Baseline struct {
+	Version int                  `json:"version"`
+	SeenAt  time.Time            `json:"seen_at"`
+	Tasks   map[string]TaskState `json:"tasks"`
+}
+
+// Capture records tasks as seen at now. States from previous are kept for
+// tasks not loaded this time (e.g. other projects), so they are not reported
+// as new when they show up again.
+func Capture(previous *Baseline, tasks []archon.Task, now time.Time) Baseline {
+	baseline := Baseline{
+		Version: SchemaVersion,
+		SeenAt:  now,
+		Tasks:   make(map[string]TaskState, len(tasks)),
+	}
+	if previous != nil {
+		for id, state := range previous.Tasks {
+			baseline.Tasks[id] = state
+		}
+	}
+	for _, task := range tasks {
+		baseline.Tasks[task.ID] = TaskState{Status: task.Status, Assignee: task.Assignee}
+	}
+	return baseline
+}
+
+// Options tune digest computation
+type Options struct {
+	CurrentUser      string        // Assignee name identifying the user; empty skips "mine" items
+	DefaultProjectID string        // Project whose new tasks are listed; empty skips new-task items
+	Threshold        time.Duration // Minimum gap since the baseline for a digest
+	Now              time.Time
+}
+
+// Item is one entry in the digest
+type Item struct {
+	Kind      Kind
+	TaskID    string
+	ProjectID string
+	Title     string
+	Detail    string // Short description of the change, e.g. "doing → review"
+}
+
+// Digest lists what changed since the user was last here
+type Digest struct {
+	Since   time.Time     // When tasks were last seen
+	AwayFor time.Duration // Gap between Since and now
+	Items   []Item
+}
+
+// Compute returns the digest for current against previous.
+// Returns nil when there is no baseline, the gap is below the threshold, or nothing needs attention.
+func Compute(previous *Baseline, current []archon.Task, opts Options) *Digest {
+	if previous == nil || previous.SeenAt.IsZero() {
+		return nil
+	}
+	awayFor := opts.Now.Sub(previous.SeenAt)
+	if awayFor < opts.Threshold {
+		return nil
+	}
+
+	var items []Item
+	for _, task := range current {
+		if task.Archived {
+			continue
+		}
+		if item, ok := classify(previous, task, opts); ok {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Kind != items[j].Kind {
+			return kindOrder[items[i].Kind] < kindOrder[items[j].Kind]
+		}
+		return items[i].Title < items[j].Title
+	})
+	return &Digest{Since: previous.SeenAt, AwayFor: awayFor, Items: items}
+}
+
+// classify decides whether task belongs in the digest and under which kind
+func classify(previous *Baseline, task archon.Task, opts Options) (Item, bool) {
+	item := Item{TaskID: task.ID, ProjectID: task.ProjectID, Title: task.Title}
+	state, known := previous.Tasks[task.ID]
+	// Tasks missing from the baseline only count if they changed after it
+	changedSince := known || task.UpdatedAt.After(previous.SeenAt)
+	mine := opts.CurrentUser != "" && strings.EqualFold(task.Assignee, opts.CurrentUser)
+
+	switch {
+	case mine && changedSince && (!known || !strings.EqualFold(state.Assignee, opts.CurrentUser)):
+		item.Kind = KindAssigned
+		item.Detail = "assigned to you (" + task.Status + ")"
+		if known && state.Assignee != "" {
+			item.Detail = "reassigned from " + state.Assignee + " (" + task.Status + ")"
+		}
+		return item, true
+
+	case mine && known && state.Status != task.Status &&
+		(task.Status == archon.TaskStatusReview || task.Status == archon.TaskStatusDone):
+		item.Kind = KindStatus
+		item.Detail = state.Status + " → " + task.Status
+		return item, true
+
+	case !known && opts.DefaultProjectID != "" && task.ProjectID == opts.DefaultProjectID &&
+		task.CreatedAt.After(previous.SeenAt):
+		item.Kind = KindNew
+		item.Detail = "new (" + task.Status + ")"
+		return item, true
+	}
+	return item, false
+}
+
+// Store reads and writes the baseline at a fixed path
+type Store struct {
+	path string
+}
+
+// NewStore creates a store that keeps the baseline at path
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the baseline location in the user's cache directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+	return filepath.Join(dir, "lazyarchon", "seen.json"), nil
+}
+
+// Load reads the baseline; returns nil without error when none was saved yet
+func (s *Store) Load() (*Baseline, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read away baseline: %w", err)
+	}
+
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("failed to parse away baseline: %w", err)
+	}
+	if baseline.Version != SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, baseline.Version)
+	}
+	return &baseline, nil
+}
+
+// Save writes the baseline atomically
+func (s *Store) Save(baseline Baseline) error {
+	baseline.Version = SchemaVersion
+	data, err := json.Marshal(baseline)
+	if err != nil {
+		return fmt.Errorf("failed to encode away baseline: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create away baseline directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so a crash mid-write never leaves a torn baseline
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write away baseline: %w", err)
+	}
+	return nil
+}
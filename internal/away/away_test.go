@@ -0,0 +1,143 @@
+package away
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+var seenAt = time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+
+// at returns a task timestamp offset from seenAt
+func at(offset time.Duration) archon.FlexibleTime {
+	return archon.FlexibleTime{Time: seenAt.Add(offset)}
+}
+
+func baseline() *Baseline {
+	return &Baseline{
+		Version: SchemaVersion,
+		SeenAt:  seenAt,
+		Tasks: map[string]TaskState{
+			"mine-review": {Status: "doing", Assignee: "alice"},
+			"mine-same":   {Status: "doing", Assignee: "alice"},
+			"mine-todo":   {Status: "review", Assignee: "alice"},
+			"reassigned":  {Status: "todo", Assignee: "bob"},
+			"still-bob":   {Status: "todo", Assignee: "bob"},
+			"old-default": {Status: "todo"},
+		},
+	}
+}
+
+func options(now time.Time) Options {
+	return Options{CurrentUser: "Alice", DefaultProjectID: "p1", Threshold: 8 * time.Hour, Now: now}
+}
+
+func TestCompute(t *testing.T) {
+	current := []archon.Task{
+		{ID: "mine-review", ProjectID: "p1", Title: "Ship login", Status: "review", Assignee: "alice"},
+		{ID: "mine-same", ProjectID: "p1", Title: "Unchanged", Status: "doing", Assignee: "alice"},
+		{ID: "mine-todo", ProjectID: "p1", Title: "Sent back", Status: "todo", Assignee: "alice"},
+		{ID: "reassigned", ProjectID: "p2", Title: "Handover", Status: "todo", Assignee: "alice"},
+		{ID: "still-bob", ProjectID: "p1", Title: "Bob's", Status: "done", Assignee: "bob"},
+		{ID: "brand-new", ProjectID: "p1", Title: "Fresh", Status: "todo", CreatedAt: at(time.Hour), UpdatedAt: at(time.Hour)},
+		{ID: "new-mine", ProjectID: "p2", Title: "Assigned new", Status: "todo", Assignee: "alice", CreatedAt: at(time.Hour), UpdatedAt: at(time.Hour)},
+		{ID: "new-other", ProjectID: "p2", Title: "Other project", Status: "todo", CreatedAt: at(time.Hour), UpdatedAt: at(time.Hour)},
+		{ID: "unseen-old", ProjectID: "p1", Title: "Never loaded", Status: "todo", Assignee: "alice", CreatedAt: at(-time.Hour), UpdatedAt: at(-time.Hour)},
+		{ID: "archived", ProjectID: "p1", Title: "Gone", Status: "todo", Assignee: "alice", Archived: true, UpdatedAt: at(time.Hour)},
+	}
+
+	digest := Compute(baseline(), current, options(seenAt.Add(72*time.Hour)))
+	if digest == nil {
+		t.Fatal("Expected a digest")
+	}
+	if digest.AwayFor != 72*time.Hour || !digest.Since.Equal(seenAt) {
+		t.Errorf("Expected 72h away since baseline, got %v since %v", digest.AwayFor, digest.Since)
+	}
+
+	expected := []struct {
+		id     string
+		kind   Kind
+		detail string
+	}{
+		{"new-mine", KindAssigned, "assigned to you (todo)"},
+		{"reassigned", KindAssigned, "reassigned from bob (todo)"},
+		{"mine-review", KindStatus, "doing → review"},
+		{"brand-new", KindNew, "new (todo)"},
+	}
+	if len(digest.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %+v", len(expected), digest.Items)
+	}
+	for i, want := range expected {
+		got := digest.Items[i]
+		if got.TaskID != want.id || got.Kind != want.kind || got.Detail != want.detail {
+			t.Errorf("Item %d: expected %s/%s %q, got %s/%s %q", i, want.id, want.kind, want.detail, got.TaskID, got.Kind, got.Detail)
+		}
+	}
+}
+
+func TestCompute_Skips(t *testing.T) {
+	tasks := []archon.Task{{ID: "mine-review", Status: "review", Assignee: "alice"}}
+
+	if Compute(nil, tasks, options(seenAt.Add(72*time.Hour))) != nil {
+		t.Error("Expected no digest without a baseline")
+	}
+	if Compute(baseline(), tasks, options(seenAt.Add(time.Hour))) != nil {
+		t.Error("Expected no digest below the away threshold")
+	}
+	quiet := []archon.Task{{ID: "mine-same", Status: "doing", Assignee: "alice"}}
+	if Compute(baseline(), quiet, options(seenAt.Add(72*time.Hour))) != nil {
+		t.Error("Expected no digest when nothing changed")
+	}
+
+	// Without a configured user, only new tasks in the default project are reported
+	anonymous := options(seenAt.Add(72 * time.Hour))
+	anonymous.CurrentUser = ""
+	if Compute(baseline(), tasks, anonymous) != nil {
+		t.Error("Expected no personal items without a current user")
+	}
+}
+
+func TestCapture_KeepsUnloadedTasks(t *testing.T) {
+	now := seenAt.Add(time.Hour)
+	captured := Capture(baseline(), []archon.Task{{ID: "mine-review", Status: "review", Assignee: "alice"}}, now)
+
+	if !captured.SeenAt.Equal(now) || captured.Version != SchemaVersion {
+		t.Errorf("Expected stamped baseline, got %+v", captured)
+	}
+	if captured.Tasks["mine-review"].Status != "review" {
+		t.Errorf("Expected loaded task state updated, got %+v", captured.Tasks["mine-review"])
+	}
+	if _, ok := captured.Tasks["still-bob"]; !ok {
+		t.Error("Expected tasks not loaded this time to be kept")
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", "seen.json"))
+
+	if loaded, err := store.Load(); loaded != nil || err != nil {
+		t.Fatalf("Expected nothing before the first save, got %v (err %v)", loaded, err)
+	}
+
+	if err := store.Save(*baseline()); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+	loaded, err := store.Load()
+	if err != nil || loaded == nil {
+		t.Fatalf("Expected baseline, got %v (err %v)", loaded, err)
+	}
+	if !loaded.SeenAt.Equal(seenAt) || loaded.Tasks["reassigned"].Assignee != "bob" {
+		t.Errorf("Unexpected baseline contents: %+v", loaded)
+	}
+
+	if err := os.WriteFile(store.path, []byte(`{"version": 99}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+}
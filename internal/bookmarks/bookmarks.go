@@ -0,0 +1,125 @@
+// Package bookmarks persists task bookmarks in named slots, similar to vim marks.
+//
+// A slot is a single lowercase letter or digit. Each slot remembers a task ID
+// together with its project and title at the time it was set, so a bookmark
+// can be listed and followed even before the task's project has been loaded.
+package bookmarks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// SchemaVersion is the bookmark file format written by this build
+const SchemaVersion = 1
+
+// ErrUnsupportedVersion is returned when bookmarks were written with another schema version
+var ErrUnsupportedVersion = errors.New("unsupported bookmarks version")
+
+// Bookmark is a task remembered in a slot
+type Bookmark struct {
+	TaskID    string `json:"task_id"`
+	ProjectID string `json:"project_id,omitempty"`
+	Title     string `json:"title"` // Title when the bookmark was set, shown if the task is not loaded
+}
+
+// Entry is a bookmark together with its slot, as listed to the user
+type Entry struct {
+	Slot string
+	Bookmark
+}
+
+// Set maps slots to bookmarks
+type Set map[string]Bookmark
+
+// ValidSlot reports whether slot names a bookmark slot (a-z or 0-9)
+func ValidSlot(slot string) bool {
+	if len(slot) != 1 {
+		return false
+	}
+	c := slot[0]
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
+
+// Entries returns the bookmarks ordered by slot
+func (s Set) Entries() []Entry {
+	entries := make([]Entry, 0, len(s))
+	for slot, bookmark := range s {
+		entries = append(entries, Entry{Slot: slot, Bookmark: bookmark})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Slot < entries[j].Slot
+	})
+	return entries
+}
+
+// file is the on-disk representation of a Set
+type file struct {
+	Version   int `json:"version"`
+	Bookmarks Set `json:"bookmarks"`
+}
+
+// Store reads and writes bookmarks at a fixed path
+type Store struct {
+	path string
+}
+
+// NewStore creates a store that keeps bookmarks at path
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the bookmark file location in the user's config directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "lazyarchon", "bookmarks.json"), nil
+}
+
+// Load reads the bookmarks; returns an empty set without error when none were saved yet
+func (s *Store) Load() (Set, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Set{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bookmarks: %w", err)
+	}
+
+	var stored file
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse bookmarks: %w", err)
+	}
+	if stored.Version != SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, stored.Version)
+	}
+	if stored.Bookmarks == nil {
+		stored.Bookmarks = Set{}
+	}
+	return stored.Bookmarks, nil
+}
+
+// Save writes the bookmarks atomically
+func (s *Store) Save(set Set) error {
+	data, err := json.MarshalIndent(file{Version: SchemaVersion, Bookmarks: set}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bookmarks: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create bookmarks directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so a crash mid-write never loses bookmarks
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write bookmarks: %w", err)
+	}
+	return nil
+}
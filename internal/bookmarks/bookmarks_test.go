@@ -0,0 +1,65 @@
+package bookmarks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidSlot(t *testing.T) {
+	for _, slot := range []string{"a", "z", "0", "9"} {
+		if !ValidSlot(slot) {
+			t.Errorf("Expected %q to be a valid slot", slot)
+		}
+	}
+	for _, slot := range []string{"", "A", "ab", "'", "esc"} {
+		if ValidSlot(slot) {
+			t.Errorf("Expected %q to be rejected", slot)
+		}
+	}
+}
+
+func TestEntries_SortedBySlot(t *testing.T) {
+	set := Set{
+		"c": {TaskID: "t3"},
+		"1": {TaskID: "t1"},
+		"a": {TaskID: "t2"},
+	}
+
+	entries := set.Entries()
+	if len(entries) != 3 || entries[0].Slot != "1" || entries[1].Slot != "a" || entries[2].Slot != "c" {
+		t.Errorf("Expected entries ordered 1, a, c, got %+v", entries)
+	}
+	if entries[1].TaskID != "t2" {
+		t.Errorf("Expected slot a to hold t2, got %s", entries[1].TaskID)
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", "bookmarks.json"))
+
+	loaded, err := store.Load()
+	if err != nil || loaded == nil || len(loaded) != 0 {
+		t.Fatalf("Expected empty set before the first save, got %v (err %v)", loaded, err)
+	}
+
+	set := Set{"a": {TaskID: "t1", ProjectID: "p1", Title: "Ship login"}}
+	if err := store.Save(set); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+	loaded, err = store.Load()
+	if err != nil {
+		t.Fatalf("Unexpected load error: %v", err)
+	}
+	if loaded["a"] != set["a"] {
+		t.Errorf("Expected %+v, got %+v", set["a"], loaded["a"])
+	}
+
+	if err := os.WriteFile(store.path, []byte(`{"version": 99}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+}
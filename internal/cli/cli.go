@@ -0,0 +1,84 @@
+// Package cli implements lazyarchon's non-interactive subcommands, such as
+// "lazyarchon list", "lazyarchon set-status" and "lazyarchon watch". They load the same configuration as the TUI, talk to
+// Archon through the same client and print to stdout, so they can be used
+// from scripts and pipelines.
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+)
+
+// Exit codes returned by Run
+const (
+	ExitOK    = 0 // Command succeeded
+	ExitError = 1 // Command failed (API error, unknown project or task, ...)
+	ExitUsage = 2 // Invalid arguments
+)
+
+// errUsage marks argument errors, reported with ExitUsage
+var errUsage = errors.New("usage")
+
+// Client is the part of the Archon API the subcommands use
+type Client interface {
+	ListTasks(projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error)
+	ListProjects() (*archon.ProjectsResponse, error)
+	UpdateTask(taskID string, updates archon.UpdateTaskRequest) (*archon.TaskResponse, error)
+}
+
+// Env is what a subcommand runs against
+type Env struct {
+	Config  *config.Config
+	Client  Client
+	Stdout  io.Writer
+	Stderr  io.Writer
+	Context context.Context // Cancelled (e.g. on Ctrl+C) to stop long-running commands; nil = never
+}
+
+// command is one subcommand: it parses its own arguments and writes to env
+type command func(env Env, args []string) error
+
+// commands maps subcommand names to their implementation
+var commands = map[string]command{
+	"config":     runConfig,
+	"list":       runList,
+	"set-status": runSetStatus,
+	"watch":      runWatch,
+}
+
+// IsCommand reports whether name is a subcommand (os.Args[1] == "list", ...)
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok
+}
+
+// Run executes the named subcommand and returns the process exit code
+func Run(env Env, name string, args []string) int {
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(env.Stderr, "Error: unknown command %q\n", name)
+		return ExitUsage
+	}
+
+	err := cmd(env, args)
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, errUsage):
+		return ExitUsage
+	default:
+		fmt.Fprintf(env.Stderr, "Error: %v\n", err)
+		return ExitError
+	}
+}
+
+// usageError reports an argument problem; the message is printed before returning
+func usageError(env Env, format string, args ...interface{}) error {
+	fmt.Fprintf(env.Stderr, "Error: "+format+"\n", args...)
+	return errUsage
+}
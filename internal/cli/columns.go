@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+)
+
+// column is one task field that list can print
+type column struct {
+	name  string
+	value func(task archon.Task, fields export.TaskFields) string
+}
+
+// columns are the fields selectable with --columns, in help order
+var columns = []column{
+	{"id", func(_ archon.Task, f export.TaskFields) string { return f.ShortID }},
+	{"full_id", func(_ archon.Task, f export.TaskFields) string { return f.ID }},
+	{"status", func(t archon.Task, _ export.TaskFields) string { return t.Status }},
+	{"order", func(t archon.Task, _ export.TaskFields) string { return strconv.Itoa(t.TaskOrder) }},
+	{"feature", func(_ archon.Task, f export.TaskFields) string { return f.Feature }},
+	{"assignee", func(t archon.Task, _ export.TaskFields) string { return t.Assignee }},
+	{"title", func(_ archon.Task, f export.TaskFields) string { return f.Title }},
+	{"project_id", func(t archon.Task, _ export.TaskFields) string { return t.ProjectID }},
+	{"created", func(t archon.Task, _ export.TaskFields) string { return formatTime(t.CreatedAt.Time) }},
+	{"updated", func(t archon.Task, _ export.TaskFields) string { return formatTime(t.UpdatedAt.Time) }},
+}
+
+// defaultColumns is the --columns value when the flag is not given
+const defaultColumns = "id,status,order,feature,assignee,title"
+
+// columnNames lists the selectable columns for error messages
+func columnNames() string {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// standardColumns returns the columns printed without --columns
+func standardColumns() []column {
+	cols, err := parseColumns(defaultColumns)
+	if err != nil {
+		panic(err) // defaultColumns only names known columns
+	}
+	return cols
+}
+
+// parseColumns resolves a comma-separated --columns value in the given order
+func parseColumns(value string) ([]column, error) {
+	names := splitList(value)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no columns given (valid: %s)", columnNames())
+	}
+
+	selected := make([]column, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, col := range columns {
+			if strings.EqualFold(col.name, name) {
+				selected = append(selected, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q (valid: %s)", name, columnNames())
+		}
+	}
+	return selected, nil
+}
+
+// writeTaskTable prints tasks as an aligned table of cols, "-" for empty cells
+func writeTaskTable(env Env, tasks []archon.Task, cols []column) error {
+	table := tabwriter.NewWriter(env.Stdout, 0, 0, 2, ' ', 0)
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		header[i] = strings.ToUpper(col.name)
+	}
+	fmt.Fprintln(table, strings.Join(header, "\t"))
+
+	for _, row := range taskRows(env, tasks, cols) {
+		for i := range row {
+			row[i] = orDash(row[i])
+		}
+		fmt.Fprintln(table, strings.Join(row, "\t"))
+	}
+	return table.Flush()
+}
+
+// writeTaskCSV prints tasks as CSV with a header row of column names
+func writeTaskCSV(env Env, tasks []archon.Task, cols []column) error {
+	writer := csv.NewWriter(env.Stdout)
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		header[i] = col.name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(taskRows(env, tasks, cols)); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// taskRows returns the cells of cols for each task
+func taskRows(env Env, tasks []archon.Task, cols []column) [][]string {
+	shortIDLength := env.Config.GetShortIDLength()
+	rows := make([][]string, len(tasks))
+	for i, task := range tasks {
+		fields := export.NewTaskFields(task, shortIDLength)
+		rows[i] = make([]string, len(cols))
+		for j, col := range cols {
+			rows[i][j] = col.value(task, fields)
+		}
+	}
+	return rows
+}
+
+// formatTime prints a timestamp as RFC 3339 in UTC, empty when unset
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
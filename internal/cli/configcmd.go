@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/yousfisaad/lazyarchon/v2/schema"
+)
+
+// runConfig prints information about the config file:
+//
+//	lazyarchon config schema > ~/.config/lazyarchon/config.schema.json
+func runConfig(env Env, args []string) error {
+	if len(args) != 1 {
+		return usageError(env, "expected a config subcommand (valid: schema)")
+	}
+	switch args[0] {
+	case "schema":
+		_, err := env.Stdout.Write(schema.Config)
+		return err
+	default:
+		return usageError(env, "unknown config subcommand %q (valid: schema)", args[0])
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/schema"
+)
+
+func TestConfigSchema(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+
+	if code := Run(env, "config", []string{"schema"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	if !bytes.Equal(stdout.Bytes(), schema.Config) {
+		t.Error("Expected the embedded schema on stdout")
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &decoded); err != nil || decoded["$schema"] == nil {
+		t.Errorf("Expected a JSON Schema document, got err %v", err)
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	for _, args := range [][]string{nil, {"dump"}, {"schema", "extra"}} {
+		env, _, stderr := newTestEnv(t)
+		if code := Run(env, "config", args); code != ExitUsage {
+			t.Errorf("Expected usage error for %q, got %d", args, code)
+		}
+		if !strings.Contains(stderr.String(), "valid: schema") {
+			t.Errorf("Expected the valid subcommands in %q", stderr)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
+)
+
+// filterOptions are the task selection flags shared by list and watch
+type filterOptions struct {
+	Project  string // Project ID, ID prefix or title
+	Statuses string // Comma-separated statuses
+	Features string // Comma-separated features
+	All      bool   // Include done tasks even when show_completed_tasks is off
+}
+
+// listOptions are the flags of "lazyarchon list"
+type listOptions struct {
+	filterOptions
+	Sort    string // Sort mode name (default: ui.display.default_sort_mode)
+	Columns string // Comma-separated columns for table and CSV output
+	JSON    bool   // Print JSON instead of a table
+	CSV     bool   // Print CSV instead of a table
+}
+
+// register adds the filter flags to flags
+func (opts *filterOptions) register(flags *flag.FlagSet) {
+	flags.StringVar(&opts.Project, "project", "", "Project ID, ID prefix or title")
+	flags.StringVar(&opts.Statuses, "status", "", "Only these statuses, comma-separated (todo,doing,review,done)")
+	flags.StringVar(&opts.Features, "feature", "", "Only tasks tagged with these features, comma-separated")
+	flags.BoolVar(&opts.All, "all", false, "Include done tasks")
+}
+
+// filters validates the flags and resolves the project into task filters
+func (opts filterOptions) filters(env Env) (helpers.TaskFilters, error) {
+	filters := helpers.TaskFilters{
+		ShowCompletedTasks: opts.All || env.Config.IsCompletedTasksVisible(),
+		StatusOrder:        env.Config.GetStatusOrder(),
+	}
+	if statuses := splitList(opts.Statuses); len(statuses) > 0 {
+		filters.StatusFilters, filters.StatusFilterActive = make(map[string]bool), true
+		statusUtils := utils.NewTaskStatusUtils()
+		for _, status := range statuses {
+			if !statusUtils.IsValidStatus(status) {
+				return filters, usageError(env, "unknown status %q", status)
+			}
+			filters.StatusFilters[status] = true
+		}
+	}
+	if features := splitList(opts.Features); len(features) > 0 {
+		filters.FeatureFilters = make(map[string]bool)
+		for _, feature := range features {
+			filters.FeatureFilters[feature] = true
+		}
+	}
+
+	if opts.Project != "" {
+		project, err := resolveProject(env.Client, opts.Project)
+		if err != nil {
+			return filters, err
+		}
+		filters.ProjectID = &project.ID
+	}
+	return filters, nil
+}
+
+// ListCommand returns the "lazyarchon list" command selecting the same tasks as
+// filters, sorted by sortMode. completedVisible is the show_completed_tasks
+// setting the command will run with, so --all or --status is only added when
+// filters.ShowCompletedTasks differs from it. Unlike the TUI filter, --feature
+// also drops tasks without a feature.
+func ListCommand(filters helpers.TaskFilters, sortMode int, completedVisible bool) string {
+	args := []string{"lazyarchon", "list"}
+	if filters.ProjectID != nil {
+		args = append(args, "--project", shellQuote(*filters.ProjectID))
+	}
+
+	switch {
+	case filters.StatusFilterActive:
+		var statuses []string
+		for _, status := range []string{archon.TaskStatusTodo, archon.TaskStatusDoing, archon.TaskStatusReview, archon.TaskStatusDone} {
+			if filters.StatusFilters[status] {
+				statuses = append(statuses, status)
+			}
+		}
+		args = append(args, "--status", strings.Join(statuses, ","))
+	case filters.ShowCompletedTasks && !completedVisible:
+		args = append(args, "--all")
+	case !filters.ShowCompletedTasks && completedVisible:
+		args = append(args, "--status", "todo,doing,review")
+	}
+
+	var features []string
+	for feature, enabled := range filters.FeatureFilters {
+		if enabled {
+			features = append(features, feature)
+		}
+	}
+	if len(features) > 0 {
+		sort.Strings(features)
+		args = append(args, "--feature", shellQuote(strings.Join(features, ",")))
+	}
+
+	return strings.Join(append(args, "--sort", shellQuote(sorting.GetSortModeName(sortMode))), " ")
+}
+
+// shellQuote single-quotes value unless it is safe to pass to a shell as is
+func shellQuote(value string) string {
+	safe := value != ""
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_@%+=:,./-", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// selectTasks returns the tasks matching filters in sortMode order
+func selectTasks(tasks []archon.Task, sortMode int, filters helpers.TaskFilters) []archon.Task {
+	selected := helpers.FilterAndSortTasks(tasks, sortMode, filters)
+	if filters.FeatureFilters != nil {
+		// Unlike the TUI filter, --feature also drops tasks without a feature
+		selected = withFeature(selected)
+	}
+	return selected
+}
+
+// runList prints the tasks matching the flags:
+//
+//	lazyarchon list --project Web --status doing,review --json
+//	lazyarchon list --columns full_id,status,title --csv
+func runList(env Env, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	var opts listOptions
+	opts.register(flags)
+	flags.StringVar(&opts.Sort, "sort", "", "Sort mode: status+priority, priority, time, alphabetical, recent")
+	flags.StringVar(&opts.Columns, "columns", "", "Columns to print, in order (default: "+defaultColumns+"; valid: "+columnNames()+")")
+	flags.BoolVar(&opts.JSON, "json", false, "Print JSON instead of a table")
+	flags.BoolVar(&opts.CSV, "csv", false, "Print CSV instead of a table")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if flags.NArg() > 0 {
+		return usageError(env, "unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	if opts.JSON && (opts.CSV || opts.Columns != "") {
+		return usageError(env, "--json cannot be combined with --csv or --columns")
+	}
+	cols := standardColumns()
+	if opts.Columns != "" {
+		var err error
+		if cols, err = parseColumns(opts.Columns); err != nil {
+			return usageError(env, "%v", err)
+		}
+	}
+
+	sortName := opts.Sort
+	if sortName == "" {
+		sortName = env.Config.GetDefaultSortMode()
+	}
+	sortMode, ok := sorting.ParseSortMode(sortName)
+	if !ok {
+		return usageError(env, "unknown sort mode %q", sortName)
+	}
+	filters, err := opts.filters(env)
+	if err != nil {
+		return err
+	}
+
+	all, err := fetchAllTasks(env, filters.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+	tasks := selectTasks(all, sortMode, filters)
+	if tasks == nil {
+		tasks = []archon.Task{} // Print [] rather than null
+	}
+
+	if opts.JSON {
+		return writeJSON(env, tasks)
+	}
+	tasks = archon.SanitizeTasks(tasks, env.Config.GetSanitizeLimits())
+	if opts.CSV {
+		return writeTaskCSV(env, tasks, cols)
+	}
+	return writeTaskTable(env, tasks, cols)
+}
+
+// allTasksLister is implemented by clients that can load the task list a page at a time
+type allTasksLister interface {
+	ListTasksAllContext(ctx context.Context, opts archon.ListTasksOptions, onPage func(page *archon.TasksResponse) error) error
+}
+
+// fetchAllTasks loads every task of projectID (nil = all projects), in pages
+// of server.page_size when set so large lists do not need one huge response.
+// Paging stops once env.Context is cancelled.
+func fetchAllTasks(env Env, projectID *string) ([]archon.Task, error) {
+	pager, ok := env.Client.(allTasksLister)
+	if pageSize := env.Config.GetPageSize(); ok && pageSize > 0 {
+		ctx := env.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		var tasks []archon.Task
+		err := pager.ListTasksAllContext(ctx, archon.ListTasksOptions{ProjectID: projectID, IncludeClosed: true, Limit: pageSize},
+			func(page *archon.TasksResponse) error {
+				tasks = append(tasks, page.Tasks...)
+				return nil
+			})
+		return tasks, err
+	}
+	resp, err := env.Client.ListTasks(projectID, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tasks, nil
+}
+
+// resolveProject finds a project by exact ID, unique ID prefix or case-insensitive title
+func resolveProject(client Client, query string) (*archon.Project, error) {
+	resp, err := client.ListProjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+
+	var matches []archon.Project
+	for _, project := range resp.Projects {
+		if project.ID == query {
+			return &project, nil
+		}
+		if strings.HasPrefix(project.ID, query) || strings.EqualFold(project.Title, query) {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no project matches %q", query)
+	case 1:
+		return &matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, project := range matches {
+			names[i] = fmt.Sprintf("%s (%s)", project.Title, project.ID)
+		}
+		return nil, fmt.Errorf("project %q is ambiguous: %s", query, strings.Join(names, ", "))
+	}
+}
+
+// writeJSON prints v as indented JSON
+func writeJSON(env Env, v interface{}) error {
+	encoder := json.NewEncoder(env.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// withFeature keeps the tasks that have a feature
+func withFeature(tasks []archon.Task) []archon.Task {
+	kept := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.Feature != nil && *task.Feature != "" {
+			kept = append(kept, task)
+		}
+	}
+	return kept
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// orDash returns "-" for empty table cells
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
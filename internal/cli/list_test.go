@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
+)
+
+// newTestEnv returns an environment backed by a mock Archon server
+func newTestEnv(t *testing.T) (Env, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	server := archon.NewMockServer()
+	t.Cleanup(server.Close)
+
+	web, mobile := "auth", "ui"
+	server.AddProject(archon.Project{ID: "1a2b3c4d-web", Title: "Web"})
+	server.AddProject(archon.Project{ID: "1a2b9999-mobile", Title: "Mobile"})
+	server.AddTask(archon.Task{ID: "aaaaaaaa-1", ProjectID: "1a2b3c4d-web", Title: "Fix login", Status: "doing", TaskOrder: 10, Feature: &web, Assignee: "alice"})
+	server.AddTask(archon.Task{ID: "bbbbbbbb-2", ProjectID: "1a2b3c4d-web", Title: "Write docs", Status: "todo", TaskOrder: 5})
+	server.AddTask(archon.Task{ID: "cccccccc-3", ProjectID: "1a2b3c4d-web", Title: "Ship v1", Status: "done", TaskOrder: 1})
+	server.AddTask(archon.Task{ID: "dddddddd-4", ProjectID: "1a2b9999-mobile", Title: "Dark mode", Status: "doing", TaskOrder: 3, Feature: &mobile})
+
+	cfg := &config.Config{}
+	cfg.UI.Display.DefaultSortMode = "alphabetical"
+	cfg.UI.Display.ShowCompletedTasks = false
+
+	var stdout, stderr bytes.Buffer
+	return Env{
+		Config: cfg,
+		Client: archon.NewClient(server.URL, ""),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}, &stdout, &stderr
+}
+
+func TestListTable(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+
+	if code := Run(env, "list", []string{"--project", "web"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	want := "" +
+		"ID        STATUS  ORDER  FEATURE  ASSIGNEE  TITLE\n" +
+		"aaaaaaaa  doing   10     auth     alice     Fix login\n" +
+		"bbbbbbbb  todo    5      -        -         Write docs\n"
+	if stdout.String() != want {
+		t.Errorf("Expected open Web tasks sorted alphabetically, got:\n%s", stdout)
+	}
+}
+
+func TestListColumns(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+
+	if code := Run(env, "list", []string{"--project", "web", "--columns", "title,STATUS,full_id"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	want := "" +
+		"TITLE       STATUS  FULL_ID\n" +
+		"Fix login   doing   aaaaaaaa-1\n" +
+		"Write docs  todo    bbbbbbbb-2\n"
+	if stdout.String() != want {
+		t.Errorf("Expected the columns in the given order, got:\n%s", stdout)
+	}
+
+	stdout.Reset()
+	if code := Run(env, "list", []string{"--project", "web", "--csv", "--columns", "id, feature,title"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	want = "" +
+		"id,feature,title\n" +
+		"aaaaaaaa,auth,Fix login\n" +
+		"bbbbbbbb,,Write docs\n"
+	if stdout.String() != want {
+		t.Errorf("Expected CSV with empty cells, got:\n%s", stdout)
+	}
+}
+
+func TestListJSONFilters(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+
+	code := Run(env, "list", []string{"--status", "doing,done", "--sort", "priority", "--json"})
+	if code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	var tasks []archon.Task
+	if err := json.Unmarshal(stdout.Bytes(), &tasks); err != nil {
+		t.Fatalf("Expected JSON output, got %q: %v", stdout, err)
+	}
+	var titles []string
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	if got := strings.Join(titles, ", "); got != "Fix login, Dark mode, Ship v1" {
+		t.Errorf("Expected doing and done tasks by priority, got %s", got)
+	}
+
+	stdout.Reset()
+	if code := Run(env, "list", []string{"--feature", "nope", "--json"}); code != ExitOK || strings.TrimSpace(stdout.String()) != "[]" {
+		t.Errorf("Expected an empty JSON array, got %d %q", code, stdout)
+	}
+}
+
+func TestListPaged(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+	env.Config.Server.PageSize = 1
+
+	if code := Run(env, "list", []string{"--all", "--columns", "id"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	if lines := strings.Count(stdout.String(), "\n"); lines != 5 {
+		t.Errorf("Expected every task across the pages, got:\n%s", stdout)
+	}
+}
+
+func TestListErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"ambiguous project", []string{"--project", "1a2b"}, ExitError, "is ambiguous"},
+		{"unknown project", []string{"--project", "Backend"}, ExitError, "no project matches"},
+		{"unknown status", []string{"--status", "blocked"}, ExitUsage, `unknown status "blocked"`},
+		{"unknown sort", []string{"--sort", "random"}, ExitUsage, `unknown sort mode "random"`},
+		{"stray argument", []string{"doing"}, ExitUsage, "unexpected arguments"},
+		{"unknown column", []string{"--columns", "id,priority"}, ExitUsage, `unknown column "priority" (valid: id, full_id, status, order, feature, assignee, title, project_id, created, updated)`},
+		{"empty columns", []string{"--columns", ","}, ExitUsage, "no columns given"},
+		{"columns with json", []string{"--columns", "id", "--json"}, ExitUsage, "--json cannot be combined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, stdout, stderr := newTestEnv(t)
+			if code := Run(env, "list", tt.args); code != tt.code {
+				t.Errorf("Expected exit code %d, got %d", tt.code, code)
+			}
+			if !strings.Contains(stderr.String(), tt.want) || stdout.Len() != 0 {
+				t.Errorf("Expected %q on stderr and nothing on stdout, got %q / %q", tt.want, stderr, stdout)
+			}
+		})
+	}
+}
+
+func TestListCommand(t *testing.T) {
+	web := "1a2b3c4d-web"
+	tests := []struct {
+		name             string
+		filters          helpers.TaskFilters
+		sortMode         int
+		completedVisible bool
+		want             string
+	}{
+		{"defaults", helpers.TaskFilters{}, sorting.SortStatusPriority, false,
+			"lazyarchon list --sort status+priority"},
+		{"project and statuses", helpers.TaskFilters{
+			ProjectID:          &web,
+			StatusFilters:      map[string]bool{"review": true, "doing": true, "todo": false},
+			StatusFilterActive: true,
+		}, sorting.SortPriorityOnly, false,
+			"lazyarchon list --project 1a2b3c4d-web --status doing,review --sort priority"},
+		{"done shown", helpers.TaskFilters{ShowCompletedTasks: true}, sorting.SortAlphabetical, false,
+			"lazyarchon list --all --sort alphabetical"},
+		{"done hidden", helpers.TaskFilters{}, sorting.SortAlphabetical, true,
+			"lazyarchon list --status todo,doing,review --sort alphabetical"},
+		{"quoted features", helpers.TaskFilters{
+			FeatureFilters: map[string]bool{"ui": true, "auth flow": true, "it's": false},
+		}, sorting.SortAlphabetical, false,
+			"lazyarchon list --feature 'auth flow,ui' --sort alphabetical"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ListCommand(tt.filters, tt.sortMode, tt.completedVisible); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	if got := shellQuote("it's"); got != `'it'\''s'` {
+		t.Errorf("Expected an escaped single quote, got %s", got)
+	}
+}
+
+// TestListCommandRoundTrip runs a generated command and expects the tasks the filters select
+func TestListCommandRoundTrip(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+	web := "1a2b3c4d-web"
+	filters := helpers.TaskFilters{ProjectID: &web, ShowCompletedTasks: true}
+
+	args := strings.Fields(ListCommand(filters, sorting.SortPriorityOnly, env.Config.IsCompletedTasksVisible()))
+	if code := Run(env, args[1], append(args[2:], "--columns", "title")); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	want := "TITLE\nFix login\nWrite docs\nShip v1\n"
+	if stdout.String() != want {
+		t.Errorf("Expected every Web task by priority, got:\n%s", stdout)
+	}
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils"
+)
+
+// runSetStatus changes the status of one task and prints the updated task:
+//
+//	lazyarchon set-status 1a2b3c4d doing
+func runSetStatus(env Env, args []string) error {
+	flags := flag.NewFlagSet("set-status", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(env.Stderr, "Usage: lazyarchon set-status [--json] <task-id> <status>")
+		flags.PrintDefaults()
+	}
+	asJSON := flags.Bool("json", false, "Print the updated task as JSON")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if flags.NArg() != 2 {
+		return usageError(env, "expected <task-id> <status>, got %d arguments", flags.NArg())
+	}
+	taskQuery, status := flags.Arg(0), flags.Arg(1)
+	if taskQuery == "" {
+		return usageError(env, "task ID must not be empty")
+	}
+
+	statusUtils := utils.NewTaskStatusUtils()
+	if !statusUtils.IsValidStatus(status) {
+		return usageError(env, "unknown status %q (expected one of %s)", status, strings.Join(statusUtils.GetAllStatuses(), ", "))
+	}
+
+	task, err := resolveTask(env.Client, taskQuery)
+	if err != nil {
+		return err
+	}
+	resp, err := env.Client.UpdateTask(task.ID, archon.UpdateTaskRequest{Status: &status})
+	if err != nil {
+		return fmt.Errorf("failed to update task %s: %w", task.ID, err)
+	}
+
+	if *asJSON {
+		return writeJSON(env, resp.Task)
+	}
+	return writeTaskTable(env, archon.SanitizeTasks([]archon.Task{resp.Task}, env.Config.GetSanitizeLimits()), standardColumns())
+}
+
+// resolveTask finds a task by exact ID or unique ID prefix (such as the short
+// IDs printed by "lazyarchon list")
+func resolveTask(client Client, query string) (*archon.Task, error) {
+	resp, err := client.ListTasks(nil, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	var matches []archon.Task
+	for _, task := range resp.Tasks {
+		if task.ID == query {
+			return &task, nil
+		}
+		if strings.HasPrefix(task.ID, query) {
+			matches = append(matches, task)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no task matches %q", query)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, task := range matches {
+			ids[i] = task.ID
+		}
+		return nil, fmt.Errorf("task ID %q is ambiguous: %s", query, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func TestSetStatus(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+
+	if code := Run(env, "set-status", []string{"bbbbbbbb", "review"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	want := "" +
+		"ID        STATUS  ORDER  FEATURE  ASSIGNEE  TITLE\n" +
+		"bbbbbbbb  review  5      -        -         Write docs\n"
+	if stdout.String() != want {
+		t.Errorf("Expected the updated task, got:\n%s", stdout)
+	}
+
+	stdout.Reset()
+	if code := Run(env, "set-status", []string{"--json", "cccccccc-3", "todo"}); code != ExitOK {
+		t.Fatalf("Expected success, got %d: %s", code, stderr)
+	}
+	var task archon.Task
+	if err := json.Unmarshal(stdout.Bytes(), &task); err != nil || task.ID != "cccccccc-3" || task.Status != "todo" {
+		t.Errorf("Expected the updated task as JSON, got %q (err %v)", stdout, err)
+	}
+}
+
+func TestSetStatusErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"unknown task", []string{"eeee", "doing"}, ExitError, `no task matches "eeee"`},
+		{"unknown status", []string{"aaaaaaaa", "blocked"}, ExitUsage, `unknown status "blocked" (expected one of todo, doing, review, done)`},
+		{"missing status", []string{"aaaaaaaa"}, ExitUsage, "expected <task-id> <status>"},
+		{"empty task ID", []string{"", "doing"}, ExitUsage, "task ID must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, stdout, stderr := newTestEnv(t)
+			if code := Run(env, "set-status", tt.args); code != tt.code {
+				t.Errorf("Expected exit code %d, got %d", tt.code, code)
+			}
+			if !strings.Contains(stderr.String(), tt.want) || stdout.Len() != 0 {
+				t.Errorf("Expected %q on stderr and nothing on stdout, got %q / %q", tt.want, stderr, stdout)
+			}
+		})
+	}
+}
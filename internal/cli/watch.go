@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
+)
+
+// watchOptions are the flags of "lazyarchon watch"
+type watchOptions struct {
+	filterOptions
+	Interval time.Duration // Time between polls (default: server.polling_interval)
+	JSON     bool          // Print one JSON object per change
+}
+
+// watchEvent is one change as printed with --json
+type watchEvent struct {
+	At time.Time `json:"at"`
+	taskdiff.Change
+}
+
+// runWatch polls Archon and prints one line per task change until interrupted:
+//
+//	lazyarchon watch --project Web --status doing,review
+func runWatch(env Env, args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	var opts watchOptions
+	opts.register(flags)
+	flags.DurationVar(&opts.Interval, "interval", 0, "Time between polls (default: server.polling_interval)")
+	flags.BoolVar(&opts.JSON, "json", false, "Print one JSON object per change")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if flags.NArg() > 0 {
+		return usageError(env, "unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+	interval := opts.Interval
+	if interval == 0 {
+		interval = time.Duration(env.Config.GetPollingInterval()) * time.Second
+	}
+	if interval < 0 {
+		return usageError(env, "interval must be positive, got %s", interval)
+	}
+
+	filters, err := opts.filters(env)
+	if err != nil {
+		return err
+	}
+	previous, err := fetchWatched(env, filters)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(env.Stderr, "Watching %d tasks every %s (Ctrl+C to stop)\n",
+		len(selectTasks(previous, sorting.SortStatusPriority, filters)), interval)
+
+	ctx := env.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := fetchWatched(env, filters)
+		if err != nil {
+			// Keep watching: the next poll may succeed
+			fmt.Fprintf(env.Stderr, "%s %v\n", time.Now().Format(time.TimeOnly), err)
+			continue
+		}
+		for _, change := range watchedChanges(previous, current, filters) {
+			if err := printChange(env, opts.JSON, change); err != nil {
+				return err
+			}
+		}
+		previous = current
+	}
+}
+
+// fetchWatched loads the tasks of the watched project (all projects when unset)
+func fetchWatched(env Env, filters helpers.TaskFilters) ([]archon.Task, error) {
+	resp, err := env.Client.ListTasks(filters.ProjectID, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+	return archon.SanitizeTasks(resp.Tasks, env.Config.GetSanitizeLimits()), nil
+}
+
+// watchedChanges returns the changes to tasks matching filters before or after
+// the poll, so a task leaving the filter (e.g. doing -> done) is still reported
+func watchedChanges(previous, current []archon.Task, filters helpers.TaskFilters) []taskdiff.Change {
+	watched := make(map[string]bool)
+	for _, tasks := range [][]archon.Task{previous, current} {
+		for _, task := range selectTasks(tasks, sorting.SortStatusPriority, filters) {
+			watched[task.ID] = true
+		}
+	}
+
+	var changes []taskdiff.Change
+	for _, change := range taskdiff.Diff(previous, current) {
+		if watched[change.Task.ID] {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// printChange writes one change as a line of text or JSON
+func printChange(env Env, asJSON bool, change taskdiff.Change) error {
+	now := time.Now()
+	if asJSON {
+		line, err := json.Marshal(watchEvent{At: now, Change: change})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(env.Stdout, "%s\n", line)
+		return err
+	}
+	_, err := fmt.Fprintf(env.Stdout, "%s %s\n", now.Format(time.TimeOnly), formatChange(change, env.Config.GetShortIDLength()))
+	return err
+}
+
+// formatChange describes a change in one line, prefixed with + (added),
+// ~ (updated) or - (removed), e.g.
+// "~ 1a2b3c4d Fix login: status doing → review, description changed"
+func formatChange(change taskdiff.Change, shortIDLength int) string {
+	id := export.ShortID(change.Task.ID, shortIDLength)
+	switch change.Kind {
+	case taskdiff.Added:
+		return fmt.Sprintf("+ %s [%s] %s", id, change.Task.Status, change.Task.Title)
+	case taskdiff.Removed:
+		return fmt.Sprintf("- %s %s", id, change.Task.Title)
+	}
+
+	parts := make([]string, 0, len(change.Fields))
+	for _, field := range change.Fields {
+		if field.Field == taskdiff.FieldDescription {
+			parts = append(parts, "description changed")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s → %s", field.Field, orDash(field.Old), orDash(field.New)))
+	}
+	return fmt.Sprintf("~ %s %s: %s", id, change.Task.Title, strings.Join(parts, ", "))
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// pollingClient runs hooks before given ListTasks calls (1 = the initial fetch)
+type pollingClient struct {
+	Client
+	polls  int
+	before map[int]func()
+}
+
+func (c *pollingClient) ListTasks(projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error) {
+	c.polls++
+	if hook := c.before[c.polls]; hook != nil {
+		hook()
+	}
+	return c.Client.ListTasks(projectID, status, includeClosed)
+}
+
+func TestWatchPrintsChanges(t *testing.T) {
+	env, stdout, stderr := newTestEnv(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env.Context = ctx
+
+	server := env.Client
+	setStatus := func(id, status string) {
+		if _, err := server.UpdateTask(id, archon.UpdateTaskRequest{Status: &status}); err != nil {
+			t.Errorf("UpdateTask failed: %v", err)
+		}
+	}
+	env.Client = &pollingClient{Client: server, before: map[int]func(){
+		2: func() {
+			setStatus("bbbbbbbb-2", "doing")  // Stays in the filter
+			setStatus("aaaaaaaa-1", "review") // Leaves the filter: still reported
+			setStatus("dddddddd-4", "done")   // Other project: not reported
+			cancel()
+		},
+	}}
+
+	code := Run(env, "watch", []string{"--project", "web", "--status", "todo,doing", "--interval", "1ms"})
+	if code != ExitOK {
+		t.Fatalf("Expected a clean stop, got %d: %s", code, stderr)
+	}
+	if !strings.Contains(stderr.String(), "Watching 2 tasks every 1ms") {
+		t.Errorf("Expected the watch banner on stderr, got %q", stderr)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		// Drop the HH:MM:SS prefix
+		_, change, _ := strings.Cut(line, " ")
+		lines = append(lines, change)
+	}
+	sort.Strings(lines)
+	want := []string{
+		"~ aaaaaaaa Fix login: status doing → review",
+		"~ bbbbbbbb Write docs: status todo → doing",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected change lines:\n%s", stdout)
+	}
+}
+
+func TestWatchErrors(t *testing.T) {
+	env, _, stderr := newTestEnv(t)
+	if code := Run(env, "watch", []string{"--interval", "-1s"}); code != ExitUsage || !strings.Contains(stderr.String(), "interval must be positive") {
+		t.Errorf("Expected a usage error for a negative interval, got %d %q", code, stderr)
+	}
+}
@@ -0,0 +1,240 @@
+// Package deps derives which tasks are waiting on which, and ranks the tasks
+// whose completion would unblock the most other work.
+//
+// Archon has no explicit blocking field, so relations come from the task
+// hierarchy: a parent cannot be finished while one of its subtasks is open,
+// so every open subtask blocks its open parent. Done and archived tasks block
+// nothing and are never blocked. Add accepts relations from other sources.
+//
+// Malformed data can make relations circular (e.g. two tasks naming each
+// other as parent). Cycles are reported separately rather than followed.
+package deps
+
+import (
+	"math/bits"
+	"slices"
+	"sort"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// Graph holds "blocker → blocked" relations between open tasks
+type Graph struct {
+	order      []string            // Task IDs in the order given, for stable ranking
+	index      map[string]int      // Task ID → position in order
+	dependents map[string][]string // Blocker → tasks directly waiting on it
+	blockers   map[string][]string // Blocked task → tasks it directly waits on
+}
+
+// isOpen reports whether a task can block or be blocked
+func isOpen(task archon.Task) bool {
+	return task.Status != archon.TaskStatusDone && !task.Archived
+}
+
+// FromTasks builds the graph for tasks. Relations to tasks not in the list are ignored,
+// so passing only the visible tasks restricts the graph to the active project and filters.
+func FromTasks(tasks []archon.Task) *Graph {
+	g := &Graph{
+		index:      make(map[string]int, len(tasks)),
+		dependents: make(map[string][]string),
+		blockers:   make(map[string][]string),
+	}
+	for _, task := range tasks {
+		if !isOpen(task) {
+			continue
+		}
+		if _, seen := g.index[task.ID]; seen {
+			continue
+		}
+		g.index[task.ID] = len(g.order)
+		g.order = append(g.order, task.ID)
+	}
+	for _, task := range tasks {
+		if task.ParentTaskID != nil && *task.ParentTaskID != "" {
+			g.Add(*task.ParentTaskID, task.ID)
+		}
+	}
+	return g
+}
+
+// Add records that blocked waits on blocker. Relations involving tasks that
+// are not open members of the graph, and duplicates, are ignored.
+func (g *Graph) Add(blocked, blocker string) {
+	if _, ok := g.index[blocked]; !ok {
+		return
+	}
+	if _, ok := g.index[blocker]; !ok {
+		return
+	}
+	if slices.Contains(g.blockers[blocked], blocker) {
+		return
+	}
+	g.blockers[blocked] = append(g.blockers[blocked], blocker)
+	g.dependents[blocker] = append(g.dependents[blocker], blocked)
+}
+
+// IsBlocked reports whether the task waits on at least one open task
+func (g *Graph) IsBlocked(taskID string) bool {
+	return len(g.blockers[taskID]) > 0
+}
+
+// BlockedBy returns the open tasks the task directly waits on
+func (g *Graph) BlockedBy(taskID string) []string {
+	return slices.Clone(g.blockers[taskID])
+}
+
+// Entry is a task ranked by the work its completion would unblock
+type Entry struct {
+	TaskID   string
+	Unblocks []string // Tasks transitively waiting on it, in graph order
+}
+
+// Report ranks blocking tasks and lists relation cycles found on the way
+type Report struct {
+	Entries []Entry    // Most unblocked work first
+	Cycles  [][]string // Tasks that (transitively) wait on each other, in graph order
+}
+
+// Unblock ranks every task that blocks others by the number of tasks
+// transitively waiting on it; ties keep graph order. Each task's dependents
+// are computed once per strongly connected component (memoized DFS), so the
+// report stays fast for thousands of tasks even when relations form cycles.
+func (g *Graph) Unblock() Report {
+	components := g.components()
+
+	// Components come out of Tarjan's algorithm dependents-first, so every
+	// component a task points to already has its reach computed. Reach is a
+	// bitset over graph order, which keeps unions cheap and results ordered.
+	words := (len(g.order) + 63) / 64
+	reach := make([][]uint64, len(components))
+	componentOf := make(map[string]int, len(g.order))
+	for c, members := range components {
+		for _, id := range members {
+			componentOf[id] = c
+		}
+	}
+
+	var report Report
+	for c, members := range components {
+		set := make([]uint64, words)
+		for _, id := range members {
+			for _, dependent := range g.dependents[id] {
+				d := componentOf[dependent]
+				if d == c {
+					continue
+				}
+				for _, member := range components[d] {
+					i := g.index[member]
+					set[i/64] |= 1 << (i % 64)
+				}
+				for w, word := range reach[d] {
+					set[w] |= word
+				}
+			}
+		}
+		if g.isCycle(members) {
+			// Members of a cycle wait on each other
+			for _, member := range members {
+				i := g.index[member]
+				set[i/64] |= 1 << (i % 64)
+			}
+			report.Cycles = append(report.Cycles, g.sorted(members))
+		}
+		reach[c] = set
+	}
+
+	for i, id := range g.order {
+		set := reach[componentOf[id]]
+		var unblocks []string
+		for w, word := range set {
+			for word != 0 {
+				j := w*64 + bits.TrailingZeros64(word)
+				word &= word - 1
+				if j != i {
+					unblocks = append(unblocks, g.order[j])
+				}
+			}
+		}
+		if len(unblocks) == 0 {
+			continue
+		}
+		report.Entries = append(report.Entries, Entry{TaskID: id, Unblocks: unblocks})
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		return len(report.Entries[i].Unblocks) > len(report.Entries[j].Unblocks)
+	})
+	sort.Slice(report.Cycles, func(i, j int) bool {
+		return g.index[report.Cycles[i][0]] < g.index[report.Cycles[j][0]]
+	})
+	return report
+}
+
+// isCycle reports whether a component's members wait on each other
+func (g *Graph) isCycle(members []string) bool {
+	if len(members) > 1 {
+		return true
+	}
+	return slices.Contains(g.dependents[members[0]], members[0])
+}
+
+// sorted returns ids in graph order
+func (g *Graph) sorted(ids []string) []string {
+	out := slices.Clone(ids)
+	sort.Slice(out, func(i, j int) bool {
+		return g.index[out[i]] < g.index[out[j]]
+	})
+	return out
+}
+
+// components returns the strongly connected components of the "blocker → dependent"
+// relation using Tarjan's algorithm; a component is emitted after every component
+// reachable from it
+func (g *Graph) components() [][]string {
+	var (
+		next       int
+		stack      []string
+		onStack    = make(map[string]bool, len(g.order))
+		indexOf    = make(map[string]int, len(g.order))
+		lowlink    = make(map[string]int, len(g.order))
+		components [][]string
+		visit      func(id string)
+	)
+
+	visit = func(id string) {
+		indexOf[id], lowlink[id] = next, next
+		next++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, dependent := range g.dependents[id] {
+			if _, visited := indexOf[dependent]; !visited {
+				visit(dependent)
+				lowlink[id] = min(lowlink[id], lowlink[dependent])
+			} else if onStack[dependent] {
+				lowlink[id] = min(lowlink[id], indexOf[dependent])
+			}
+		}
+
+		if lowlink[id] == indexOf[id] {
+			var members []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				members = append(members, top)
+				if top == id {
+					break
+				}
+			}
+			components = append(components, members)
+		}
+	}
+
+	for _, id := range g.order {
+		if _, visited := indexOf[id]; !visited {
+			visit(id)
+		}
+	}
+	return components
+}
@@ -0,0 +1,111 @@
+package deps
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// task builds an open task with an optional parent
+func task(id, parent string) archon.Task {
+	t := archon.Task{ID: id, Status: archon.TaskStatusTodo}
+	if parent != "" {
+		t.ParentTaskID = &parent
+	}
+	return t
+}
+
+func TestFromTasks_Hierarchy(t *testing.T) {
+	done := task("shipped", "epic")
+	done.Status = archon.TaskStatusDone
+	graph := FromTasks([]archon.Task{
+		task("epic", ""),
+		task("story", "epic"),
+		task("subtask", "story"),
+		done,
+		task("orphan", "not-loaded"),
+	})
+
+	if !graph.IsBlocked("epic") || !graph.IsBlocked("story") || graph.IsBlocked("subtask") {
+		t.Error("Expected parents with open subtasks to be blocked")
+	}
+	if got := graph.BlockedBy("epic"); !slices.Equal(got, []string{"story"}) {
+		t.Errorf("Expected done subtasks not to block, got %v", got)
+	}
+	if graph.IsBlocked("orphan") {
+		t.Error("Expected relations to unloaded tasks to be ignored")
+	}
+}
+
+func TestUnblock_Ranking(t *testing.T) {
+	report := FromTasks([]archon.Task{
+		task("epic", ""),
+		task("story-a", "epic"),
+		task("story-b", "epic"),
+		task("leaf", "story-a"),
+		task("other", ""),
+	}).Unblock()
+
+	want := []Entry{
+		{TaskID: "leaf", Unblocks: []string{"epic", "story-a"}},
+		{TaskID: "story-a", Unblocks: []string{"epic"}},
+		{TaskID: "story-b", Unblocks: []string{"epic"}},
+	}
+	if len(report.Entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %+v", len(want), report.Entries)
+	}
+	for i := range want {
+		if report.Entries[i].TaskID != want[i].TaskID || !slices.Equal(report.Entries[i].Unblocks, want[i].Unblocks) {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, want[i], report.Entries[i])
+		}
+	}
+	if len(report.Cycles) != 0 {
+		t.Errorf("Expected no cycles, got %v", report.Cycles)
+	}
+}
+
+func TestUnblock_Cycles(t *testing.T) {
+	graph := FromTasks([]archon.Task{
+		task("a", "b"),
+		task("b", "a"),
+		task("self", "self"),
+		task("child", "a"),
+	})
+	report := graph.Unblock()
+
+	if len(report.Cycles) != 2 || !slices.Equal(report.Cycles[0], []string{"a", "b"}) || !slices.Equal(report.Cycles[1], []string{"self"}) {
+		t.Errorf("Expected the a/b and self cycles, got %v", report.Cycles)
+	}
+	for _, entry := range report.Entries {
+		if entry.TaskID == "child" && !slices.Equal(entry.Unblocks, []string{"a", "b"}) {
+			t.Errorf("Expected child to unblock the whole cycle, got %v", entry.Unblocks)
+		}
+	}
+}
+
+func TestUnblock_LargeGraph(t *testing.T) {
+	// A deep chain plus a wide fan-out, a few thousand tasks in total
+	var tasks []archon.Task
+	for i := range 1500 {
+		parent := ""
+		if i > 0 {
+			parent = fmt.Sprintf("chain-%d", i-1)
+		}
+		tasks = append(tasks, task(fmt.Sprintf("chain-%d", i), parent))
+	}
+	for i := range 1500 {
+		tasks = append(tasks, task(fmt.Sprintf("leaf-%d", i), "chain-0"))
+	}
+
+	start := time.Now()
+	report := FromTasks(tasks).Unblock()
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the report in well under 5s, took %v", elapsed)
+	}
+	if top := report.Entries[0]; top.TaskID != "chain-1499" || len(top.Unblocks) != 1499 {
+		t.Errorf("Expected the end of the chain to rank first, got %s with %d", top.TaskID, len(top.Unblocks))
+	}
+}
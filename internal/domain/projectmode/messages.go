@@ -13,6 +13,7 @@ type ProjectModeActivatedMsg struct{}
 // ProjectModeDeactivatedMsg is sent when project mode should be deactivated
 type ProjectModeDeactivatedMsg struct {
 	ShouldLoadTasks bool // Whether to load tasks after deactivation
+	Canceled        bool // Left without picking a project (Esc, q, h); see ui.display.project_mode_cancel
 }
 
 // Ensure all message types implement tea.Msg
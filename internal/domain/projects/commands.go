@@ -1,8 +1,10 @@
 package projects
 
 import (
+	"context"
+
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
 )
 
@@ -11,22 +13,24 @@ import (
 // =============================================================================
 // Command functions for project-related operations
 
-// LoadProjectsInterface loads projects using interface dependency (preferred for DI)
-func LoadProjectsInterface(client interfaces.ArchonClient) tea.Cmd {
+// LoadProjectsInterface loads projects using interface dependency (preferred for DI).
+// Once ctx is cancelled the load reports ctx's error, even if the projects arrived.
+func LoadProjectsInterface(ctx context.Context, client interfaces.ArchonClient) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := client.ListProjects()
+		var resp *archon.ProjectsResponse
+		var err error
+		if lister, ok := client.(interfaces.ContextLister); ok {
+			resp, err = lister.ListProjectsContext(ctx)
+		} else {
+			resp, err = client.ListProjects()
+		}
+		if ctx.Err() != nil {
+			return ProjectsLoadedMsg{Error: ctx.Err()} // Superseded; the newer load reports
+		}
 		if err != nil {
 			return ProjectsLoadedMsg{Error: err}
 		}
 
-		return ProjectsLoadedMsg{Projects: resp.Projects}
+		return ProjectsLoadedMsg{Projects: resp.Projects, Meta: resp.Meta}
 	}
 }
-
-// RefreshDataInterface refreshes both tasks and projects using interface dependency (preferred for DI)
-func RefreshDataInterface(client interfaces.ArchonClient, selectedProjectID *string) tea.Cmd {
-	return tea.Batch(
-		tasks.LoadTasksInterface(client, selectedProjectID),
-		LoadProjectsInterface(client),
-	)
-}
@@ -13,6 +13,7 @@ import (
 // ProjectsLoadedMsg is sent when projects are loaded from the API
 type ProjectsLoadedMsg struct {
 	Projects []archon.Project
+	Meta     archon.ResponseMeta // Response header metadata (total count, rate limit)
 	Error    error
 }
 
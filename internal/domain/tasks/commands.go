@@ -1,6 +1,8 @@
 package tasks
 
 import (
+	"context"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
@@ -14,16 +16,110 @@ import (
 // LoadTasksInterface loads all tasks using interface dependency (preferred for DI)
 // Note: Always loads ALL tasks (projectID parameter is ignored) to ensure task counts
 // are accurate for all projects. Filtering by project happens at the UI display layer.
-func LoadTasksInterface(client interfaces.ArchonClient, projectID *string) tea.Cmd {
+// Once ctx is cancelled the load reports ctx's error, even if the tasks arrived.
+func LoadTasksInterface(ctx context.Context, client interfaces.ArchonClient, projectID *string) tea.Cmd {
 	return func() tea.Msg {
 		// Always pass nil to load ALL tasks, regardless of selected project
 		// This ensures GetTaskCountForProject() can count tasks for all projects
-		resp, err := client.ListTasks(nil, nil, true) // include_closed=true for full visibility
+		var resp *archon.TasksResponse
+		var err error
+		if lister, ok := client.(interfaces.ContextLister); ok {
+			resp, err = lister.ListTasksContext(ctx, nil, nil, true) // include_closed=true for full visibility
+		} else {
+			resp, err = client.ListTasks(nil, nil, true)
+		}
+		if ctx.Err() != nil {
+			return TasksLoadedMsg{Error: ctx.Err()} // Superseded; the newer load reports
+		}
 		if err != nil {
 			return TasksLoadedMsg{Error: err}
 		}
 
-		return TasksLoadedMsg{Tasks: resp.Tasks}
+		return TasksLoadedMsg{Tasks: resp.Tasks, Meta: resp.Meta}
+	}
+}
+
+// LoadTasksPaged loads the first limit tasks of every project, leaving the
+// rest for LoadTasksPage. Clients that cannot page load the whole list.
+// Cancelling ctx works as for LoadTasksInterface.
+func LoadTasksPaged(ctx context.Context, client interfaces.ArchonClient, limit int) tea.Cmd {
+	pager, ok := client.(interfaces.TaskPager)
+	if !ok {
+		return LoadTasksInterface(ctx, client, nil)
+	}
+	return func() tea.Msg {
+		opts := archon.ListTasksOptions{IncludeClosed: true, Limit: limit}
+		var resp *archon.TasksResponse
+		var err error
+		if lister, ok := client.(interfaces.ContextLister); ok {
+			resp, err = lister.ListTasksPagedContext(ctx, opts)
+		} else {
+			resp, err = pager.ListTasksPaged(opts)
+		}
+		if ctx.Err() != nil {
+			return TasksLoadedMsg{Error: ctx.Err()}
+		}
+		if err != nil {
+			return TasksLoadedMsg{Error: err}
+		}
+
+		return TasksLoadedMsg{Tasks: resp.Tasks, Meta: resp.Meta, Total: resp.Total, HasMore: resp.HasMore}
+	}
+}
+
+// LoadTasksPage loads the page of limit tasks starting at offset. Cancelling
+// ctx works as for LoadTasksInterface.
+func LoadTasksPage(ctx context.Context, client interfaces.ArchonClient, offset, limit int) tea.Cmd {
+	return func() tea.Msg {
+		pager, ok := client.(interfaces.TaskPager)
+		if !ok {
+			return TasksPageLoadedMsg{Offset: offset, Error: archon.ErrNotSupported}
+		}
+		opts := archon.ListTasksOptions{IncludeClosed: true, Offset: offset, Limit: limit}
+		var resp *archon.TasksResponse
+		var err error
+		if lister, ok := client.(interfaces.ContextLister); ok {
+			resp, err = lister.ListTasksPagedContext(ctx, opts)
+		} else {
+			resp, err = pager.ListTasksPaged(opts)
+		}
+		if ctx.Err() != nil {
+			return TasksPageLoadedMsg{Offset: offset, Error: ctx.Err()}
+		}
+		if err != nil {
+			return TasksPageLoadedMsg{Offset: offset, Error: err}
+		}
+
+		return TasksPageLoadedMsg{Offset: offset, Tasks: resp.Tasks, Total: resp.Total, HasMore: resp.HasMore, Meta: resp.Meta}
+	}
+}
+
+// SearchTasks has the server search the tasks for query, within projectID
+// when set. Clients without server search report archon.ErrNotSupported.
+func SearchTasks(client interfaces.ArchonClient, query string, projectID *string) tea.Cmd {
+	return func() tea.Msg {
+		searcher, ok := client.(interfaces.TaskSearcher)
+		if !ok {
+			return TasksSearchedMsg{Query: query, Error: archon.ErrNotSupported}
+		}
+		resp, err := searcher.SearchTasks(query, projectID)
+		if err != nil {
+			return TasksSearchedMsg{Query: query, Error: err}
+		}
+
+		return TasksSearchedMsg{Query: query, Tasks: resp.Tasks}
+	}
+}
+
+// ReloadTask fetches a single task again, without reloading the task list
+func ReloadTask(client interfaces.ArchonClient, taskID string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := client.GetTask(taskID)
+		if err != nil {
+			return TaskReloadedMsg{TaskID: taskID, Error: err}
+		}
+
+		return TaskReloadedMsg{TaskID: taskID, Task: &resp.Task}
 	}
 }
 
@@ -38,10 +134,10 @@ func UpdateTaskStatusInterface(client interfaces.ArchonClient, taskID string, ne
 		// Call API to update task
 		resp, err := client.UpdateTask(taskID, updateRequest)
 		if err != nil {
-			return TaskUpdateMsg{Error: err}
+			return TaskUpdateMsg{TaskID: taskID, Updates: &updateRequest, Error: err}
 		}
 
-		return TaskUpdateMsg{Task: &resp.Task}
+		return TaskUpdateMsg{TaskID: taskID, Task: &resp.Task}
 	}
 }
 
@@ -56,10 +152,22 @@ func UpdateTaskFeatureInterface(client interfaces.ArchonClient, taskID string, n
 		// Call API to update task
 		resp, err := client.UpdateTask(taskID, updateRequest)
 		if err != nil {
-			return TaskUpdateMsg{Error: err}
+			return TaskUpdateMsg{TaskID: taskID, Updates: &updateRequest, Error: err}
+		}
+
+		return TaskUpdateMsg{TaskID: taskID, Task: &resp.Task}
+	}
+}
+
+// CreateTask creates a task and reports it, with its new ID, in a TaskCreatedMsg
+func CreateTask(client interfaces.ArchonClient, createRequest archon.CreateTaskRequest) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := client.CreateTask(createRequest)
+		if err != nil {
+			return TaskCreatedMsg{ProjectID: createRequest.ProjectID, Error: err}
 		}
 
-		return TaskUpdateMsg{Task: &resp.Task}
+		return TaskCreatedMsg{ProjectID: createRequest.ProjectID, Task: &resp.Task}
 	}
 }
 
@@ -70,11 +178,107 @@ func UpdateTaskWithRequest(client interfaces.ArchonClient, taskID string, update
 		// Call API to update task with the provided request
 		resp, err := client.UpdateTask(taskID, updateRequest)
 		if err != nil {
-			return TaskUpdateMsg{Error: err}
+			return TaskUpdateMsg{TaskID: taskID, Updates: &updateRequest, Error: err}
+		}
+
+		return TaskUpdateMsg{TaskID: taskID, Task: &resp.Task}
+	}
+}
+
+// UpdateTasksFeature sets the feature of several tasks ("" clears it).
+// Tasks are updated one per command so progress can be shown: each reports a
+// TaskFeatureProgressMsg whose Next updates the following task, and the last
+// one's Next returns the TasksFeatureUpdateMsg for the whole batch. Failures
+// don't stop the batch and are reported per task.
+func UpdateTasksFeature(client interfaces.ArchonClient, batch string, taskIDs []string, newFeature string) tea.Cmd {
+	result := TasksFeatureUpdateMsg{Batch: batch, Feature: newFeature}
+	updateRequest := archon.UpdateTaskRequest{
+		Feature: &newFeature,
+	}
+
+	var step func(i int) tea.Cmd
+	step = func(i int) tea.Cmd {
+		if i == len(taskIDs) {
+			return func() tea.Msg { return result }
+		}
+		return func() tea.Msg {
+			taskID := taskIDs[i]
+			_, err := client.UpdateTask(taskID, updateRequest)
+			if err != nil {
+				if result.Errors == nil {
+					result.Errors = make(map[string]error)
+				}
+				result.Errors[taskID] = err
+			} else {
+				result.Updated = append(result.Updated, taskID)
+			}
+			return TaskFeatureProgressMsg{Batch: batch, TaskID: taskID, Error: err, Next: step(i + 1)}
+		}
+	}
+	return step(0)
+}
+
+// UpdateTasksStatus sets the status of several tasks, one per command like
+// UpdateTasksFeature: each reports a TaskStatusProgressMsg whose Next updates
+// the following task, and the last one's Next returns the TasksStatusUpdateMsg
+// for the whole batch. assignees gives the assignee set along with the status,
+// by task ID (workflow auto-assign). Failures don't stop the batch.
+func UpdateTasksStatus(client interfaces.ArchonClient, batch string, taskIDs []string, newStatus string, assignees map[string]string) tea.Cmd {
+	result := TasksStatusUpdateMsg{Batch: batch, Status: newStatus}
+
+	var step func(i int) tea.Cmd
+	step = func(i int) tea.Cmd {
+		if i == len(taskIDs) {
+			return func() tea.Msg { return result }
 		}
+		return func() tea.Msg {
+			taskID := taskIDs[i]
+			updateRequest := archon.UpdateTaskRequest{Status: &newStatus}
+			if assignee, ok := assignees[taskID]; ok {
+				updateRequest.Assignee = &assignee
+			}
+			_, err := client.UpdateTask(taskID, updateRequest)
+			if err != nil {
+				if result.Errors == nil {
+					result.Errors = make(map[string]error)
+				}
+				result.Errors[taskID] = err
+			} else {
+				result.Updated = append(result.Updated, taskID)
+			}
+			return TaskStatusProgressMsg{Batch: batch, TaskID: taskID, Error: err, Next: step(i + 1)}
+		}
+	}
+	return step(0)
+}
+
+// ArchiveTasks archives several tasks, one per command like UpdateTasksFeature:
+// each reports a TaskArchiveProgressMsg whose Next archives the following
+// task, and the last one's Next returns the TasksArchivedMsg for the whole
+// batch. Failures don't stop the batch and are reported per task.
+func ArchiveTasks(client interfaces.ArchonClient, batch string, taskIDs []string) tea.Cmd {
+	result := TasksArchivedMsg{Batch: batch}
 
-		return TaskUpdateMsg{Task: &resp.Task}
+	var step func(i int) tea.Cmd
+	step = func(i int) tea.Cmd {
+		if i == len(taskIDs) {
+			return func() tea.Msg { return result }
+		}
+		return func() tea.Msg {
+			taskID := taskIDs[i]
+			err := client.DeleteTask(taskID)
+			if err != nil {
+				if result.Errors == nil {
+					result.Errors = make(map[string]error)
+				}
+				result.Errors[taskID] = err
+			} else {
+				result.Archived = append(result.Archived, taskID)
+			}
+			return TaskArchiveProgressMsg{Batch: batch, TaskID: taskID, Error: err, Next: step(i + 1)}
+		}
 	}
+	return step(0)
 }
 
 // DeleteTaskInterface deletes/archives a task using interface dependency
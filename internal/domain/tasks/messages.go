@@ -13,24 +13,121 @@ import (
 // TasksLoadedMsg is sent when tasks are loaded from the API
 type TasksLoadedMsg struct {
 	Tasks []archon.Task
+	Meta  archon.ResponseMeta // Response header metadata (total count, rate limit)
 	Error error
+
+	// Paged loads (server.page_size) only: the server's total and whether more pages follow
+	Total   int
+	HasMore bool
+}
+
+// TasksPageLoadedMsg is sent when a further page of a paged task list has loaded
+type TasksPageLoadedMsg struct {
+	Offset  int // Offset the page was requested at (set even on error)
+	Tasks   []archon.Task
+	Total   int  // Tasks on the server across all pages (0 = not reported)
+	HasMore bool // More pages follow this one
+	Meta    archon.ResponseMeta
+	Error   error
 }
 
 // TaskUpdateMsg is sent when a task is updated
 type TaskUpdateMsg struct {
-	Task  *archon.Task
+	TaskID  string // ID of the task the update was requested for (set even on error)
+	Task    *archon.Task
+	Updates *archon.UpdateTaskRequest // The update that failed, so it can be recovered (set on error)
+	Error   error
+}
+
+// TaskCreatedMsg is sent when a task has been created
+type TaskCreatedMsg struct {
+	ProjectID string       // Project the task was created in (set even on error)
+	Task      *archon.Task // The created task, with the ID the server gave it
+	Error     error
+}
+
+// TasksSearchedMsg is sent when a server-side search has finished
+type TasksSearchedMsg struct {
+	Query string        // Query that was searched for
+	Tasks []archon.Task // Matching tasks
 	Error error
 }
 
+// TaskReloadedMsg is sent when a single task was fetched again
+type TaskReloadedMsg struct {
+	TaskID string // ID of the task that was requested (set even on error)
+	Task   *archon.Task
+	Error  error
+}
+
+// TaskFeatureProgressMsg is sent as each task of a batch feature assignment
+// finishes. Next continues the batch and must be run for it to complete.
+type TaskFeatureProgressMsg struct {
+	Batch  string  // Batch ID given to UpdateTasksFeature
+	TaskID string  // Task that was just updated
+	Error  error   // Why the update failed (nil = updated)
+	Next   tea.Cmd // Updates the next task, or reports the TasksFeatureUpdateMsg
+}
+
+// TasksFeatureUpdateMsg is sent when a batch feature assignment has finished
+type TasksFeatureUpdateMsg struct {
+	Batch   string           // Batch ID given to UpdateTasksFeature
+	Feature string           // Feature that was assigned ("" when it was cleared)
+	Updated []string         // IDs of the tasks that were updated
+	Errors  map[string]error // Failures by task ID
+}
+
+// TaskStatusProgressMsg is sent as each task of a batch status change
+// finishes. Next continues the batch and must be run for it to complete.
+type TaskStatusProgressMsg struct {
+	Batch  string  // Batch ID given to UpdateTasksStatus
+	TaskID string  // Task that was just updated
+	Error  error   // Why the update failed (nil = updated)
+	Next   tea.Cmd // Updates the next task, or reports the TasksStatusUpdateMsg
+}
+
+// TasksStatusUpdateMsg is sent when a batch status change has finished
+type TasksStatusUpdateMsg struct {
+	Batch   string           // Batch ID given to UpdateTasksStatus
+	Status  string           // Status that was set
+	Updated []string         // IDs of the tasks that were updated
+	Errors  map[string]error // Failures by task ID
+}
+
 // TaskDeleteMsg is sent when a task is deleted/archived
 type TaskDeleteMsg struct {
 	TaskID string
 	Error  error
 }
 
+// TaskArchiveProgressMsg is sent as each task of a batch archive finishes.
+// Next continues the batch and must be run for it to complete.
+type TaskArchiveProgressMsg struct {
+	Batch  string  // Batch ID given to ArchiveTasks
+	TaskID string  // Task that was just archived
+	Error  error   // Why archiving failed (nil = archived)
+	Next   tea.Cmd // Archives the next task, or reports the TasksArchivedMsg
+}
+
+// TasksArchivedMsg is sent when a batch archive has finished
+type TasksArchivedMsg struct {
+	Batch    string           // Batch ID given to ArchiveTasks
+	Archived []string         // IDs of the tasks that were archived
+	Errors   map[string]error // Failures by task ID
+}
+
 // Ensure all message types implement tea.Msg
 var (
 	_ tea.Msg = TasksLoadedMsg{}
+	_ tea.Msg = TasksPageLoadedMsg{}
 	_ tea.Msg = TaskUpdateMsg{}
+	_ tea.Msg = TaskCreatedMsg{}
+	_ tea.Msg = TaskReloadedMsg{}
+	_ tea.Msg = TaskFeatureProgressMsg{}
+	_ tea.Msg = TasksFeatureUpdateMsg{}
+	_ tea.Msg = TaskStatusProgressMsg{}
+	_ tea.Msg = TasksStatusUpdateMsg{}
 	_ tea.Msg = TaskDeleteMsg{}
+	_ tea.Msg = TaskArchiveProgressMsg{}
+	_ tea.Msg = TasksArchivedMsg{}
 )
@@ -0,0 +1,169 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// Board export formats
+const (
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+)
+
+// boardStatuses is the order statuses are listed in a board export
+var boardStatuses = []string{archon.TaskStatusDoing, archon.TaskStatusReview, archon.TaskStatusTodo, archon.TaskStatusDone}
+
+// BoardFilter selects the tasks included in a board export. Zero values match everything.
+type BoardFilter struct {
+	Statuses  []string // Task statuses to include (e.g., doing, review)
+	Feature   string   // Only tasks with this feature
+	ProjectID string   // Only tasks in this project
+}
+
+// Match reports whether task passes the filter. Archived tasks never match.
+func (f BoardFilter) Match(task archon.Task) bool {
+	if task.Archived {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, task.Status) {
+		return false
+	}
+	if f.Feature != "" && (task.Feature == nil || *task.Feature != f.Feature) {
+		return false
+	}
+	return f.ProjectID == "" || task.ProjectID == f.ProjectID
+}
+
+// Board is a snapshot of tasks grouped by status
+type Board struct {
+	Title       string
+	GeneratedAt time.Time
+	Tasks       []archon.Task // Tasks in export order: by status, then task order
+}
+
+// NewBoard snapshots the tasks matching filter
+func NewBoard(title string, tasks []archon.Task, filter BoardFilter, generatedAt time.Time) Board {
+	matched := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if filter.Match(task) {
+			matched = append(matched, task)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		ri, rj := statusRank(matched[i].Status), statusRank(matched[j].Status)
+		if ri != rj {
+			return ri < rj
+		}
+		return matched[i].TaskOrder > matched[j].TaskOrder
+	})
+	return Board{Title: title, GeneratedAt: generatedAt, Tasks: matched}
+}
+
+// FormatBoard renders the board as markdown (default) or JSON
+func FormatBoard(board Board, format string, shortIDLength int) ([]byte, error) {
+	switch format {
+	case "", FormatMarkdown:
+		return []byte(formatBoardMarkdown(board, shortIDLength)), nil
+	case FormatJSON:
+		return formatBoardJSON(board)
+	default:
+		return nil, fmt.Errorf("unknown export format %q (use markdown or json)", format)
+	}
+}
+
+// formatBoardMarkdown lists tasks under a heading per status, e.g.
+//
+//	## Doing (2)
+//	- Ship login [auth] @alice (1a2b3c4d)
+func formatBoardMarkdown(board Board, shortIDLength int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s (%s)\n", board.Title, board.GeneratedAt.Format("2006-01-02 15:04"))
+	if len(board.Tasks) == 0 {
+		b.WriteString("\nNo matching tasks.\n")
+		return b.String()
+	}
+
+	for start := 0; start < len(board.Tasks); {
+		status := board.Tasks[start].Status
+		end := start
+		for end < len(board.Tasks) && board.Tasks[end].Status == status {
+			end++
+		}
+
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", statusHeading(status), end-start)
+		for _, task := range board.Tasks[start:end] {
+			fields := NewTaskFields(task, shortIDLength)
+			b.WriteString("- " + fields.Title)
+			if fields.Feature != "" {
+				b.WriteString(" [" + fields.Feature + "]")
+			}
+			if fields.Assignee != "" {
+				b.WriteString(" @" + fields.Assignee)
+			}
+			b.WriteString(" (" + fields.ShortID + ")\n")
+		}
+		start = end
+	}
+	return b.String()
+}
+
+// boardJSON is the JSON board export layout
+type boardJSON struct {
+	Title       string      `json:"title"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Tasks       []boardTask `json:"tasks"`
+}
+
+type boardTask struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	Feature   string `json:"feature,omitempty"`
+	Assignee  string `json:"assignee,omitempty"`
+	ProjectID string `json:"project_id"`
+}
+
+func formatBoardJSON(board Board) ([]byte, error) {
+	out := boardJSON{Title: board.Title, GeneratedAt: board.GeneratedAt, Tasks: make([]boardTask, 0, len(board.Tasks))}
+	for _, task := range board.Tasks {
+		fields := NewTaskFields(task, 0)
+		out.Tasks = append(out.Tasks, boardTask{
+			ID:        fields.ID,
+			Title:     fields.Title,
+			Status:    fields.Status,
+			Feature:   fields.Feature,
+			Assignee:  fields.Assignee,
+			ProjectID: fields.ProjectID,
+		})
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode board: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// statusRank orders statuses for export; unknown statuses go last
+func statusRank(status string) int {
+	for i, s := range boardStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return len(boardStatuses)
+}
+
+// statusHeading capitalizes a status for a markdown heading
+func statusHeading(status string) string {
+	if status == "" {
+		return "Unknown"
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func TestBoardFilter_Match(t *testing.T) {
+	auth := "auth"
+	task := archon.Task{Status: "doing", Feature: &auth, ProjectID: "p1"}
+
+	tests := []struct {
+		name   string
+		filter BoardFilter
+		want   bool
+	}{
+		{"empty filter", BoardFilter{}, true},
+		{"status match", BoardFilter{Statuses: []string{"doing", "review"}}, true},
+		{"status mismatch", BoardFilter{Statuses: []string{"todo"}}, false},
+		{"feature mismatch", BoardFilter{Feature: "ui"}, false},
+		{"project mismatch", BoardFilter{ProjectID: "p2"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Match(task); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	task.Archived = true
+	if (BoardFilter{}).Match(task) {
+		t.Error("Expected archived tasks to be excluded")
+	}
+}
+
+func TestFormatBoard(t *testing.T) {
+	generated := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	tasks := []archon.Task{
+		{ID: "t-todo", Title: "Later", Status: "todo"},
+		{ID: "t-low", Title: "Low", Status: "doing", TaskOrder: 1},
+		{ID: "t-high", Title: "High", Status: "doing", TaskOrder: 5},
+	}
+	board := NewBoard("Standup", tasks, BoardFilter{}, generated)
+
+	data, err := FormatBoard(board, FormatMarkdown, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	markdown := string(data)
+	if !strings.Contains(markdown, "## Doing (2)\n\n- High (t-high)\n- Low (t-low)\n") {
+		t.Errorf("Expected doing tasks first, by priority, got:\n%s", markdown)
+	}
+	if strings.Index(markdown, "## Todo") < strings.Index(markdown, "## Doing") {
+		t.Errorf("Expected todo after doing, got:\n%s", markdown)
+	}
+
+	empty, _ := FormatBoard(NewBoard("Standup", nil, BoardFilter{}, generated), "", 0)
+	if !strings.Contains(string(empty), "No matching tasks.") {
+		t.Errorf("Expected empty board notice, got %q", empty)
+	}
+
+	if _, err := FormatBoard(board, "pdf", 0); err == nil {
+		t.Error("Expected error for unknown format")
+	}
+}
@@ -0,0 +1,152 @@
+// Package export provides text formatting for tasks leaving the application,
+// such as clipboard references and exported board snapshots.
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// DefaultCommitTemplate is the commit reference format used when none is configured
+const DefaultCommitTemplate = "[{{.ShortID}}] {{.Title}}"
+
+// DefaultShortIDLength is the number of ID characters kept by ShortID when none is configured
+const DefaultShortIDLength = 8
+
+// TaskFields exposes the task fields available to reference templates
+type TaskFields struct {
+	ID        string
+	ShortID   string
+	Title     string
+	Status    string
+	Feature   string
+	Assignee  string
+	ProjectID string
+}
+
+// NewTaskFields builds template fields for a task, shortening the ID to shortIDLength characters
+func NewTaskFields(task archon.Task, shortIDLength int) TaskFields {
+	feature := ""
+	if task.Feature != nil {
+		feature = *task.Feature
+	}
+	return TaskFields{
+		ID:        task.ID,
+		ShortID:   ShortID(task.ID, shortIDLength),
+		Title:     task.Title,
+		Status:    task.Status,
+		Feature:   feature,
+		Assignee:  task.Assignee,
+		ProjectID: task.ProjectID,
+	}
+}
+
+// ShortID returns the first n characters of id; n <= 0 returns the full ID
+func ShortID(id string, n int) string {
+	if n <= 0 || len(id) <= n {
+		return id
+	}
+	return id[:n]
+}
+
+// UniqueShortIDs shortens each of ids to its shortest prefix of at least
+// minLength characters that no other ID shares, like git short hashes. IDs
+// that are a prefix of another ID stay whole.
+func UniqueShortIDs(ids []string, minLength int) map[string]string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	short := make(map[string]string, len(sorted))
+	for i, id := range sorted {
+		// In sorted order the longest shared prefix is with a neighbor
+		length := max(minLength, 1)
+		if i > 0 {
+			length = max(length, commonPrefix(id, sorted[i-1])+1)
+		}
+		if i < len(sorted)-1 {
+			length = max(length, commonPrefix(id, sorted[i+1])+1)
+		}
+		short[id] = ShortID(id, length)
+	}
+	return short
+}
+
+// commonPrefix returns the length of the prefix a and b share
+func commonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// FormatCommitReference renders a task through a text/template commit reference template.
+// An empty template falls back to DefaultCommitTemplate.
+func FormatCommitReference(tmpl string, task archon.Task, shortIDLength int) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultCommitTemplate
+	}
+
+	parsed, err := template.New("commit").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid commit reference template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, NewTaskFields(task, shortIDLength)); err != nil {
+		return "", fmt.Errorf("failed to render commit reference: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// DefaultPathSeparator joins task titles in a breadcrumb path
+const DefaultPathSeparator = " › "
+
+// TaskLookup resolves a task by ID, returning nil when it is not loaded
+type TaskLookup func(taskID string) *archon.Task
+
+// FormatTaskPath walks ParentTaskID links from task up to its root and joins
+// the titles root-first, e.g. "Parent › Child › Grandchild".
+// A parent that cannot be resolved ends the walk with a leading "…" so the
+// path is visibly incomplete; a cycle ends the walk at the first repeated task.
+func FormatTaskPath(task archon.Task, lookup TaskLookup, separator string) string {
+	if separator == "" {
+		separator = DefaultPathSeparator
+	}
+
+	titles := []string{task.Title}
+	visited := map[string]bool{task.ID: true}
+	current := task
+
+	for current.ParentTaskID != nil && *current.ParentTaskID != "" {
+		parentID := *current.ParentTaskID
+		if visited[parentID] {
+			break // Cycle in the hierarchy
+		}
+		visited[parentID] = true
+
+		var parent *archon.Task
+		if lookup != nil {
+			parent = lookup(parentID)
+		}
+		if parent == nil {
+			titles = append(titles, "…")
+			break
+		}
+		titles = append(titles, parent.Title)
+		current = *parent
+	}
+
+	// Titles were collected child-first
+	for i, j := 0, len(titles)-1; i < j; i, j = i+1, j-1 {
+		titles[i], titles[j] = titles[j], titles[i]
+	}
+	return strings.Join(titles, separator)
+}
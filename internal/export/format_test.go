@@ -0,0 +1,143 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func TestShortID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		length   int
+		expected string
+	}{
+		{"shortens long ID", "550e8400-e29b-41d4", 8, "550e8400"},
+		{"keeps short ID", "abc", 8, "abc"},
+		{"zero length keeps full ID", "550e8400-e29b", 0, "550e8400-e29b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortID(tt.id, tt.length); got != tt.expected {
+				t.Errorf("ShortID(%q, %d) = %q, want %q", tt.id, tt.length, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUniqueShortIDs(t *testing.T) {
+	ids := []string{
+		"550e8400-e29b-41d4",
+		"550e8401-aaaa-bbbb",
+		"550e8401-aaab-cccc",
+		"7c9e6679-7425-40de",
+		"7c9e6679-7425-40de", // Duplicates do not collide with themselves
+		"abc",
+	}
+	want := map[string]string{
+		"550e8400-e29b-41d4": "550e8400",
+		"550e8401-aaaa-bbbb": "550e8401-aaaa",
+		"550e8401-aaab-cccc": "550e8401-aaab",
+		"7c9e6679-7425-40de": "7c9e6679",
+		"abc":                "abc",
+	}
+
+	got := UniqueShortIDs(ids, 8)
+	if len(got) != len(want) {
+		t.Fatalf("UniqueShortIDs() = %v, want %v", got, want)
+	}
+	for id, short := range want {
+		if got[id] != short {
+			t.Errorf("UniqueShortIDs()[%q] = %q, want %q", id, got[id], short)
+		}
+	}
+
+	// A prefix of another ID stays whole
+	if got := UniqueShortIDs([]string{"abcd", "abcdef"}, 2); got["abcd"] != "abcd" || got["abcdef"] != "abcde" {
+		t.Errorf("Expected the prefix kept whole, got %v", got)
+	}
+}
+
+func TestFormatCommitReference(t *testing.T) {
+	feature := "auth"
+	task := archon.Task{
+		ID:      "550e8400-e29b-41d4-a716-446655440000",
+		Title:   "Add login form",
+		Status:  archon.TaskStatusDoing,
+		Feature: &feature,
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		length   int
+		expected string
+	}{
+		{"default template", "", 8, "[550e8400] Add login form"},
+		{"custom template", "{{.Feature}}: {{.Title}} ({{.ShortID}})", 4, "auth: Add login form (550e)"},
+		{"full ID", "{{.ID}}", 0, "550e8400-e29b-41d4-a716-446655440000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatCommitReference(tt.template, task, tt.length)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatCommitReference_InvalidTemplate(t *testing.T) {
+	if _, err := FormatCommitReference("{{.Title", archon.Task{}, 8); err == nil {
+		t.Error("Expected parse error for malformed template")
+	}
+	if _, err := FormatCommitReference("{{.Missing}}", archon.Task{}, 8); err == nil {
+		t.Error("Expected execution error for unknown field")
+	}
+}
+
+func TestFormatTaskPath(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	tasks := map[string]*archon.Task{
+		"root":   {ID: "root", Title: "Parent"},
+		"child":  {ID: "child", Title: "Child", ParentTaskID: ptr("root")},
+		"grand":  {ID: "grand", Title: "Grandchild", ParentTaskID: ptr("child")},
+		"orphan": {ID: "orphan", Title: "Orphan", ParentTaskID: ptr("gone")},
+		"loop-a": {ID: "loop-a", Title: "A", ParentTaskID: ptr("loop-b")},
+		"loop-b": {ID: "loop-b", Title: "B", ParentTaskID: ptr("loop-a")},
+		"self":   {ID: "self", Title: "Self", ParentTaskID: ptr("self")},
+	}
+	lookup := func(id string) *archon.Task { return tasks[id] }
+
+	tests := []struct {
+		name      string
+		taskID    string
+		separator string
+		expected  string
+	}{
+		{"full chain", "grand", "", "Parent › Child › Grandchild"},
+		{"custom separator", "child", " / ", "Parent / Child"},
+		{"root task", "root", "", "Parent"},
+		{"missing parent", "orphan", "", "… › Orphan"},
+		{"cycle", "loop-a", "", "B › A"},
+		{"self parent", "self", "", "Self"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTaskPath(*tasks[tt.taskID], lookup, tt.separator); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if got := FormatTaskPath(*tasks["child"], nil, ""); got != "… › Child" {
+		t.Errorf("Expected nil lookup to mark missing parent, got %q", got)
+	}
+}
@@ -0,0 +1,152 @@
+package scheduled
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// Spec is an uncompiled scheduled export as written in configuration
+type Spec struct {
+	Name      string
+	Schedule  string
+	Format    string
+	Statuses  []string
+	Feature   string
+	ProjectID string
+	Output    string
+	CatchUp   bool
+}
+
+// Job is a validated scheduled export
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Format   string
+	Filter   export.BoardFilter // Filter.ProjectID also limits which tasks are fetched
+	CatchUp  bool               // Run once at startup when a scheduled run was missed
+	output   *template.Template
+}
+
+// outputFields are the values available to output path templates
+type outputFields struct {
+	Date    string
+	Time    string
+	Weekday string
+	Name    string
+}
+
+// Compile validates scheduled export specs.
+// Errors identify the offending export by position and name.
+func Compile(specs []Spec) ([]Job, error) {
+	jobs := make([]Job, 0, len(specs))
+	names := make(map[string]bool, len(specs))
+
+	for i, spec := range specs {
+		label := fmt.Sprintf("scheduled export #%d", i+1)
+		if spec.Name != "" {
+			label = fmt.Sprintf("%s (%q)", label, spec.Name)
+		}
+
+		if spec.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", label)
+		}
+		if names[spec.Name] {
+			return nil, fmt.Errorf("%s: duplicate name", label)
+		}
+		names[spec.Name] = true
+		if spec.Output == "" {
+			return nil, fmt.Errorf("%s: output is required", label)
+		}
+
+		schedule, err := ParseSchedule(spec.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", label, err)
+		}
+		if spec.Format != "" && spec.Format != export.FormatMarkdown && spec.Format != export.FormatJSON {
+			return nil, fmt.Errorf("%s: unknown format %q (use markdown or json)", label, spec.Format)
+		}
+		for _, status := range spec.Statuses {
+			switch status {
+			case archon.TaskStatusTodo, archon.TaskStatusDoing, archon.TaskStatusReview, archon.TaskStatusDone:
+			default:
+				return nil, fmt.Errorf("%s: unknown status %q in filter", label, status)
+			}
+		}
+
+		output, err := template.New(spec.Name).Option("missingkey=error").Parse(spec.Output)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid output path: %w", label, err)
+		}
+		job := Job{
+			Name:     spec.Name,
+			Schedule: schedule,
+			Format:   spec.Format,
+			Filter:   export.BoardFilter{Statuses: spec.Statuses, Feature: spec.Feature, ProjectID: spec.ProjectID},
+			CatchUp:  spec.CatchUp,
+			output:   output,
+		}
+		if _, err := job.OutputPath(time.Time{}); err != nil {
+			return nil, fmt.Errorf("%s: invalid output path: %w", label, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// OutputPath expands the job's output path template for a run at at
+func (j Job) OutputPath(at time.Time) (string, error) {
+	var buf bytes.Buffer
+	fields := outputFields{
+		Date:    at.Format("2006-01-02"),
+		Time:    at.Format("15-04"),
+		Weekday: at.Weekday().String(),
+		Name:    j.Name,
+	}
+	if err := j.output.Execute(&buf, fields); err != nil {
+		return "", err
+	}
+
+	path := buf.String()
+	if rest, ok := strings.CutPrefix(path, "~"); ok && (rest == "" || os.IsPathSeparator(rest[0])) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to expand ~: %w", err)
+		}
+		path = home + rest
+	}
+	return filepath.Clean(path), nil
+}
+
+// Run exports the tasks matching the job and writes them to its output path.
+// Returns the path written.
+func (j Job) Run(tasks []archon.Task, at time.Time, shortIDLength int) (string, error) {
+	path, err := j.OutputPath(at)
+	if err != nil {
+		return "", fmt.Errorf("invalid output path: %w", err)
+	}
+
+	board := export.NewBoard(j.Name, tasks, j.Filter, at)
+	data, err := export.FormatBoard(board, j.Format, shortIDLength)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+	// Write to a temporary file and rename so readers never see a partial export
+	if err := atomicfile.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // Exports are meant to be shared
+		return "", fmt.Errorf("failed to write export: %w", err)
+	}
+	return path, nil
+}
@@ -0,0 +1,93 @@
+// Package scheduled runs board exports on a schedule while the application is open,
+// e.g. writing the doing and review tasks to a markdown file before every standup.
+//
+// Schedules are deliberately simple:
+//
+//	daily@09:00      every day at 09:00 local time
+//	weekdays@09:00   Monday to Friday at 09:00
+//	every 2h         at a fixed interval (minimum one minute)
+//
+// A job's output path is a Go text/template receiving {{.Date}} (2006-01-02),
+// {{.Time}} (15-04), {{.Weekday}} and {{.Name}}; a leading ~ expands to the
+// home directory.
+package scheduled
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// minInterval is the shortest "every" interval accepted
+const minInterval = time.Minute
+
+// Schedule is when a job runs
+type Schedule struct {
+	spec     string
+	every    time.Duration // Non-zero for interval schedules
+	weekdays bool          // Time-of-day schedule skips Saturday and Sunday
+	hour     int
+	minute   int
+}
+
+// ParseSchedule parses "daily@HH:MM", "weekdays@HH:MM" or "every <duration>"
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if rest, ok := strings.CutPrefix(spec, "every "); ok {
+		every, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid interval in schedule %q: %w", spec, err)
+		}
+		if every < minInterval {
+			return Schedule{}, fmt.Errorf("schedule %q: interval must be at least %s", spec, minInterval)
+		}
+		return Schedule{spec: spec, every: every}, nil
+	}
+
+	kind, clock, ok := strings.Cut(spec, "@")
+	if !ok || (kind != "daily" && kind != "weekdays") {
+		return Schedule{}, fmt.Errorf("invalid schedule %q (use daily@HH:MM, weekdays@HH:MM or every <duration>)", spec)
+	}
+	hour, minute, err := parseClock(clock)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid time in schedule %q: %w", spec, err)
+	}
+	return Schedule{spec: spec, weekdays: kind == "weekdays", hour: hour, minute: minute}, nil
+}
+
+// parseClock parses a 24-hour "HH:MM" time of day
+func parseClock(clock string) (hour, minute int, err error) {
+	h, m, ok := strings.Cut(clock, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected HH:MM, got %q", clock)
+	}
+	hour, errHour := strconv.Atoi(h)
+	minute, errMinute := strconv.Atoi(m)
+	if errHour != nil || errMinute != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 || len(m) != 2 {
+		return 0, 0, fmt.Errorf("expected HH:MM, got %q", clock)
+	}
+	return hour, minute, nil
+}
+
+// String returns the schedule as written
+func (s Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first run time strictly after after, in after's location
+func (s Schedule) Next(after time.Time) time.Time {
+	if s.every > 0 {
+		return after.Add(s.every)
+	}
+
+	next := time.Date(after.Year(), after.Month(), after.Day(), s.hour, s.minute, 0, 0, after.Location())
+	for !next.After(after) || (s.weekdays && isWeekend(next)) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
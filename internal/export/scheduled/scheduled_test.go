@@ -0,0 +1,239 @@
+package scheduled
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// fakeClock is a settable clock for the scheduler
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+// at returns a local time on 2026-10-12, a Monday
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 10, day, hour, minute, 0, 0, time.Local)
+}
+
+func TestParseSchedule(t *testing.T) {
+	for _, spec := range []string{"daily@09:00", "weekdays@17:30", "every 2h", " every 15m "} {
+		if _, err := ParseSchedule(spec); err != nil {
+			t.Errorf("Expected %q to parse, got %v", spec, err)
+		}
+	}
+	for _, spec := range []string{"", "daily", "daily@9", "daily@24:00", "daily@09:60", "daily@09:5", "hourly@09:00", "every 10s", "every soon"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("Expected %q to be rejected", spec)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	daily, _ := ParseSchedule("daily@09:00")
+	weekdays, _ := ParseSchedule("weekdays@09:00")
+	every, _ := ParseSchedule("every 90m")
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		after    time.Time
+		want     time.Time
+	}{
+		{"daily before time", daily, at(12, 8, 0), at(12, 9, 0)},
+		{"daily at time", daily, at(12, 9, 0), at(13, 9, 0)},
+		{"daily after time", daily, at(12, 10, 0), at(13, 9, 0)},
+		{"weekdays friday evening skips weekend", weekdays, at(16, 18, 0), at(19, 9, 0)},
+		{"weekdays saturday", weekdays, at(17, 8, 0), at(19, 9, 0)},
+		{"interval", every, at(12, 8, 0), at(12, 9, 30)},
+	}
+	for _, tt := range tests {
+		if got := tt.schedule.Next(tt.after); !got.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	valid := Spec{Name: "Standup", Schedule: "daily@09:00", Output: "/tmp/{{.Date}}.md"}
+
+	tests := []struct {
+		name   string
+		mutate func(*Spec)
+		want   string
+	}{
+		{"missing name", func(s *Spec) { s.Name = "" }, "name is required"},
+		{"missing output", func(s *Spec) { s.Output = "" }, "output is required"},
+		{"bad schedule", func(s *Spec) { s.Schedule = "daily@9am" }, "invalid time"},
+		{"bad format", func(s *Spec) { s.Format = "pdf" }, "unknown format"},
+		{"bad status", func(s *Spec) { s.Statuses = []string{"blocked"} }, "unknown status"},
+		{"unknown template field", func(s *Spec) { s.Output = "/tmp/{{.Project}}.md" }, "invalid output path"},
+	}
+	for _, tt := range tests {
+		spec := valid
+		tt.mutate(&spec)
+		_, err := Compile([]Spec{spec})
+		if err == nil || !strings.Contains(err.Error(), tt.want) || !strings.Contains(err.Error(), "scheduled export #1") {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.want, err)
+		}
+	}
+
+	if _, err := Compile([]Spec{valid, valid}); err == nil || !strings.Contains(err.Error(), "duplicate name") {
+		t.Errorf("Expected duplicate name error, got %v", err)
+	}
+}
+
+func TestScheduler_Due(t *testing.T) {
+	jobs, err := Compile([]Spec{{Name: "Standup", Schedule: "daily@09:00", Output: "/tmp/x.md"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := &fakeClock{now: at(12, 8, 0)}
+	scheduler := NewScheduler(jobs, nil, clock.Now)
+
+	if due := scheduler.Due(); len(due) != 0 {
+		t.Fatalf("Expected nothing due before 09:00, got %d", len(due))
+	}
+	if next, ok := scheduler.NextRun(); !ok || !next.Equal(at(12, 9, 0)) {
+		t.Errorf("Expected next run at 09:00, got %v", next)
+	}
+
+	clock.now = at(12, 9, 0)
+	if due := scheduler.Due(); len(due) != 1 {
+		t.Fatalf("Expected the job due at 09:00, got %d", len(due))
+	}
+	clock.now = at(12, 9, 1)
+	if due := scheduler.Due(); len(due) != 0 {
+		t.Errorf("Expected the job not to run twice, got %d", len(due))
+	}
+	if next, _ := scheduler.NextRun(); !next.Equal(at(13, 9, 0)) {
+		t.Errorf("Expected next run tomorrow, got %v", next)
+	}
+}
+
+func TestScheduler_CatchUp(t *testing.T) {
+	jobs, err := Compile([]Spec{
+		{Name: "catch", Schedule: "daily@09:00", Output: "/tmp/a.md", CatchUp: true},
+		{Name: "skip", Schedule: "daily@09:00", Output: "/tmp/b.md"},
+		{Name: "fresh", Schedule: "daily@09:00", Output: "/tmp/c.md", CatchUp: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastRuns := map[string]time.Time{
+		"catch": at(11, 9, 0), // Missed today's 09:00 run
+		"skip":  at(11, 9, 0),
+	}
+	clock := &fakeClock{now: at(12, 14, 0)}
+
+	due := NewScheduler(jobs, lastRuns, clock.Now).Due()
+	if len(due) != 1 || due[0].Name != "catch" {
+		t.Errorf("Expected only the catch_up job with a missed run, got %+v", due)
+	}
+
+	// Nothing missed when the last run was after the latest scheduled time
+	lastRuns["catch"] = at(12, 9, 5)
+	if due := NewScheduler(jobs, lastRuns, clock.Now).Due(); len(due) != 0 {
+		t.Errorf("Expected nothing to catch up, got %+v", due)
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", "exports.json"))
+
+	loaded, err := store.Load()
+	if err != nil || len(loaded) != 0 {
+		t.Fatalf("Expected empty history before the first save, got %v (err %v)", loaded, err)
+	}
+
+	runs := map[string]time.Time{"Standup": at(12, 9, 0)}
+	if err := store.Save(runs); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+	loaded, err = store.Load()
+	if err != nil || !loaded["Standup"].Equal(runs["Standup"]) {
+		t.Errorf("Expected %v, got %v (err %v)", runs, loaded, err)
+	}
+
+	if err := os.WriteFile(store.path, []byte(`{"version": 99}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+}
+
+func TestScheduledExport_EndToEnd(t *testing.T) {
+	dir := t.TempDir()
+	feature := "auth"
+	tasks := []archon.Task{
+		{ID: "aaaaaaaa-1", Title: "Ship login", Status: "doing", Assignee: "alice", Feature: &feature, ProjectID: "p1"},
+		{ID: "bbbbbbbb-2", Title: "Review docs", Status: "review", ProjectID: "p1"},
+		{ID: "cccccccc-3", Title: "Backlog item", Status: "todo", ProjectID: "p1"},
+	}
+	jobs, err := Compile([]Spec{
+		{
+			Name:     "Standup",
+			Schedule: "weekdays@09:00",
+			Statuses: []string{"doing", "review"},
+			Output:   filepath.Join(dir, "{{.Name}}-{{.Date}}.md"),
+		},
+		{
+			Name:     "Board",
+			Schedule: "every 1h",
+			Format:   "json",
+			Output:   filepath.Join(dir, "json", "board-{{.Date}}-{{.Time}}.json"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := &fakeClock{now: at(12, 8, 30)}
+	scheduler := NewScheduler(jobs, nil, clock.Now)
+	clock.now = at(12, 9, 30)
+
+	written := map[string]string{}
+	for _, job := range scheduler.Due() {
+		path, err := job.Run(tasks, clock.now, 8)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", job.Name, err)
+		}
+		written[job.Name] = path
+	}
+
+	markdown, err := os.ReadFile(written["Standup"])
+	if err != nil {
+		t.Fatalf("Expected standup export, got %v", err)
+	}
+	if filepath.Base(written["Standup"]) != "Standup-2026-10-12.md" {
+		t.Errorf("Unexpected standup path %s", written["Standup"])
+	}
+	for _, want := range []string{"# Standup (2026-10-12 09:30)", "## Doing (1)", "- Ship login [auth] @alice (aaaaaaaa)", "## Review (1)"} {
+		if !strings.Contains(string(markdown), want) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", want, markdown)
+		}
+	}
+	if strings.Contains(string(markdown), "Backlog item") {
+		t.Error("Expected todo tasks to be filtered out")
+	}
+
+	data, err := os.ReadFile(written["Board"])
+	if err != nil {
+		t.Fatalf("Expected JSON export, got %v", err)
+	}
+	var board struct {
+		Tasks []struct {
+			ID string `json:"id"`
+		} `json:"tasks"`
+	}
+	if err := json.Unmarshal(data, &board); err != nil || len(board.Tasks) != 3 {
+		t.Errorf("Expected 3 tasks in JSON export, got %d (err %v)", len(board.Tasks), err)
+	}
+}
@@ -0,0 +1,58 @@
+package scheduled
+
+import "time"
+
+// Scheduler decides which jobs are due. It does no I/O: callers ask for due
+// jobs on every tick, run them and record the completed runs.
+type Scheduler struct {
+	jobs []Job
+	next map[string]time.Time // Planned run per job name
+	now  func() time.Time
+}
+
+// NewScheduler plans each job's first run after now. A catch_up job whose
+// last run (from lastRuns) was followed by a scheduled time that has already
+// passed is due immediately; jobs that never ran wait for their next time.
+func NewScheduler(jobs []Job, lastRuns map[string]time.Time, now func() time.Time) *Scheduler {
+	s := &Scheduler{jobs: jobs, next: make(map[string]time.Time, len(jobs)), now: now}
+	current := now()
+	for _, job := range jobs {
+		last, ran := lastRuns[job.Name]
+		if job.CatchUp && ran && !job.Schedule.Next(last).After(current) {
+			s.next[job.Name] = current
+			continue
+		}
+		s.next[job.Name] = job.Schedule.Next(current)
+	}
+	return s
+}
+
+// Jobs returns the scheduled jobs
+func (s *Scheduler) Jobs() []Job {
+	return s.jobs
+}
+
+// Due returns the jobs whose run time has come and plans their next runs
+func (s *Scheduler) Due() []Job {
+	current := s.now()
+	var due []Job
+	for _, job := range s.jobs {
+		if s.next[job.Name].After(current) {
+			continue
+		}
+		due = append(due, job)
+		s.next[job.Name] = job.Schedule.Next(current)
+	}
+	return due
+}
+
+// NextRun returns the earliest planned run; false when there are no jobs
+func (s *Scheduler) NextRun() (time.Time, bool) {
+	var earliest time.Time
+	for _, job := range s.jobs {
+		if next := s.next[job.Name]; earliest.IsZero() || next.Before(earliest) {
+			earliest = next
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
@@ -0,0 +1,82 @@
+package scheduled
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// SchemaVersion is the run history format written by this build
+const SchemaVersion = 1
+
+// ErrUnsupportedVersion is returned when run history was written with another schema version
+var ErrUnsupportedVersion = errors.New("unsupported export history version")
+
+// file is the on-disk run history
+type file struct {
+	Version  int                  `json:"version"`
+	LastRuns map[string]time.Time `json:"last_runs"`
+}
+
+// Store remembers when each job last completed, so missed runs can be caught up
+type Store struct {
+	path string
+}
+
+// NewStore creates a store that keeps run history at path
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the run history location in the user's config directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "lazyarchon", "exports.json"), nil
+}
+
+// Load reads the last run per job name; returns an empty map without error when none was saved yet
+func (s *Store) Load() (map[string]time.Time, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]time.Time{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export history: %w", err)
+	}
+
+	var stored file
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse export history: %w", err)
+	}
+	if stored.Version != SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, stored.Version)
+	}
+	if stored.LastRuns == nil {
+		stored.LastRuns = map[string]time.Time{}
+	}
+	return stored.LastRuns, nil
+}
+
+// Save writes the run history atomically
+func (s *Store) Save(lastRuns map[string]time.Time) error {
+	data, err := json.MarshalIndent(file{Version: SchemaVersion, LastRuns: lastRuns}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode export history: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create export history directory: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+)
+
+// FormatUnblockReport renders an unblock report as markdown, e.g.
+//
+//	## Ship login (doing) @alice: unblocks 2
+//	- Auth epic (1a2b3c4d)
+//
+// find resolves task IDs to titles; unknown IDs are listed as-is.
+func FormatUnblockReport(title string, report deps.Report, find func(id string) *archon.Task, shortIDLength int) string {
+	label := func(id string) string {
+		if task := find(id); task != nil {
+			return fmt.Sprintf("%s (%s)", task.Title, ShortID(id, shortIDLength))
+		}
+		return ShortID(id, shortIDLength)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+	if len(report.Entries) == 0 {
+		b.WriteString("\nNo task is blocking other work.\n")
+	}
+
+	for _, entry := range report.Entries {
+		b.WriteString("\n## ")
+		if task := find(entry.TaskID); task != nil {
+			fields := NewTaskFields(*task, shortIDLength)
+			fmt.Fprintf(&b, "%s (%s)", fields.Title, fields.Status)
+			if fields.Assignee != "" {
+				b.WriteString(" @" + fields.Assignee)
+			}
+		} else {
+			b.WriteString(ShortID(entry.TaskID, shortIDLength))
+		}
+		fmt.Fprintf(&b, ": unblocks %d\n\n", len(entry.Unblocks))
+		for _, id := range entry.Unblocks {
+			b.WriteString("- " + label(id) + "\n")
+		}
+	}
+
+	if len(report.Cycles) > 0 {
+		fmt.Fprintf(&b, "\n## Cycles (%d)\n\nThese tasks wait on each other; check their parent links.\n\n", len(report.Cycles))
+		for _, cycle := range report.Cycles {
+			labels := make([]string, len(cycle))
+			for i, id := range cycle {
+				labels[i] = label(id)
+			}
+			b.WriteString("- " + strings.Join(labels, " ↔ ") + "\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,34 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+)
+
+func TestFormatUnblockReport(t *testing.T) {
+	tasks := map[string]*archon.Task{
+		"t-leaf": {ID: "t-leaf", Title: "Write migration", Status: "doing", Assignee: "alice"},
+		"t-epic": {ID: "t-epic", Title: "Auth epic", Status: "todo"},
+	}
+	find := func(id string) *archon.Task { return tasks[id] }
+	report := deps.Report{
+		Entries: []deps.Entry{{TaskID: "t-leaf", Unblocks: []string{"t-epic"}}},
+		Cycles:  [][]string{{"t-a", "t-b"}},
+	}
+
+	markdown := FormatUnblockReport("Unblock report", report, find, 0)
+	if !strings.Contains(markdown, "## Write migration (doing) @alice: unblocks 1\n\n- Auth epic (t-epic)\n") {
+		t.Errorf("Expected ranked entry with unblocked tasks, got:\n%s", markdown)
+	}
+	if !strings.Contains(markdown, "## Cycles (1)") || !strings.Contains(markdown, "- t-a ↔ t-b\n") {
+		t.Errorf("Expected cycle warning, got:\n%s", markdown)
+	}
+
+	empty := FormatUnblockReport("Unblock report", deps.Report{}, find, 0)
+	if !strings.Contains(empty, "No task is blocking other work.") {
+		t.Errorf("Expected empty report notice, got %q", empty)
+	}
+}
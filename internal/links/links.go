@@ -0,0 +1,155 @@
+// Package links turns configurable regex rules into URLs for related resources
+// such as pull requests, branches or issue trackers.
+//
+// A rule's pattern is matched against a task's title, description and feature.
+// Every match is expanded through the rule's url_template, a Go text/template
+// that receives the matched text and capture groups:
+//
+//	{{.match}}   the full match
+//	{{.g1}}      numbered capture groups (g1, g2, ...)
+//	{{.name}}    named capture groups, e.g. (?P<ticket>[A-Z]+-\d+) → {{.ticket}}
+//	{{.field}}   which task field matched: title, description or feature
+//
+// Use the built-in urlquery function to escape values: {{urlquery .match}}.
+package links
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"text/template"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// Rule is an uncompiled link rule as written in configuration
+type Rule struct {
+	Name        string
+	Pattern     string
+	URLTemplate string
+}
+
+// Link is a resolved URL produced by a rule match
+type Link struct {
+	Name  string // Rule name shown to the user (e.g., "Open PR search")
+	URL   string // Expanded URL
+	Match string // Text that matched the rule's pattern
+}
+
+// compiledRule is a rule with its pattern and template parsed
+type compiledRule struct {
+	name     string
+	pattern  *regexp.Regexp
+	template *template.Template
+}
+
+// Engine matches task text against compiled link rules.
+// A nil *Engine is valid and never matches.
+type Engine struct {
+	rules []compiledRule
+}
+
+// Compile validates and compiles link rules.
+// Errors identify the offending rule by position and name.
+func Compile(rules []Rule) (*Engine, error) {
+	engine := &Engine{rules: make([]compiledRule, 0, len(rules))}
+
+	for i, rule := range rules {
+		label := fmt.Sprintf("link rule #%d", i+1)
+		if rule.Name != "" {
+			label = fmt.Sprintf("%s (%q)", label, rule.Name)
+		}
+
+		if rule.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", label)
+		}
+		if rule.Pattern == "" {
+			return nil, fmt.Errorf("%s: pattern is required", label)
+		}
+		if rule.URLTemplate == "" {
+			return nil, fmt.Errorf("%s: url_template is required", label)
+		}
+
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid pattern: %w", label, err)
+		}
+
+		tmpl, err := template.New(rule.Name).Option("missingkey=error").Parse(rule.URLTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid url_template: %w", label, err)
+		}
+
+		engine.rules = append(engine.rules, compiledRule{
+			name:     rule.Name,
+			pattern:  pattern,
+			template: tmpl,
+		})
+	}
+
+	return engine, nil
+}
+
+// Len returns the number of compiled rules
+func (e *Engine) Len() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.rules)
+}
+
+// MatchTask returns links for every rule match in the task's title, description and feature.
+// Links are ordered by rule, then by field, and duplicate URLs are dropped.
+func (e *Engine) MatchTask(task archon.Task) []Link {
+	if e.Len() == 0 {
+		return nil
+	}
+
+	fields := []struct{ name, text string }{
+		{"title", task.Title},
+		{"description", task.Description},
+	}
+	if task.Feature != nil {
+		fields = append(fields, struct{ name, text string }{"feature", *task.Feature})
+	}
+
+	var links []Link
+	seen := make(map[string]bool)
+	for _, rule := range e.rules {
+		for _, field := range fields {
+			for _, groups := range rule.pattern.FindAllStringSubmatch(field.text, -1) {
+				url, err := rule.expand(groups, field.name)
+				if err != nil || url == "" || seen[url] {
+					continue
+				}
+				seen[url] = true
+				links = append(links, Link{Name: rule.name, URL: url, Match: groups[0]})
+			}
+		}
+	}
+
+	return links
+}
+
+// expand renders the rule's URL template for a single match
+func (r compiledRule) expand(groups []string, field string) (string, error) {
+	data := map[string]string{
+		"match": groups[0],
+		"field": field,
+	}
+	for i := 1; i < len(groups); i++ {
+		data["g"+strconv.Itoa(i)] = groups[i]
+	}
+	for i, name := range r.pattern.SubexpNames() {
+		if name != "" && i < len(groups) {
+			data[name] = groups[i]
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := r.template.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("link rule %q: %w", r.name, err)
+	}
+	return buf.String(), nil
+}
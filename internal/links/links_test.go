@@ -0,0 +1,119 @@
+package links
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		rules  []Rule
+		errMsg string
+	}{
+		{"missing name", []Rule{{Pattern: "x", URLTemplate: "https://x"}}, "link rule #1: name is required"},
+		{"missing pattern", []Rule{{Name: "PR", URLTemplate: "https://x"}}, `link rule #1 ("PR"): pattern is required`},
+		{"missing template", []Rule{{Name: "PR", Pattern: "x"}}, "url_template is required"},
+		{"bad regex", []Rule{{Name: "PR", Pattern: "(", URLTemplate: "https://x"}}, "invalid pattern"},
+		{"bad template", []Rule{{Name: "PR", Pattern: "x", URLTemplate: "{{.match"}}, "invalid url_template"},
+		{
+			"error names the second rule",
+			[]Rule{{Name: "ok", Pattern: "x", URLTemplate: "https://x"}, {Name: "broken", Pattern: "[", URLTemplate: "https://x"}},
+			`link rule #2 ("broken")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.rules)
+			if err == nil {
+				t.Fatal("Expected compile error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Expected error containing %q, got %q", tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestMatchTask(t *testing.T) {
+	engine, err := Compile([]Rule{
+		{
+			Name:        "Open PR search",
+			Pattern:     `auth-\d+`,
+			URLTemplate: "https://github.com/org/repo/pulls?q={{urlquery .match}}",
+		},
+		{
+			Name:        "Open ticket",
+			Pattern:     `(?P<project>[A-Z]+)-(\d+)`,
+			URLTemplate: "https://jira.example.com/browse/{{.project}}-{{.g2}}",
+		},
+		{
+			Name:        "Open branch",
+			Pattern:     `^.+$`,
+			URLTemplate: "https://github.com/org/repo/tree/{{.match}}",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected compile error: %v", err)
+	}
+
+	task := archon.Task{
+		Title:       "Fix login for auth-123",
+		Description: "See PAY-42 and auth-123 again",
+		Feature:     stringPtr("auth-123"),
+	}
+
+	got := engine.MatchTask(task)
+	want := []Link{
+		{Name: "Open PR search", URL: "https://github.com/org/repo/pulls?q=auth-123", Match: "auth-123"},
+		{Name: "Open ticket", URL: "https://jira.example.com/browse/PAY-42", Match: "PAY-42"},
+		{Name: "Open branch", URL: "https://github.com/org/repo/tree/Fix login for auth-123", Match: "Fix login for auth-123"},
+		{Name: "Open branch", URL: "https://github.com/org/repo/tree/See PAY-42 and auth-123 again", Match: "See PAY-42 and auth-123 again"},
+		{Name: "Open branch", URL: "https://github.com/org/repo/tree/auth-123", Match: "auth-123"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d links, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Link %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestMatchTask_FieldAndNoMatch(t *testing.T) {
+	engine, err := Compile([]Rule{
+		{Name: "Feature only", Pattern: `.+`, URLTemplate: `{{if eq .field "feature"}}https://x/{{.match}}{{end}}`},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected compile error: %v", err)
+	}
+
+	// Empty expansions are skipped, so only the feature produces a link
+	got := engine.MatchTask(archon.Task{Title: "title", Feature: stringPtr("auth")})
+	if len(got) != 1 || got[0].URL != "https://x/auth" {
+		t.Errorf("Expected single feature link, got %+v", got)
+	}
+
+	if got := engine.MatchTask(archon.Task{Title: "title"}); len(got) != 0 {
+		t.Errorf("Expected no links without feature, got %+v", got)
+	}
+}
+
+func TestNilEngine(t *testing.T) {
+	var engine *Engine
+	if engine.Len() != 0 {
+		t.Error("Expected nil engine to have no rules")
+	}
+	if got := engine.MatchTask(archon.Task{Title: "auth-1"}); got != nil {
+		t.Errorf("Expected nil engine to match nothing, got %+v", got)
+	}
+}
@@ -6,13 +6,14 @@ import (
 	"os"
 	"runtime"
 	"time"
-)
 
-const (
-	// DefaultLogFile is the default log file path for TUI applications
-	DefaultLogFile = "/tmp/lazyarchon.log"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/paths"
 )
 
+// DefaultLogFile is the default log file path for TUI applications:
+// lazyarchon.log in the OS temp directory
+var DefaultLogFile = paths.DefaultLogFile(runtime.GOOS, os.Getenv)
+
 // SlogLogger provides structured logging using slog without dependency injection
 type SlogLogger struct {
 	logger           *slog.Logger
@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+)
+
+// instrumentedClient times every API call and reports it to a Collector
+type instrumentedClient struct {
+	next      interfaces.ArchonClient
+	collector *Collector
+}
+
+// InstrumentClient wraps client so each call's duration and outcome is recorded
+// by collector under the method name; a nil collector returns client unchanged
+func InstrumentClient(client interfaces.ArchonClient, collector *Collector) interfaces.ArchonClient {
+	if collector == nil {
+		return client
+	}
+	return &instrumentedClient{next: client, collector: collector}
+}
+
+// observe records one call started at start
+func (c *instrumentedClient) observe(operation string, start time.Time, err error) {
+	c.collector.ObserveRequest(operation, time.Since(start), err)
+}
+
+func (c *instrumentedClient) ListTasks(projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error) {
+	start := time.Now()
+	resp, err := c.next.ListTasks(projectID, status, includeClosed)
+	c.observe("ListTasks", start, err)
+	return resp, err
+}
+
+// ListTasksPaged forwards to the wrapped client's paged listing, if it has one
+func (c *instrumentedClient) ListTasksPaged(opts archon.ListTasksOptions) (*archon.TasksResponse, error) {
+	pager, ok := c.next.(interfaces.TaskPager)
+	if !ok {
+		return nil, archon.ErrNotSupported
+	}
+	start := time.Now()
+	resp, err := pager.ListTasksPaged(opts)
+	c.observe("ListTasksPaged", start, err)
+	return resp, err
+}
+
+// ListTasksContext forwards to the wrapped client's cancellable listing, if
+// it has one; otherwise ctx is not passed on
+func (c *instrumentedClient) ListTasksContext(ctx context.Context, projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error) {
+	lister, ok := c.next.(interfaces.ContextLister)
+	if !ok {
+		return c.ListTasks(projectID, status, includeClosed)
+	}
+	start := time.Now()
+	resp, err := lister.ListTasksContext(ctx, projectID, status, includeClosed)
+	c.observe("ListTasks", start, err)
+	return resp, err
+}
+
+// ListTasksPagedContext forwards to the wrapped client's cancellable paged
+// listing, if it has one; otherwise ctx is not passed on
+func (c *instrumentedClient) ListTasksPagedContext(ctx context.Context, opts archon.ListTasksOptions) (*archon.TasksResponse, error) {
+	lister, ok := c.next.(interfaces.ContextLister)
+	if !ok {
+		return c.ListTasksPaged(opts)
+	}
+	start := time.Now()
+	resp, err := lister.ListTasksPagedContext(ctx, opts)
+	c.observe("ListTasksPaged", start, err)
+	return resp, err
+}
+
+// SearchTasks forwards to the wrapped client's server search, if it has one
+func (c *instrumentedClient) SearchTasks(query string, projectID *string) (*archon.TasksResponse, error) {
+	searcher, ok := c.next.(interfaces.TaskSearcher)
+	if !ok {
+		return nil, archon.ErrNotSupported
+	}
+	start := time.Now()
+	resp, err := searcher.SearchTasks(query, projectID)
+	c.observe("SearchTasks", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) GetTask(taskID string) (*archon.TaskResponse, error) {
+	start := time.Now()
+	resp, err := c.next.GetTask(taskID)
+	c.observe("GetTask", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) CreateTask(req archon.CreateTaskRequest) (*archon.TaskResponse, error) {
+	start := time.Now()
+	resp, err := c.next.CreateTask(req)
+	c.observe("CreateTask", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) UpdateTask(taskID string, updates archon.UpdateTaskRequest) (*archon.TaskResponse, error) {
+	start := time.Now()
+	resp, err := c.next.UpdateTask(taskID, updates)
+	c.observe("UpdateTask", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) DeleteTask(taskID string) error {
+	start := time.Now()
+	err := c.next.DeleteTask(taskID)
+	c.observe("DeleteTask", start, err)
+	return err
+}
+
+// InvalidateCache forwards to the wrapped client's response cache, if it has one
+func (c *instrumentedClient) InvalidateCache() {
+	if cacher, ok := c.next.(interfaces.ResponseCacher); ok {
+		cacher.InvalidateCache()
+	}
+}
+
+func (c *instrumentedClient) ListProjects() (*archon.ProjectsResponse, error) {
+	start := time.Now()
+	resp, err := c.next.ListProjects()
+	c.observe("ListProjects", start, err)
+	return resp, err
+}
+
+// ListProjectsContext forwards to the wrapped client's cancellable listing,
+// if it has one; otherwise ctx is not passed on
+func (c *instrumentedClient) ListProjectsContext(ctx context.Context) (*archon.ProjectsResponse, error) {
+	lister, ok := c.next.(interfaces.ContextLister)
+	if !ok {
+		return c.ListProjects()
+	}
+	start := time.Now()
+	resp, err := lister.ListProjectsContext(ctx)
+	c.observe("ListProjects", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) GetProject(projectID string) (*archon.ProjectResponse, error) {
+	start := time.Now()
+	resp, err := c.next.GetProject(projectID)
+	c.observe("GetProject", start, err)
+	return resp, err
+}
+
+func (c *instrumentedClient) HealthCheck() error {
+	start := time.Now()
+	err := c.next.HealthCheck()
+	c.observe("HealthCheck", start, err)
+	return err
+}
@@ -0,0 +1,282 @@
+// Package metrics exposes backlog health in the Prometheus text format, so
+// task counts, sync age and API latency can be graphed without another
+// poller hitting the Archon API.
+//
+// The TUI hands the Collector a copy of its data after every refresh; scrapes
+// only ever read that snapshot and never touch live UI state.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// Label values used when a task has no feature or its feature is over the cap
+const (
+	NoFeature    = "none"
+	OtherFeature = "other"
+)
+
+// DefaultMaxFeatures is how many features get their own label when none is given
+const DefaultMaxFeatures = 10
+
+// latencyWindow is how many recent request durations each operation keeps
+const latencyWindow = 256
+
+// latencyQuantiles are the quantiles reported for API request durations
+var latencyQuantiles = []float64{0.5, 0.9, 0.99}
+
+// BuildInfo identifies the running binary in lazyarchon_build_info
+type BuildInfo struct {
+	Version string
+	Commit  string
+}
+
+// taskKey groups tasks for lazyarchon_tasks
+type taskKey struct {
+	ProjectID string
+	Status    string
+	Feature   string
+}
+
+// snapshot is the data of the last refresh, computed up front
+type snapshot struct {
+	tasks    map[taskKey]int
+	projects map[string]string // Project ID -> title
+	syncedAt time.Time
+}
+
+// apiStats accumulates request durations and errors for one API operation
+type apiStats struct {
+	recent []time.Duration // Ring of recent durations for quantiles
+	next   int
+	count  int64
+	sum    time.Duration
+	errors int64
+}
+
+// Collector holds the data /metrics reports. All methods are safe for
+// concurrent use; Refresh and ObserveRequest also accept a nil receiver, so
+// callers don't need to check whether metrics are enabled.
+type Collector struct {
+	build       BuildInfo
+	maxFeatures int
+	now         func() time.Time
+
+	mu       sync.RWMutex
+	snapshot snapshot
+
+	apiMu sync.Mutex
+	api   map[string]*apiStats
+}
+
+// NewCollector creates a collector keeping at most maxFeatures feature labels
+// (0 uses DefaultMaxFeatures); the remaining features are counted as "other"
+func NewCollector(build BuildInfo, maxFeatures int) *Collector {
+	if maxFeatures <= 0 {
+		maxFeatures = DefaultMaxFeatures
+	}
+	return &Collector{
+		build:       build,
+		maxFeatures: maxFeatures,
+		now:         time.Now,
+		api:         make(map[string]*apiStats),
+	}
+}
+
+// Refresh replaces the snapshot with counts computed from tasks and projects.
+// Nothing is retained from the slices, so callers may keep mutating them.
+func (c *Collector) Refresh(tasks []archon.Task, projects []archon.Project) {
+	if c == nil {
+		return
+	}
+	next := snapshot{
+		tasks:    make(map[taskKey]int),
+		projects: make(map[string]string, len(projects)),
+		syncedAt: c.now(),
+	}
+	for _, project := range projects {
+		next.projects[project.ID] = project.Title
+	}
+	kept := c.topFeatures(tasks)
+	for _, task := range tasks {
+		feature := NoFeature
+		if task.Feature != nil && *task.Feature != "" {
+			feature = OtherFeature
+			if kept[*task.Feature] {
+				feature = *task.Feature
+			}
+		}
+		next.tasks[taskKey{ProjectID: task.ProjectID, Status: task.Status, Feature: feature}]++
+	}
+
+	c.mu.Lock()
+	c.snapshot = next
+	c.mu.Unlock()
+}
+
+// topFeatures returns the maxFeatures features with the most tasks (ties by name)
+func (c *Collector) topFeatures(tasks []archon.Task) map[string]bool {
+	counts := make(map[string]int)
+	for _, task := range tasks {
+		if task.Feature != nil && *task.Feature != "" {
+			counts[*task.Feature]++
+		}
+	}
+	features := make([]string, 0, len(counts))
+	for feature := range counts {
+		features = append(features, feature)
+	}
+	sort.Slice(features, func(i, j int) bool {
+		if counts[features[i]] != counts[features[j]] {
+			return counts[features[i]] > counts[features[j]]
+		}
+		return features[i] < features[j]
+	})
+
+	kept := make(map[string]bool, c.maxFeatures)
+	for _, feature := range features[:min(c.maxFeatures, len(features))] {
+		kept[feature] = true
+	}
+	return kept
+}
+
+// ObserveRequest records one API call; err marks it as failed
+func (c *Collector) ObserveRequest(operation string, d time.Duration, err error) {
+	if c == nil {
+		return
+	}
+	c.apiMu.Lock()
+	defer c.apiMu.Unlock()
+
+	stats, ok := c.api[operation]
+	if !ok {
+		stats = &apiStats{}
+		c.api[operation] = stats
+	}
+	if len(stats.recent) < latencyWindow {
+		stats.recent = append(stats.recent, d)
+	} else {
+		stats.recent[stats.next] = d
+		stats.next = (stats.next + 1) % latencyWindow
+	}
+	stats.count++
+	stats.sum += d
+	if err != nil {
+		stats.errors++
+	}
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	c.writeBuildInfo(&b)
+	c.writeSnapshot(&b)
+	c.writeAPI(&b)
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func (c *Collector) writeBuildInfo(b *strings.Builder) {
+	writeHeader(b, "lazyarchon_build_info", "gauge", "Build information of the running lazyarchon.")
+	fmt.Fprintf(b, "lazyarchon_build_info{version=%s,commit=%s,goversion=%s} 1\n",
+		quote(c.build.Version), quote(c.build.Commit), quote(runtime.Version()))
+}
+
+func (c *Collector) writeSnapshot(b *strings.Builder) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	writeHeader(b, "lazyarchon_tasks", "gauge", "Tasks by project, status and feature at the last refresh.")
+	keys := make([]taskKey, 0, len(c.snapshot.tasks))
+	for key := range c.snapshot.tasks {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ProjectID != keys[j].ProjectID {
+			return keys[i].ProjectID < keys[j].ProjectID
+		}
+		if keys[i].Status != keys[j].Status {
+			return keys[i].Status < keys[j].Status
+		}
+		return keys[i].Feature < keys[j].Feature
+	})
+	for _, key := range keys {
+		fmt.Fprintf(b, "lazyarchon_tasks{project_id=%s,status=%s,feature=%s} %d\n",
+			quote(key.ProjectID), quote(key.Status), quote(key.Feature), c.snapshot.tasks[key])
+	}
+
+	writeHeader(b, "lazyarchon_project_info", "gauge", "Project titles, to join with lazyarchon_tasks on project_id.")
+	ids := make([]string, 0, len(c.snapshot.projects))
+	for id := range c.snapshot.projects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		fmt.Fprintf(b, "lazyarchon_project_info{project_id=%s,title=%s} 1\n", quote(id), quote(c.snapshot.projects[id]))
+	}
+
+	if !c.snapshot.syncedAt.IsZero() {
+		writeHeader(b, "lazyarchon_last_sync_age_seconds", "gauge", "Seconds since tasks were last loaded from Archon.")
+		fmt.Fprintf(b, "lazyarchon_last_sync_age_seconds %g\n", c.now().Sub(c.snapshot.syncedAt).Seconds())
+	}
+}
+
+func (c *Collector) writeAPI(b *strings.Builder) {
+	c.apiMu.Lock()
+	defer c.apiMu.Unlock()
+
+	operations := make([]string, 0, len(c.api))
+	for operation := range c.api {
+		operations = append(operations, operation)
+	}
+	sort.Strings(operations)
+
+	writeHeader(b, "lazyarchon_api_request_duration_seconds", "summary", "Archon API request durations by operation.")
+	for _, operation := range operations {
+		stats := c.api[operation]
+		sorted := append([]time.Duration(nil), stats.recent...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		for _, q := range latencyQuantiles {
+			fmt.Fprintf(b, "lazyarchon_api_request_duration_seconds{operation=%s,quantile=\"%g\"} %g\n",
+				quote(operation), q, quantile(sorted, q).Seconds())
+		}
+		fmt.Fprintf(b, "lazyarchon_api_request_duration_seconds_sum{operation=%s} %g\n", quote(operation), stats.sum.Seconds())
+		fmt.Fprintf(b, "lazyarchon_api_request_duration_seconds_count{operation=%s} %d\n", quote(operation), stats.count)
+	}
+
+	writeHeader(b, "lazyarchon_api_errors_total", "counter", "Failed Archon API requests by operation.")
+	for _, operation := range operations {
+		fmt.Fprintf(b, "lazyarchon_api_errors_total{operation=%s} %d\n", quote(operation), c.api[operation].errors)
+	}
+}
+
+// quantile returns the nearest-rank quantile of sorted durations
+func quantile(sorted []time.Duration, q float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(q * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// writeHeader writes the HELP and TYPE lines of a metric family
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// labelEscaper escapes label values as the text format requires
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote returns a label value in double quotes
+func quote(value string) string {
+	return `"` + labelEscaper.Replace(value) + `"`
+}
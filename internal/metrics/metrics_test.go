@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+)
+
+// sampleLine matches one sample of the text exposition format
+var sampleLine = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*(\{([a-zA-Z_][a-zA-Z0-9_]*="([^"\\]|\\.)*",?)*\})? [-+0-9.eE]+$`)
+
+// stubClient answers ListTasks and fails GetTask; other methods are not used
+type stubClient struct {
+	interfaces.ArchonClient
+}
+
+func (stubClient) ListTasks(*string, *string, bool) (*archon.TasksResponse, error) {
+	return &archon.TasksResponse{}, nil
+}
+
+func (stubClient) GetTask(string) (*archon.TaskResponse, error) {
+	return nil, errors.New("boom")
+}
+
+// fixtureCollector returns a collector refreshed with a small backlog 90s ago
+func fixtureCollector(maxFeatures int) *Collector {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	c := NewCollector(BuildInfo{Version: "v2.1.0", Commit: "abc123"}, maxFeatures)
+	c.now = func() time.Time { return now }
+
+	feature := func(name string) *string { return &name }
+	c.Refresh([]archon.Task{
+		{ID: "1", ProjectID: "web", Status: "todo", Feature: feature("auth")},
+		{ID: "2", ProjectID: "web", Status: "todo", Feature: feature("auth")},
+		{ID: "3", ProjectID: "web", Status: "doing", Feature: feature("ui")},
+		{ID: "4", ProjectID: "web", Status: "doing", Feature: feature("ui")},
+		{ID: "5", ProjectID: "web", Status: "done", Feature: feature(`say "hi"`)},
+		{ID: "6", ProjectID: "api", Status: "todo"},
+	}, []archon.Project{{ID: "web", Title: "Web"}, {ID: "api", Title: "API"}})
+
+	now = now.Add(90 * time.Second)
+	return c
+}
+
+// scrape serves c on a free port and returns the /metrics body
+func scrape(t *testing.T, c *Collector) string {
+	t.Helper()
+	server, err := Listen("127.0.0.1:0", c)
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer func() {
+		if err := server.Shutdown(context.Background()); err != nil {
+			t.Errorf("Shutdown failed: %v", err)
+		}
+	}()
+
+	resp, err := http.Get("http://" + server.Addr() + "/metrics")
+	if err != nil {
+		t.Fatalf("Scrape failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Fatalf("Unexpected response %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Reading body failed: %v", err)
+	}
+	return string(body)
+}
+
+func TestScrapeFormat(t *testing.T) {
+	c := fixtureCollector(0)
+	client := InstrumentClient(stubClient{}, c)
+	_, _ = client.ListTasks(nil, nil, true)
+	_, _ = client.GetTask("1")
+
+	body := scrape(t, c)
+	declared := map[string]bool{}
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		if strings.HasPrefix(line, "# TYPE ") {
+			declared[strings.Fields(line)[2]] = true
+			continue
+		}
+		if strings.HasPrefix(line, "# HELP ") {
+			continue
+		}
+		if !sampleLine.MatchString(line) {
+			t.Errorf("Invalid sample line %q", line)
+		}
+		name := strings.TrimSuffix(strings.TrimSuffix(strings.SplitN(line, "{", 2)[0], "_sum"), "_count")
+		if !declared[strings.Fields(name)[0]] {
+			t.Errorf("Sample before its TYPE line: %q", line)
+		}
+	}
+
+	for _, want := range []string{
+		`lazyarchon_build_info{version="v2.1.0",commit="abc123",goversion="`,
+		`lazyarchon_tasks{project_id="web",status="todo",feature="auth"} 2`,
+		`lazyarchon_tasks{project_id="web",status="done",feature="say \"hi\""} 1`,
+		`lazyarchon_tasks{project_id="api",status="todo",feature="none"} 1`,
+		`lazyarchon_project_info{project_id="web",title="Web"} 1`,
+		"lazyarchon_last_sync_age_seconds 90\n",
+		`lazyarchon_api_request_duration_seconds_count{operation="ListTasks"} 1`,
+		`lazyarchon_api_errors_total{operation="ListTasks"} 0`,
+		`lazyarchon_api_errors_total{operation="GetTask"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in scrape:\n%s", want, body)
+		}
+	}
+}
+
+func TestFeatureLabelCap(t *testing.T) {
+	c := fixtureCollector(2)
+	body := scrape(t, c)
+
+	// auth and ui have two tasks each; the third feature is folded into "other"
+	if !strings.Contains(body, `lazyarchon_tasks{project_id="web",status="done",feature="other"} 1`) {
+		t.Errorf("Expected the least used feature counted as other:\n%s", body)
+	}
+	if strings.Contains(body, `say \"hi\"`) {
+		t.Errorf("Expected no label for a feature over the cap:\n%s", body)
+	}
+}
+
+func TestNilCollector(t *testing.T) {
+	var c *Collector
+	c.Refresh(nil, nil)
+	c.ObserveRequest("ListTasks", time.Millisecond, nil)
+
+	var client interfaces.ArchonClient = stubClient{}
+	if InstrumentClient(client, nil) != client {
+		t.Error("Expected the client unchanged without a collector")
+	}
+}
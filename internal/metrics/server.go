@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// readHeaderTimeout bounds how long a scraper may take to send its request headers
+const readHeaderTimeout = 5 * time.Second
+
+// Server serves /metrics for a Collector
+type Server struct {
+	http     *http.Server
+	listener net.Listener
+}
+
+// Handler returns an http.Handler writing the collector's metrics
+func (c *Collector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = c.WriteTo(w)
+	})
+}
+
+// Listen binds addr and serves /metrics in the background. Binding happens
+// before Listen returns, so a busy port is reported to the caller.
+func Listen(addr string, collector *Collector) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", collector.Handler())
+	server := &Server{
+		http:     &http.Server{Handler: mux, ReadHeaderTimeout: readHeaderTimeout},
+		listener: listener,
+	}
+	go func() {
+		if err := server.http.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			_ = listener.Close()
+		}
+	}()
+	return server, nil
+}
+
+// Addr returns the address the server listens on (useful with port 0)
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Shutdown stops accepting scrapes and waits for running ones until ctx is done
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
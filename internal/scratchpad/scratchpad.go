@@ -0,0 +1,307 @@
+// Package scratchpad maps a task's editable fields to a YAML document edited
+// in $EDITOR, and maps the edited document back to one task update.
+package scratchpad
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+)
+
+// Editable fields, in the order they are written and reported
+const (
+	FieldTitle       = "title"
+	FieldStatus      = "status"
+	FieldPriority    = "priority"
+	FieldFeature     = "feature"
+	FieldDescription = "description"
+)
+
+// editableFields lists the keys Parse accepts
+var editableFields = []string{FieldTitle, FieldStatus, FieldPriority, FieldFeature, FieldDescription}
+
+// unsupportedFields are task fields users expect to edit that the Archon
+// server does not store; Parse rejects them instead of dropping them
+var unsupportedFields = []string{"labels", "due_date", "estimate"}
+
+// errorPrefix starts the comment lines WithError puts at the top of a document
+const errorPrefix = "# error: "
+
+// ErrEmpty is returned by Parse for a document without fields (e.g. an
+// emptied file), which cancels the edit
+var ErrEmpty = errors.New("scratchpad is empty")
+
+// Edit holds the fields present in an edited document; nil fields were
+// removed from the document and stay unchanged
+type Edit struct {
+	Title       *string
+	Status      *string
+	Priority    *int
+	Feature     *string
+	Description *string
+}
+
+// document is the YAML shape written by Render
+type document struct {
+	Title       string `yaml:"title"`
+	Status      string `yaml:"status"`
+	Priority    int    `yaml:"priority"`
+	Feature     string `yaml:"feature"`
+	Description string `yaml:"description"`
+}
+
+// Render returns the scratchpad document for task
+func Render(task archon.Task) ([]byte, error) {
+	feature := ""
+	if task.Feature != nil {
+		feature = *task.Feature
+	}
+	body, err := yaml.Marshal(document{
+		Title:       task.Title,
+		Status:      task.Status,
+		Priority:    task.TaskOrder,
+		Feature:     feature,
+		Description: task.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Editing task %s. Save and quit to preview the changes; empty the file to cancel.\n", task.ID)
+	fmt.Fprintf(&buf, "# status: %s. priority: %d-%d. feature: \"\" for none.\n",
+		strings.Join(utils.NewTaskStatusUtils().GetAllStatuses(), ", "), utils.MinTaskPriority, utils.MaxTaskPriority)
+	fmt.Fprintf(&buf, "# Not supported by the Archon server: %s.\n", strings.Join(unsupportedFields, ", "))
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// WithError returns content with err as comment lines at the top, replacing
+// the lines of a previous WithError, so the user can fix the document
+func WithError(content []byte, err error) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	for len(lines) > 0 && strings.HasPrefix(lines[0], errorPrefix) {
+		lines = lines[1:]
+	}
+
+	var buf bytes.Buffer
+	for _, line := range strings.Split(err.Error(), "\n") {
+		buf.WriteString(errorPrefix + line + "\n")
+	}
+	buf.WriteString(strings.Join(lines, ""))
+	return buf.Bytes()
+}
+
+// Parse reads an edited document. All problems are reported together, one
+// per line: YAML syntax, unknown or unsupported fields, wrong types and
+// values rejected by the task edit rules.
+func Parse(content []byte) (Edit, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return Edit{}, err
+	}
+	if len(doc.Content) == 0 {
+		return Edit{}, ErrEmpty
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return Edit{}, fmt.Errorf("line %d: expected \"field: value\" lines", root.Line)
+	}
+	if len(root.Content) == 0 {
+		return Edit{}, ErrEmpty
+	}
+
+	var edit Edit
+	var errs []error
+	seen := make(map[string]bool)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if seen[key.Value] {
+			errs = append(errs, fmt.Errorf("line %d: %s is set twice", key.Line, key.Value))
+			continue
+		}
+		seen[key.Value] = true
+		if err := edit.set(key.Value, value); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", key.Line, err))
+		}
+	}
+	return edit, errors.Join(errs...)
+}
+
+// set decodes and validates one field of the document
+func (e *Edit) set(field string, value *yaml.Node) error {
+	switch field {
+	case FieldTitle:
+		title, err := text(field, value, false)
+		if err == nil {
+			err = utils.ValidateTaskTitle(title)
+		}
+		e.Title = &title
+		return err
+	case FieldStatus:
+		status, err := text(field, value, false)
+		if err == nil {
+			err = utils.ValidateTaskStatus(status)
+		}
+		e.Status = &status
+		return err
+	case FieldPriority:
+		priority, err := number(field, value)
+		if err == nil {
+			err = utils.ValidateTaskPriority(priority)
+		}
+		e.Priority = &priority
+		return err
+	case FieldFeature:
+		feature, err := text(field, value, true)
+		if err == nil {
+			err = utils.ValidateFeatureName(feature)
+		}
+		e.Feature = &feature
+		return err
+	case FieldDescription:
+		description, err := text(field, value, true)
+		e.Description = &description
+		return err
+	}
+
+	for _, unsupported := range unsupportedFields {
+		if field == unsupported {
+			return fmt.Errorf("%s is not supported by the Archon server; remove it", field)
+		}
+	}
+	return fmt.Errorf("unknown field %q (editable: %s)", field, strings.Join(editableFields, ", "))
+}
+
+// text decodes a scalar field; null is the empty string when nullable
+func text(field string, value *yaml.Node, nullable bool) (string, error) {
+	if value.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("%s must be text", field)
+	}
+	if value.Tag == "!!null" {
+		if nullable {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s must not be empty", field)
+	}
+	if field == FieldDescription {
+		return value.Value, nil
+	}
+	return strings.TrimSpace(value.Value), nil
+}
+
+// number decodes an integer field
+func number(field string, value *yaml.Node) (int, error) {
+	if value.Kind != yaml.ScalarNode || value.Tag != "!!int" {
+		return 0, fmt.Errorf("%s must be a whole number, got %q", field, value.Value)
+	}
+	n, err := strconv.Atoi(value.Value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a whole number, got %q", field, value.Value)
+	}
+	return n, nil
+}
+
+// Diff returns the update applying edit to task and the changed fields, in
+// document order. Descriptions that only differ in trailing newlines (added
+// by YAML block scalars) are unchanged.
+func Diff(task archon.Task, edit Edit) (archon.UpdateTaskRequest, []taskdiff.FieldChange) {
+	var updates archon.UpdateTaskRequest
+	var changes []taskdiff.FieldChange
+	change := func(field, old, current string) {
+		changes = append(changes, taskdiff.FieldChange{Field: field, Old: old, New: current})
+	}
+
+	if edit.Title != nil && *edit.Title != task.Title {
+		updates.Title = edit.Title
+		change(FieldTitle, task.Title, *edit.Title)
+	}
+	if edit.Status != nil && *edit.Status != task.Status {
+		updates.Status = edit.Status
+		change(FieldStatus, task.Status, *edit.Status)
+	}
+	if edit.Priority != nil && *edit.Priority != task.TaskOrder {
+		updates.TaskOrder = edit.Priority
+		change(FieldPriority, strconv.Itoa(task.TaskOrder), strconv.Itoa(*edit.Priority))
+	}
+	feature := ""
+	if task.Feature != nil {
+		feature = *task.Feature
+	}
+	if edit.Feature != nil && *edit.Feature != feature {
+		updates.Feature = edit.Feature
+		change(FieldFeature, feature, *edit.Feature)
+	}
+	if edit.Description != nil && strings.TrimRight(*edit.Description, "\n") != strings.TrimRight(task.Description, "\n") {
+		description := strings.TrimRight(*edit.Description, "\n")
+		updates.Description = &description
+		change(FieldDescription, "", "")
+	}
+	return updates, changes
+}
+
+// Conflicts returns the fields among changes that also differ between
+// original, the task the document was rendered from, and current, the task
+// as the server has it now: applying the edit would overwrite those.
+func Conflicts(original, current archon.Task, changes []taskdiff.FieldChange) []string {
+	var conflicts []string
+	for _, change := range changes {
+		if fieldValue(original, change.Field) != fieldValue(current, change.Field) {
+			conflicts = append(conflicts, change.Field)
+		}
+	}
+	return conflicts
+}
+
+// fieldValue returns an editable field of task as Diff compares it
+func fieldValue(task archon.Task, field string) string {
+	switch field {
+	case FieldTitle:
+		return task.Title
+	case FieldStatus:
+		return task.Status
+	case FieldPriority:
+		return strconv.Itoa(task.TaskOrder)
+	case FieldFeature:
+		if task.Feature == nil {
+			return ""
+		}
+		return *task.Feature
+	case FieldDescription:
+		return strings.TrimRight(task.Description, "\n")
+	}
+	return ""
+}
+
+// Preview describes changes one per line, e.g. "status: doing → review".
+// Descriptions are reported as changed without their text.
+func Preview(changes []taskdiff.FieldChange) []string {
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		switch change.Field {
+		case FieldDescription:
+			lines = append(lines, "description: changed")
+		case FieldFeature:
+			lines = append(lines, fmt.Sprintf("feature: %s → %s", orNone(change.Old), orNone(change.New)))
+		default:
+			lines = append(lines, fmt.Sprintf("%s: %s → %s", change.Field, change.Old, change.New))
+		}
+	}
+	return lines
+}
+
+// orNone shows an empty feature as "(none)"
+func orNone(feature string) string {
+	if feature == "" {
+		return "(none)"
+	}
+	return feature
+}
@@ -0,0 +1,245 @@
+package scratchpad
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+)
+
+func strPtr(s string) *string { return &s }
+func intPtr(n int) *int       { return &n }
+
+// fixtureTask is the task edited in these tests
+func fixtureTask() archon.Task {
+	return archon.Task{
+		ID:          "1a2b3c4d-0000",
+		Title:       "Fix login",
+		Description: "Steps:\n1. open /login\n2. submit",
+		Status:      archon.TaskStatusDoing,
+		TaskOrder:   10,
+		Feature:     strPtr("auth"),
+	}
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	content, err := Render(fixtureTask())
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	for _, want := range []string{
+		"# Editing task 1a2b3c4d-0000.",
+		"# Not supported by the Archon server: labels, due_date, estimate.",
+		"title: Fix login\n",
+		"priority: 10\n",
+		"description: |-\n",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Expected %q in:\n%s", want, content)
+		}
+	}
+
+	edit, err := Parse(content)
+	if err != nil {
+		t.Fatalf("Parse of an untouched document failed: %v", err)
+	}
+	if updates, changes := Diff(fixtureTask(), edit); len(changes) != 0 || !reflect.DeepEqual(updates, archon.UpdateTaskRequest{}) {
+		t.Errorf("Expected no changes for an untouched document, got %+v %+v", changes, updates)
+	}
+}
+
+func TestRenderQuotesAmbiguousValues(t *testing.T) {
+	task := fixtureTask()
+	task.Title = "yes"
+	task.Feature = nil
+	task.Description = ""
+	content, err := Render(task)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	edit, err := Parse(content)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if *edit.Title != "yes" || *edit.Feature != "" || *edit.Description != "" {
+		t.Errorf("Expected values to survive the round trip, got %q %q %q", *edit.Title, *edit.Feature, *edit.Description)
+	}
+}
+
+func TestParseAndDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		updates  archon.UpdateTaskRequest
+		changes  []taskdiff.FieldChange
+	}{
+		{
+			name:     "title and status",
+			document: "title: Fix login redirect\nstatus: review\n",
+			updates:  archon.UpdateTaskRequest{Title: strPtr("Fix login redirect"), Status: strPtr("review")},
+			changes: []taskdiff.FieldChange{
+				{Field: FieldTitle, Old: "Fix login", New: "Fix login redirect"},
+				{Field: FieldStatus, Old: "doing", New: "review"},
+			},
+		},
+		{
+			name:     "priority",
+			document: "priority: 999\n",
+			updates:  archon.UpdateTaskRequest{TaskOrder: intPtr(999)},
+			changes:  []taskdiff.FieldChange{{Field: FieldPriority, Old: "10", New: "999"}},
+		},
+		{
+			name:     "feature cleared with empty string",
+			document: "feature: \"\"\n",
+			updates:  archon.UpdateTaskRequest{Feature: strPtr("")},
+			changes:  []taskdiff.FieldChange{{Field: FieldFeature, Old: "auth", New: ""}},
+		},
+		{
+			name:     "feature cleared with null",
+			document: "feature:\n",
+			updates:  archon.UpdateTaskRequest{Feature: strPtr("")},
+			changes:  []taskdiff.FieldChange{{Field: FieldFeature, Old: "auth", New: ""}},
+		},
+		{
+			name:     "description block",
+			document: "description: |\n  Steps:\n  1. open /login\n",
+			updates:  archon.UpdateTaskRequest{Description: strPtr("Steps:\n1. open /login")},
+			changes:  []taskdiff.FieldChange{{Field: FieldDescription}},
+		},
+		{
+			name:     "description trailing newline only",
+			document: "description: |\n  Steps:\n  1. open /login\n  2. submit\n",
+		},
+		{
+			name:     "surrounding spaces in title",
+			document: "title: \"  Fix login  \"\n",
+		},
+		{
+			name:     "removed fields stay unchanged",
+			document: "# only a comment left besides\nstatus: doing\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edit, err := Parse([]byte(tt.document))
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			updates, changes := Diff(fixtureTask(), edit)
+			if !reflect.DeepEqual(updates, tt.updates) {
+				t.Errorf("Expected updates %+v, got %+v", tt.updates, updates)
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("Expected changes %+v, got %+v", tt.changes, changes)
+			}
+		})
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     []string
+	}{
+		{"labels", "labels: [bug]\n", []string{"line 1: labels is not supported by the Archon server; remove it"}},
+		{"due date", "title: x\ndue_date: 2026-11-01\n", []string{"line 2: due_date is not supported by the Archon server"}},
+		{"estimate", "estimate: 3h\n", []string{"estimate is not supported by the Archon server"}},
+		{"unknown field", "assignee: bob\n", []string{`unknown field "assignee" (editable: title, status, priority, feature, description)`}},
+		{"priority text", "priority: high\n", []string{`priority must be a whole number, got "high"`}},
+		{"priority quoted", "priority: \"5\"\n", []string{`priority must be a whole number, got "5"`}},
+		{"priority fraction", "priority: 1.5\n", []string{"priority must be a whole number"}},
+		{"priority range", "priority: 1000\n", []string{"priority 1000 is outside 0-999"}},
+		{"negative priority", "priority: -1\n", []string{"priority -1 is outside 0-999"}},
+		{"status", "status: blocked\n", []string{`status "blocked" is not one of todo, doing, review, done`}},
+		{"empty title", "title: \"\"\n", []string{"title must not be empty"}},
+		{"null title", "title:\n", []string{"title must not be empty"}},
+		{"list title", "title: [a, b]\n", []string{"title must be text"}},
+		{"feature chars", "feature: auth/login\n", []string{`feature "auth/login" contains '/'`}},
+		{"feature length", "feature: " + strings.Repeat("a", 31) + "\n", []string{"longer than 30 characters"}},
+		{"duplicate", "status: todo\nstatus: done\n", []string{"line 2: status is set twice"}},
+		{"not a mapping", "- title\n", []string{`line 1: expected "field: value" lines`}},
+		{"syntax", "title: [unclosed\n", []string{"yaml:"}},
+		{
+			"several problems",
+			"status: blocked\nlabels: [bug]\npriority: x\n",
+			[]string{"line 1: status", "line 2: labels", "line 3: priority"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.document))
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected %q in error %q", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, document := range []string{"", "\n\n", "# all comments\n", "{}\n"} {
+		if _, err := Parse([]byte(document)); !errors.Is(err, ErrEmpty) {
+			t.Errorf("Expected ErrEmpty for %q, got %v", document, err)
+		}
+	}
+}
+
+func TestWithError(t *testing.T) {
+	content := []byte("title: x\nlabels: [bug]\n")
+	_, err := Parse(content)
+
+	annotated := WithError(content, err)
+	if !strings.HasPrefix(string(annotated), "# error: line 2: labels is not supported") {
+		t.Errorf("Expected the error at the top, got:\n%s", annotated)
+	}
+	if !strings.HasSuffix(string(annotated), string(content)) {
+		t.Errorf("Expected the edited document kept, got:\n%s", annotated)
+	}
+
+	// A second failure replaces the previous error instead of stacking
+	again := WithError(annotated, errors.New("first\nsecond"))
+	if want := "# error: first\n# error: second\n" + string(content); string(again) != want {
+		t.Errorf("Expected %q, got %q", want, again)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	lines := Preview([]taskdiff.FieldChange{
+		{Field: FieldStatus, Old: "doing", New: "review"},
+		{Field: FieldFeature, Old: "", New: "auth"},
+		{Field: FieldFeature, Old: "auth", New: ""},
+		{Field: FieldDescription},
+	})
+	want := []string{"status: doing → review", "feature: (none) → auth", "feature: auth → (none)", "description: changed"}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("Expected %q, got %q", want, lines)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	auth := "auth"
+	original := archon.Task{ID: "t1", Title: "One", Status: "todo", TaskOrder: 5, Description: "Old\n"}
+	current := original
+	current.Status = "doing"
+	current.Feature = &auth
+	current.Description = "Old"
+
+	edit := Edit{Status: strPtr("review"), Priority: intPtr(9), Description: strPtr("Old\n")}
+	_, changes := Diff(original, edit)
+	if got := Conflicts(original, current, changes); !reflect.DeepEqual(got, []string{FieldStatus}) {
+		t.Errorf("Expected only the status in conflict, got %q", got)
+	}
+	if got := Conflicts(original, original, changes); len(got) != 0 {
+		t.Errorf("Expected no conflicts for an unchanged task, got %q", got)
+	}
+}
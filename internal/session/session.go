@@ -0,0 +1,188 @@
+// Package session persists a snapshot of transient UI state (selection, search,
+// scroll positions, unsaved edit drafts) so it can be offered for restore after
+// lazyarchon exits unexpectedly, e.g. when an SSH connection drops.
+//
+// Snapshots are versioned JSON documents capped at MaxSnapshotBytes. A snapshot
+// written by a different schema version is ignored rather than migrated.
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// SchemaVersion is the snapshot format written by this build
+const SchemaVersion = 1
+
+// MaxSnapshotBytes caps the encoded snapshot size
+const MaxSnapshotBytes = 64 * 1024
+
+// MaxDrafts bounds how many unsaved edit drafts a snapshot keeps
+const MaxDrafts = 10
+
+// View modes and panels as stored in snapshots
+const (
+	ViewTasks    = "tasks"
+	ViewProjects = "projects"
+	PanelLeft    = "left"
+	PanelRight   = "right"
+)
+
+var (
+	// ErrUnsupportedVersion is returned when a snapshot was written with another schema version
+	ErrUnsupportedVersion = errors.New("unsupported session snapshot version")
+
+	// ErrTooLarge is returned when a snapshot exceeds MaxSnapshotBytes even without drafts
+	ErrTooLarge = errors.New("session snapshot too large")
+)
+
+// Snapshot is the persisted session state.
+// Every field is optional on restore: entities that no longer exist are skipped.
+type Snapshot struct {
+	Version int       `json:"version"`
+	SavedAt time.Time `json:"saved_at"`
+
+	ProjectID      *string `json:"project_id,omitempty"`       // nil = "All Tasks"
+	ViewMode       string  `json:"view_mode,omitempty"`        // ViewTasks or ViewProjects
+	ActivePanel    string  `json:"active_panel,omitempty"`     // PanelLeft or PanelRight
+	SelectedTaskID string  `json:"selected_task_id,omitempty"` // Task highlighted in the list
+	SearchQuery    string  `json:"search_query,omitempty"`     // Committed search; matches are recomputed
+	SortMode       int     `json:"sort_mode"`
+
+	TaskListOffset    int `json:"task_list_offset,omitempty"`    // Task list viewport offset
+	TaskDetailsOffset int `json:"task_details_offset,omitempty"` // Task details viewport offset
+
+	Drafts []Draft `json:"drafts,omitempty"` // Unsaved edit modal values
+}
+
+// Draft holds unsaved values from the task edit modal
+type Draft struct {
+	TaskID   string `json:"task_id"`
+	Status   string `json:"status"`
+	Priority int    `json:"priority"`
+	Feature  string `json:"feature"`
+}
+
+// FindDraft returns the draft for taskID, if any
+func (s *Snapshot) FindDraft(taskID string) (Draft, bool) {
+	for _, draft := range s.Drafts {
+		if draft.TaskID == taskID {
+			return draft, true
+		}
+	}
+	return Draft{}, false
+}
+
+// Store reads and writes snapshots at a fixed path
+type Store struct {
+	path string
+}
+
+// NewStore creates a store that keeps the snapshot at path
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the snapshot location in the user's cache directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+	return filepath.Join(dir, "lazyarchon", "session.json"), nil
+}
+
+// Path returns the snapshot file location
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Save writes the snapshot atomically, stamping the schema version.
+// Drafts are dropped oldest-first when the encoded snapshot exceeds MaxSnapshotBytes.
+func (s *Store) Save(snapshot Snapshot) error {
+	snapshot.Version = SchemaVersion
+	if snapshot.SavedAt.IsZero() {
+		snapshot.SavedAt = time.Now()
+	}
+	if len(snapshot.Drafts) > MaxDrafts {
+		snapshot.Drafts = snapshot.Drafts[len(snapshot.Drafts)-MaxDrafts:]
+	}
+
+	data, err := encode(&snapshot)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create session directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so a crash mid-write never leaves a torn snapshot
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write session snapshot: %w", err)
+	}
+	return nil
+}
+
+// Load reads the snapshot if it exists and was saved within maxAge of now.
+// Returns nil without error when there is no snapshot or it is stale.
+func (s *Store) Load(maxAge time.Duration, now time.Time) (*Snapshot, error) {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session snapshot: %w", err)
+	}
+	if info.Size() > MaxSnapshotBytes {
+		return nil, ErrTooLarge
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse session snapshot: %w", err)
+	}
+	if snapshot.Version != SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, snapshot.Version)
+	}
+	if maxAge > 0 && now.Sub(snapshot.SavedAt) > maxAge {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+// Clear removes the snapshot; a missing snapshot is not an error
+func (s *Store) Clear() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove session snapshot: %w", err)
+	}
+	return nil
+}
+
+// encode marshals the snapshot, shedding drafts until it fits MaxSnapshotBytes
+func encode(snapshot *Snapshot) ([]byte, error) {
+	for {
+		data, err := json.Marshal(snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode session snapshot: %w", err)
+		}
+		if len(data) <= MaxSnapshotBytes {
+			return data, nil
+		}
+		if len(snapshot.Drafts) == 0 {
+			return nil, ErrTooLarge
+		}
+		snapshot.Drafts = snapshot.Drafts[1:]
+	}
+}
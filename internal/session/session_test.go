@@ -0,0 +1,127 @@
+package session
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", "session.json"))
+	projectID := "project-1"
+	savedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	err := store.Save(Snapshot{
+		SavedAt:        savedAt,
+		ProjectID:      &projectID,
+		ViewMode:       ViewTasks,
+		ActivePanel:    PanelRight,
+		SelectedTaskID: "task-2",
+		SearchQuery:    "auth",
+		Drafts:         []Draft{{TaskID: "task-2", Status: "doing", Priority: 3, Feature: "auth"}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+
+	snapshot, err := store.Load(time.Hour, savedAt.Add(time.Minute))
+	if err != nil || snapshot == nil {
+		t.Fatalf("Expected snapshot, got %v (err %v)", snapshot, err)
+	}
+	if snapshot.Version != SchemaVersion {
+		t.Errorf("Expected version %d, got %d", SchemaVersion, snapshot.Version)
+	}
+	if snapshot.ProjectID == nil || *snapshot.ProjectID != projectID || snapshot.SelectedTaskID != "task-2" {
+		t.Errorf("Unexpected snapshot contents: %+v", snapshot)
+	}
+	if draft, ok := snapshot.FindDraft("task-2"); !ok || draft.Status != "doing" {
+		t.Errorf("Expected draft for task-2, got %+v", draft)
+	}
+	if _, ok := snapshot.FindDraft("missing"); ok {
+		t.Error("Expected no draft for unknown task")
+	}
+
+	info, err := os.Stat(store.Path())
+	if err != nil {
+		t.Fatalf("Expected snapshot file: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected snapshot to be private, got %v", info.Mode().Perm())
+	}
+}
+
+func TestStore_LoadMissingOrStale(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "session.json"))
+
+	if snapshot, err := store.Load(time.Hour, time.Now()); snapshot != nil || err != nil {
+		t.Errorf("Expected nothing for missing snapshot, got %v (err %v)", snapshot, err)
+	}
+
+	savedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := store.Save(Snapshot{SavedAt: savedAt}); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+	if snapshot, err := store.Load(time.Hour, savedAt.Add(2*time.Hour)); snapshot != nil || err != nil {
+		t.Errorf("Expected stale snapshot to be ignored, got %v (err %v)", snapshot, err)
+	}
+
+	if err := store.Clear(); err != nil {
+		t.Fatalf("Unexpected clear error: %v", err)
+	}
+	if err := store.Clear(); err != nil {
+		t.Errorf("Expected clearing a missing snapshot to succeed, got %v", err)
+	}
+}
+
+func TestStore_LoadRejectsOtherVersions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	if err := os.WriteFile(path, []byte(`{"version": 99, "saved_at": "2025-03-01T12:00:00Z"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewStore(path).Load(0, time.Now())
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{not json`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewStore(path).Load(0, time.Now()); err == nil {
+		t.Error("Expected parse error for corrupt snapshot")
+	}
+}
+
+func TestStore_SaveCapsSize(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "session.json"))
+
+	// Large drafts are shed oldest-first until the snapshot fits
+	bigFeature := strings.Repeat("x", MaxSnapshotBytes/4)
+	drafts := make([]Draft, 0, MaxDrafts+2)
+	for i := 0; i < MaxDrafts+2; i++ {
+		drafts = append(drafts, Draft{TaskID: string(rune('a' + i)), Feature: bigFeature})
+	}
+	if err := store.Save(Snapshot{Drafts: drafts}); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+
+	snapshot, err := store.Load(0, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected load error: %v", err)
+	}
+	if len(snapshot.Drafts) == 0 || len(snapshot.Drafts) >= MaxDrafts {
+		t.Errorf("Expected drafts to be trimmed to fit, got %d", len(snapshot.Drafts))
+	}
+	if last := snapshot.Drafts[len(snapshot.Drafts)-1]; last.TaskID != drafts[len(drafts)-1].TaskID {
+		t.Errorf("Expected newest draft to be kept, got %s", last.TaskID)
+	}
+
+	// A snapshot that cannot fit even without drafts is refused
+	err = store.Save(Snapshot{SearchQuery: strings.Repeat("q", MaxSnapshotBytes)})
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected ErrTooLarge, got %v", err)
+	}
+}
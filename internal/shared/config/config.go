@@ -1,13 +1,22 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"path/filepath"
+	"os/exec"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v3"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export/scheduled"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/paths"
+	"github.com/yousfisaad/lazyarchon/v2/internal/sprints"
 )
 
 // Default configuration constants
@@ -18,11 +27,17 @@ const (
 
 // Config represents the application configuration
 type Config struct {
-	Version     string            `yaml:"version,omitempty" validate:"omitempty,semver"`
-	Profile     string            `yaml:"profile,omitempty" validate:"omitempty,oneof=dev development staging production prod"`
-	Server      ServerConfig      `yaml:"server" validate:"required"`
-	UI          UIConfig          `yaml:"ui" validate:"required"`
-	Development DevelopmentConfig `yaml:"development" validate:"required"`
+	Version      string             `yaml:"version,omitempty" validate:"omitempty,semver"`
+	Profile      string             `yaml:"profile,omitempty" validate:"omitempty,oneof=dev development staging production prod"`
+	Server       ServerConfig       `yaml:"server" validate:"required"`
+	UI           UIConfig           `yaml:"ui" validate:"required"`
+	Development  DevelopmentConfig  `yaml:"development" validate:"required"`
+	Integrations IntegrationsConfig `yaml:"integrations"` // External links (PRs, branches, trackers)
+	Workflow     WorkflowConfig     `yaml:"workflow"`     // Task workflow automation
+	Session      SessionConfig      `yaml:"session"`      // Crash recovery of transient UI state
+	Exports      ExportsConfig      `yaml:"exports"`      // Board exports written on a schedule
+
+	path string // File the config was loaded from, re-read by ResolveAPIKey ("" = defaults only)
 }
 
 // ServerConfig holds server-related configuration
@@ -30,8 +45,24 @@ type ServerConfig struct {
 	URL             string        `yaml:"url" validate:"required,url"`
 	Timeout         time.Duration `yaml:"timeout" validate:"min=1s,max=300s"`
 	APIKey          string        `yaml:"api_key" validate:"omitempty,min=10"`
+	APIKeyCommand   string        `yaml:"api_key_command"`                           // Prints the API key on stdout; run when the server rejects the current key
 	EnableRealtime  bool          `yaml:"enable_realtime"`                           // Enable HTTP polling for auto-refresh (WebSocket not supported by backend)
 	PollingInterval int           `yaml:"polling_interval" validate:"min=0,max=300"` // Polling interval in seconds (0 = disabled, default: 10)
+
+	// Minimum time between two full task reloads from any source (0 = 2s)
+	RefreshMinSpacing time.Duration `yaml:"refresh_min_spacing" validate:"min=0s,max=60s"`
+
+	// Tasks per request when loading the task list; more load as the selection
+	// nears the last loaded task (0 = load the whole list at once)
+	PageSize int `yaml:"page_size" validate:"min=0,max=1000"`
+
+	// With PageSize set, load the remaining pages in the background after the
+	// first one is shown instead of waiting for the selection to reach them
+	LoadAllPages bool `yaml:"load_all_pages"`
+
+	// How long task and project list responses are reused without asking the
+	// server; older ones are revalidated by ETag when the server sends one (0 = no cache)
+	CacheTTL time.Duration `yaml:"cache_ttl" validate:"min=0s,max=300s"`
 }
 
 // UIConfig holds UI-related configuration
@@ -39,6 +70,10 @@ type UIConfig struct {
 	Theme       ThemeConfig       `yaml:"theme" validate:"required"`
 	Display     DisplayConfig     `yaml:"display" validate:"required"`
 	Keybindings KeybindingsConfig `yaml:"keybindings"` // Keyboard shortcuts customization
+	Clipboard   ClipboardConfig   `yaml:"clipboard"`   // Clipboard (yank) formatting
+	Scrollbar   ScrollbarConfig   `yaml:"scrollbar"`   // Scrollbar appearance
+	TextLimits  TextLimitsConfig  `yaml:"text_limits"` // Caps on task text kept for display
+	Sprint      SprintConfig      `yaml:"sprint"`      // Sprint grouping by a task field
 }
 
 // ThemeConfig holds theme/color configuration
@@ -55,7 +90,7 @@ type ThemeConfig struct {
 // DisplayConfig holds display-related settings
 type DisplayConfig struct {
 	ShowCompletedTasks  bool   `yaml:"show_completed_tasks"`
-	DefaultSortMode     string `yaml:"default_sort_mode" validate:"oneof=status+priority priority time alphabetical"`
+	DefaultSortMode     string `yaml:"default_sort_mode" validate:"oneof=status+priority priority time alphabetical recent"`
 	AutoRefreshInterval int    `yaml:"auto_refresh_interval" validate:"min=0,max=300"`
 
 	// Color enhancement options
@@ -65,7 +100,223 @@ type DisplayConfig struct {
 	StatusColorScheme  string `yaml:"status_color_scheme" validate:"oneof=blue gray warm_gray cool_gray"` // Task status color hierarchy
 
 	// Startup behavior
-	DefaultProjectID string `yaml:"default_project_id" validate:"omitempty,uuid"` // Default project to select on startup (empty = "All Tasks")
+	DefaultProjectID   string `yaml:"default_project_id" validate:"omitempty,uuid"` // Default project to select on startup (empty = "All Tasks")
+	StartInProjectMode bool   `yaml:"start_in_project_mode"`                        // Open the project picker first (cursor on DefaultProjectID)
+
+	// 'a' key behavior: "reset" clears the project selection, "toggle" flips between the project and All Tasks
+	ShowAllBehavior string `yaml:"show_all_behavior" validate:"omitempty,oneof=reset toggle"`
+
+	// Leaving project mode with Esc/q/h: "previous" (default) keeps the selection, "all" shows All Tasks,
+	// "default" shows DefaultProjectID
+	ProjectModeCancel string `yaml:"project_mode_cancel" validate:"omitempty,oneof=previous all default"`
+
+	// Number keys 1-9 in the task list: "off" (default), "jump" to the Nth visible task,
+	// or "bookmark" to jump to the task bookmarked in that slot
+	NumberKeyBehavior string `yaml:"number_key_behavior" validate:"omitempty,oneof=off jump bookmark"`
+
+	// 'q' with nothing to close: "modal" (default) asks to confirm, "double_press" wants q twice, "immediate" quits
+	QuitBehavior string `yaml:"quit_behavior" validate:"omitempty,oneof=modal double_press immediate"`
+
+	// Details panel: "auto" (default) follows the selection, "manual" stays blank until opened with Enter or l
+	DetailsPanel string `yaml:"details_panel" validate:"omitempty,oneof=auto manual"`
+
+	// Terminal window integration: title "lazyarchon — Project · N doing" and a progress hint while loading
+	SetTerminalTitle bool `yaml:"set_terminal_title"`
+
+	// Collapse rendered descriptions longer than this many lines behind "show more" (0 = never)
+	DescriptionMaxLines int `yaml:"description_max_lines" validate:"min=0,max=1000"`
+
+	// Description whitespace: "tidy" (default) trims trailing spaces and blank line runs, "exact" shows it as written
+	DescriptionWhitespace string `yaml:"description_whitespace" validate:"omitempty,oneof=tidy exact"`
+
+	// Remember the descriptions of up to this many viewed tasks to show what changed since (0 = off)
+	DescriptionSnapshots int `yaml:"description_snapshots" validate:"min=0,max=10000"`
+
+	// Task detail panel fields, in display order; unknown names are skipped (empty = DefaultDetailFields)
+	DetailFields []string `yaml:"detail_fields"`
+
+	// How task IDs are shown: "full" (default) or "short" unique prefixes, like git short hashes
+	IDDisplay string `yaml:"id_display" validate:"omitempty,oneof=full short"`
+
+	// Cancel confirmation and status modals after this long without input (0 = never)
+	ModalTimeout time.Duration `yaml:"modal_timeout" validate:"min=0s,max=24h"`
+
+	// Same for confirmations that delete a task or discard unsaved work (0 = never, the default)
+	DestructiveModalTimeout time.Duration `yaml:"destructive_modal_timeout" validate:"min=0s,max=24h"`
+
+	// Mark tasks updated since their details were last shown with a dot, like unread mail
+	UnreadIndicator bool `yaml:"unread_indicator"`
+
+	// Ask before Esc/q closes the task edit modal with changed fields
+	ConfirmDiscardEdits bool `yaml:"confirm_discard_edits"`
+
+	// Show shortcut hints ("/: search | ?: help") after the status bar counts; '!' toggles them
+	ShortcutHints bool `yaml:"shortcut_hints"`
+
+	// What X does with the done tasks in view: "archive" (default) on the server, or "hide" them for the session
+	ArchiveDone string `yaml:"archive_done" validate:"omitempty,oneof=archive hide"`
+
+	// Status bar ●/○ connection dot: "always" (default) or only the ○ while "disconnected"
+	ConnectionIndicator string `yaml:"connection_indicator" validate:"omitempty,oneof=always disconnected"`
+
+	// Label each task row with its project title in All Tasks; 'P' toggles
+	ProjectLabels bool `yaml:"project_labels"`
+
+	// Show loading indicators only once loading lasts this long, so quick polls never flash them (0 = at once)
+	LoadingDelay time.Duration `yaml:"loading_delay" validate:"min=0s,max=10s"`
+
+	// Search: "local" (default) highlights matches in the loaded tasks, "server" sends committed searches to the API
+	SearchMode string `yaml:"search_mode" validate:"omitempty,oneof=local server"`
+
+	// Status precedence of the status+priority sort, e.g. [review, doing, todo, done]; unlisted statuses
+	// sort last (empty = todo, doing, review, done)
+	StatusOrder []string `yaml:"status_order" validate:"omitempty,unique,dive,oneof=todo doing review done"`
+
+	// Field the edit modal ('e') starts on: "status" (default) or the first "incomplete" one (no priority or feature)
+	EditFocus string `yaml:"edit_focus" validate:"omitempty,oneof=status incomplete"`
+
+	// A dialog opening while another is open: "ignore" (default) drops it, "queue" opens it once the other closes
+	ModalConflict string `yaml:"modal_conflict" validate:"omitempty,oneof=ignore queue"`
+
+	// Hide tasks below this priority (task_order) at startup; '+'/'-' step it through the priority levels (0 = all)
+	MinPriority int `yaml:"min_priority" validate:"min=0,max=999"`
+}
+
+// Task ID display modes (ui.display.id_display)
+const (
+	IDDisplayFull  = "full"  // Whole UUIDs (default)
+	IDDisplayShort = "short" // Shortest prefix unique among loaded tasks, at least short_id_length long
+)
+
+// Task detail panel fields (ui.display.detail_fields)
+const (
+	DetailFieldTitle        = "title"
+	DetailFieldStatus       = "status"
+	DetailFieldAssignee     = "assignee"
+	DetailFieldData         = "data" // Cleanup applied to malformed task payloads
+	DetailFieldPriority     = "priority"
+	DetailFieldFeature      = "feature"
+	DetailFieldTags         = "tags"
+	DetailFieldID           = "id"
+	DetailFieldDescription  = "description"
+	DetailFieldCreated      = "created"
+	DetailFieldUpdated      = "updated"
+	DetailFieldSources      = "sources"
+	DetailFieldLinks        = "links" // Matches of integrations.links rules
+	DetailFieldCodeExamples = "code_examples"
+)
+
+// DefaultDetailFields is the detail panel layout when detail_fields is empty
+var DefaultDetailFields = []string{
+	DetailFieldTitle, DetailFieldStatus, DetailFieldAssignee, DetailFieldData, DetailFieldPriority,
+	DetailFieldFeature, DetailFieldTags, DetailFieldDescription, DetailFieldCreated, DetailFieldUpdated,
+	DetailFieldSources, DetailFieldLinks, DetailFieldCodeExamples,
+}
+
+// Show-all ('a' key) behaviors
+const (
+	ShowAllReset  = "reset"  // Always switch to All Tasks (default)
+	ShowAllToggle = "toggle" // Switch between the current project and All Tasks
+)
+
+// Project mode cancel behaviors (ui.display.project_mode_cancel)
+const (
+	ProjectCancelPrevious = "previous" // Keep the selection from before project mode (default)
+	ProjectCancelAll      = "all"      // Return to All Tasks
+	ProjectCancelDefault  = "default"  // Return to default_project_id (All Tasks when unset)
+)
+
+// Number key (1-9) behaviors in the task list
+const (
+	NumberKeysOff      = "off"      // Number keys do nothing (default)
+	NumberKeysJump     = "jump"     // Select the Nth visible task
+	NumberKeysBookmark = "bookmark" // Jump to the task bookmarked in that slot
+)
+
+// Quit ('q' key) behaviors when no modal or search is open
+const (
+	QuitModal       = "modal"        // Ask for confirmation (default)
+	QuitDoublePress = "double_press" // Quit when q is pressed again shortly after
+	QuitImmediate   = "immediate"    // Quit right away
+)
+
+// Details panel behaviors
+const (
+	DetailsAuto   = "auto"   // Show the selected task's details as the selection moves (default)
+	DetailsManual = "manual" // Show details only once opened for the selected task
+)
+
+// Done task cleanup ('X' key) operations (ui.display.archive_done)
+const (
+	ArchiveDoneArchive = "archive" // Archive on the server, hiding locally where it cannot (default)
+	ArchiveDoneHide    = "hide"    // Only hide them until lazyarchon exits
+)
+
+// Search modes (ui.display.search_mode)
+const (
+	SearchLocal  = "local"  // Match the loaded tasks as you type (default)
+	SearchServer = "server" // Also send the search to the API on Enter and list its results
+)
+
+// Edit modal starting fields (ui.display.edit_focus)
+const (
+	EditFocusStatus     = "status"     // Always start on the status field (default)
+	EditFocusIncomplete = "incomplete" // Start on the first field without a value
+)
+
+// Modal conflict policies (ui.display.modal_conflict)
+const (
+	ModalConflictIgnore = "ignore" // Drop a modal that opens while another is active (default)
+	ModalConflictQueue  = "queue"  // Open it after the active modal closes
+)
+
+// Status bar connection indicator modes (ui.display.connection_indicator)
+const (
+	ConnectionIndicatorAlways       = "always"       // ● while connected, ○ while not (default)
+	ConnectionIndicatorDisconnected = "disconnected" // Only ○ while not connected
+)
+
+// Description whitespace handling in the details panel
+const (
+	DescriptionTidy  = "tidy"  // Trim trailing whitespace and collapse blank lines outside code blocks (default)
+	DescriptionExact = "exact" // Render the description exactly as stored
+)
+
+// ClipboardConfig holds formatting options for clipboard copy actions
+type ClipboardConfig struct {
+	CommitTemplate string `yaml:"commit_template"`                                   // Go text/template for commit references (e.g., "[{{.ShortID}}] {{.Title}}")
+	ShortIDLength  int    `yaml:"short_id_length" validate:"omitempty,min=1,max=36"` // Characters kept in {{.ShortID}} (default: 8)
+
+	// Size limits - oversized clipboard writes otherwise fail silently on some backends
+	Backend      string `yaml:"backend" validate:"omitempty,oneof=auto system osc52"` // auto (default), system or osc52
+	MaxBytes     int    `yaml:"max_bytes" validate:"omitempty,min=0"`                 // Largest copy the backend takes whole; 0 = backend default
+	ConfirmAbove int    `yaml:"confirm_above" validate:"omitempty,min=0"`             // Ask before copying more bytes than this (default: 65536)
+	SaveDir      string `yaml:"save_dir"`                                             // Where "save to file" writes; empty = system temp directory
+}
+
+// ScrollbarConfig controls scrollbar visibility and appearance in scrollable panels and modals
+type ScrollbarConfig struct {
+	Enabled    *bool  `yaml:"enabled"`                                 // Show scrollbars on overflow (default: true); false frees the column for content
+	ThumbChar  string `yaml:"thumb_char" validate:"omitempty,max=1"`   // Thumb glyph (default: "▓")
+	TrackChar  string `yaml:"track_char" validate:"omitempty,max=1"`   // Track glyph (default: "░")
+	ThumbColor string `yaml:"thumb_color" validate:"omitempty,max=20"` // Thumb color (e.g., "62"); empty = terminal default
+	TrackColor string `yaml:"track_color" validate:"omitempty,max=20"` // Track color (e.g., "240"); empty = terminal default
+}
+
+// TextLimitsConfig caps task text kept for display. Oversized payloads are
+// truncated in the UI; the full values are still used for copying and exports.
+type TextLimitsConfig struct {
+	MaxTitleLength       int `yaml:"max_title_length" validate:"omitempty,min=10"`        // Characters shown in titles (default: 200)
+	MaxDescriptionLength int `yaml:"max_description_bytes" validate:"omitempty,min=1024"` // Description bytes rendered (default: 65536)
+	MaxFeatureLength     int `yaml:"max_feature_length" validate:"omitempty,min=1"`       // Longer features are flagged as malformed (default: 64)
+}
+
+// SprintConfig names the task field holding a sprint value and tells sprint
+// values apart from other values of that field
+type SprintConfig struct {
+	Field   string `yaml:"field" validate:"omitempty,oneof=feature"` // Field holding the sprint (default: feature, the only free-form task field)
+	Pattern string `yaml:"pattern"`                                  // Regular expression sprint values match, e.g. "^sprint-\d+$"; empty = sprints off
+	Current string `yaml:"current"`                                  // Current sprint; empty = the latest sprint found on a task
 }
 
 // KeybindingsConfig holds customizable keyboard shortcuts
@@ -79,12 +330,15 @@ type KeybindingsConfig struct {
 
 // ApplicationKeybindings defines application-level keyboard shortcuts
 type ApplicationKeybindings struct {
-	Quit         []string `yaml:"quit" validate:"omitempty,dive,min=1"`           // Smart quit (e.g., ["q"])
-	ForceQuit    []string `yaml:"force_quit" validate:"omitempty,dive,min=1"`     // Emergency quit (e.g., ["ctrl+c"])
-	Refresh      []string `yaml:"refresh" validate:"omitempty,dive,min=1"`        // Refresh data (e.g., ["r", "F5"])
-	ProjectMode  []string `yaml:"project_mode" validate:"omitempty,dive,min=1"`   // Activate project selection (e.g., ["p"])
-	ShowAllTasks []string `yaml:"show_all_tasks" validate:"omitempty,dive,min=1"` // Show all tasks (e.g., ["a"])
-	ToggleHelp   []string `yaml:"toggle_help" validate:"omitempty,dive,min=1"`    // Toggle help modal (e.g., ["?"])
+	Quit          []string `yaml:"quit" validate:"omitempty,dive,min=1"`           // Smart quit (e.g., ["q"])
+	ForceQuit     []string `yaml:"force_quit" validate:"omitempty,dive,min=1"`     // Emergency quit (e.g., ["ctrl+c"])
+	Refresh       []string `yaml:"refresh" validate:"omitempty,dive,min=1"`        // Refresh data (e.g., ["r", "F5"])
+	ProjectMode   []string `yaml:"project_mode" validate:"omitempty,dive,min=1"`   // Activate project selection (e.g., ["p"])
+	ShowAllTasks  []string `yaml:"show_all_tasks" validate:"omitempty,dive,min=1"` // Show all tasks (e.g., ["a"])
+	ToggleHelp    []string `yaml:"toggle_help" validate:"omitempty,dive,min=1"`    // Toggle help modal (e.g., ["?"])
+	AwayDigest    []string `yaml:"away_digest" validate:"omitempty,dive,min=1"`    // Show the away digest again (e.g., ["A"])
+	UnblockReport []string `yaml:"unblock_report" validate:"omitempty,dive,min=1"` // Rank tasks by the work they unblock (e.g., ["U"])
+	ToggleHints   []string `yaml:"toggle_hints" validate:"omitempty,dive,min=1"`   // Show/hide status bar shortcut hints (e.g., ["!"])
 }
 
 // NavigationKeybindings defines navigation keyboard shortcuts
@@ -99,6 +353,11 @@ type NavigationKeybindings struct {
 	FastScrollDown []string `yaml:"fast_scroll_down" validate:"omitempty,dive,min=1"` // Fast scroll down (e.g., ["J"])
 	HalfPageUp     []string `yaml:"half_page_up" validate:"omitempty,dive,min=1"`     // Half page up (e.g., ["ctrl+u", "pgup"])
 	HalfPageDown   []string `yaml:"half_page_down" validate:"omitempty,dive,min=1"`   // Half page down (e.g., ["ctrl+d", "pgdown"])
+	GoParent       []string `yaml:"go_parent" validate:"omitempty,dive,min=1"`        // Jump to parent task (e.g., ["["])
+	GoFirstChild   []string `yaml:"go_first_child" validate:"omitempty,dive,min=1"`   // Jump to first child task (e.g., ["]"])
+	SetBookmark    []string `yaml:"set_bookmark" validate:"omitempty,dive,min=1"`     // Bookmark selected task, then slot key (e.g., ["m"])
+	JumpBookmark   []string `yaml:"jump_bookmark" validate:"omitempty,dive,min=1"`    // Jump to bookmark, then slot key (e.g., ["'"])
+	ListBookmarks  []string `yaml:"list_bookmarks" validate:"omitempty,dive,min=1"`   // List bookmarks (e.g., ["M"])
 }
 
 // SearchKeybindings defines search-related keyboard shortcuts
@@ -111,14 +370,101 @@ type SearchKeybindings struct {
 
 // TaskKeybindings defines task operation keyboard shortcuts
 type TaskKeybindings struct {
-	ChangeStatus  []string `yaml:"change_status" validate:"omitempty,dive,min=1"`  // Change task status (e.g., ["t"])
-	Edit          []string `yaml:"edit" validate:"omitempty,dive,min=1"`           // Edit task (e.g., ["e"])
-	Delete        []string `yaml:"delete" validate:"omitempty,dive,min=1"`         // Delete task (e.g., ["d"])
-	CopyID        []string `yaml:"copy_id" validate:"omitempty,dive,min=1"`        // Copy task ID (e.g., ["y"])
-	CopyTitle     []string `yaml:"copy_title" validate:"omitempty,dive,min=1"`     // Copy task title (e.g., ["Y"])
-	SelectFeature []string `yaml:"select_feature" validate:"omitempty,dive,min=1"` // Select feature (e.g., ["f"])
-	SortForward   []string `yaml:"sort_forward" validate:"omitempty,dive,min=1"`   // Sort forward (e.g., ["s"])
-	SortBackward  []string `yaml:"sort_backward" validate:"omitempty,dive,min=1"`  // Sort backward (e.g., ["S"])
+	ChangeStatus      []string `yaml:"change_status" validate:"omitempty,dive,min=1"`      // Change task status (e.g., ["t"])
+	VisualMode        []string `yaml:"visual_mode" validate:"omitempty,dive,min=1"`        // Mark tasks for a bulk status change (e.g., ["v"])
+	Edit              []string `yaml:"edit" validate:"omitempty,dive,min=1"`               // Edit task (e.g., ["e"])
+	Create            []string `yaml:"create" validate:"omitempty,dive,min=1"`             // Create a task (e.g., ["i"])
+	EditInEditor      []string `yaml:"edit_in_editor" validate:"omitempty,dive,min=1"`     // Edit task fields as YAML in $EDITOR (e.g., ["E"])
+	Delete            []string `yaml:"delete" validate:"omitempty,dive,min=1"`             // Delete task (e.g., ["d"])
+	CopyID            []string `yaml:"copy_id" validate:"omitempty,dive,min=1"`            // Copy task ID (e.g., ["y"])
+	CopyTitle         []string `yaml:"copy_title" validate:"omitempty,dive,min=1"`         // Copy task title (e.g., ["Y"])
+	CopyCommitRef     []string `yaml:"copy_commit_ref" validate:"omitempty,dive,min=1"`    // Copy commit reference (e.g., ["c"])
+	CopyPath          []string `yaml:"copy_path" validate:"omitempty,dive,min=1"`          // Copy parent→child path (e.g., ["b"])
+	CopyCommand       []string `yaml:"copy_command" validate:"omitempty,dive,min=1"`       // Copy view as a "lazyarchon list" command (e.g., ["C"])
+	ArchiveDone       []string `yaml:"archive_done" validate:"omitempty,dive,min=1"`       // Archive or hide the done tasks in view (e.g., ["X"])
+	OpenLink          []string `yaml:"open_link" validate:"omitempty,dive,min=1"`          // Open matched link (e.g., ["o"])
+	SelectFeature     []string `yaml:"select_feature" validate:"omitempty,dive,min=1"`     // Select feature (e.g., ["f"])
+	QuickFeature      []string `yaml:"quick_feature" validate:"omitempty,dive,min=1"`      // Toggle filter to selected task's feature (e.g., ["F"])
+	SelectTags        []string `yaml:"select_tags" validate:"omitempty,dive,min=1"`        // Select tags to filter by (e.g., ["ctrl+t"])
+	CreatedToday      []string `yaml:"created_today" validate:"omitempty,dive,min=1"`      // Toggle tasks-created-today view (e.g., ["T"])
+	ToggleDescription []string `yaml:"toggle_description" validate:"omitempty,dive,min=1"` // Show more/less of a long description (e.g., ["x"])
+	DescriptionDiff   []string `yaml:"description_diff" validate:"omitempty,dive,min=1"`   // Show description changes since last viewed (e.g., ["D"])
+	ReloadTask        []string `yaml:"reload_task" validate:"omitempty,dive,min=1"`        // Reload the selected task only (e.g., ["R"])
+	SprintFilter      []string `yaml:"sprint_filter" validate:"omitempty,dive,min=1"`      // Toggle current-sprint filter (e.g., ["w"])
+	SprintOverview    []string `yaml:"sprint_overview" validate:"omitempty,dive,min=1"`    // Sprint overview and rollover (e.g., ["W"])
+	SortForward       []string `yaml:"sort_forward" validate:"omitempty,dive,min=1"`       // Sort forward (e.g., ["s"])
+	SortBackward      []string `yaml:"sort_backward" validate:"omitempty,dive,min=1"`      // Sort backward (e.g., ["S"])
+	ToggleCount       []string `yaml:"toggle_count" validate:"omitempty,dive,min=1"`       // Toggle shown/total status bar count (e.g., ["#"])
+	ProjectLabels     []string `yaml:"project_labels" validate:"omitempty,dive,min=1"`     // Show/hide project labels in All Tasks (e.g., ["P"])
+	ToggleDetails     []string `yaml:"toggle_details" validate:"omitempty,dive,min=1"`     // Hide/show the task details panel (e.g., ["z"])
+	RaiseMinPriority  []string `yaml:"raise_min_priority" validate:"omitempty,dive,min=1"` // Hide the next lower priority level (e.g., ["+"])
+	LowerMinPriority  []string `yaml:"lower_min_priority" validate:"omitempty,dive,min=1"` // Show the next lower priority level again (e.g., ["-"])
+	ChangedOnly       []string `yaml:"changed_only" validate:"omitempty,dive,min=1"`       // Show only tasks changed by the last refresh (e.g., ["u"])
+}
+
+// IntegrationsConfig holds settings for external tools related to tasks
+type IntegrationsConfig struct {
+	Links []LinkRuleConfig `yaml:"links"` // Regex rules that turn task text into URLs
+
+	// Prometheus metrics listener (e.g. "127.0.0.1:9188"; empty = off)
+	MetricsListen      string `yaml:"metrics_listen" validate:"omitempty,hostname_port"`
+	MetricsMaxFeatures int    `yaml:"metrics_max_features" validate:"min=0,max=1000"` // Feature labels kept before "other" (0 = 10)
+}
+
+// LinkRuleConfig maps text matching Pattern to a URL built from URLTemplate
+type LinkRuleConfig struct {
+	Name        string `yaml:"name"`         // Action label (e.g., "Open PR search")
+	Pattern     string `yaml:"pattern"`      // Regex applied to task title, description and feature
+	URLTemplate string `yaml:"url_template"` // Go template expanded with {{.match}}, {{.g1}}, named groups
+}
+
+// ExportsConfig holds board exports written automatically while the app runs
+type ExportsConfig struct {
+	Scheduled []ScheduledExportConfig `yaml:"scheduled"`
+}
+
+// ScheduledExportConfig writes the tasks matching Filter to Output on Schedule
+type ScheduledExportConfig struct {
+	Name     string             `yaml:"name"`     // Unique name, also the export's title (e.g., "Standup")
+	Schedule string             `yaml:"schedule"` // "daily@09:00", "weekdays@09:00" or "every 2h"
+	Format   string             `yaml:"format"`   // markdown (default) or json
+	Filter   ExportFilterConfig `yaml:"filter"`   // Tasks to include; empty = all tasks
+	Output   string             `yaml:"output"`   // Path template: {{.Date}}, {{.Time}}, {{.Weekday}}, {{.Name}}; ~ = home
+	CatchUp  bool               `yaml:"catch_up"` // Run once at startup if a run was missed while the app was closed
+}
+
+// ExportFilterConfig selects the tasks in a scheduled export
+type ExportFilterConfig struct {
+	Statuses  []string `yaml:"statuses"`   // e.g., ["doing", "review"]
+	Feature   string   `yaml:"feature"`    // Only tasks with this feature
+	ProjectID string   `yaml:"project_id"` // Only tasks in this project
+}
+
+// WorkflowConfig holds task workflow automation settings
+type WorkflowConfig struct {
+	CurrentUser string           `yaml:"current_user"` // Assignee name identifying you in Archon (e.g., "alice")
+	AutoAssign  AutoAssignConfig `yaml:"auto_assign"`
+	ConfirmDone bool             `yaml:"confirm_done"` // Ask before moving a task to done
+}
+
+// AutoAssignConfig assigns tasks to CurrentUser on selected status transitions
+type AutoAssignConfig struct {
+	Enabled     bool     `yaml:"enabled"`                                                 // Toggle auto-assignment
+	Transitions []string `yaml:"transitions" validate:"omitempty,dive,status_transition"` // "from->to" pairs, "*" matches any status (e.g., ["*->doing"])
+}
+
+// SessionConfig controls snapshotting of transient UI state for restore after a crash
+type SessionConfig struct {
+	Restore bool          `yaml:"restore"`                                      // Save session snapshots and offer to restore them on startup
+	MaxAge  time.Duration `yaml:"max_age" validate:"omitempty,min=1m,max=720h"` // Snapshots older than this are not offered (default: 24h)
+	Path    string        `yaml:"path"`                                         // Snapshot file (default: user cache dir/lazyarchon/session.json)
+
+	// "While you were away" digest shown at startup after a long gap
+	AwayDigest    bool          `yaml:"away_digest"`                                          // Record when tasks were last seen and show the digest
+	AwayThreshold time.Duration `yaml:"away_threshold" validate:"omitempty,min=1m,max=2160h"` // Minimum gap before a digest is shown (default: 8h)
+
+	// Reselect the task (and its project) selected when lazyarchon last quit
+	RememberSelection bool `yaml:"remember_selection"`
 }
 
 // DevelopmentConfig holds development-related settings
@@ -126,6 +472,16 @@ type DevelopmentConfig struct {
 	Debug           bool   `yaml:"debug"`
 	LogLevel        string `yaml:"log_level" validate:"oneof=debug info warn error"`
 	EnableProfiling bool   `yaml:"enable_profiling"`
+
+	// Update/View calls slower than this are logged as slow frames in debug or profiling mode (0 = 16ms)
+	FrameBudgetMs int `yaml:"frame_budget_ms" validate:"min=0,max=10000"`
+
+	// HTTP capture directories, set from --record-http / --replay-http (never read from config files)
+	RecordHTTPDir string `yaml:"-"`
+	ReplayHTTPDir string `yaml:"-"`
+
+	// Set by --safe-mode: start with defaults, no persisted state and read-only task access
+	SafeMode bool `yaml:"-"`
 }
 
 // Global validator instance
@@ -133,6 +489,22 @@ var validate *validator.Validate
 
 func init() {
 	validate = validator.New()
+	_ = validate.RegisterValidation("status_transition", validateStatusTransition)
+}
+
+// transitionStatuses lists the statuses accepted on either side of a transition
+var transitionStatuses = map[string]bool{"*": true, "todo": true, "doing": true, "review": true, "done": true}
+
+// validateStatusTransition checks "from->to" strings such as "todo->doing" or "*->doing"
+func validateStatusTransition(fl validator.FieldLevel) bool {
+	from, to, ok := parseTransition(fl.Field().String())
+	return ok && transitionStatuses[from] && transitionStatuses[to]
+}
+
+// parseTransition splits a "from->to" transition string
+func parseTransition(transition string) (from, to string, ok bool) {
+	from, to, ok = strings.Cut(transition, "->")
+	return strings.TrimSpace(from), strings.TrimSpace(to), ok
 }
 
 // Default configuration values
@@ -165,8 +537,35 @@ var defaultConfig = Config{
 			PriorityIndicators:  true,   // Enable priority indicators by default
 			StatusColorScheme:   "blue", // Default to current blue scheme
 			DefaultProjectID:    "",     // Empty = "All Tasks" view on startup
+
+			DescriptionSnapshots: 100,
+			UnreadIndicator:      true,
+			ConfirmDiscardEdits:  true,
+			ShortcutHints:        true,
+			ProjectLabels:        true,
+			LoadingDelay:         300 * time.Millisecond,
+		},
+		Clipboard: ClipboardConfig{
+			CommitTemplate: "[{{.ShortID}}] {{.Title}}",
+			ShortIDLength:  8,
+			Backend:        "auto",
+			ConfirmAbove:   64 * 1024,
+		},
+	},
+	Workflow: WorkflowConfig{
+		AutoAssign: AutoAssignConfig{
+			Enabled:     false,
+			Transitions: []string{"*->doing"}, // Picking up work assigns it to you
 		},
 	},
+	Session: SessionConfig{
+		Restore:       true,
+		MaxAge:        24 * time.Hour,
+		AwayDigest:    true,
+		AwayThreshold: 8 * time.Hour,
+
+		RememberSelection: true,
+	},
 	Development: DevelopmentConfig{
 		Debug:           false,
 		LogLevel:        "info",
@@ -194,8 +593,10 @@ func LoadFromPath(configPath string) (*Config, error) {
 		return &config, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	config.path = configPath
+
 	// Validate configuration
-	if err := validate.Struct(&config); err != nil {
+	if err := config.Validate(); err != nil {
 		return &config, fmt.Errorf("config validation failed: %w", err)
 	}
 
@@ -221,15 +622,8 @@ func Load() (*Config, error) {
 	config := defaultConfig // Start with defaults
 
 	// Try to find config file in order of preference
-	configPaths := []string{
-		"./config.yaml",
-		"./configs/default.yaml",
-		filepath.Join(os.Getenv("HOME"), ".config", "lazyarchon", "config.yaml"),
-		"/etc/lazyarchon/config.yaml",
-	}
-
 	var configFile string
-	for _, path := range configPaths {
+	for _, path := range paths.ConfigSearchPaths(runtime.GOOS, os.Getenv) {
 		if _, err := os.Stat(path); err == nil {
 			configFile = path
 			break
@@ -254,8 +648,10 @@ func Load() (*Config, error) {
 		return &config, err // Return defaults even on error
 	}
 
+	config.path = configFile
+
 	// Validate configuration
-	if err := validate.Struct(&config); err != nil {
+	if err := config.Validate(); err != nil {
 		return &config, fmt.Errorf("config validation failed: %w", err)
 	}
 
@@ -276,6 +672,44 @@ func Load() (*Config, error) {
 	return &config, nil
 }
 
+// SafeMode returns the built-in defaults for a --safe-mode start. Only what is
+// needed to reach the server (URL, API key, timeout) and logging are kept from
+// loaded, and only when valid, so a broken config file cannot prevent startup.
+// Polling, session restore and the away digest are turned off.
+func SafeMode(loaded *Config) *Config {
+	config := defaultConfig
+	if loaded != nil {
+		config.path = loaded.path
+		config.Server.APIKeyCommand = loaded.Server.APIKeyCommand
+		if validate.Var(loaded.Server.URL, "required,url") == nil {
+			config.Server.URL = loaded.Server.URL
+		}
+		if validate.Var(loaded.Server.APIKey, "omitempty,min=10") == nil {
+			config.Server.APIKey = loaded.Server.APIKey
+		}
+		if validate.Var(loaded.Server.Timeout, "min=1s,max=300s") == nil {
+			config.Server.Timeout = loaded.Server.Timeout
+		}
+		if validate.Var(loaded.Development.LogLevel, "oneof=debug info warn error") == nil {
+			config.Development.LogLevel = loaded.Development.LogLevel
+		}
+		config.Development.Debug = loaded.Development.Debug
+	}
+	config.applyEnvironmentOverrides()
+
+	config.Server.EnableRealtime = false
+	config.Server.PollingInterval = 0
+	config.Session.Restore = false
+	config.Session.AwayDigest = false
+	config.Development.SafeMode = true
+	return &config
+}
+
+// IsSafeMode returns whether the app was started with --safe-mode
+func (c *Config) IsSafeMode() bool {
+	return c.Development.SafeMode
+}
+
 // applyEnvironmentOverrides applies environment variable overrides
 func (c *Config) applyEnvironmentOverrides() {
 	if url := os.Getenv("LAZYARCHON_SERVER_URL"); url != "" {
@@ -284,6 +718,9 @@ func (c *Config) applyEnvironmentOverrides() {
 	if apiKey := os.Getenv("LAZYARCHON_API_KEY"); apiKey != "" {
 		c.Server.APIKey = apiKey
 	}
+	if user := os.Getenv("LAZYARCHON_USER"); user != "" {
+		c.Workflow.CurrentUser = user
+	}
 	if logLevel := os.Getenv("LAZYARCHON_LOG_LEVEL"); logLevel != "" {
 		c.Development.LogLevel = logLevel
 	}
@@ -302,6 +739,73 @@ func (c *Config) GetAPIKey() string {
 	return c.Server.APIKey
 }
 
+// apiKeyCommandTimeout bounds server.api_key_command, which may prompt a
+// password manager but must not hang a request forever
+const apiKeyCommandTimeout = 30 * time.Second
+
+// ResolveAPIKey reads the API key again from its sources, for when the server
+// rejected the current one (e.g. after rotation). In order: the
+// LAZYARCHON_API_KEY environment variable, the output of
+// server.api_key_command, and server.api_key re-read from the config file.
+func (c *Config) ResolveAPIKey() (string, error) {
+	if apiKey := os.Getenv("LAZYARCHON_API_KEY"); apiKey != "" {
+		return apiKey, nil
+	}
+
+	var errs []error
+	if c.Server.APIKeyCommand != "" {
+		apiKey, err := runAPIKeyCommand(c.Server.APIKeyCommand)
+		if err == nil {
+			return apiKey, nil
+		}
+		errs = append(errs, err)
+	}
+
+	if c.path != "" {
+		data, err := os.ReadFile(c.path)
+		if err != nil {
+			return "", fmt.Errorf("failed to re-read config file: %w", err)
+		}
+		var file struct {
+			Server struct {
+				APIKey string `yaml:"api_key"`
+			} `yaml:"server"`
+		}
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return "", fmt.Errorf("failed to parse config file: %w", err)
+		}
+		if file.Server.APIKey != "" {
+			return file.Server.APIKey, nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return "", errs[0]
+	}
+	return "", fmt.Errorf("no API key found (set LAZYARCHON_API_KEY, server.api_key_command or server.api_key)")
+}
+
+// runAPIKeyCommand runs command (split on spaces, no shell) and returns its
+// trimmed output
+func runAPIKeyCommand(command string) (string, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return "", fmt.Errorf("api_key_command is blank")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), apiKeyCommandTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, args[0], args[1:]...).Output() //nolint:gosec // the user's own command
+	if err != nil {
+		return "", fmt.Errorf("api_key_command failed: %w", err)
+	}
+	apiKey := strings.TrimSpace(string(output))
+	if apiKey == "" {
+		return "", fmt.Errorf("api_key_command printed no key")
+	}
+	return apiKey, nil
+}
+
 // ShouldShowCompletedTasks returns whether to show completed tasks by default
 func (c *Config) ShouldShowCompletedTasks() bool {
 	return c.UI.Display.ShowCompletedTasks
@@ -335,11 +839,366 @@ func (c *Config) GetStatusColorScheme() string {
 	return c.UI.Display.StatusColorScheme
 }
 
+// GetShowAllBehavior returns how the 'a' key treats the project selection (default: reset)
+func (c *Config) GetShowAllBehavior() string {
+	if c.UI.Display.ShowAllBehavior == ShowAllToggle {
+		return ShowAllToggle
+	}
+	return ShowAllReset
+}
+
+// GetProjectModeCancel returns where cancelling project mode goes (default: previous)
+func (c *Config) GetProjectModeCancel() string {
+	switch c.UI.Display.ProjectModeCancel {
+	case ProjectCancelAll, ProjectCancelDefault:
+		return c.UI.Display.ProjectModeCancel
+	default:
+		return ProjectCancelPrevious
+	}
+}
+
+// GetNumberKeyBehavior returns what number keys do in the task list (default: off)
+func (c *Config) GetNumberKeyBehavior() string {
+	switch c.UI.Display.NumberKeyBehavior {
+	case NumberKeysJump, NumberKeysBookmark:
+		return c.UI.Display.NumberKeyBehavior
+	default:
+		return NumberKeysOff
+	}
+}
+
+// GetQuitBehavior returns what 'q' does when there is nothing to close (default: modal)
+func (c *Config) GetQuitBehavior() string {
+	switch c.UI.Display.QuitBehavior {
+	case QuitDoublePress, QuitImmediate:
+		return c.UI.Display.QuitBehavior
+	default:
+		return QuitModal
+	}
+}
+
+// GetDetailsPanel returns whether the details panel follows the selection (default: auto)
+func (c *Config) GetDetailsPanel() string {
+	if c.UI.Display.DetailsPanel == DetailsManual {
+		return DetailsManual
+	}
+	return DetailsAuto
+}
+
+// GetDescriptionMaxLines returns how many description lines show before "show more" (0 = all)
+func (c *Config) GetDescriptionMaxLines() int {
+	return max(c.UI.Display.DescriptionMaxLines, 0)
+}
+
+// GetDescriptionWhitespace returns how description whitespace is rendered (default: tidy)
+func (c *Config) GetDescriptionWhitespace() string {
+	if c.UI.Display.DescriptionWhitespace == DescriptionExact {
+		return DescriptionExact
+	}
+	return DescriptionTidy
+}
+
+// GetDescriptionSnapshots returns how many viewed task descriptions are remembered for diffs (0 = off)
+func (c *Config) GetDescriptionSnapshots() int {
+	return max(c.UI.Display.DescriptionSnapshots, 0)
+}
+
+// IsTerminalTitleEnabled returns whether the terminal window title should be managed
+func (c *Config) IsTerminalTitleEnabled() bool {
+	return c.UI.Display.SetTerminalTitle
+}
+
+// IsUnreadIndicatorEnabled returns whether tasks changed since last viewed are marked
+func (c *Config) IsUnreadIndicatorEnabled() bool {
+	return c.UI.Display.UnreadIndicator
+}
+
+// ShouldConfirmDiscardEdits returns whether closing the edit modal with changes asks first
+func (c *Config) ShouldConfirmDiscardEdits() bool {
+	return c.UI.Display.ConfirmDiscardEdits
+}
+
+// GetEditFocus returns which field the edit modal starts on (default: status)
+func (c *Config) GetEditFocus() string {
+	if c.UI.Display.EditFocus == EditFocusIncomplete {
+		return EditFocusIncomplete
+	}
+	return EditFocusStatus
+}
+
+// GetModalConflict returns what happens to a modal opening while another is active (default: ignore)
+func (c *Config) GetModalConflict() string {
+	if c.UI.Display.ModalConflict == ModalConflictQueue {
+		return ModalConflictQueue
+	}
+	return ModalConflictIgnore
+}
+
+// GetMinPriority returns the priority (task_order) tasks need to be shown at startup (0 = all)
+func (c *Config) GetMinPriority() int {
+	return c.UI.Display.MinPriority
+}
+
+// ShouldShowShortcutHints returns whether the status bar starts with shortcut hints
+func (c *Config) ShouldShowShortcutHints() bool {
+	return c.UI.Display.ShortcutHints
+}
+
+// ShouldShowProjectLabels returns whether All Tasks starts with project labels on task rows
+func (c *Config) ShouldShowProjectLabels() bool {
+	return c.UI.Display.ProjectLabels
+}
+
+// GetArchiveDone returns what X does with the done tasks in view (default: archive)
+func (c *Config) GetArchiveDone() string {
+	if c.UI.Display.ArchiveDone == ArchiveDoneHide {
+		return ArchiveDoneHide
+	}
+	return ArchiveDoneArchive
+}
+
+// ShouldStartInProjectMode returns whether the project picker is the first screen
+func (c *Config) ShouldStartInProjectMode() bool {
+	return c.UI.Display.StartInProjectMode
+}
+
 // GetDefaultProjectID returns the configured default project ID
 func (c *Config) GetDefaultProjectID() string {
 	return c.UI.Display.DefaultProjectID
 }
 
+// GetLinkRules returns the configured link rules in the form the links engine compiles
+func (c *Config) GetLinkRules() []links.Rule {
+	rules := make([]links.Rule, 0, len(c.Integrations.Links))
+	for _, rule := range c.Integrations.Links {
+		rules = append(rules, links.Rule{
+			Name:        rule.Name,
+			Pattern:     rule.Pattern,
+			URLTemplate: rule.URLTemplate,
+		})
+	}
+	return rules
+}
+
+// GetMetricsListen returns the metrics listen address, empty when metrics are off
+func (c *Config) GetMetricsListen() string {
+	return c.Integrations.MetricsListen
+}
+
+// GetMetricsMaxFeatures returns how many features get their own metrics label (default: 10)
+func (c *Config) GetMetricsMaxFeatures() int {
+	if c.Integrations.MetricsMaxFeatures <= 0 {
+		return 10
+	}
+	return c.Integrations.MetricsMaxFeatures
+}
+
+// GetScheduledExports returns the scheduled exports in the form the scheduler compiles
+func (c *Config) GetScheduledExports() []scheduled.Spec {
+	specs := make([]scheduled.Spec, 0, len(c.Exports.Scheduled))
+	for _, export := range c.Exports.Scheduled {
+		specs = append(specs, scheduled.Spec{
+			Name:      export.Name,
+			Schedule:  export.Schedule,
+			Format:    export.Format,
+			Statuses:  export.Filter.Statuses,
+			Feature:   export.Filter.Feature,
+			ProjectID: export.Filter.ProjectID,
+			Output:    export.Output,
+			CatchUp:   export.CatchUp,
+		})
+	}
+	return specs
+}
+
+// GetCommitTemplate returns the commit reference template used by the copy action
+func (c *Config) GetCommitTemplate() string {
+	if c.UI.Clipboard.CommitTemplate == "" {
+		return "[{{.ShortID}}] {{.Title}}" // Default fallback
+	}
+	return c.UI.Clipboard.CommitTemplate
+}
+
+// GetIDDisplay returns how task IDs are shown (default: full)
+func (c *Config) GetIDDisplay() string {
+	if c.UI.Display.IDDisplay == IDDisplayShort {
+		return IDDisplayShort
+	}
+	return IDDisplayFull
+}
+
+// GetModalTimeout returns how long a confirmation or status modal waits for
+// input before it is canceled (0 = forever). Destructive confirmations use
+// their own setting, so they never time out unless asked to.
+func (c *Config) GetModalTimeout(destructive bool) time.Duration {
+	if destructive {
+		return c.UI.Display.DestructiveModalTimeout
+	}
+	return c.UI.Display.ModalTimeout
+}
+
+// GetSearchMode returns where searches run (default: local)
+func (c *Config) GetSearchMode() string {
+	if c.UI.Display.SearchMode == SearchServer {
+		return SearchServer
+	}
+	return SearchLocal
+}
+
+// GetStatusOrder returns the configured status precedence of the status+priority sort (nil = default order)
+func (c *Config) GetStatusOrder() []string {
+	if len(c.UI.Display.StatusOrder) == 0 {
+		return nil
+	}
+	return c.UI.Display.StatusOrder
+}
+
+// GetLoadingDelay returns how long loading must last before it is shown (0 = at once)
+func (c *Config) GetLoadingDelay() time.Duration {
+	return max(c.UI.Display.LoadingDelay, 0)
+}
+
+// GetShortIDLength returns how many ID characters commit references keep (default: 8)
+func (c *Config) GetShortIDLength() int {
+	if c.UI.Clipboard.ShortIDLength <= 0 {
+		return 8 // Default fallback
+	}
+	return c.UI.Clipboard.ShortIDLength
+}
+
+// GetClipboardBackend returns the configured clipboard backend (default: "auto")
+func (c *Config) GetClipboardBackend() string {
+	if c.UI.Clipboard.Backend == "" {
+		return "auto" // Default fallback
+	}
+	return c.UI.Clipboard.Backend
+}
+
+// GetClipboardMaxBytes returns the clipboard size limit override; 0 keeps the backend's default
+func (c *Config) GetClipboardMaxBytes() int {
+	return max(c.UI.Clipboard.MaxBytes, 0)
+}
+
+// GetClipboardConfirmAbove returns the copy size that asks for confirmation (default: 65536)
+func (c *Config) GetClipboardConfirmAbove() int {
+	if c.UI.Clipboard.ConfirmAbove <= 0 {
+		return 64 * 1024 // Default fallback
+	}
+	return c.UI.Clipboard.ConfirmAbove
+}
+
+// GetClipboardSaveDir returns where oversized copies are saved; empty means the system temp directory
+func (c *Config) GetClipboardSaveDir() string {
+	return c.UI.Clipboard.SaveDir
+}
+
+// GetSanitizeLimits returns the task text limits with defaults applied
+func (c *Config) GetSanitizeLimits() archon.SanitizeLimits {
+	limits := archon.DefaultSanitizeLimits()
+	if c.UI.TextLimits.MaxTitleLength > 0 {
+		limits.MaxTitleLength = c.UI.TextLimits.MaxTitleLength
+	}
+	if c.UI.TextLimits.MaxDescriptionLength > 0 {
+		limits.MaxDescriptionLength = c.UI.TextLimits.MaxDescriptionLength
+	}
+	if c.UI.TextLimits.MaxFeatureLength > 0 {
+		limits.MaxFeatureLength = c.UI.TextLimits.MaxFeatureLength
+	}
+	return limits
+}
+
+// GetSprintResolver returns the resolver grouping tasks by sprint (nil when
+// ui.sprint.pattern is empty)
+func (c *Config) GetSprintResolver() (*sprints.Resolver, error) {
+	return sprints.NewResolver(c.UI.Sprint.Field, c.UI.Sprint.Pattern, c.UI.Sprint.Current)
+}
+
+// IsScrollbarEnabled returns whether scrollbars are rendered (default: true)
+func (c *Config) IsScrollbarEnabled() bool {
+	if c.UI.Scrollbar.Enabled == nil {
+		return true
+	}
+	return *c.UI.Scrollbar.Enabled
+}
+
+// GetScrollbarGlyphs returns the thumb and track glyphs with defaults applied
+func (c *Config) GetScrollbarGlyphs() (thumb, track string) {
+	thumb, track = c.UI.Scrollbar.ThumbChar, c.UI.Scrollbar.TrackChar
+	if thumb == "" {
+		thumb = "▓"
+	}
+	if track == "" {
+		track = "░"
+	}
+	return thumb, track
+}
+
+// IsSessionRestoreEnabled returns whether session snapshots are saved and offered for restore
+func (c *Config) IsSessionRestoreEnabled() bool {
+	return c.Session.Restore
+}
+
+// GetSessionMaxAge returns how old a snapshot may be and still be offered (default: 24h)
+func (c *Config) GetSessionMaxAge() time.Duration {
+	if c.Session.MaxAge <= 0 {
+		return 24 * time.Hour
+	}
+	return c.Session.MaxAge
+}
+
+// IsAwayDigestEnabled returns whether the away digest baseline is recorded and shown
+func (c *Config) IsAwayDigestEnabled() bool {
+	return c.Session.AwayDigest
+}
+
+// GetAwayThreshold returns the gap after which the away digest is shown (default: 8h)
+func (c *Config) GetAwayThreshold() time.Duration {
+	if c.Session.AwayThreshold <= 0 {
+		return 8 * time.Hour
+	}
+	return c.Session.AwayThreshold
+}
+
+// ShouldRememberSelection returns whether startup reselects the task selected at the last quit
+func (c *Config) ShouldRememberSelection() bool {
+	return c.Session.RememberSelection
+}
+
+// GetSessionPath returns the configured snapshot path, or empty for the default location
+func (c *Config) GetSessionPath() string {
+	return strings.TrimSpace(c.Session.Path)
+}
+
+// GetCurrentUser returns the configured assignee name for the current user
+func (c *Config) GetCurrentUser() string {
+	return strings.TrimSpace(c.Workflow.CurrentUser)
+}
+
+// ShouldAutoAssign reports whether moving a task from one status to another
+// should assign it to the current user. Requires auto-assign to be enabled
+// and a current user to be configured.
+func (c *Config) ShouldAutoAssign(fromStatus, toStatus string) bool {
+	if !c.Workflow.AutoAssign.Enabled || c.GetCurrentUser() == "" || fromStatus == toStatus {
+		return false
+	}
+
+	for _, transition := range c.Workflow.AutoAssign.Transitions {
+		from, to, ok := parseTransition(transition)
+		if !ok {
+			continue
+		}
+		if (from == "*" || from == fromStatus) && (to == "*" || to == toStatus) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldConfirmDone reports whether moving a task from fromStatus to done needs confirmation
+func (c *Config) ShouldConfirmDone(fromStatus, toStatus string) bool {
+	return c.Workflow.ConfirmDone && toStatus == "done" && fromStatus != toStatus
+}
+
 // GetTheme returns the theme configuration
 func (c *Config) GetTheme() *ThemeConfig {
 	return &c.UI.Theme
@@ -365,6 +1224,19 @@ func (c *Config) IsDebugEnabled() bool {
 	return c.Development.Debug
 }
 
+// IsFrameTimingEnabled returns whether Update/View durations should be measured
+func (c *Config) IsFrameTimingEnabled() bool {
+	return c.Development.Debug || c.Development.EnableProfiling
+}
+
+// GetFrameBudget returns the per-frame render budget (default: 16ms, one frame at 60 FPS)
+func (c *Config) GetFrameBudget() time.Duration {
+	if c.Development.FrameBudgetMs <= 0 {
+		return 16 * time.Millisecond
+	}
+	return time.Duration(c.Development.FrameBudgetMs) * time.Millisecond
+}
+
 // IsDarkModeEnabled returns whether dark mode is enabled (always true for terminal apps)
 func (c *Config) IsDarkModeEnabled() bool {
 	return true // Terminal applications are inherently dark mode
@@ -388,6 +1260,29 @@ func (c *Config) GetPollingInterval() int {
 	return c.Server.PollingInterval
 }
 
+// GetRefreshMinSpacing returns the minimum time between full task reloads (default: 2s)
+func (c *Config) GetRefreshMinSpacing() time.Duration {
+	if c.Server.RefreshMinSpacing == 0 {
+		return 2 * time.Second
+	}
+	return c.Server.RefreshMinSpacing
+}
+
+// GetPageSize returns how many tasks each task list request loads (0 = the whole list)
+func (c *Config) GetPageSize() int {
+	return c.Server.PageSize
+}
+
+// ShouldLoadAllPages returns whether pages after the first load in the background
+func (c *Config) ShouldLoadAllPages() bool {
+	return c.Server.LoadAllPages
+}
+
+// GetCacheTTL returns how long list responses are reused (0 = no cache)
+func (c *Config) GetCacheTTL() time.Duration {
+	return c.Server.CacheTTL
+}
+
 // applyPredefinedTheme applies a predefined theme if specified
 func (c *Config) applyPredefinedTheme() {
 	if c.UI.Theme.Name == "" {
@@ -493,9 +1388,20 @@ func (c *Config) applyProfileDefaults() {
 	}
 }
 
-// Validate validates the configuration
+// Validate validates the configuration, including link rules, export schedules
+// and the sprint pattern
 func (c *Config) Validate() error {
-	return validate.Struct(c)
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	if _, err := links.Compile(c.GetLinkRules()); err != nil {
+		return err
+	}
+	if _, err := c.GetSprintResolver(); err != nil {
+		return err
+	}
+	_, err := scheduled.Compile(c.GetScheduledExports())
+	return err
 }
 
 // GetProfile returns the current configuration profile
@@ -2,6 +2,9 @@ package config
 
 import (
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 )
@@ -202,6 +205,30 @@ func TestConfigValidation(t *testing.T) {
 			shouldErr: true,
 			errMsg:    "Development.LogLevel",
 		},
+		{
+			name: "invalid link rule pattern",
+			config: func() Config {
+				config := defaultConfig
+				config.Integrations.Links = []LinkRuleConfig{
+					{Name: "Open PR", Pattern: "auth-(\\d+", URLTemplate: "https://example.com/{{.g1}}"},
+				}
+				return config
+			}(),
+			shouldErr: true,
+			errMsg:    `link rule #1 ("Open PR"): invalid pattern`,
+		},
+		{
+			name: "invalid link rule template",
+			config: func() Config {
+				config := defaultConfig
+				config.Integrations.Links = []LinkRuleConfig{
+					{Name: "Open PR", Pattern: "auth-\\d+", URLTemplate: "https://example.com/{{.match"},
+				}
+				return config
+			}(),
+			shouldErr: true,
+			errMsg:    "invalid url_template",
+		},
 	}
 
 	for _, tt := range tests { //nolint:varnamelen // tt is idiomatic for table-driven tests
@@ -409,6 +436,62 @@ func TestDefaultProjectID(t *testing.T) {
 	}
 }
 
+func TestResolveAPIKey(t *testing.T) {
+	t.Setenv("LAZYARCHON_API_KEY", "")
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeKey := func(key string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte("server:\n  url: \"http://localhost:8181\"\n  api_key: \""+key+"\"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeKey("original-key-123")
+	config, err := LoadFromPath(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The file is re-read, so a key rotated on disk is picked up
+	writeKey("rotated-key-456")
+	if key, err := config.ResolveAPIKey(); err != nil || key != "rotated-key-456" {
+		t.Errorf("Expected the rotated key from the file, got %q (%v)", key, err)
+	}
+
+	// echo and false are shell built-ins on Windows
+	echoCommand, failCommand := "echo  command-key-789 ", "false"
+	if runtime.GOOS == "windows" {
+		echoCommand, failCommand = "cmd /c echo command-key-789", "cmd /c exit 1"
+	}
+
+	// The command wins over the file
+	config.Server.APIKeyCommand = echoCommand
+	if key, err := config.ResolveAPIKey(); err != nil || key != "command-key-789" {
+		t.Errorf("Expected the command output, got %q (%v)", key, err)
+	}
+
+	// A failing command falls back to the file
+	config.Server.APIKeyCommand = failCommand
+	if key, err := config.ResolveAPIKey(); err != nil || key != "rotated-key-456" {
+		t.Errorf("Expected the file key after a failed command, got %q (%v)", key, err)
+	}
+
+	// The environment wins over everything
+	t.Setenv("LAZYARCHON_API_KEY", "env-key-000")
+	if key, err := config.ResolveAPIKey(); err != nil || key != "env-key-000" {
+		t.Errorf("Expected the environment key, got %q (%v)", key, err)
+	}
+
+	t.Setenv("LAZYARCHON_API_KEY", "")
+	writeKey("")
+	if _, err := config.ResolveAPIKey(); err == nil || !strings.Contains(err.Error(), "api_key_command failed") {
+		t.Errorf("Expected the command error when no key resolves, got %v", err)
+	}
+	config.Server.APIKeyCommand = ""
+	if _, err := config.ResolveAPIKey(); err == nil || !strings.Contains(err.Error(), "no API key found") {
+		t.Errorf("Expected no key found, got %v", err)
+	}
+}
+
 func TestDefaultProjectIDEnvironmentOverride(t *testing.T) {
 	// Set environment variable
 	os.Setenv("LAZYARCHON_DEFAULT_PROJECT_ID", "123e4567-e89b-12d3-a456-426614174000")
@@ -444,6 +527,250 @@ func TestGetDefaultProjectID(t *testing.T) {
 	}
 }
 
+func TestGetShowAllBehavior(t *testing.T) {
+	config := &Config{}
+	if config.GetShowAllBehavior() != ShowAllReset {
+		t.Errorf("Expected reset by default, got %s", config.GetShowAllBehavior())
+	}
+
+	config.UI.Display.ShowAllBehavior = ShowAllToggle
+	if config.GetShowAllBehavior() != ShowAllToggle {
+		t.Errorf("Expected toggle, got %s", config.GetShowAllBehavior())
+	}
+
+	config.UI.Display.ShowAllBehavior = "peek"
+	if err := validate.Var(config.UI.Display.ShowAllBehavior, "omitempty,oneof=reset toggle"); err == nil {
+		t.Error("Expected validation error for unknown behavior")
+	}
+}
+
+func TestGetNumberKeyBehavior(t *testing.T) {
+	config := &Config{}
+	if config.GetNumberKeyBehavior() != NumberKeysOff {
+		t.Errorf("Expected off by default, got %s", config.GetNumberKeyBehavior())
+	}
+
+	config.UI.Display.NumberKeyBehavior = NumberKeysJump
+	if config.GetNumberKeyBehavior() != NumberKeysJump {
+		t.Errorf("Expected jump, got %s", config.GetNumberKeyBehavior())
+	}
+
+	config.UI.Display.NumberKeyBehavior = NumberKeysBookmark
+	if config.GetNumberKeyBehavior() != NumberKeysBookmark {
+		t.Errorf("Expected bookmark, got %s", config.GetNumberKeyBehavior())
+	}
+}
+
+func TestGetQuitBehavior(t *testing.T) {
+	config := &Config{}
+	if config.GetQuitBehavior() != QuitModal {
+		t.Errorf("Expected modal by default, got %s", config.GetQuitBehavior())
+	}
+
+	config.UI.Display.QuitBehavior = QuitDoublePress
+	if config.GetQuitBehavior() != QuitDoublePress {
+		t.Errorf("Expected double_press, got %s", config.GetQuitBehavior())
+	}
+
+	config.UI.Display.QuitBehavior = "twice"
+	if err := validate.Var(config.UI.Display.QuitBehavior, "omitempty,oneof=modal double_press immediate"); err == nil {
+		t.Error("Expected validation error for unknown behavior")
+	}
+}
+
+func TestSafeMode(t *testing.T) {
+	loaded := defaultConfig
+	loaded.Server.URL = "http://archon.internal:8181"
+	loaded.Server.APIKey = "secret-key-123"
+	loaded.Server.PollingInterval = 5
+	loaded.UI.Theme.Name = "no-such-theme"
+	loaded.UI.Keybindings.Application.Quit = []string{""}
+	loaded.Workflow.CurrentUser = "alice"
+	loaded.Development.ReplayHTTPDir = "/tmp/capture"
+
+	config := SafeMode(&loaded)
+	if !config.IsSafeMode() {
+		t.Fatal("Expected safe mode to be flagged")
+	}
+	if config.Server.URL != loaded.Server.URL || config.Server.APIKey != loaded.Server.APIKey {
+		t.Errorf("Expected the server connection kept, got %+v", config.Server)
+	}
+	if config.UI.Theme.Name != "default" || config.UI.Keybindings.Application.Quit != nil || config.Workflow.CurrentUser != "" {
+		t.Error("Expected everything else reset to built-in defaults")
+	}
+	if config.Server.PollingInterval != 0 || config.IsSessionRestoreEnabled() || config.IsAwayDigestEnabled() || config.Development.ReplayHTTPDir != "" {
+		t.Error("Expected polling, session restore, away digest and HTTP replay off")
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected a valid config, got %v", err)
+	}
+
+	// A broken server section falls back to the default server rather than failing
+	loaded.Server.URL = "not a url"
+	if config := SafeMode(&loaded); config.Server.URL != defaultServerURL {
+		t.Errorf("Expected the default server for an invalid URL, got %s", config.Server.URL)
+	}
+	if config := SafeMode(nil); config.Server.URL != defaultServerURL {
+		t.Errorf("Expected defaults without a loaded config, got %s", config.Server.URL)
+	}
+}
+
+func TestGetClipboardSettings(t *testing.T) {
+	config := &Config{}
+
+	if config.GetCommitTemplate() != "[{{.ShortID}}] {{.Title}}" {
+		t.Errorf("Expected default commit template, got %s", config.GetCommitTemplate())
+	}
+	if config.GetShortIDLength() != 8 {
+		t.Errorf("Expected default short ID length 8, got %d", config.GetShortIDLength())
+	}
+
+	config.UI.Clipboard = ClipboardConfig{CommitTemplate: "{{.Title}}", ShortIDLength: 12}
+	if config.GetCommitTemplate() != "{{.Title}}" {
+		t.Errorf("Expected custom commit template, got %s", config.GetCommitTemplate())
+	}
+	if config.GetShortIDLength() != 12 {
+		t.Errorf("Expected short ID length 12, got %d", config.GetShortIDLength())
+	}
+}
+
+func TestGetScrollbarSettings(t *testing.T) {
+	config := &Config{}
+
+	if !config.IsScrollbarEnabled() {
+		t.Error("Expected scrollbar to be enabled by default")
+	}
+	if thumb, track := config.GetScrollbarGlyphs(); thumb != "▓" || track != "░" {
+		t.Errorf("Expected default glyphs, got %q/%q", thumb, track)
+	}
+
+	disabled := false
+	config.UI.Scrollbar = ScrollbarConfig{Enabled: &disabled, ThumbChar: "█", TrackChar: "│"}
+	if config.IsScrollbarEnabled() {
+		t.Error("Expected scrollbar to be disabled")
+	}
+	if thumb, track := config.GetScrollbarGlyphs(); thumb != "█" || track != "│" {
+		t.Errorf("Expected custom glyphs, got %q/%q", thumb, track)
+	}
+
+	// Glyphs must be a single character so the scrollbar column keeps its width
+	config.UI.Scrollbar.ThumbChar = "##"
+	if err := validate.Struct(config.UI.Scrollbar); err == nil {
+		t.Error("Expected validation error for multi-character thumb glyph")
+	}
+}
+
+func TestGetSessionSettings(t *testing.T) {
+	config := &Config{}
+
+	if config.IsSessionRestoreEnabled() {
+		t.Error("Expected session restore to be off for an empty config")
+	}
+	if config.GetSessionMaxAge() != 24*time.Hour {
+		t.Errorf("Expected default max age 24h, got %v", config.GetSessionMaxAge())
+	}
+
+	config.Session = SessionConfig{Restore: true, MaxAge: 2 * time.Hour, Path: " /tmp/session.json "}
+	if !config.IsSessionRestoreEnabled() || config.GetSessionMaxAge() != 2*time.Hour {
+		t.Error("Expected configured session settings")
+	}
+	if config.GetSessionPath() != "/tmp/session.json" {
+		t.Errorf("Expected trimmed session path, got %q", config.GetSessionPath())
+	}
+
+	if !defaultConfig.IsSessionRestoreEnabled() {
+		t.Error("Expected session restore to be on by default")
+	}
+}
+
+func TestShouldAutoAssign(t *testing.T) {
+	config := &Config{}
+	config.Workflow.AutoAssign.Transitions = []string{"*->doing", "review->done"}
+
+	if config.ShouldAutoAssign("todo", "doing") {
+		t.Error("Expected no auto-assign while disabled")
+	}
+
+	config.Workflow.AutoAssign.Enabled = true
+	if config.ShouldAutoAssign("todo", "doing") {
+		t.Error("Expected no auto-assign without a current user")
+	}
+
+	config.Workflow.CurrentUser = " alice "
+	tests := []struct {
+		from, to string
+		expected bool
+	}{
+		{"todo", "doing", true},
+		{"review", "doing", true},
+		{"review", "done", true},
+		{"todo", "done", false},
+		{"doing", "doing", false},
+		{"doing", "review", false},
+	}
+	for _, tt := range tests {
+		if got := config.ShouldAutoAssign(tt.from, tt.to); got != tt.expected {
+			t.Errorf("ShouldAutoAssign(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.expected)
+		}
+	}
+
+	if config.GetCurrentUser() != "alice" {
+		t.Errorf("Expected trimmed current user, got %q", config.GetCurrentUser())
+	}
+}
+
+func TestAutoAssignTransitionValidation(t *testing.T) {
+	config := defaultConfig
+	config.Workflow.AutoAssign.Transitions = []string{"todo->doing", "* -> review"}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected valid transitions, got: %v", err)
+	}
+
+	for _, invalid := range []string{"doing", "todo->started", "->doing"} {
+		config.Workflow.AutoAssign.Transitions = []string{invalid}
+		if err := config.Validate(); err == nil {
+			t.Errorf("Expected validation error for transition %q", invalid)
+		}
+	}
+}
+
+func TestStatusOrderValidation(t *testing.T) {
+	config := defaultConfig
+	if config.GetStatusOrder() != nil {
+		t.Errorf("Expected no status order by default, got %v", config.GetStatusOrder())
+	}
+	config.UI.Display.StatusOrder = []string{"review", "doing", "todo", "done"}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected valid status order, got: %v", err)
+	}
+
+	for _, invalid := range [][]string{{"review", "blocked"}, {"review", "review"}} {
+		config.UI.Display.StatusOrder = invalid
+		if err := config.Validate(); err == nil {
+			t.Errorf("Expected validation error for status order %v", invalid)
+		}
+	}
+}
+
+func TestScheduledExportValidation(t *testing.T) {
+	config := defaultConfig
+	config.Exports.Scheduled = []ScheduledExportConfig{{
+		Name:     "Standup",
+		Schedule: "weekdays@09:00",
+		Filter:   ExportFilterConfig{Statuses: []string{"doing", "review"}},
+		Output:   "/tmp/standup-{{.Date}}.md",
+	}}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected valid scheduled export, got: %v", err)
+	}
+
+	config.Exports.Scheduled[0].Schedule = "daily@25:00"
+	err := config.Validate()
+	if err == nil || !strings.Contains(err.Error(), `scheduled export #1 ("Standup")`) {
+		t.Errorf("Expected error naming the export, got: %v", err)
+	}
+}
+
 func TestGetKeybindings(t *testing.T) {
 	config := &Config{
 		UI: UIConfig{
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SchemaID is where editors fetch the schema from when the config file
+// names it in a yaml-language-server modeline
+const SchemaID = "https://raw.githubusercontent.com/yousfisaad/lazyarchon/main/schema/config.schema.json"
+
+// Patterns for values the validator checks with custom or string rules
+const (
+	durationPattern   = `^-?([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$|^0$`
+	numericPattern    = `^[0-9]+$`
+	semverPattern     = `^v?[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`
+	uuidPattern       = `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+	hostPortPattern   = `^[^:\s]*:[0-9]{1,5}$`
+	transitionPattern = `^\s*(\*|todo|doing|review|done)\s*->\s*(\*|todo|doing|review|done)\s*$`
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BuildSchema returns a JSON Schema (draft-07) for the config file, derived
+// from the yaml and validate tags of Config. descriptions maps
+// "TypeName.FieldName" to the text shown by editors on hover.
+func BuildSchema(descriptions map[string]string) map[string]any {
+	schema := objectSchema(reflect.TypeOf(Config{}), descriptions)
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["$id"] = SchemaID
+	schema["title"] = "lazyarchon configuration"
+	return schema
+}
+
+// YAMLFieldName returns the key a struct field is read from, or "" when the
+// field is not part of the config file
+func YAMLFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return strings.ToLower(field.Name) // yaml.v3 default
+	}
+	return name
+}
+
+// objectSchema describes a struct; unknown keys are flagged so typos show up
+func objectSchema(t reflect.Type, descriptions map[string]string) map[string]any {
+	properties := map[string]any{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := YAMLFieldName(field)
+		if name == "" {
+			continue
+		}
+		property := fieldSchema(field.Type, field.Tag.Get("validate"), descriptions)
+		if description := descriptions[t.Name()+"."+field.Name]; description != "" {
+			property["description"] = description
+		}
+		properties[name] = property
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// fieldSchema describes a value of type t checked by the validate tag rules
+func fieldSchema(t reflect.Type, rules string, descriptions map[string]string) map[string]any {
+	own, dive, _ := strings.Cut(rules, ",dive")
+	dive = strings.TrimPrefix(dive, ",")
+
+	switch {
+	case t == durationType:
+		return constrain(map[string]any{"type": "string", "pattern": durationPattern}, nil, own)
+	case t.Kind() == reflect.Pointer:
+		schema := fieldSchema(t.Elem(), rules, descriptions)
+		schema["type"] = []any{schema["type"], "null"}
+		return schema
+	case t.Kind() == reflect.Struct:
+		return objectSchema(t, descriptions)
+	case t.Kind() == reflect.Slice:
+		return map[string]any{"type": "array", "items": fieldSchema(t.Elem(), dive, descriptions)}
+	case t.Kind() == reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": fieldSchema(t.Elem(), dive, descriptions)}
+	case t.Kind() == reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return constrain(map[string]any{"type": "integer"}, 0, own)
+	default:
+		return constrain(map[string]any{"type": "string"}, "", own)
+	}
+}
+
+// constrain adds the validate rules to schema. With omitempty the zero value
+// is accepted besides the values the rules allow.
+func constrain(schema map[string]any, zero any, rules string) map[string]any {
+	constraints := map[string]any{}
+	omitEmpty := false
+	isString := schema["type"] == "string" && schema["pattern"] == nil
+
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "omitempty":
+			omitEmpty = true
+		case "oneof":
+			var values []any
+			for _, value := range strings.Fields(param) {
+				values = append(values, value)
+			}
+			constraints["enum"] = values
+		case "min", "max":
+			addBound(constraints, name, param, isString)
+		case "numeric":
+			constraints["pattern"] = numericPattern
+		case "url":
+			constraints["format"] = "uri"
+		case "uuid":
+			constraints["pattern"] = uuidPattern
+		case "semver":
+			constraints["pattern"] = semverPattern
+		case "hostname_port":
+			constraints["pattern"] = hostPortPattern
+		case "status_transition":
+			constraints["pattern"] = transitionPattern
+		}
+	}
+	if len(constraints) == 0 {
+		return schema
+	}
+	if omitEmpty && zero != nil {
+		schema["anyOf"] = []any{map[string]any{"const": zero}, constraints}
+		return schema
+	}
+	for key, value := range constraints {
+		schema[key] = value
+	}
+	return schema
+}
+
+// addBound turns a min/max rule into a length (strings) or value bound.
+// Duration bounds ("1s") cannot be expressed and are left to the validator.
+func addBound(constraints map[string]any, rule, param string, isString bool) {
+	bound, err := strconv.Atoi(param)
+	if err != nil {
+		return
+	}
+	switch {
+	case isString && rule == "min":
+		constraints["minLength"] = bound
+	case isString:
+		constraints["maxLength"] = bound
+	case rule == "min":
+		constraints["minimum"] = bound
+	default:
+		constraints["maximum"] = bound
+	}
+}
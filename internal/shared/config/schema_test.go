@@ -0,0 +1,217 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yousfisaad/lazyarchon/v2/schema"
+)
+
+// embeddedSchema decodes the checked-in schema
+func embeddedSchema(t *testing.T) map[string]any {
+	t.Helper()
+	var decoded map[string]any
+	if err := json.Unmarshal(schema.Config, &decoded); err != nil {
+		t.Fatalf("Embedded schema is not valid JSON: %v", err)
+	}
+	return decoded
+}
+
+func TestSchemaCoversEveryField(t *testing.T) {
+	var walk func(t *testing.T, typ reflect.Type, node map[string]any, path string)
+	walk = func(t *testing.T, typ reflect.Type, node map[string]any, path string) {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+			if items, ok := node["items"].(map[string]any); ok {
+				node = items
+			}
+		}
+		if typ.Kind() != reflect.Struct || typ == durationType {
+			return
+		}
+		properties, _ := node["properties"].(map[string]any)
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name := YAMLFieldName(field)
+			if name == "" {
+				continue
+			}
+			child, ok := properties[name].(map[string]any)
+			if !ok {
+				t.Errorf("%s%s is missing from the schema; run go generate ./schema", path, name)
+				continue
+			}
+			walk(t, field.Type, child, path+name+".")
+		}
+	}
+	walk(t, reflect.TypeOf(Config{}), embeddedSchema(t), "")
+}
+
+func TestConfigFilesMatchSchema(t *testing.T) {
+	defaults, err := yaml.Marshal(defaultConfig)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	documents := map[string][]byte{"defaultConfig": defaults}
+	for _, path := range []string{"../../../configs/default.yaml", "../../../config.example.yaml", "../../../config.yaml"} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Read failed: %v", err)
+		}
+		documents[path] = data
+	}
+
+	configSchema := embeddedSchema(t)
+	for name, data := range documents {
+		var document any
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			t.Fatalf("%s: unmarshal failed: %v", name, err)
+		}
+		for _, problem := range validateSchema(configSchema, document, "") {
+			t.Errorf("%s: %s", name, problem)
+		}
+	}
+}
+
+func TestSchemaRejectsMistakes(t *testing.T) {
+	configSchema := embeddedSchema(t)
+	tests := map[string]string{
+		"unknown key":     "server:\n  urll: http://x\n",
+		"theme name":      "ui:\n  theme:\n    name: solarized\n",
+		"color name":      "ui:\n  theme:\n    border_color: blue\n",
+		"transition":      "workflow:\n  auto_assign:\n    transitions: [\"*->blocked\"]\n",
+		"polling range":   "server:\n  polling_interval: 301\n",
+		"short api key":   "server:\n  api_key: short\n",
+		"duration":        "session:\n  max_age: 1 day\n",
+		"empty key":       "ui:\n  keybindings:\n    task:\n      edit: [\"\"]\n",
+		"scrollbar glyph": "ui:\n  scrollbar:\n    enabled: maybe\n",
+	}
+	for name, document := range tests {
+		t.Run(name, func(t *testing.T) {
+			var decoded any
+			if err := yaml.Unmarshal([]byte(document), &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if problems := validateSchema(configSchema, decoded, ""); len(problems) == 0 {
+				t.Errorf("Expected %q to be rejected", document)
+			}
+		})
+	}
+}
+
+// validateSchema checks value against the subset of JSON Schema BuildSchema
+// emits and returns the problems found
+func validateSchema(node map[string]any, value any, path string) []string {
+	var problems []string
+	fail := func(format string, args ...any) {
+		problems = append(problems, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	if !matchesType(node["type"], value) {
+		fail("expected %v, got %T", node["type"], value)
+		return problems
+	}
+	if enum, ok := node["enum"].([]any); ok && !containsValue(enum, value) {
+		fail("%v is not one of %v", value, enum)
+	}
+	if constant, ok := node["const"]; ok && fmt.Sprint(constant) != fmt.Sprint(value) {
+		fail("expected %v", constant)
+	}
+	if anyOf, ok := node["anyOf"].([]any); ok {
+		matched := false
+		for _, option := range anyOf {
+			if len(validateSchema(option.(map[string]any), value, path)) == 0 {
+				matched = true
+			}
+		}
+		if !matched {
+			fail("%v matches none of %v", value, anyOf)
+		}
+	}
+
+	switch value := value.(type) {
+	case string:
+		if pattern, ok := node["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(value) {
+			fail("%q does not match %s", value, pattern)
+		}
+		if limit, ok := node["minLength"].(float64); ok && len([]rune(value)) < int(limit) {
+			fail("%q is shorter than %v", value, limit)
+		}
+		if limit, ok := node["maxLength"].(float64); ok && len([]rune(value)) > int(limit) {
+			fail("%q is longer than %v", value, limit)
+		}
+	case int:
+		if limit, ok := node["minimum"].(float64); ok && float64(value) < limit {
+			fail("%d is below %v", value, limit)
+		}
+		if limit, ok := node["maximum"].(float64); ok && float64(value) > limit {
+			fail("%d is above %v", value, limit)
+		}
+	case []any:
+		if items, ok := node["items"].(map[string]any); ok {
+			for i, item := range value {
+				problems = append(problems, validateSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case map[string]any:
+		properties, _ := node["properties"].(map[string]any)
+		for key, child := range value {
+			property, ok := properties[key].(map[string]any)
+			if !ok {
+				if node["additionalProperties"] == false {
+					fail("unknown key %q", key)
+				}
+				continue
+			}
+			problems = append(problems, validateSchema(property, child, strings.TrimPrefix(path+"."+key, "."))...)
+		}
+	}
+	return problems
+}
+
+// matchesType reports whether value has one of the JSON types in want
+func matchesType(want any, value any) bool {
+	if want == nil {
+		return true
+	}
+	if types, ok := want.([]any); ok {
+		for _, t := range types {
+			if matchesType(t, value) {
+				return true
+			}
+		}
+		return false
+	}
+	switch value.(type) {
+	case nil:
+		return want == "null"
+	case bool:
+		return want == "boolean"
+	case int:
+		return want == "integer"
+	case string:
+		return want == "string"
+	case []any:
+		return want == "array"
+	case map[string]any:
+		return want == "object"
+	}
+	return false
+}
+
+// containsValue reports whether values holds value
+func containsValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+// Command schemagen writes the JSON Schema of the config file. Field
+// descriptions are taken from the comments in the config source:
+//
+//	go run ./internal/shared/config/schemagen -src internal/shared/config/config.go -o schema/config.schema.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+)
+
+func main() {
+	src := flag.String("src", "config.go", "Go file declaring the config structs")
+	out := flag.String("o", "config.schema.json", "Where to write the schema")
+	flag.Parse()
+
+	schema, err := generate(*src)
+	if err == nil {
+		err = os.WriteFile(*out, schema, 0o644) //nolint:gosec // checked-in, world-readable file
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "schemagen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the indented schema with descriptions read from src
+func generate(src string) ([]byte, error) {
+	descriptions, err := fieldComments(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false) // Keep "->" and "<" readable in patterns
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(config.BuildSchema(descriptions)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fieldComments maps "TypeName.FieldName" to the field's doc or line comment
+func fieldComments(src string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), src, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := map[string]string{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		for _, field := range structType.Fields.List {
+			text := commentText(field.Doc)
+			if text == "" {
+				text = commentText(field.Comment)
+			}
+			for _, name := range field.Names {
+				if text != "" {
+					comments[spec.Name.Name+"."+name.Name] = text
+				}
+			}
+		}
+		return false
+	})
+	return comments, nil
+}
+
+// commentText joins a comment group into one line
+func commentText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(group.Text()), " ")
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/schema"
+)
+
+func TestCheckedInSchemaIsCurrent(t *testing.T) {
+	generated, err := generate("../config.go")
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	if !bytes.Equal(generated, schema.Config) {
+		t.Error("schema/config.schema.json is out of date; run go generate ./schema")
+	}
+}
+
+func TestFieldComments(t *testing.T) {
+	comments, err := fieldComments("../config.go")
+	if err != nil {
+		t.Fatalf("fieldComments failed: %v", err)
+	}
+	// Trailing comment
+	if got := comments["ServerConfig.PollingInterval"]; got != "Polling interval in seconds (0 = disabled, default: 10)" {
+		t.Errorf("Unexpected trailing comment %q", got)
+	}
+	// Leading comment
+	if got := comments["ServerConfig.RefreshMinSpacing"]; got != "Minimum time between two full task reloads from any source (0 = 2s)" {
+		t.Errorf("Unexpected leading comment %q", got)
+	}
+}
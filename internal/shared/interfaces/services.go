@@ -1,6 +1,7 @@
 package interfaces
 
 import (
+	"context"
 	"time"
 
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
@@ -14,6 +15,7 @@ type ArchonClient interface {
 	// Task operations
 	ListTasks(projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error)
 	GetTask(taskID string) (*archon.TaskResponse, error)
+	CreateTask(req archon.CreateTaskRequest) (*archon.TaskResponse, error)
 	UpdateTask(taskID string, updates archon.UpdateTaskRequest) (*archon.TaskResponse, error)
 	DeleteTask(taskID string) error
 
@@ -25,6 +27,38 @@ type ArchonClient interface {
 	HealthCheck() error
 }
 
+// TaskSearcher is implemented by clients that can have the server search tasks,
+// for datasets too large to search locally
+type TaskSearcher interface {
+	SearchTasks(query string, projectID *string) (*archon.TasksResponse, error)
+}
+
+// TaskPager is implemented by clients that can load the task list a page at
+// a time, so large projects load as they are scrolled
+type TaskPager interface {
+	ListTasksPaged(opts archon.ListTasksOptions) (*archon.TasksResponse, error)
+}
+
+// ContextLister is implemented by clients whose list requests can be
+// cancelled, so a load superseded by a newer one stops instead of finishing
+type ContextLister interface {
+	ListTasksContext(ctx context.Context, projectID *string, status *string, includeClosed bool) (*archon.TasksResponse, error)
+	ListTasksPagedContext(ctx context.Context, opts archon.ListTasksOptions) (*archon.TasksResponse, error)
+	ListProjectsContext(ctx context.Context) (*archon.ProjectsResponse, error)
+}
+
+// ResponseCacher is implemented by clients that may answer list requests from
+// a response cache; InvalidateCache makes the next load go to the server
+type ResponseCacher interface {
+	InvalidateCache()
+}
+
+// APIKeySetter is implemented by clients whose API key can be replaced while
+// running, e.g. with a key the user entered after a 401
+type APIKeySetter interface {
+	SetAPIKey(apiKey string)
+}
+
 // RealtimeClient defines the interface for real-time WebSocket operations
 // This allows us to inject different implementations (WebSocket, mock, offline)
 type RealtimeClient interface {
@@ -92,5 +126,11 @@ type Logger interface {
 // Verify archon.Client implements ArchonClient
 var _ ArchonClient = (*archon.Client)(nil)
 
+// Verify archon.Client implements TaskPager
+var _ TaskPager = (*archon.Client)(nil)
+
+// Verify archon.Client implements ResponseCacher
+var _ ResponseCacher = (*archon.Client)(nil)
+
 // Note: config.Config implementation verification moved to config package
 // to avoid circular imports
@@ -61,6 +61,13 @@ func (d *DimensionCalculator) WithScrollbar() *DimensionCalculator {
 	return d
 }
 
+// WithScrollbarEnabled reserves scrollbar space only when enabled is true,
+// so content can use the freed column when scrollbars are turned off
+func (d *DimensionCalculator) WithScrollbarEnabled(enabled bool) *DimensionCalculator {
+	d.hasScrollbar = enabled
+	return d
+}
+
 // WithPadding sets custom padding (overrides default modal padding)
 // Padding is applied on both left and right (total reduction = padding * 2)
 func (d *DimensionCalculator) WithPadding(padding int) *DimensionCalculator {
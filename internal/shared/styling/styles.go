@@ -62,6 +62,7 @@ const (
 	PanelPadding       = 1
 	SelectionIndicator = "→ " // Arrow indicator for better visibility
 	NoSelection        = "  "
+	MarkIndicator      = "◆ " // Task marked for a bulk status change
 	MaxTasksPerPage    = 100
 )
 
@@ -126,11 +127,17 @@ func GetPrioritySymbol(priority PriorityLevel) string {
 	}
 }
 
+// Lowest task_order of each priority level
+const (
+	PriorityHighThreshold   = 80
+	PriorityMediumThreshold = 50
+)
+
 func GetTaskPriority(taskOrder int, allTasks []archon.Task) PriorityLevel {
 	// Simplified priority calculation for compatibility
-	if taskOrder >= 80 {
+	if taskOrder >= PriorityHighThreshold {
 		return PriorityHigh
-	} else if taskOrder >= 50 {
+	} else if taskOrder >= PriorityMediumThreshold {
 		return PriorityMedium
 	}
 	return PriorityLow
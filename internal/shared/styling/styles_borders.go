@@ -0,0 +1,36 @@
+package styling
+
+import "github.com/charmbracelet/lipgloss"
+
+// asciiBorders swaps box-drawing borders for ASCII ones, for consoles whose
+// fonts render the box-drawing glyphs as tofu (the legacy Windows console)
+var asciiBorders bool
+
+// SetASCIIBorders selects ASCII borders for every style created afterwards
+func SetASCIIBorders(enabled bool) {
+	asciiBorders = enabled
+}
+
+// RoundedBorder is the border of panels and modals
+func RoundedBorder() lipgloss.Border {
+	if asciiBorders {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
+
+// NormalBorder is the border of inner boxes and separators
+func NormalBorder() lipgloss.Border {
+	if asciiBorders {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.NormalBorder()
+}
+
+// ThickBorder is the border of emphasized overlays
+func ThickBorder() lipgloss.Border {
+	if asciiBorders {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.ThickBorder()
+}
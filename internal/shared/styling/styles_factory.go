@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 )
 
 // StyleFactory generates consistent styles based on the current StyleContext
@@ -122,7 +124,7 @@ func (f *StyleFactory) Panel(width, height int, isActive bool) lipgloss.Style {
 	contentWidth := width - borderOverhead
 
 	return lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(RoundedBorder()).
 		BorderForeground(lipgloss.Color(borderColor)).
 		Width(contentWidth).   // Width for content + padding (borders outside this)
 		Height(contentHeight). // Height for content (borders outside this)
@@ -155,58 +157,37 @@ func (f *StyleFactory) getFeatureColor(featureName string) string {
 	return f.context.theme.FeatureColors[colorIndex]
 }
 
-// highlightSearchTerms highlights search query matches in the given text
+// highlightSearchTerms highlights search query matches in the given text.
+// Matches come from view.MatchRanges, so they never cut a multi-byte
+// character or grapheme cluster in half.
 func (f *StyleFactory) highlightSearchTerms(text, query, textColor string) string {
 	if query == "" {
 		return text
 	}
 
-	// Case-insensitive search
-	lowerText := strings.ToLower(text)
-	lowerQuery := strings.ToLower(query)
+	plainStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(textColor))
+	if f.context.selectionState.IsSelected {
+		plainStyle = plainStyle.Background(lipgloss.Color(f.context.selectionState.BackgroundColor))
+	}
+	matchStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("0")).                             // Black text
+		Background(lipgloss.Color(f.context.searchState.MatchColor)) // Yellow background
 
-	// Find all matches
 	var result strings.Builder
 	lastIndex := 0
-
-	for {
-		index := strings.Index(lowerText[lastIndex:], lowerQuery)
-		if index == -1 {
-			break
-		}
-
-		// Adjust index to absolute position
-		index += lastIndex
-
+	for _, match := range view.MatchRanges(text, query) {
 		// Add text before match with original color
-		if index > lastIndex {
-			beforeMatch := text[lastIndex:index]
-			beforeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(textColor))
-			if f.context.selectionState.IsSelected {
-				beforeStyle = beforeStyle.Background(lipgloss.Color(f.context.selectionState.BackgroundColor))
-			}
-			result.WriteString(beforeStyle.Render(beforeMatch))
+		if match[0] > lastIndex {
+			result.WriteString(plainStyle.Render(text[lastIndex:match[0]]))
 		}
-
 		// Add highlighted match
-		match := text[index : index+len(query)]
-		matchStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("0")).                             // Black text
-			Background(lipgloss.Color(f.context.searchState.MatchColor)) // Yellow background
-		result.WriteString(matchStyle.Render(match))
-
-		// Move to next position
-		lastIndex = index + len(query)
+		result.WriteString(matchStyle.Render(text[match[0]:match[1]]))
+		lastIndex = match[1]
 	}
 
 	// Add remaining text
 	if lastIndex < len(text) {
-		remaining := text[lastIndex:]
-		remainingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(textColor))
-		if f.context.selectionState.IsSelected {
-			remainingStyle = remainingStyle.Background(lipgloss.Color(f.context.selectionState.BackgroundColor))
-		}
-		result.WriteString(remainingStyle.Render(remaining))
+		result.WriteString(plainStyle.Render(text[lastIndex:]))
 	}
 
 	return result.String()
@@ -0,0 +1,54 @@
+package styling
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+func TestApplySearchHighlightingUnicodeTitles(t *testing.T) {
+	// Colors are dropped without a terminal; force them so matches are visible
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	tests := []struct {
+		title string
+		query string
+		want  []string // Highlighted parts of the title
+	}{
+		{"修复登录页面的登录错误", "登录", []string{"登录", "登录"}},
+		{"🚀 Launch the 🚀 rocket", "launch", []string{"Launch"}},
+		{"🚀 Launch the 🚀 rocket", "🚀", []string{"🚀", "🚀"}},
+		{"ẞẞ 登录", "登录", []string{"登录"}}, // Lowercasing ẞ shortens it by a byte
+		{"dev 👩‍💻 onboarding", "👩", []string{"👩‍💻"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title+"/"+tt.query, func(t *testing.T) {
+			context := NewStyleContext(&ThemeAdapter{}, nil).WithSearch(tt.query, true)
+			matchBackground := "48;5;" + context.searchState.MatchColor
+			out := context.Factory().ApplySearchHighlighting(tt.title, "7")
+
+			if !utf8.ValidString(out) {
+				t.Fatalf("Expected valid UTF-8, got %q", out)
+			}
+			if plain := ansi.Strip(out); plain != tt.title {
+				t.Errorf("Expected the title unchanged under the highlight, got %q", plain)
+			}
+			var highlighted []string
+			for _, segment := range strings.Split(out, "\x1b[0m") {
+				if strings.Contains(segment, matchBackground) {
+					highlighted = append(highlighted, ansi.Strip(segment))
+				}
+			}
+			if !reflect.DeepEqual(highlighted, tt.want) {
+				t.Errorf("Expected %q highlighted, got %q", tt.want, highlighted)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package styling
 
 import (
+	"sync/atomic"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 )
@@ -47,6 +49,15 @@ type ThemeConfig struct {
 // ActiveTheme holds the active theme (will be initialized from config)
 var ActiveTheme ThemeConfig
 
+// themeGeneration counts theme initializations, so output rendered with an
+// earlier theme can be told apart
+var themeGeneration atomic.Uint64
+
+// ThemeGeneration returns the number of times the theme was initialized
+func ThemeGeneration() uint64 {
+	return themeGeneration.Load()
+}
+
 // PredefinedThemes similar to lazygit/lazydocker
 var PredefinedThemes = map[string]ThemeConfig{
 	"default": {
@@ -217,6 +228,7 @@ func InitializeThemeNew(cfg *config.Config) {
 
 	// Update styles with new theme
 	updateStylesFromThemeNew()
+	themeGeneration.Add(1)
 }
 
 // updateStylesFromThemeNew applies the current theme to all styles
@@ -238,7 +250,7 @@ func updateStylesFromThemeNew() {
 
 	// Update base panel style with enhanced borders
 	BasePanelStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(RoundedBorder()).
 		BorderForeground(lipgloss.Color(ActiveTheme.BorderColor)).
 		// Background handled by StyleContext when needed
 		Padding(0, 1)
@@ -22,7 +22,7 @@ func RenderLine(content string, width int) string {
 // CreateModalStyle creates a modal overlay style
 func CreateModalStyle(width, height int) lipgloss.Style {
 	return lipgloss.NewStyle().
-		Border(lipgloss.ThickBorder()).
+		Border(ThickBorder()).
 		BorderForeground(lipgloss.Color(CurrentTheme.ActiveBorderColor)).
 		// Background handled by global background system
 		Width(width).
@@ -44,7 +44,7 @@ func CreateKeyBindingStyle() lipgloss.Style {
 // CreateButtonStyle creates a button-like style
 func CreateButtonStyle(isSelected bool) lipgloss.Style {
 	baseStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(RoundedBorder()).
 		Padding(0, 2)
 
 	if isSelected {
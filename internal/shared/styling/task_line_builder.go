@@ -72,6 +72,52 @@ func (b *TaskLineBuilder) AddStatusIndicator(task archon.Task) *TaskLineBuilder
 	return b
 }
 
+// TagChips renders tags as chips, e.g. "[db] [urgent]"
+func TagChips(tags []string) string {
+	chips := make([]string, len(tags))
+	for i, tag := range tags {
+		chips[i] = "[" + tag + "]"
+	}
+	return strings.Join(chips, " ")
+}
+
+// AddTagChips adds the task's tags after the feature tag if space permits
+func (b *TaskLineBuilder) AddTagChips(task archon.Task) *TaskLineBuilder {
+	if len(task.Tags) == 0 {
+		return b
+	}
+
+	b.components = append(b.components, LineComponent{
+		content:  " " + TagChips(task.Tags),
+		style:    b.styleContext.Factory().Muted(),
+		priority: 40, // Dropped before the feature tag
+		isFixed:  false,
+		minWidth: 0, // Can be completely removed
+	})
+
+	return b
+}
+
+// UnreadBadge marks tasks updated since their details were last shown
+const UnreadBadge = "• "
+
+// AddUnreadIndicator adds the unread dot before the title
+func (b *TaskLineBuilder) AddUnreadIndicator(unread bool) *TaskLineBuilder {
+	if !unread {
+		return b
+	}
+
+	b.components = append(b.components, LineComponent{
+		content:  UnreadBadge,
+		style:    b.styleContext.Factory().Accent(),
+		priority: 95, // Always show - the task changed
+		isFixed:  true,
+		minWidth: len(UnreadBadge),
+	})
+
+	return b
+}
+
 // AddTitle adds the task title with search highlighting support
 func (b *TaskLineBuilder) AddTitle(task archon.Task, searchQuery string, searchActive bool) *TaskLineBuilder {
 	var content string
@@ -127,6 +173,50 @@ func (b *TaskLineBuilder) AddFeatureTag(task archon.Task) *TaskLineBuilder {
 	return b
 }
 
+// AddProjectLabel adds the task's project title, e.g. " (Backend)", after the
+// feature tag if space permits
+func (b *TaskLineBuilder) AddProjectLabel(projectTitle string) *TaskLineBuilder {
+	if projectTitle == "" {
+		return b
+	}
+
+	// Projects share the feature palette so each keeps a stable color
+	style := b.styleContext.Factory().Muted()
+	if b.styleContext.StyleProvider().IsFeatureColorsEnabled() {
+		style = b.styleContext.Factory().Feature(projectTitle)
+	}
+
+	b.components = append(b.components, LineComponent{
+		content:  " (" + projectTitle + ")",
+		style:    style,
+		priority: 45, // Dropped after the tag chips, before the feature tag
+		isFixed:  false,
+		minWidth: 0, // Can be completely removed
+	})
+
+	return b
+}
+
+// MalformedBadge marks tasks whose payload had to be cleaned for display
+const MalformedBadge = " ⚠"
+
+// AddMalformedBadge flags tasks with malformed data so they stand out without breaking the layout
+func (b *TaskLineBuilder) AddMalformedBadge(task archon.Task) *TaskLineBuilder {
+	if !task.IsMalformed() {
+		return b
+	}
+
+	b.components = append(b.components, LineComponent{
+		content:  MalformedBadge,
+		style:    b.styleContext.Factory().Text(CurrentTheme.WarningColor),
+		priority: 95, // Always show - the task needs attention
+		isFixed:  true,
+		minWidth: len(MalformedBadge),
+	})
+
+	return b
+}
+
 // Build assembles the line with intelligent truncation
 //
 //nolint:gocyclo // Complexity unavoidable - handles intelligent truncation with multiple edge cases
@@ -0,0 +1,52 @@
+// Package atomicfile replaces files so readers never see a torn write: the
+// data goes to a temporary file next to the target, which is then renamed
+// over it.
+//
+// On Windows the rename fails while another process (an editor, a virus
+// scanner, a second lazyarchon) briefly holds the target open, so transient
+// failures there are retried for a short while before giving up.
+package atomicfile
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Retry schedule for transient rename failures
+const (
+	renameAttempts = 10
+	renameBackoff  = 20 * time.Millisecond
+)
+
+// WriteFile writes data to path atomically, creating it with perm when it
+// does not exist yet. The parent directory must exist.
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return fmt.Errorf("write %s: %w", tmp, err)
+	}
+	if err := replace(tmp, path, os.Rename, isTransient, time.Sleep); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// replace renames tmp over path, retrying while transient reports the
+// failure as temporary
+func replace(tmp, path string, rename func(string, string) error, transient func(error) bool, sleep func(time.Duration)) error {
+	var err error
+	for attempt := 1; attempt <= renameAttempts; attempt++ {
+		if err = rename(tmp, path); err == nil || !transient(err) {
+			break
+		}
+		if attempt < renameAttempts {
+			sleep(time.Duration(attempt) * renameBackoff)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("replace %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package atomicfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFile(path, []byte("new"), 0o600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "new" {
+		t.Fatalf("Expected new content, got %q (%v)", data, err)
+	}
+	if _, err := os.Stat(path + ".tmp"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected the temporary file to be gone, got %v", err)
+	}
+}
+
+func TestWriteFileMissingDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "state.json")
+	if err := WriteFile(path, []byte("x"), 0o600); err == nil {
+		t.Fatal("Expected an error for a missing directory")
+	}
+}
+
+func TestReplaceRetries(t *testing.T) {
+	busy := errors.New("sharing violation")
+	transient := func(err error) bool { return errors.Is(err, busy) }
+
+	tests := []struct {
+		name      string
+		failures  int   // Renames that fail before one succeeds
+		failWith  error // Error the failing renames return
+		wantErr   bool
+		wantCalls int
+	}{
+		{"succeeds first time", 0, busy, false, 1},
+		{"retries while busy", 3, busy, false, 4},
+		{"gives up after the last attempt", renameAttempts, busy, true, renameAttempts},
+		{"permanent errors are not retried", 5, errors.New("no such directory"), true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			rename := func(string, string) error {
+				calls++
+				if calls <= tt.failures {
+					return tt.failWith
+				}
+				return nil
+			}
+			var slept time.Duration
+			err := replace("a.tmp", "a", rename, transient, func(d time.Duration) { slept += d })
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("replace() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d rename calls, got %d", tt.wantCalls, calls)
+			}
+			if tt.wantCalls == 1 && slept != 0 {
+				t.Errorf("Expected no backoff, slept %v", slept)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package atomicfile
+
+// isTransient reports whether a rename failure is worth retrying. POSIX
+// rename replaces open files, so no failure is.
+func isTransient(error) bool {
+	return false
+}
@@ -0,0 +1,25 @@
+//go:build windows
+
+package atomicfile
+
+import (
+	"errors"
+	"syscall"
+)
+
+// Win32 errors os.Rename (MoveFileEx) reports while the target is open elsewhere
+const (
+	errorAccessDenied     syscall.Errno = 5
+	errorSharingViolation syscall.Errno = 32
+	errorLockViolation    syscall.Errno = 33
+)
+
+// isTransient reports whether a rename failed only because another process
+// has the target open, which usually clears within milliseconds
+func isTransient(err error) bool {
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		return false
+	}
+	return errno == errorAccessDenied || errno == errorSharingViolation || errno == errorLockViolation
+}
@@ -0,0 +1,27 @@
+//go:build windows
+
+package atomicfile
+
+import (
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&os.LinkError{Op: "rename", Err: errorSharingViolation}, true},
+		{&os.LinkError{Op: "rename", Err: errorAccessDenied}, true},
+		{&os.LinkError{Op: "rename", Err: errorLockViolation}, true},
+		{&os.LinkError{Op: "rename", Err: syscall.ERROR_FILE_NOT_FOUND}, false},
+		{os.ErrNotExist, false},
+	}
+	for _, tt := range tests {
+		if got := isTransient(tt.err); got != tt.want {
+			t.Errorf("isTransient(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package browser
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Open launches the system browser for url without waiting for it to exit.
+// Only http and https URLs are opened to avoid handing arbitrary schemes to the OS.
+func Open(url string) error {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return fmt.Errorf("refusing to open non-http URL: %s", url)
+	}
+
+	// URL is validated above and passed as a single argument, never through a shell
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url) //nolint:gosec // validated URL argument
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url) //nolint:gosec // validated URL argument
+	default:
+		cmd = exec.Command("xdg-open", url) //nolint:gosec // validated URL argument
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	// Reap the launcher process in the background
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
@@ -0,0 +1,318 @@
+// Package clipboard copies text to the system clipboard or, where no
+// clipboard tool is reachable (typically over SSH), through the terminal's
+// OSC 52 escape sequence.
+//
+// Both backends cap how much they accept and oversized writes tend to fail
+// silently: the paste just comes up empty. Plan reports a payload's size
+// against the backend's limit so callers can ask before copying, Write
+// truncates to the limit and says so, and SaveToFile is the fallback for
+// payloads that should not go through the clipboard at all.
+//
+// Whether the system clipboard can work at all (a clipboard tool, and on
+// X11/Wayland systems a display) is probed once in New. While it cannot,
+// system writes fail straight away with ErrUnavailable and the reason.
+//
+// Read returns the system clipboard's text. OSC 52 reads need the terminal
+// to answer, which most refuse, so reads never go through OSC 52.
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/atotto/clipboard"
+)
+
+// Backend names a way of reaching the clipboard
+type Backend string
+
+const (
+	BackendAuto   Backend = "auto"   // OSC 52 in SSH sessions or without a clipboard tool or display, else system
+	BackendSystem Backend = "system" // pbcopy, xclip/xsel/wl-copy or the Windows clipboard
+	BackendOSC52  Backend = "osc52"  // Terminal escape sequence, works through SSH
+)
+
+// Default limits, in bytes of copied text
+const (
+	// DefaultOSC52Limit keeps the base64-encoded sequence under the
+	// 100000 bytes many terminals (hterm, tmux, older xterm) accept
+	DefaultOSC52Limit = 74994
+
+	// DefaultConfirmAbove is the payload size above which callers should ask first
+	DefaultConfirmAbove = 64 * 1024
+)
+
+// ErrNoOutput is returned when OSC 52 is selected but no terminal output is attached
+var ErrNoOutput = errors.New("no terminal output for OSC 52")
+
+// ErrUnavailable is returned by system writes when the startup probe found
+// the system clipboard unusable; the error also gives the reason
+var ErrUnavailable = errors.New("system clipboard unavailable")
+
+// Options configure a Clipboard. Zero values select the defaults.
+type Options struct {
+	Backend      Backend                 // auto (default), system or osc52
+	MaxBytes     int                     // Overrides the backend's limit; 0 keeps the default
+	ConfirmAbove int                     // Payloads larger than this need confirmation; 0 uses DefaultConfirmAbove
+	SaveDir      string                  // Directory for SaveToFile; empty uses the OS temp directory
+	Output       io.Writer               // Receives OSC 52 sequences; nil disables OSC 52
+	Getenv       func(string) string     // Environment lookup for auto detection (default: os.Getenv)
+	WriteSystem  func(text string) error // System clipboard writer (default: atotto/clipboard)
+	ReadSystem   func() (string, error)  // System clipboard reader (default: atotto/clipboard)
+}
+
+// Clipboard writes text to the resolved backend within its size limit
+type Clipboard struct {
+	backend      Backend
+	limit        int // 0 = no known limit
+	confirmAbove int
+	saveDir      string
+	output       io.Writer
+	writeSystem  func(string) error
+	readSystem   func() (string, error)
+	unavailable  error // Why the system clipboard cannot work (nil = it may)
+}
+
+// New resolves the backend and its limit from opts
+func New(opts Options) *Clipboard {
+	getenv := opts.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	writeSystem := opts.WriteSystem
+	var unavailable error
+	if writeSystem == nil {
+		writeSystem = clipboard.WriteAll
+		unavailable = probeSystem(clipboard.Unsupported, runtime.GOOS, getenv)
+	}
+	readSystem := opts.ReadSystem
+	if readSystem == nil {
+		readSystem = clipboard.ReadAll
+	}
+
+	c := &Clipboard{
+		backend:      resolveBackend(opts.Backend, opts.Output != nil, unavailable == nil, getenv),
+		confirmAbove: opts.ConfirmAbove,
+		saveDir:      opts.SaveDir,
+		output:       opts.Output,
+		writeSystem:  writeSystem,
+		readSystem:   readSystem,
+		unavailable:  unavailable,
+	}
+	if c.confirmAbove <= 0 {
+		c.confirmAbove = DefaultConfirmAbove
+	}
+	if c.backend == BackendOSC52 {
+		c.limit = DefaultOSC52Limit
+	}
+	if opts.MaxBytes > 0 {
+		c.limit = opts.MaxBytes
+	}
+	return c
+}
+
+// probeSystem reports why the system clipboard cannot work, or nil when it
+// may. noTool is atotto/clipboard's verdict on the clipboard tools in PATH.
+// xclip and xsel need an X11 display and wl-copy a Wayland one, which SSH
+// sessions and headless machines lack; WSL (clip.exe) and Termux need neither.
+func probeSystem(noTool bool, goos string, getenv func(string) string) error {
+	if noTool {
+		return errors.New("no clipboard tool found (install xclip, xsel or wl-clipboard)")
+	}
+	switch goos {
+	case "darwin", "windows", "plan9":
+		return nil
+	}
+	if getenv("WSL_DISTRO_NAME") != "" || getenv("TERMUX_VERSION") != "" {
+		return nil
+	}
+	if getenv("DISPLAY") == "" && getenv("WAYLAND_DISPLAY") == "" {
+		return errors.New("no X11 or Wayland display (DISPLAY and WAYLAND_DISPLAY are unset)")
+	}
+	return nil
+}
+
+// resolveBackend picks the backend for auto: OSC 52 when the system clipboard
+// is unreachable (SSH session or no clipboard tool) and terminal output is available
+func resolveBackend(requested Backend, hasOutput, systemAvailable bool, getenv func(string) string) Backend {
+	if requested == BackendSystem || requested == BackendOSC52 {
+		return requested
+	}
+	remote := getenv("SSH_TTY") != "" || getenv("SSH_CONNECTION") != ""
+	if hasOutput && (remote || !systemAvailable) {
+		return BackendOSC52
+	}
+	return BackendSystem
+}
+
+// Backend returns the backend writes go to
+func (c *Clipboard) Backend() Backend {
+	return c.backend
+}
+
+// Unavailable returns why the system clipboard cannot work, or nil when it may.
+// Only system writes depend on it; OSC 52 goes through the terminal.
+func (c *Clipboard) Unavailable() error {
+	return c.unavailable
+}
+
+// Limit returns the largest payload in bytes the backend takes whole; 0 means no known limit
+func (c *Clipboard) Limit() int {
+	return c.limit
+}
+
+// Plan describes how a payload would be copied
+type Plan struct {
+	Size     int     // Payload size in bytes
+	Limit    int     // Backend limit in bytes; 0 = no known limit
+	Backend  Backend // Backend the payload would go to
+	Truncate bool    // Payload exceeds Limit and would be cut
+	Confirm  bool    // Payload is large enough that the user should be asked first
+}
+
+// Plan sizes text against the backend limit and the confirmation threshold.
+// Payloads that would be truncated always need confirmation.
+func (c *Clipboard) Plan(text string) Plan {
+	size := len(text)
+	truncate := c.limit > 0 && size > c.limit
+	return Plan{
+		Size:     size,
+		Limit:    c.limit,
+		Backend:  c.backend,
+		Truncate: truncate,
+		Confirm:  truncate || size > c.confirmAbove,
+	}
+}
+
+// Result reports what a write copied
+type Result struct {
+	Backend   Backend
+	Written   int  // Bytes of text copied
+	Size      int  // Bytes of text requested
+	Truncated bool // Only the first Written bytes were copied
+}
+
+// Write copies text, cut at the last whole character within the backend limit
+func (c *Clipboard) Write(text string) (Result, error) {
+	result := Result{Backend: c.backend, Size: len(text)}
+	if c.limit > 0 && len(text) > c.limit {
+		text = truncateUTF8(text, c.limit)
+		result.Truncated = true
+	}
+
+	var err error
+	switch {
+	case c.backend == BackendOSC52:
+		err = c.writeOSC52(text)
+	case c.unavailable != nil:
+		return Result{Backend: c.backend, Size: result.Size}, fmt.Errorf("%w: %w", ErrUnavailable, c.unavailable)
+	default:
+		err = c.writeSystem(text)
+	}
+	if err != nil {
+		return Result{Backend: c.backend, Size: result.Size}, fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	result.Written = len(text)
+	return result, nil
+}
+
+// Read returns the text on the system clipboard, whichever backend writes use
+func (c *Clipboard) Read() (string, error) {
+	if c.unavailable != nil {
+		return "", fmt.Errorf("%w: %w", ErrUnavailable, c.unavailable)
+	}
+	text, err := c.readSystem()
+	if err != nil {
+		return "", fmt.Errorf("failed to read clipboard: %w", err)
+	}
+	return text, nil
+}
+
+// writeOSC52 sends text as a single OSC 52 clipboard sequence
+func (c *Clipboard) writeOSC52(text string) error {
+	if c.output == nil {
+		return ErrNoOutput
+	}
+	_, err := io.WriteString(c.output, EncodeOSC52(text))
+	return err
+}
+
+// EncodeOSC52 returns the escape sequence setting the clipboard to text
+func EncodeOSC52(text string) string {
+	return "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+}
+
+// truncateUTF8 cuts text to at most limit bytes without splitting a character
+func truncateUTF8(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut]
+}
+
+// SaveToFile writes text to a new file named after name in the save directory
+// and returns its path
+func (c *Clipboard) SaveToFile(name, text string) (string, error) {
+	dir := c.saveDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create save directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(dir, "lazyarchon-"+fileSlug(name)+"-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	if _, err := file.WriteString(text); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("failed to write %s: %w", file.Name(), err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", file.Name(), err)
+	}
+	return filepath.Clean(file.Name()), nil
+}
+
+// fileSlug turns a description such as "task title" into "task-title"
+func fileSlug(name string) string {
+	slug := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, name)
+	slug = strings.Trim(slug, "-")
+	if slug == "" {
+		return "clipboard"
+	}
+	return slug
+}
+
+// FormatSize renders a byte count for prompts, e.g. "512B", "312KB", "1.4MB"
+func FormatSize(bytes int) string {
+	switch {
+	case bytes < 1024:
+		return fmt.Sprintf("%dB", bytes)
+	case bytes < 1024*1024:
+		return fmt.Sprintf("%dKB", (bytes+1023)/1024)
+	default:
+		return fmt.Sprintf("%.1fMB", float64(bytes)/(1024*1024))
+	}
+}
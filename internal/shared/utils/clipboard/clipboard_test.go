@@ -0,0 +1,303 @@
+package clipboard
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// env returns a Getenv backed by vars
+func env(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+// recorder captures system clipboard writes
+type recorder struct {
+	text string
+	err  error
+}
+
+func (r *recorder) write(text string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.text = text
+	return nil
+}
+
+func TestNew_ResolvesBackend(t *testing.T) {
+	ssh := env(map[string]string{"SSH_TTY": "/dev/pts/1"})
+	local := env(nil)
+	out := &bytes.Buffer{}
+	system := (&recorder{}).write
+
+	tests := []struct {
+		name string
+		opts Options
+		want Backend
+	}{
+		{"auto local", Options{Output: out, Getenv: local, WriteSystem: system}, BackendSystem},
+		{"auto over ssh", Options{Output: out, Getenv: ssh, WriteSystem: system}, BackendOSC52},
+		{"auto over ssh without output", Options{Getenv: ssh, WriteSystem: system}, BackendSystem},
+		{"forced system over ssh", Options{Backend: BackendSystem, Output: out, Getenv: ssh, WriteSystem: system}, BackendSystem},
+		{"forced osc52 locally", Options{Backend: BackendOSC52, Output: out, Getenv: local, WriteSystem: system}, BackendOSC52},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.opts).Backend(); got != tt.want {
+				t.Errorf("Expected backend %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNew_Limits(t *testing.T) {
+	system := (&recorder{}).write
+	out := &bytes.Buffer{}
+
+	if limit := New(Options{Backend: BackendSystem, WriteSystem: system}).Limit(); limit != 0 {
+		t.Errorf("Expected no system limit by default, got %d", limit)
+	}
+	if limit := New(Options{Backend: BackendOSC52, Output: out}).Limit(); limit != DefaultOSC52Limit {
+		t.Errorf("Expected OSC 52 limit %d, got %d", DefaultOSC52Limit, limit)
+	}
+	if limit := New(Options{Backend: BackendOSC52, Output: out, MaxBytes: 1000}).Limit(); limit != 1000 {
+		t.Errorf("Expected override 1000, got %d", limit)
+	}
+	if limit := New(Options{Backend: BackendSystem, WriteSystem: system, MaxBytes: 500}).Limit(); limit != 500 {
+		t.Errorf("Expected system override 500, got %d", limit)
+	}
+}
+
+func TestPlan_ThresholdBoundaries(t *testing.T) {
+	c := New(Options{Backend: BackendOSC52, Output: &bytes.Buffer{}, MaxBytes: 200, ConfirmAbove: 100})
+
+	tests := []struct {
+		size         int
+		wantConfirm  bool
+		wantTruncate bool
+	}{
+		{99, false, false},
+		{100, false, false}, // At the threshold copies without asking
+		{101, true, false},
+		{200, true, false}, // Exactly the limit still fits
+		{201, true, true},
+	}
+	for _, tt := range tests {
+		plan := c.Plan(strings.Repeat("x", tt.size))
+		if plan.Size != tt.size || plan.Confirm != tt.wantConfirm || plan.Truncate != tt.wantTruncate {
+			t.Errorf("size %d: expected confirm=%v truncate=%v, got %+v", tt.size, tt.wantConfirm, tt.wantTruncate, plan)
+		}
+	}
+}
+
+func TestPlan_TruncationAlwaysConfirms(t *testing.T) {
+	// A limit below the threshold must still prompt before cutting
+	c := New(Options{Backend: BackendOSC52, Output: &bytes.Buffer{}, MaxBytes: 10, ConfirmAbove: 1000})
+
+	plan := c.Plan(strings.Repeat("x", 11))
+	if !plan.Truncate || !plan.Confirm {
+		t.Errorf("Expected truncation to require confirmation, got %+v", plan)
+	}
+}
+
+func TestPlan_DefaultThreshold(t *testing.T) {
+	c := New(Options{Backend: BackendSystem, WriteSystem: (&recorder{}).write})
+
+	if c.Plan(strings.Repeat("x", DefaultConfirmAbove)).Confirm {
+		t.Error("Expected no confirmation at the default threshold")
+	}
+	if !c.Plan(strings.Repeat("x", DefaultConfirmAbove+1)).Confirm {
+		t.Error("Expected confirmation above the default threshold")
+	}
+}
+
+func TestWrite_System(t *testing.T) {
+	rec := &recorder{}
+	c := New(Options{Backend: BackendSystem, WriteSystem: rec.write})
+
+	result, err := c.Write("task-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rec.text != "task-123" || result.Truncated || result.Written != 8 || result.Backend != BackendSystem {
+		t.Errorf("Unexpected write %q with result %+v", rec.text, result)
+	}
+
+	rec.err = errors.New("xclip not found")
+	if _, err := c.Write("again"); err == nil || !strings.Contains(err.Error(), "xclip not found") {
+		t.Errorf("Expected backend error to be returned, got %v", err)
+	}
+}
+
+func TestWrite_SystemOverride_Truncates(t *testing.T) {
+	rec := &recorder{}
+	c := New(Options{Backend: BackendSystem, WriteSystem: rec.write, MaxBytes: 4})
+
+	result, err := c.Write("abcdefgh")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rec.text != "abcd" || !result.Truncated || result.Written != 4 || result.Size != 8 {
+		t.Errorf("Expected first 4 bytes copied, got %q with %+v", rec.text, result)
+	}
+}
+
+func TestWrite_OSC52(t *testing.T) {
+	out := &bytes.Buffer{}
+	c := New(Options{Backend: BackendOSC52, Output: out})
+
+	result, err := c.Write("hello")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte("hello")) + "\x07"
+	if out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+	if result.Truncated || result.Backend != BackendOSC52 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestWrite_OSC52_TruncatesOnCharacterBoundary(t *testing.T) {
+	out := &bytes.Buffer{}
+	c := New(Options{Backend: BackendOSC52, Output: out, MaxBytes: 4})
+
+	// "aé" is 3 bytes and "€" 3 more; a 4-byte cut must not split the euro sign
+	result, err := c.Write("aé€")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Truncated || result.Written != 3 || result.Size != 6 {
+		t.Errorf("Expected 3 of 6 bytes written, got %+v", result)
+	}
+	if out.String() != EncodeOSC52("aé") {
+		t.Errorf("Expected sequence for %q, got %q", "aé", out.String())
+	}
+}
+
+func TestWrite_OSC52_DefaultLimitFitsSequence(t *testing.T) {
+	out := &bytes.Buffer{}
+	c := New(Options{Backend: BackendOSC52, Output: out})
+
+	if _, err := c.Write(strings.Repeat("x", DefaultOSC52Limit*2)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if out.Len() > 100000 {
+		t.Errorf("Expected sequence within 100000 bytes, got %d", out.Len())
+	}
+}
+
+func TestWrite_OSC52_WithoutOutput(t *testing.T) {
+	c := New(Options{Backend: BackendOSC52})
+
+	if _, err := c.Write("hello"); !errors.Is(err, ErrNoOutput) {
+		t.Errorf("Expected ErrNoOutput, got %v", err)
+	}
+}
+
+func TestProbeSystem(t *testing.T) {
+	tests := []struct {
+		name   string
+		noTool bool
+		goos   string
+		vars   map[string]string
+		want   string // Substring of the reason; "" = available
+	}{
+		{"no tool", true, "linux", map[string]string{"DISPLAY": ":0"}, "no clipboard tool"},
+		{"x11", false, "linux", map[string]string{"DISPLAY": ":0"}, ""},
+		{"wayland", false, "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, ""},
+		{"ssh without display", false, "linux", map[string]string{"SSH_TTY": "/dev/pts/1"}, "no X11 or Wayland display"},
+		{"wsl", false, "linux", map[string]string{"WSL_DISTRO_NAME": "Ubuntu"}, ""},
+		{"macos", false, "darwin", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := probeSystem(tt.noTool, tt.goos, env(tt.vars))
+			if tt.want == "" && err != nil {
+				t.Errorf("Expected the clipboard available, got %v", err)
+			}
+			if tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)) {
+				t.Errorf("Expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestWrite_SystemUnavailable(t *testing.T) {
+	rec := &recorder{}
+	c := New(Options{Backend: BackendSystem, WriteSystem: rec.write})
+	c.unavailable = errors.New("no X11 or Wayland display")
+
+	_, err := c.Write("hello")
+	if !errors.Is(err, ErrUnavailable) || !strings.Contains(err.Error(), "no X11 or Wayland display") {
+		t.Errorf("Expected ErrUnavailable with the reason, got %v", err)
+	}
+	if rec.text != "" {
+		t.Error("Expected no attempt to write to the system clipboard")
+	}
+
+	// Auto picks OSC 52 instead when the terminal is attached
+	if got := resolveBackend(BackendAuto, true, false, env(nil)); got != BackendOSC52 {
+		t.Errorf("Expected OSC 52 for auto without a usable system clipboard, got %s", got)
+	}
+}
+
+func TestRead(t *testing.T) {
+	text, readErr := "task-123\n", error(nil)
+	read := func() (string, error) { return text, readErr }
+	c := New(Options{Backend: BackendOSC52, Output: &bytes.Buffer{}, WriteSystem: (&recorder{}).write, ReadSystem: read})
+
+	// Reads go to the system clipboard even when writes use OSC 52
+	if got, err := c.Read(); err != nil || got != "task-123\n" {
+		t.Errorf("Expected the system clipboard text, got %q (err %v)", got, err)
+	}
+
+	readErr = errors.New("xclip not found")
+	if _, err := c.Read(); err == nil || !strings.Contains(err.Error(), "xclip not found") {
+		t.Errorf("Expected backend error to be returned, got %v", err)
+	}
+
+	c.unavailable = errors.New("no X11 or Wayland display")
+	if _, err := c.Read(); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Expected ErrUnavailable, got %v", err)
+	}
+}
+
+func TestSaveToFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+	c := New(Options{Backend: BackendSystem, WriteSystem: (&recorder{}).write, SaveDir: dir})
+
+	path, err := c.SaveToFile("task description", "full text")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if filepath.Dir(path) != dir || !strings.HasPrefix(filepath.Base(path), "lazyarchon-task-description-") {
+		t.Errorf("Unexpected path %s", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "full text" {
+		t.Errorf("Expected saved text, got %q (err %v)", data, err)
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := map[int]string{
+		0:               "0B",
+		1023:            "1023B",
+		1024:            "1KB",
+		312 * 1024:      "312KB",
+		312*1024 + 1:    "313KB",
+		3 * 1024 * 1024: "3.0MB",
+	}
+	for size, want := range tests {
+		if got := FormatSize(size); got != want {
+			t.Errorf("FormatSize(%d) = %s, want %s", size, got, want)
+		}
+	}
+}
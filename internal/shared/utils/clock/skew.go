@@ -0,0 +1,149 @@
+// Package clock estimates the offset between the local clock and the Archon
+// server so relative times stay correct on machines with a wrong clock.
+package clock
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultSkewThreshold is the skew above which times are anchored to the server
+const DefaultSkewThreshold = 2 * time.Minute
+
+// sampleWindow is how many recent samples the estimate is smoothed over
+const sampleWindow = 8
+
+// maxSampleRTT discards samples whose round trip is too slow to place the server time precisely
+const maxSampleRTT = 10 * time.Second
+
+// SkewEstimator tracks how far the local clock is ahead of the server.
+// The estimate is the median of recent samples, so a single slow response
+// cannot swing it. All methods are safe for concurrent use and on a nil receiver.
+type SkewEstimator struct {
+	mu        sync.Mutex
+	samples   []time.Duration // Ring buffer of local-minus-server offsets
+	next      int             // Next ring buffer slot to overwrite
+	threshold time.Duration
+	now       func() time.Time
+}
+
+// NewSkewEstimator creates an estimator that anchors to the server once skew reaches threshold.
+// A non-positive threshold uses DefaultSkewThreshold.
+func NewSkewEstimator(threshold time.Duration) *SkewEstimator {
+	if threshold <= 0 {
+		threshold = DefaultSkewThreshold
+	}
+	return &SkewEstimator{
+		samples:   make([]time.Duration, 0, sampleWindow),
+		threshold: threshold,
+		now:       time.Now,
+	}
+}
+
+// Observe records one server timestamp for a request sent at sent and answered at received.
+// The server time is assumed to correspond to the middle of the round trip.
+func (e *SkewEstimator) Observe(serverTime, sent, received time.Time) {
+	if e == nil || serverTime.IsZero() {
+		return
+	}
+	rtt := received.Sub(sent)
+	if rtt < 0 || rtt > maxSampleRTT {
+		return
+	}
+	offset := sent.Add(rtt / 2).Sub(serverTime)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.samples) < sampleWindow {
+		e.samples = append(e.samples, offset)
+	} else {
+		e.samples[e.next] = offset
+	}
+	e.next = (e.next + 1) % sampleWindow
+}
+
+// Skew returns the smoothed offset; positive means the local clock is ahead of the server
+func (e *SkewEstimator) Skew() time.Duration {
+	if e == nil {
+		return 0
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.samples) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration(nil), e.samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// Significant reports whether the measured skew reaches the threshold
+func (e *SkewEstimator) Significant() bool {
+	if e == nil {
+		return false
+	}
+	skew := e.Skew()
+	if skew < 0 {
+		skew = -skew
+	}
+	return skew >= e.threshold
+}
+
+// Now returns the current time, anchored to the server when skew is significant.
+// Small skews are ignored so timestamps do not jitter with network latency.
+func (e *SkewEstimator) Now() time.Time {
+	if e == nil {
+		return time.Now()
+	}
+	now := e.now()
+	if e.Significant() {
+		return now.Add(-e.Skew())
+	}
+	return now
+}
+
+// Since returns the time elapsed since t, measured against Now
+func (e *SkewEstimator) Since(t time.Time) time.Duration {
+	return e.Now().Sub(t)
+}
+
+// Describe renders a skew for users, e.g. "local clock is 40m ahead of server"
+func Describe(skew time.Duration) string {
+	direction := "ahead of"
+	if skew < 0 {
+		direction = "behind"
+		skew = -skew
+	}
+	return fmt.Sprintf("local clock is %s %s server", FormatDuration(skew), direction)
+}
+
+// FormatAge renders how long ago t was relative to now, e.g. "38m ago".
+// Timestamps in the future (from residual skew) render as "just now" rather than a negative age.
+func FormatAge(t, now time.Time) string {
+	age := now.Sub(t)
+	if age < time.Minute {
+		return "just now"
+	}
+	return FormatDuration(age) + " ago"
+}
+
+// FormatDuration renders d in its largest whole unit (s, m, h or d)
+func FormatDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	default:
+		return fmt.Sprintf("%ds", int(d/time.Second))
+	}
+}
@@ -0,0 +1,90 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSkewEstimator_MedianIgnoresLatencyOutliers(t *testing.T) {
+	estimator := NewSkewEstimator(time.Minute)
+	local := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := local.Add(-40 * time.Minute) // Local clock 40 minutes fast
+
+	// Fast round trips place the server time precisely
+	for i := 0; i < 4; i++ {
+		estimator.Observe(server, local.Add(-100*time.Millisecond), local.Add(100*time.Millisecond))
+	}
+	// One slow response skews its own sample but not the median
+	estimator.Observe(server, local.Add(-8*time.Second), local)
+	// Samples slower than maxSampleRTT are discarded entirely
+	estimator.Observe(server.Add(time.Hour), local.Add(-time.Minute), local)
+
+	if got := estimator.Skew(); got != 40*time.Minute {
+		t.Errorf("Expected 40m skew, got %v", got)
+	}
+	if !estimator.Significant() {
+		t.Error("Expected 40m skew to be significant")
+	}
+
+	estimator.now = func() time.Time { return local }
+	if got := estimator.Now(); !got.Equal(server) {
+		t.Errorf("Expected server-anchored now %v, got %v", server, got)
+	}
+}
+
+func TestSkewEstimator_SmallSkewIgnored(t *testing.T) {
+	estimator := NewSkewEstimator(DefaultSkewThreshold)
+	local := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	estimator.now = func() time.Time { return local }
+
+	estimator.Observe(local.Add(-30*time.Second), local, local)
+	if estimator.Significant() {
+		t.Error("Expected 30s skew to stay below the default threshold")
+	}
+	if got := estimator.Now(); !got.Equal(local) {
+		t.Errorf("Expected local time for insignificant skew, got %v", got)
+	}
+
+	// Window keeps only recent samples: the clock was corrected
+	for i := 0; i < sampleWindow; i++ {
+		estimator.Observe(local, local, local)
+	}
+	if got := estimator.Skew(); got != 0 {
+		t.Errorf("Expected old samples to age out, got %v", got)
+	}
+}
+
+func TestSkewEstimator_Nil(t *testing.T) {
+	var estimator *SkewEstimator
+	estimator.Observe(time.Now(), time.Now(), time.Now())
+	if estimator.Skew() != 0 || estimator.Significant() {
+		t.Error("Expected nil estimator to report no skew")
+	}
+	if estimator.Now().IsZero() {
+		t.Error("Expected nil estimator to fall back to local time")
+	}
+}
+
+func TestFormatting(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"minutes ago", FormatAge(now.Add(-38*time.Minute), now), "38m ago"},
+		{"days ago", FormatAge(now.Add(-50*time.Hour), now), "2d ago"},
+		{"future is just now", FormatAge(now.Add(38*time.Minute), now), "just now"},
+		{"ahead", Describe(40 * time.Minute), "local clock is 40m ahead of server"},
+		{"behind", Describe(-3 * time.Hour), "local clock is 3h behind server"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, tt.got)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package keys
+
+import "strings"
+
+// Key Contexts where bindings apply
+const (
+	ContextMain      = "main"       // Task and project views
+	ContextHelpModal = "help_modal" // Help modal
+	ContextModal     = "modal"      // Any modal
+)
+
+// ActionInfo describes a user-facing action: what it is called, what it does
+// and which keys trigger it by default. The actions table is the single source
+// for the help modal and anything else that lists actions; every key the main
+// view dispatches must have an entry (see TestDispatchedKeysHaveActionInfo).
+type ActionInfo struct {
+	ID          string   // Action constant; paired actions sharing a row join theirs with "/"
+	Title       string   // Short name (e.g., "Copy task ID")
+	Description string   // One line for help listings
+	Example     string   // Longer usage example
+	Category    string   // Help section the action is listed in
+	Contexts    []string // Where the action applies (ContextMain, ...)
+	Keys        []string // Default keys, as reported by tea.KeyMsg.String()
+	KeyLabel    string   // How the keys show in help ("" = Keys joined with "/")
+}
+
+// Label returns how the action's keys show in help
+func (a ActionInfo) Label() string {
+	if a.KeyLabel != "" {
+		return a.KeyLabel
+	}
+	return strings.Join(a.Keys, "/")
+}
+
+// Actions returns the metadata of every action in help display order
+func Actions() []ActionInfo {
+	return actions
+}
+
+// ActionByID returns the metadata of the action with the given ID
+func ActionByID(id string) (ActionInfo, bool) {
+	for _, action := range actions {
+		if action.ID == id {
+			return action, true
+		}
+	}
+	return ActionInfo{}, false
+}
+
+var mainContext = []string{ContextMain}
+
+// actions lists every action, grouped by help section
+var actions = []ActionInfo{
+	// Panel Navigation
+	{
+		ID: ActionMoveLeft + "/" + ActionMoveRight, Title: "Switch panel", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyH, KeyL}, Description: "Switch between panels",
+		Example: "Press l to move from the task list into the details panel, h to go back",
+	},
+	{
+		ID: ActionMoveUp + "/" + ActionMoveDown, Title: "Move", Category: CategoryNavigation, Contexts: mainContext,
+		Keys:        []string{KeyArrowUp, KeyArrowDown, KeyJ, KeyK},
+		KeyLabel:    KeyArrowUp + "/" + KeyArrowDown + " or " + KeyJ + "/" + KeyK,
+		Description: "Navigate/scroll (1 line)",
+		Example:     "j selects the next task; in the details panel it scrolls one line",
+	},
+	{
+		ID: ActionFastScrollDown + "/" + ActionFastScrollUp, Title: "Fast scroll", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyJCap, KeyKCap}, Description: "Fast scroll (4 lines)",
+		Example: "J moves four tasks down at once",
+	},
+	{
+		ID: ActionHalfPageUp + "/" + ActionHalfPageDown, Title: "Half-page scroll", Category: CategoryNavigation, Contexts: mainContext,
+		Keys:        []string{KeyCtrlU, KeyCtrlD, KeyPgUp, KeyPgDn},
+		KeyLabel:    KeyCtrlU + "/" + KeyCtrlD,
+		Description: "Half-page scroll",
+		Example:     "ctrl+d (or pgdown) scrolls a long description by half a screen",
+	},
+	{
+		ID: ActionJumpFirst + "/" + ActionJumpLast, Title: "Jump to top/bottom", Category: CategoryNavigation, Contexts: mainContext,
+		Keys:        []string{KeyG, KeyGG, KeyGCap},
+		KeyLabel:    KeyGG + "/" + KeyGCap,
+		Description: "Jump to top/bottom",
+		Example:     "gg selects the first task, G the last",
+	},
+	{
+		ID: ActionJumpFirst + "/" + ActionJumpLast, Title: "Jump to start/end", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyHome, KeyEnd}, Description: "Jump to start/end",
+		Example: "home and end work like gg and G",
+	},
+	{
+		ID: ActionGoParent + "/" + ActionGoFirstChild, Title: "Parent/child task", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyBracketLeft, KeyBracketRight}, Description: "Jump to parent/first child task",
+		Example: "[ on a subtask selects its parent; ] goes back down to the first child",
+	},
+	{
+		ID: ActionJumpToNumber, Title: "Jump to Nth task", Category: CategoryNavigation, Contexts: mainContext,
+		Keys:        []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"},
+		KeyLabel:    "1-9",
+		Description: "Jump to Nth visible task (number_key_behavior)",
+		Example:     "3 selects the third visible task",
+	},
+	{
+		ID: ActionSetBookmark + "/" + ActionJumpBookmark, Title: "Bookmarks", Category: CategoryNavigation, Contexts: mainContext,
+		Keys:        []string{KeyM, KeyApostrophe},
+		KeyLabel:    KeyM + "/" + KeyApostrophe + " + a-z",
+		Description: "Set/jump to bookmark",
+		Example:     "ma bookmarks the selected task in slot a; 'a jumps back to it from any project",
+	},
+	{
+		ID: ActionListBookmarks, Title: "List bookmarks", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyMCap}, Description: "List bookmarks",
+		Example: "M lists every slot; Enter jumps to the chosen task",
+	},
+	{
+		ID: ActionJumpClipboard, Title: "Jump to copied task", Category: CategoryNavigation, Contexts: mainContext,
+		Keys: []string{KeyCtrlG}, Description: "Jump to the task whose ID or URL is on the clipboard",
+		Example: "ctrl+g after copying a task link from chat selects that task, fetching it if needed",
+	},
+
+	// Project Management
+	{
+		ID: ActionProjectMode, Title: "Select project", Category: CategoryProject, Contexts: mainContext,
+		Keys: []string{KeyP}, Description: "Project selection mode",
+		Example: "p opens the project list; pick one with j/k and Enter",
+	},
+	{
+		ID: ActionShowAllTasks, Title: "Show all tasks", Category: CategoryProject, Contexts: mainContext,
+		Keys: []string{KeyA}, Description: "Show all tasks",
+		Example: "a drops the project filter and lists tasks from every project",
+	},
+	{
+		ID: ActionConfirm, Title: "Confirm", Category: CategoryProject, Contexts: mainContext,
+		Keys: []string{KeyEnter}, Description: "Select project",
+		Example: "Enter in the project list shows that project's tasks; with details_panel: manual it opens a task's details",
+	},
+	{
+		ID: ActionEscape, Title: "Back", Category: CategoryProject, Contexts: mainContext,
+		Keys: []string{KeyEscape}, Description: "Exit project mode",
+		Example: "Esc leaves the project list without changing the project",
+	},
+
+	// Search
+	{
+		ID: ActionActivateSearch, Title: "Search", Category: CategorySearch, Contexts: mainContext,
+		Keys:        []string{KeySlash, KeyCtrlF},
+		KeyLabel:    KeySlash + " or " + KeyCtrlF,
+		Description: "Search tasks",
+		Example:     "/login then Enter highlights every task mentioning login",
+	},
+	{
+		ID: ActionNextMatch + "/" + ActionPrevMatch, Title: "Next/previous match", Category: CategorySearch, Contexts: mainContext,
+		Keys: []string{KeyN, KeyNCap}, Description: "Next/previous search match",
+		Example: "n selects the next matching task, N the previous one",
+	},
+	{
+		ID: ActionClearSearch, Title: "Clear search", Category: CategorySearch, Contexts: mainContext,
+		Keys: []string{KeyCtrlX, KeyCtrlL}, Description: "Clear search",
+		Example: "ctrl+x removes the highlights of the last search",
+	},
+
+	// Task Management
+	{
+		ID: ActionSortForward + "/" + ActionSortBackward, Title: "Sort", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyS, KeySCap}, Description: "Sort tasks by different criteria",
+		Example: "s cycles status+priority → priority → time → alphabetical → recent; S goes back",
+	},
+	{
+		ID: ActionChangeStatus, Title: "Change status", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyT}, Description: "Change task status (Todo/Doing/Review/Done)",
+		Example: "t then pick Doing to start working on the selected task",
+	},
+	{
+		ID: ActionVisualMode, Title: "Mark tasks", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyV}, Description: "Visual mode: j/k mark tasks, t changes the status of all marked tasks",
+		Example: "v, j j j, t, done moves four tasks to done; Esc clears the marks",
+	},
+	{
+		ID: ActionEditTask, Title: "Edit task", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyE}, Description: "Edit task properties (status/priority/feature)",
+		Example: "e to change the selected task's status, priority and feature in one place",
+	},
+	{
+		ID: ActionCreateTask, Title: "New task", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyI}, Description: "Create a task in the selected project",
+		Example: "i, type a title, Tab to the other fields, Enter to create and select it",
+	},
+	{
+		ID: ActionEditInEditor, Title: "Edit in $EDITOR", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyECap}, Description: "Edit task fields as YAML in $EDITOR",
+		Example: "E opens the task as YAML; save and quit to preview the changes",
+	},
+	{
+		ID: ActionDeleteTask, Title: "Delete task", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyD}, Description: "Delete/archive task (with confirmation)",
+		Example: "d then confirm to archive the selected task",
+	},
+	{
+		ID: ActionUndo, Title: "Undo", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyCtrlZ}, Description: "Revert the last status, feature or priority change or deletion",
+		Example: "ctrl+z after moving a task to done by mistake moves it back; a deleted task is re-created with a new ID",
+	},
+	{
+		ID: ActionCopyID, Title: "Copy task ID", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyY}, Description: "Copy task ID to clipboard (yank)",
+		Example: "y copies the selected task's ID, e.g. to paste into a commit message",
+	},
+	{
+		ID: ActionCopyTitle, Title: "Copy task title", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyYCap}, Description: "Copy task title to clipboard (yank)",
+		Example: "Y copies the selected task's title",
+	},
+	{
+		ID: ActionCopyCommitRef, Title: "Copy commit reference", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyC}, Description: "Copy task as commit reference",
+		Example: "c copies the task formatted by ui.clipboard.commit_template, ready for git commit -m",
+	},
+	{
+		ID: ActionOpenLink, Title: "Open link", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyO}, Description: "Open related link (integrations.links)",
+		Example: "o on a task mentioning #123 opens the matching PR in the browser",
+	},
+	{
+		ID: ActionCopyPath, Title: "Copy task path", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyB}, Description: "Copy parent → child task path",
+		Example: "b on a subtask copies \"Parent › Child\"",
+	},
+	{
+		ID: ActionCopyCommand, Title: "Copy view as command", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyCCap}, Description: "Copy the current filters and sort as a lazyarchon list command",
+		Example: "C while filtering Web by doing copies \"lazyarchon list --project <id> --status doing --sort priority\"",
+	},
+	{
+		ID: ActionSelectFeatures, Title: "Filter features", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyF}, Description: "Filter tasks by feature",
+		Example: "f then space to pick the features to show",
+	},
+	{
+		ID: ActionQuickFeature, Title: "Quick feature filter", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyFCap}, Description: "Filter to selected task's feature (toggle)",
+		Example: "F shows only tasks sharing the selected task's feature; F again restores the filter",
+	},
+	{
+		ID: ActionSelectTags, Title: "Filter tags", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyCtrlT}, Description: "Filter tasks by tag",
+		Example: "ctrl+t then space to pick the tags to show; untagged tasks stay visible",
+	},
+	{
+		ID: ActionCreatedToday, Title: "Created today", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyTCap}, Description: "Show tasks created today, newest first (toggle)",
+		Example: "T to review what was filed today; T again to go back",
+	},
+	{
+		ID: ActionToggleDesc, Title: "Expand description", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyX}, Description: "Show more/less of a long description",
+		Example: "x expands a description collapsed by description_max_lines",
+	},
+	{
+		ID: ActionDescDiff, Title: "Description changes", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyDCap}, Description: "Show description changes since last viewed",
+		Example: "D when the details panel says the description changed; Enter marks it as seen",
+	},
+	{
+		ID: ActionReloadTask, Title: "Reload task", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyRCap}, Description: "Reload the selected task only",
+		Example: "R fetches the latest version of a task you are watching without reloading the list",
+	},
+	{
+		ID: ActionSprintFilter, Title: "Current sprint", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyW}, Description: "Show only the current sprint (toggle, ui.sprint)",
+		Example: "w shows the tasks of sprint-12 when it is the current sprint; w again restores the filter",
+	},
+	{
+		ID: ActionSprintOverview, Title: "Sprint overview", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyWCap}, Description: "Sprint totals and burndown; roll over unfinished tasks",
+		Example: "W then Enter moves the open tasks of sprint-12 to sprint-13 after a preview",
+	},
+	{
+		ID: ActionArchiveDone, Title: "Archive done", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyXCap}, Description: "Archive the done tasks in view (ui.display.archive_done)",
+		Example: "X at the end of a sprint archives every done task shown, after confirmation",
+	},
+	{
+		ID: ActionToggleCount, Title: "Toggle count", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyHash}, Description: "Lead the status bar count with the shown or the total tasks",
+		Example: "# switches \"12 of 57 tasks\" to \"57 tasks (12 shown)\" while a filter is on",
+	},
+	{
+		ID: ActionProjectLabels, Title: "Project labels", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyPCap}, Description: "Show/hide each task's project in All Tasks",
+		Example: "P hides \"(Backend)\" after the titles to leave the row to feature and tags",
+	},
+	{
+		ID: ActionToggleDetails, Title: "Toggle details", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyZ}, Description: "Hide/show the details panel, giving its width to the task list",
+		Example: "z on a narrow terminal shows the task list alone; z or l brings the details back",
+	},
+	{
+		ID: ActionRaisePriority, Title: "Raise min priority", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyPlus}, Description: "Hide the lowest priority level still shown",
+		Example: "+ hides low priority tasks, + again leaves only high priority ones",
+	},
+	{
+		ID: ActionLowerPriority, Title: "Lower min priority", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyMinus}, Description: "Show the next lower priority level again",
+		Example: "- after + shows the low priority tasks again",
+	},
+	{
+		ID: ActionChangedOnly, Title: "Changed tasks", Category: CategoryTask, Contexts: mainContext,
+		Keys: []string{KeyU}, Description: "Show only the tasks added or changed by the last refresh (toggle)",
+		Example: "u after a poll shows what teammates just moved; Esc or the next refresh shows all tasks",
+	},
+
+	// Application Controls
+	{
+		ID: ActionRefresh, Title: "Refresh", Category: CategoryApplication, Contexts: mainContext,
+		Keys:        []string{KeyR, KeyF5},
+		KeyLabel:    KeyR + " or " + KeyF5,
+		Description: "Refresh data from API",
+		Example:     "r reloads projects and tasks from the server",
+	},
+	{
+		ID: ActionQuit, Title: "Quit", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyQ}, Description: "Quit application",
+		Example: "q closes the open modal, or quits as set by quit_behavior",
+	},
+	{
+		ID: ActionForceQuit, Title: "Force quit", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyCtrlC}, Description: "Quit immediately",
+		Example: "ctrl+c quits from anywhere without asking",
+	},
+	{
+		ID: ActionToggleHelp, Title: "Help", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyQuestion}, Description: "Toggle this help",
+		Example: "? shows this list; ? again closes it",
+	},
+	{
+		ID: ActionToggleHints, Title: "Shortcut hints", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyBang}, Description: "Show/hide the status bar shortcut hints",
+		Example: "! hides \"/: search | ?: help\" to leave the status bar to counts and filters",
+	},
+	{
+		ID: ActionAwayDigest, Title: "Away digest", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyACap}, Description: "Show away digest",
+		Example: "A lists what changed since you last ran lazyarchon",
+	},
+	{
+		ID: ActionUnblockReport, Title: "Unblock report", Category: CategoryApplication, Contexts: mainContext,
+		Keys: []string{KeyUCap}, Description: "What should I unblock?",
+		Example: "U ranks the tasks whose completion unblocks the most others",
+	},
+
+	// Help Navigation
+	{
+		ID: ActionDown1 + "/" + ActionUp1, Title: "Scroll help", Category: CategoryNavigation, Contexts: []string{ContextHelpModal},
+		Keys: []string{KeyJ, KeyK}, Description: "Scroll help (1 line)",
+		Example: "j scrolls this help down one line",
+	},
+	{
+		ID: ActionDown4 + "/" + ActionUp4, Title: "Fast scroll help", Category: CategoryNavigation, Contexts: []string{ContextHelpModal},
+		Keys: []string{KeyJCap, KeyKCap}, Description: "Fast scroll help (4 lines)",
+		Example: "J scrolls this help down four lines",
+	},
+	{
+		ID: ActionHalfUp + "/" + ActionHalfDown, Title: "Half-page scroll help", Category: CategoryNavigation, Contexts: []string{ContextHelpModal},
+		Keys: []string{KeyCtrlU, KeyCtrlD}, Description: "Half-page scroll help",
+		Example: "ctrl+d scrolls this help by half a page",
+	},
+	{
+		ID: ActionTop + "/" + ActionBottom, Title: "Help top/bottom", Category: CategoryNavigation, Contexts: []string{ContextHelpModal},
+		Keys: []string{KeyGG, KeyGCap}, Description: "Jump to help top/bottom",
+		Example: "G jumps to the end of this help",
+	},
+
+	// Modals
+	{
+		ID: ActionClose, Title: "Close", Category: CategoryModal, Contexts: []string{ContextModal},
+		Keys: []string{KeyQuestion, KeyEscape, KeyQ}, Description: "Close modal",
+		Example: "Esc closes any modal without applying it",
+	},
+}
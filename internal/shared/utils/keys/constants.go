@@ -14,15 +14,18 @@ const (
 
 	// Refresh and Retry Operations
 	KeyR  = "r"  // Refresh data or retry failed operations
-	KeyF5 = "F5" // Alternative refresh key
+	KeyF5 = "f5" // Alternative refresh key (Bubble Tea names function keys in lower case)
 
 	// Mode Control Keys
 	KeyP     = "p"     // Activate project selection mode
 	KeyA     = "a"     // Show all tasks (exit project filtering)
+	KeyACap  = "A"     // Show the away digest again
+	KeyUCap  = "U"     // Show the unblock report
 	KeyEnter = "enter" // General confirmation/selection
 
 	// Help and Information
 	KeyQuestion = "?" // Toggle help modal
+	KeyBang     = "!" // Show/hide the status bar shortcut hints
 )
 
 // Navigation Keys
@@ -58,6 +61,18 @@ const (
 	KeyCtrlD = "ctrl+d" // Half-page down
 	KeyPgUp  = "pgup"   // Page up (alternative)
 	KeyPgDn  = "pgdown" // Page down (alternative)
+
+	// Hierarchy Navigation
+	KeyBracketLeft  = "[" // Jump to parent task
+	KeyBracketRight = "]" // Jump to first child task
+
+	// Bookmarks (vim-style marks, followed by a slot key)
+	KeyM          = "m" // Bookmark selected task in a slot
+	KeyApostrophe = "'" // Jump to the task in a slot
+	KeyMCap       = "M" // List bookmarks
+
+	// Jump to the task whose ID or URL is on the clipboard
+	KeyCtrlG = "ctrl+g"
 )
 
 // Search and Filter Keys
@@ -81,18 +96,45 @@ const (
 // These keys control task-specific operations
 const (
 	// Task Status and Editing
-	KeyT = "t" // Open task status change modal
-	KeyE = "e" // Open task edit modal
-	KeyD = "d" // Delete/archive task
+	KeyT    = "t" // Open task status change modal
+	KeyV    = "v" // Mark tasks for a bulk status change (visual mode)
+	KeyE    = "e" // Open task edit modal
+	KeyI    = "i" // Create a task in the selected project
+	KeyECap = "E" // Edit task fields as YAML in $EDITOR
+	KeyD    = "d" // Delete/archive task
+
+	// Revert the most recent status, feature or priority change or deletion
+	KeyCtrlZ = "ctrl+z"
 
 	// Copy Operations (Yank in vim terminology)
 	KeyY    = "y" // Copy task ID (yank)
 	KeyYCap = "Y" // Copy task title (yank title)
+	KeyC    = "c" // Copy task as commit reference
+	KeyB    = "b" // Copy task breadcrumb path (Parent › Child)
+	KeyCCap = "C" // Copy the current view as a "lazyarchon list" command
+
+	// Integrations
+	KeyO = "o" // Open related link (PR, branch, ticket)
 
 	// Task Organization
-	KeyF    = "f" // Open feature selection modal
-	KeyS    = "s" // Cycle sort mode forward
-	KeySCap = "S" // Cycle sort mode backward
+	KeyF     = "f"      // Open feature selection modal
+	KeyFCap  = "F"      // Toggle filter to the selected task's feature
+	KeyTCap  = "T"      // Toggle quick view of tasks created today
+	KeyX     = "x"      // Show more/less of a collapsed description
+	KeyDCap  = "D"      // Show description changes since last viewed
+	KeyRCap  = "R"      // Reload the selected task only
+	KeyW     = "w"      // Toggle filter to the current sprint
+	KeyWCap  = "W"      // Show the sprint overview and roll over unfinished tasks
+	KeyXCap  = "X"      // Archive (or hide) the done tasks in view
+	KeyS     = "s"      // Cycle sort mode forward
+	KeySCap  = "S"      // Cycle sort mode backward
+	KeyHash  = "#"      // Toggle which count leads the status bar when filtered
+	KeyPCap  = "P"      // Show/hide project labels in All Tasks
+	KeyZ     = "z"      // Hide/show the task details panel
+	KeyPlus  = "+"      // Raise the minimum priority shown
+	KeyMinus = "-"      // Lower the minimum priority shown
+	KeyU     = "u"      // Toggle the tasks changed by the last refresh
+	KeyCtrlT = "ctrl+t" // Open tag filter modal
 )
 
 // Modal and Special Input Keys
@@ -113,6 +155,7 @@ const (
 const (
 	CategoryApplication = "application"
 	CategoryNavigation  = "navigation"
+	CategoryProject     = "project"
 	CategorySearch      = "search"
 	CategoryTask        = "task"
 	CategoryModal       = "modal"
@@ -122,14 +165,17 @@ const (
 // These provide semantic meaning for key operations
 const (
 	// Application Actions
-	ActionQuit         = "quit"
-	ActionForceQuit    = "force_quit"
-	ActionRefresh      = "refresh"
-	ActionProjectMode  = "project_mode"
-	ActionShowAllTasks = "show_all_tasks"
-	ActionEscape       = "escape"
-	ActionConfirm      = "confirm"
-	ActionToggleHelp   = "toggle_help"
+	ActionQuit          = "quit"
+	ActionForceQuit     = "force_quit"
+	ActionRefresh       = "refresh"
+	ActionProjectMode   = "project_mode"
+	ActionShowAllTasks  = "show_all_tasks"
+	ActionEscape        = "escape"
+	ActionConfirm       = "confirm"
+	ActionToggleHelp    = "toggle_help"
+	ActionToggleHints   = "toggle_hints"
+	ActionAwayDigest    = "away_digest"
+	ActionUnblockReport = "unblock_report"
 
 	// Navigation Actions
 	ActionMoveUp         = "move_up"
@@ -142,6 +188,13 @@ const (
 	ActionFastScrollUp   = "fast_scroll_up"
 	ActionHalfPageUp     = "half_page_up"
 	ActionHalfPageDown   = "half_page_down"
+	ActionGoParent       = "go_parent"
+	ActionGoFirstChild   = "go_first_child"
+	ActionJumpToNumber   = "jump_to_number"
+	ActionSetBookmark    = "set_bookmark"
+	ActionJumpBookmark   = "jump_bookmark"
+	ActionListBookmarks  = "list_bookmarks"
+	ActionJumpClipboard  = "jump_clipboard"
 
 	// Search Actions
 	ActionActivateSearch = "activate_search"
@@ -152,12 +205,35 @@ const (
 	// Task Actions
 	ActionChangeStatus   = "change_status"
 	ActionEditTask       = "edit_task"
+	ActionVisualMode     = "visual_mode"
+	ActionCreateTask     = "create_task"
+	ActionEditInEditor   = "edit_in_editor"
 	ActionDeleteTask     = "delete_task"
+	ActionUndo           = "undo"
 	ActionCopyID         = "copy_id"
 	ActionCopyTitle      = "copy_title"
+	ActionCopyCommitRef  = "copy_commit_ref"
+	ActionCopyPath       = "copy_path"
+	ActionCopyCommand    = "copy_command"
+	ActionOpenLink       = "open_link"
 	ActionSelectFeatures = "select_features"
+	ActionQuickFeature   = "quick_feature_filter"
+	ActionSelectTags     = "select_tags"
+	ActionCreatedToday   = "created_today"
+	ActionToggleDesc     = "toggle_description"
+	ActionDescDiff       = "description_diff"
+	ActionReloadTask     = "reload_task"
+	ActionSprintFilter   = "sprint_filter"
+	ActionSprintOverview = "sprint_overview"
+	ActionArchiveDone    = "archive_done"
 	ActionSortForward    = "sort_forward"
 	ActionSortBackward   = "sort_backward"
+	ActionToggleCount    = "toggle_count"
+	ActionProjectLabels  = "project_labels"
+	ActionToggleDetails  = "toggle_details"
+	ActionRaisePriority  = "raise_min_priority"
+	ActionLowerPriority  = "lower_min_priority"
+	ActionChangedOnly    = "changed_only"
 
 	// Modal Actions
 	ActionToggle = "toggle"
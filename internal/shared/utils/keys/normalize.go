@@ -0,0 +1,31 @@
+package keys
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Normalize maps a key as Bubble Tea reports it (tea.KeyMsg.String()) to the
+// name the key constants use, smoothing over platform differences
+func Normalize(key string) string {
+	return normalize(key, altGrReportsAlt)
+}
+
+// normalize does the work of Normalize. With altGr set, "alt+" in front of a
+// symbol is dropped: the Windows console reports AltGr as Ctrl+Alt, so on
+// layouts that type [ ] { } @ / with AltGr those symbols arrive as "alt+[".
+// Letters and digits keep the modifier, Alt+letter is a chord of its own.
+func normalize(key string, altGr bool) string {
+	if !altGr {
+		return key
+	}
+	symbol, ok := strings.CutPrefix(key, "alt+")
+	if !ok || utf8.RuneCountInString(symbol) != 1 {
+		return key
+	}
+	r, _ := utf8.DecodeRuneInString(symbol)
+	if r < utf8.RuneSelf && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+		return key
+	}
+	return symbol
+}
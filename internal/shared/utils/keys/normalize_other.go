@@ -0,0 +1,7 @@
+//go:build !windows
+
+package keys
+
+// altGrReportsAlt is set where AltGr symbols arrive with the Alt modifier.
+// Unix terminals send the composed character on its own.
+const altGrReportsAlt = false
@@ -0,0 +1,46 @@
+package keys
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		key   string
+		altGr bool
+		want  string
+	}{
+		{"alt+[", true, "["},
+		{"alt+]", true, "]"},
+		{"alt+@", true, "@"},
+		{"alt+€", true, "€"},
+		{"alt+j", true, "alt+j"},
+		{"alt+7", true, "alt+7"},
+		{"alt+enter", true, "alt+enter"},
+		{"ctrl+u", true, "ctrl+u"},
+		{"alt+[", false, "alt+["},
+		{"f5", false, "f5"},
+	}
+	for _, tt := range tests {
+		if got := normalize(tt.key, tt.altGr); got != tt.want {
+			t.Errorf("normalize(%q, %v) = %q, want %q", tt.key, tt.altGr, got, tt.want)
+		}
+	}
+}
+
+func TestKeyNamesMatchBubbleTea(t *testing.T) {
+	tests := map[string]tea.KeyMsg{
+		KeyF5:        {Type: tea.KeyF5},
+		KeyCtrlC:     {Type: tea.KeyCtrlC},
+		KeyEscape:    {Type: tea.KeyEscape},
+		KeyPgDn:      {Type: tea.KeyPgDown},
+		KeyBackspace: {Type: tea.KeyBackspace},
+	}
+	for want, msg := range tests {
+		if got := Normalize(msg.String()); got != want {
+			t.Errorf("Expected %v to arrive as %q, got %q", msg.Type, want, got)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build windows
+
+package keys
+
+// altGrReportsAlt is set where AltGr symbols arrive with the Alt modifier
+const altGrReportsAlt = true
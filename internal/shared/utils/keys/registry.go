@@ -32,11 +32,8 @@ func NewKeyRegistry(keybindingsConfig interface{}) *KeyRegistry {
 		actionToKey:     make(map[string]string),
 	}
 
-	// Register all key bindings with defaults
-	registry.registerMainContextBindings()
-	registry.registerHelpModalBindings()
-	registry.registerProjectModeBindings()
-	registry.registerModalBindings()
+	// Register the default keys of every action
+	registry.registerActions()
 
 	// Apply custom keybindings if provided
 	if keybindingsConfig != nil {
@@ -97,11 +94,12 @@ func (r *KeyRegistry) GetHelpSections() []HelpSection {
 		Context  string
 		Priority int
 	}{
-		{CategoryNavigation, "Panel Navigation", "main", 1},
-		{CategoryApplication, "Project Management", "main", 2},
-		{CategoryTask, "Task Management", "main", 3},
-		{CategoryApplication, "Application Controls", "main", 4},
-		{CategoryNavigation, "Help Navigation", "help_modal", 5},
+		{CategoryNavigation, "Panel Navigation", ContextMain, 1},
+		{CategoryProject, "Project Management", ContextMain, 2},
+		{CategorySearch, "Search", ContextMain, 3},
+		{CategoryTask, "Task Management", ContextMain, 4},
+		{CategoryApplication, "Application Controls", ContextMain, 5},
+		{CategoryNavigation, "Help Navigation", ContextHelpModal, 6},
 	}
 
 	for _, config := range sectionConfigs {
@@ -116,9 +114,8 @@ func (r *KeyRegistry) GetHelpSections() []HelpSection {
 		}
 	}
 
-	// Add visual indicators and task status sections
+	// Add visual indicators (task status symbols are rendered by the help modal with theme styling)
 	sections = append(sections, r.getVisualIndicatorsSection())
-	sections = append(sections, r.getTaskStatusSection())
 
 	return sections
 }
@@ -140,136 +137,35 @@ func (r *KeyRegistry) addBinding(context string, binding KeyBinding) {
 	// Add to context bindings
 	r.contextBindings[context] = append(r.contextBindings[context], binding)
 
-	// Add to lookup maps
-	r.keyToAction[binding.Key] = binding.Action
-	r.actionToKey[binding.Action] = binding.Key
-}
-
-// registerMainContextBindings registers bindings for the main application context
-func (r *KeyRegistry) registerMainContextBindings() {
-	context := "main"
-
-	// Panel Navigation
-	r.addBinding(context, KeyBinding{
-		Key: KeyH + "/" + KeyL, Action: ActionMoveLeft + "/" + ActionMoveRight,
-		Category: CategoryNavigation, Description: "Switch between panels", Priority: 1,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyArrowUp + "/" + KeyArrowDown + " or " + KeyJ + "/" + KeyK, Action: ActionMoveUp + "/" + ActionMoveDown,
-		Category: CategoryNavigation, Description: "Navigate/scroll (1 line)", Priority: 2,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyJCap + "/" + KeyKCap, Action: ActionFastScrollDown + "/" + ActionFastScrollUp,
-		Category: CategoryNavigation, Description: "Fast scroll (4 lines)", Priority: 3,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyCtrlU + "/" + KeyCtrlD, Action: ActionHalfPageUp + "/" + ActionHalfPageDown,
-		Category: CategoryNavigation, Description: "Half-page scroll", Priority: 4,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyGG + "/" + KeyGCap, Action: ActionJumpFirst + "/" + ActionJumpLast,
-		Category: CategoryNavigation, Description: "Jump to top/bottom", Priority: 5,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyHome + "/" + KeyEnd, Action: ActionJumpFirst + "/" + ActionJumpLast,
-		Category: CategoryNavigation, Description: "Jump to start/end", Priority: 6,
-	})
-
-	// Project Management
-	r.addBinding(context, KeyBinding{
-		Key: KeyP, Action: ActionProjectMode,
-		Category: CategoryApplication, Description: "Project selection mode", Priority: 10,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyA, Action: ActionShowAllTasks,
-		Category: CategoryApplication, Description: "Show all tasks", Priority: 11,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyEnter, Action: ActionConfirm,
-		Category: CategoryApplication, Description: "Select project", Priority: 12,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyEscape, Action: ActionEscape,
-		Category: CategoryApplication, Description: "Exit project mode", Priority: 13,
-	})
-
-	// Task Management
-	r.addBinding(context, KeyBinding{
-		Key: KeyS + "/" + KeySCap, Action: ActionSortForward + "/" + ActionSortBackward,
-		Category: CategoryTask, Description: "Sort tasks by different criteria", Priority: 20,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyT, Action: ActionChangeStatus,
-		Category: CategoryTask, Description: "Change task status (Todo/Doing/Review/Done)", Priority: 21,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyE, Action: ActionEditTask,
-		Category: CategoryTask, Description: "Edit task properties (status/priority/feature)", Priority: 22,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyD, Action: ActionDeleteTask,
-		Category: CategoryTask, Description: "Delete/archive task (with confirmation)", Priority: 23,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyY, Action: ActionCopyID,
-		Category: CategoryTask, Description: "Copy task ID to clipboard (yank)", Priority: 24,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyYCap, Action: ActionCopyTitle,
-		Category: CategoryTask, Description: "Copy task title to clipboard (yank)", Priority: 25,
-	})
-
-	// Application Controls
-	r.addBinding(context, KeyBinding{
-		Key: KeyR + " or " + KeyF5, Action: ActionRefresh,
-		Category: CategoryApplication, Description: "Refresh data from API", Priority: 30,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyQ, Action: ActionQuit,
-		Category: CategoryApplication, Description: "Quit application", Priority: 31,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyQuestion, Action: ActionToggleHelp,
-		Category: CategoryApplication, Description: "Toggle this help", Priority: 32,
-	})
-}
-
-// registerHelpModalBindings registers bindings specific to the help modal
-func (r *KeyRegistry) registerHelpModalBindings() {
-	context := "help_modal"
-
-	r.addBinding(context, KeyBinding{
-		Key: KeyJ + "/" + KeyK, Action: ActionDown1 + "/" + ActionUp1,
-		Category: CategoryNavigation, Description: "Scroll help (1 line)", Priority: 1,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyJCap + "/" + KeyKCap, Action: ActionDown4 + "/" + ActionUp4,
-		Category: CategoryNavigation, Description: "Fast scroll help (4 lines)", Priority: 2,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyCtrlU + "/" + KeyCtrlD, Action: ActionHalfUp + "/" + ActionHalfDown,
-		Category: CategoryNavigation, Description: "Half-page scroll help", Priority: 3,
-	})
-	r.addBinding(context, KeyBinding{
-		Key: KeyGG + "/" + KeyGCap, Action: ActionTop + "/" + ActionBottom,
-		Category: CategoryNavigation, Description: "Jump to help top/bottom", Priority: 4,
-	})
-}
-
-// registerProjectModeBindings registers bindings for project selection mode
-func (r *KeyRegistry) registerProjectModeBindings() {
-	// Project mode inherits most navigation bindings from main context
-	// We can add project-specific ones here if needed
+	// Add to lookup maps (the first context registering a key or action wins)
+	if _, exists := r.keyToAction[binding.Key]; !exists {
+		r.keyToAction[binding.Key] = binding.Action
+	}
+	if _, exists := r.actionToKey[binding.Action]; !exists {
+		r.actionToKey[binding.Action] = binding.Key
+	}
 }
 
-// registerModalBindings registers common modal bindings
-func (r *KeyRegistry) registerModalBindings() {
-	context := "modal"
-
-	r.addBinding(context, KeyBinding{
-		Key: KeyQuestion + "/" + KeyEscape + "/" + KeyQ, Action: ActionClose,
-		Category: CategoryModal, Description: "Close modal", Priority: 1,
-	})
+// registerActions registers the default keys of every action in the actions
+// table, one binding per action and context. Each of the action's keys maps
+// back to it for GetActionForKey.
+func (r *KeyRegistry) registerActions() {
+	for i, action := range actions {
+		for _, context := range action.Contexts {
+			r.addBinding(context, KeyBinding{
+				Key:         action.Label(),
+				Action:      action.ID,
+				Category:    action.Category,
+				Description: action.Description,
+				Priority:    i + 1,
+			})
+			for _, key := range action.Keys {
+				if _, exists := r.keyToAction[key]; !exists {
+					r.keyToAction[key] = action.ID
+				}
+			}
+		}
+	}
 }
 
 // getFilteredBindings returns bindings matching category and context
@@ -299,17 +195,3 @@ func (r *KeyRegistry) getVisualIndicatorsSection() HelpSection {
 		},
 	}
 }
-
-// getTaskStatusSection creates the task status symbols help section
-func (r *KeyRegistry) getTaskStatusSection() HelpSection {
-	return HelpSection{
-		Title:    "Task Status Symbols",
-		Priority: 101,
-		Bindings: []KeyBinding{
-			{Description: "○  Todo       Not started"},
-			{Description: "◐  Doing      In progress"},
-			{Description: "◈  Review     Under review"},
-			{Description: "✓  Done       Completed"},
-		},
-	}
-}
@@ -190,3 +190,38 @@ func TestHelpSection_Structure(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyRegistry_ActionsTable(t *testing.T) {
+	registry := NewKeyRegistry(nil)
+
+	// Every key of an action maps back to it, not just the first
+	tests := map[string]string{
+		KeyF5:     ActionRefresh,
+		KeyPgDn:   ActionHalfPageUp + "/" + ActionHalfPageDown,
+		"7":       ActionJumpToNumber,
+		KeyRCap:   ActionReloadTask,
+		KeyCtrlF:  ActionActivateSearch,
+		KeyEscape: ActionEscape, // main context wins over the modal close binding
+	}
+	for key, want := range tests {
+		if got := registry.GetActionForKey(key); got != want {
+			t.Errorf("GetActionForKey(%q) = %q, expected %q", key, got, want)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, action := range Actions() {
+		for _, context := range action.Contexts {
+			for _, key := range action.Keys {
+				if seen[context+" "+key] {
+					t.Errorf("Key %q is bound twice in context %s", key, context)
+				}
+				seen[context+" "+key] = true
+			}
+		}
+	}
+
+	if action, ok := ActionByID(ActionCopyID); !ok || action.Label() != KeyY {
+		t.Errorf("Expected copy_id bound to y, got %+v", action)
+	}
+}
@@ -0,0 +1,97 @@
+// Package paths resolves where lazyarchon looks for its files on each
+// operating system.
+//
+// The functions take the GOOS value and an environment lookup instead of
+// reading runtime.GOOS and os.Getenv, so the rules for every platform can be
+// tested on any host.
+package paths
+
+import (
+	"path"
+	"strings"
+)
+
+// AppName is the directory name used under the per-user and system config roots
+const AppName = "lazyarchon"
+
+// ConfigFileName is the name of the config file in every search location
+const ConfigFileName = "config.yaml"
+
+// ConfigSearchPaths returns the config file locations in order of preference:
+// the working directory first, then the user's config directory, then the
+// system-wide one. Locations whose root is not set in the environment are
+// skipped.
+//
+//   - Windows: %APPDATA%\lazyarchon, then %ProgramData%\lazyarchon
+//   - macOS and other Unix: $XDG_CONFIG_HOME/lazyarchon, then
+//     $HOME/.config/lazyarchon, then /etc/lazyarchon
+func ConfigSearchPaths(goos string, getenv func(string) string) []string {
+	search := []string{
+		"./" + ConfigFileName,
+		"./configs/default.yaml",
+	}
+	for _, dir := range configDirs(goos, getenv) {
+		search = append(search, join(goos, dir, AppName, ConfigFileName))
+	}
+	return search
+}
+
+// configDirs returns the user and system config roots that are set
+func configDirs(goos string, getenv func(string) string) []string {
+	var dirs []string
+	add := func(dir string) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	if goos == "windows" {
+		add(getenv("APPDATA"))
+		add(getenv("ProgramData"))
+		return dirs
+	}
+
+	xdg := getenv("XDG_CONFIG_HOME")
+	add(xdg)
+	if home := getenv("HOME"); home != "" && join(goos, home, ".config") != path.Clean(xdg) {
+		add(join(goos, home, ".config"))
+	}
+	add("/etc")
+	return dirs
+}
+
+// DefaultLogFile returns the log file used when neither --log-file nor
+// LAZYARCHON_LOG_FILE is set: lazyarchon.log in the OS temp directory
+// (%TEMP% on Windows, $TMPDIR or /tmp elsewhere)
+func DefaultLogFile(goos string, getenv func(string) string) string {
+	return join(goos, tempDir(goos, getenv), AppName+".log")
+}
+
+// tempDir mirrors os.TempDir for the given OS
+func tempDir(goos string, getenv func(string) string) string {
+	if goos == "windows" {
+		for _, name := range []string{"TMP", "TEMP", "USERPROFILE"} {
+			if dir := getenv(name); dir != "" {
+				return dir
+			}
+		}
+		return getenv("SystemRoot")
+	}
+	if dir := getenv("TMPDIR"); dir != "" {
+		return dir
+	}
+	return "/tmp"
+}
+
+// join joins path elements with the separator of goos, so results for
+// another OS are spelled the way that OS expects
+func join(goos string, elem ...string) string {
+	if goos != "windows" {
+		return path.Join(elem...)
+	}
+	trimmed := make([]string, len(elem))
+	for i, e := range elem {
+		trimmed[i] = strings.TrimRight(e, `\/`)
+	}
+	return strings.Join(trimmed, `\`)
+}
@@ -0,0 +1,99 @@
+package paths
+
+import (
+	"reflect"
+	"testing"
+)
+
+func envOf(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestConfigSearchPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want []string
+	}{
+		{
+			name: "windows",
+			goos: "windows",
+			env:  map[string]string{"APPDATA": `C:\Users\ada\AppData\Roaming\`, "ProgramData": `C:\ProgramData`, "HOME": "/ignored"},
+			want: []string{
+				"./config.yaml",
+				"./configs/default.yaml",
+				`C:\Users\ada\AppData\Roaming\lazyarchon\config.yaml`,
+				`C:\ProgramData\lazyarchon\config.yaml`,
+			},
+		},
+		{
+			name: "windows without APPDATA",
+			goos: "windows",
+			env:  map[string]string{"ProgramData": `C:\ProgramData`},
+			want: []string{"./config.yaml", "./configs/default.yaml", `C:\ProgramData\lazyarchon\config.yaml`},
+		},
+		{
+			name: "linux",
+			goos: "linux",
+			env:  map[string]string{"HOME": "/home/ada"},
+			want: []string{
+				"./config.yaml",
+				"./configs/default.yaml",
+				"/home/ada/.config/lazyarchon/config.yaml",
+				"/etc/lazyarchon/config.yaml",
+			},
+		},
+		{
+			name: "linux with XDG_CONFIG_HOME",
+			goos: "linux",
+			env:  map[string]string{"HOME": "/home/ada", "XDG_CONFIG_HOME": "/data/config"},
+			want: []string{
+				"./config.yaml",
+				"./configs/default.yaml",
+				"/data/config/lazyarchon/config.yaml",
+				"/home/ada/.config/lazyarchon/config.yaml",
+				"/etc/lazyarchon/config.yaml",
+			},
+		},
+		{
+			name: "darwin with XDG_CONFIG_HOME at the default",
+			goos: "darwin",
+			env:  map[string]string{"HOME": "/Users/ada", "XDG_CONFIG_HOME": "/Users/ada/.config/"},
+			want: []string{
+				"./config.yaml",
+				"./configs/default.yaml",
+				"/Users/ada/.config/lazyarchon/config.yaml",
+				"/etc/lazyarchon/config.yaml",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConfigSearchPaths(tt.goos, envOf(tt.env)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConfigSearchPaths() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultLogFile(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want string
+	}{
+		{"windows TMP", "windows", map[string]string{"TMP": `C:\Temp`, "TEMP": `D:\Temp`}, `C:\Temp\lazyarchon.log`},
+		{"windows TEMP", "windows", map[string]string{"TEMP": `C:\Users\ada\AppData\Local\Temp`}, `C:\Users\ada\AppData\Local\Temp\lazyarchon.log`},
+		{"linux", "linux", nil, "/tmp/lazyarchon.log"},
+		{"darwin TMPDIR", "darwin", map[string]string{"TMPDIR": "/var/folders/xy/T/"}, "/var/folders/xy/T/lazyarchon.log"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultLogFile(tt.goos, envOf(tt.env)); got != tt.want {
+				t.Errorf("DefaultLogFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Client-side limits for editable task fields, shared by the task edit modal
+// and the YAML scratchpad
+const (
+	MinTaskPriority      = 0
+	MaxTaskPriority      = 999
+	MaxFeatureNameLength = 30
+)
+
+// ClampTaskPriority limits priority to MinTaskPriority..MaxTaskPriority
+func ClampTaskPriority(priority int) int {
+	return max(MinTaskPriority, min(MaxTaskPriority, priority))
+}
+
+// IsFeatureNameChar reports whether r may appear in a feature name:
+// ASCII letters, digits, '_', '-' and space
+func IsFeatureNameChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+		(r >= '0' && r <= '9') || r == '_' || r == '-' || r == ' '
+}
+
+// ValidateTaskTitle rejects blank titles
+func ValidateTaskTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return errors.New("title must not be empty")
+	}
+	return nil
+}
+
+// ValidateTaskStatus rejects statuses other than todo, doing, review and done
+func ValidateTaskStatus(status string) error {
+	statuses := NewTaskStatusUtils()
+	if !statuses.IsValidStatus(status) {
+		return fmt.Errorf("status %q is not one of %s", status, strings.Join(statuses.GetAllStatuses(), ", "))
+	}
+	return nil
+}
+
+// ValidateTaskPriority rejects priorities outside MinTaskPriority..MaxTaskPriority
+func ValidateTaskPriority(priority int) error {
+	if priority != ClampTaskPriority(priority) {
+		return fmt.Errorf("priority %d is outside %d-%d", priority, MinTaskPriority, MaxTaskPriority)
+	}
+	return nil
+}
+
+// ValidateFeatureName rejects feature names the task edit modal could not
+// create: too long or with characters other than IsFeatureNameChar.
+// The empty name is valid and means no feature.
+func ValidateFeatureName(name string) error {
+	if len(name) > MaxFeatureNameLength {
+		return fmt.Errorf("feature %q is longer than %d characters", name, MaxFeatureNameLength)
+	}
+	for _, r := range name {
+		if !IsFeatureNameChar(r) {
+			return fmt.Errorf("feature %q contains %q (allowed: letters, digits, '_', '-' and space)", name, r)
+		}
+	}
+	return nil
+}
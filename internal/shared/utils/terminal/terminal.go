@@ -0,0 +1,179 @@
+// Package terminal integrates lazyarchon with the hosting terminal window:
+// the window title and the OSC 9;4 progress indicator.
+//
+// Escape sequences are only emitted when the detected capabilities allow it,
+// and always through Output so they never land in the middle of a frame that
+// Bubble Tea is writing.
+package terminal
+
+import (
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// maxTitleRunes keeps titles short enough for tab bars
+const maxTitleRunes = 80
+
+// Escape sequences (XTWINOPS title stack and ConEmu/Windows Terminal progress)
+const (
+	seqSaveTitle     = "\x1b[22;0t"
+	seqRestoreTitle  = "\x1b[23;0t"
+	seqProgressBusy  = "\x1b]9;4;3;0\x07" // Indeterminate progress
+	seqProgressClear = "\x1b]9;4;0;0\x07"
+)
+
+// Capabilities describes what the output terminal can be asked to do
+type Capabilities struct {
+	TTY           bool // Output is an interactive terminal
+	Dumb          bool // TERM is "dumb", or unset outside Windows
+	Progress      bool // Terminal is known to understand OSC 9;4 progress
+	LegacyConsole bool // Windows console host without a modern terminal in front (no box-drawing glyphs)
+}
+
+// Detect inspects the output file and environment
+func Detect(out *os.File, getenv func(string) string) Capabilities {
+	return detect(runtime.GOOS, out != nil && term.IsTerminal(out.Fd()), getenv)
+}
+
+// detect derives the capabilities for goos. Windows terminals do not set
+// TERM, so an unset TERM only means a dumb terminal elsewhere; on Windows it
+// means the legacy console host unless a known terminal announces itself.
+func detect(goos string, tty bool, getenv func(string) string) Capabilities {
+	termName := getenv("TERM")
+	termProgram := getenv("TERM_PROGRAM")
+	modern := getenv("WT_SESSION") != "" || getenv("ConEmuANSI") == "ON"
+	windows := goos == "windows"
+	return Capabilities{
+		TTY:  tty,
+		Dumb: termName == "dumb" || (termName == "" && !windows),
+		Progress: modern ||
+			termProgram == "WezTerm" || termProgram == "ghostty",
+		LegacyConsole: windows && !modern && termName == "" && termProgram == "",
+	}
+}
+
+// CanSetTitle reports whether window title sequences are safe to emit
+func (c Capabilities) CanSetTitle() bool {
+	return c.TTY && !c.Dumb
+}
+
+// CanShowProgress reports whether progress sequences are safe to emit
+func (c Capabilities) CanShowProgress() bool {
+	return c.CanSetTitle() && c.Progress
+}
+
+// TitleInfo is the application context shown in the window title
+type TitleInfo struct {
+	Project string // Selected project title; empty means all tasks
+	Doing   int    // Tasks in progress
+}
+
+// ComposeTitle builds the window title, e.g. "lazyarchon — ProjectX · 3 doing".
+// Control characters from task data are dropped so they cannot inject escapes.
+func ComposeTitle(info TitleInfo) string {
+	project := sanitize(info.Project)
+	if project == "" {
+		project = "All Tasks"
+	}
+
+	title := "lazyarchon — " + project
+	if info.Doing > 0 {
+		title += " · " + strconv.Itoa(info.Doing) + " doing"
+	}
+
+	runes := []rune(title)
+	if len(runes) > maxTitleRunes {
+		title = string(runes[:maxTitleRunes-1]) + "…"
+	}
+	return title
+}
+
+// sanitize removes control characters and collapses surrounding whitespace
+func sanitize(text string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, text)
+	return strings.TrimSpace(cleaned)
+}
+
+// Output wraps the program's output file so out-of-band escape sequences
+// are serialized with Bubble Tea's frame writes. It still satisfies
+// Bubble Tea's terminal file interface, so TTY handling is unchanged.
+type Output struct {
+	*os.File
+	caps Capabilities
+	mu   sync.Mutex
+	busy bool
+}
+
+// NewOutput wraps file for use with tea.WithOutput
+func NewOutput(file *os.File, caps Capabilities) *Output {
+	return &Output{File: file, caps: caps}
+}
+
+// Capabilities returns the detected terminal capabilities
+func (o *Output) Capabilities() Capabilities {
+	return o.caps
+}
+
+// Write implements io.Writer, serialized with the escape helpers below
+func (o *Output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.File.Write(p)
+}
+
+// WriteString implements io.StringWriter; without it the embedded file's
+// unsynchronized WriteString would be used by io.WriteString
+func (o *Output) WriteString(s string) (int, error) {
+	return o.Write([]byte(s))
+}
+
+// SaveTitle pushes the current window title so RestoreTitle can bring it back.
+// Terminals without a title stack ignore the sequence.
+func (o *Output) SaveTitle() {
+	if o.caps.CanSetTitle() {
+		o.emit(seqSaveTitle)
+	}
+}
+
+// RestoreTitle pops the title saved by SaveTitle (best-effort)
+func (o *Output) RestoreTitle() {
+	if o.caps.CanSetTitle() {
+		o.emit(seqRestoreTitle)
+	}
+}
+
+// SetBusy shows or clears the indeterminate progress indicator
+func (o *Output) SetBusy(busy bool) {
+	if !o.caps.CanShowProgress() {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.busy == busy {
+		return
+	}
+	o.busy = busy
+	seq := seqProgressClear
+	if busy {
+		seq = seqProgressBusy
+	}
+	_, _ = o.File.WriteString(seq)
+}
+
+// emit writes a sequence between frames
+func (o *Output) emit(seq string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, _ = o.File.WriteString(seq)
+}
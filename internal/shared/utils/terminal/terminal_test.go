@@ -0,0 +1,128 @@
+package terminal
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestComposeTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     TitleInfo
+		expected string
+	}{
+		{"project with doing", TitleInfo{Project: "ProjectX", Doing: 3}, "lazyarchon — ProjectX · 3 doing"},
+		{"nothing in progress", TitleInfo{Project: "ProjectX"}, "lazyarchon — ProjectX"},
+		{"all tasks", TitleInfo{Doing: 1}, "lazyarchon — All Tasks · 1 doing"},
+		{"control characters dropped", TitleInfo{Project: "Evil\x1b]0;pwned\x07 "}, "lazyarchon — Evil]0;pwned"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComposeTitle(tt.info); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	long := ComposeTitle(TitleInfo{Project: strings.Repeat("é", 200), Doing: 2})
+	if utf8.RuneCountInString(long) != maxTitleRunes || !strings.HasSuffix(long, "…") {
+		t.Errorf("Expected title truncated to %d runes with ellipsis, got %q", maxTitleRunes, long)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	env := func(values map[string]string) func(string) string {
+		return func(name string) string { return values[name] }
+	}
+
+	// Test binaries never write to a terminal through a regular file
+	file, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	caps := Detect(file, env(map[string]string{"TERM": "xterm-256color", "WT_SESSION": "1"}))
+	if caps.TTY || caps.CanSetTitle() || caps.CanShowProgress() {
+		t.Errorf("Expected non-TTY output to disable everything, got %+v", caps)
+	}
+
+	dumb := detect("linux", true, env(map[string]string{"TERM": "dumb"}))
+	if dumb.CanSetTitle() {
+		t.Error("Expected dumb terminal to disable the title")
+	}
+
+	wezterm := detect("darwin", true, env(map[string]string{"TERM": "xterm", "TERM_PROGRAM": "WezTerm"}))
+	if !wezterm.CanSetTitle() || !wezterm.CanShowProgress() {
+		t.Errorf("Expected WezTerm to support title and progress, got %+v", wezterm)
+	}
+
+	unset := detect("linux", true, env(map[string]string{}))
+	if unset.CanSetTitle() {
+		t.Error("Expected unset TERM to be treated as dumb")
+	}
+}
+
+func TestDetectWindows(t *testing.T) {
+	env := func(values map[string]string) func(string) string {
+		return func(name string) string { return values[name] }
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		legacy   bool
+		progress bool
+	}{
+		{"console host", map[string]string{}, true, false},
+		{"Windows Terminal", map[string]string{"WT_SESSION": "0b5c"}, false, true},
+		{"ConEmu", map[string]string{"ConEmuANSI": "ON"}, false, true},
+		{"VS Code", map[string]string{"TERM_PROGRAM": "vscode"}, false, false},
+		{"mintty", map[string]string{"TERM": "xterm-256color"}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caps := detect("windows", true, env(tt.env))
+			if caps.LegacyConsole != tt.legacy {
+				t.Errorf("Expected LegacyConsole %v, got %+v", tt.legacy, caps)
+			}
+			if !caps.CanSetTitle() {
+				t.Errorf("Expected unset TERM not to count as dumb on Windows, got %+v", caps)
+			}
+			if caps.CanShowProgress() != tt.progress {
+				t.Errorf("Expected progress %v, got %+v", tt.progress, caps)
+			}
+		})
+	}
+
+	if caps := detect("linux", true, env(map[string]string{})); caps.LegacyConsole {
+		t.Errorf("Expected legacy console detection to be Windows only, got %+v", caps)
+	}
+}
+
+func TestOutputSkipsSequencesWithoutCapabilities(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	out := NewOutput(file, Capabilities{})
+	out.SaveTitle()
+	out.SetBusy(true)
+	out.RestoreTitle()
+	if _, err := out.WriteString("frame"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "frame" {
+		t.Errorf("Expected only frame output, got %q", data)
+	}
+}
@@ -1,9 +1,13 @@
 package view
 
 import (
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/rivo/uniseg"
 )
 
 // Highlighting module handles search highlighting and text styling
@@ -48,13 +52,9 @@ func IsColorConflictWithHighlight(textColor string) bool {
 
 // HighlightSearchTermsWithColor highlights search query matches while preserving specified text color
 func HighlightSearchTermsWithColor(text, query, textColor string) string {
-	if query == "" {
-		return text
-	}
-
-	// Trim and normalize query
-	query = strings.TrimSpace(query)
-	if query == "" {
+	// Split query into individual words for multi-word highlighting
+	queryWords := strings.Fields(query)
+	if len(queryWords) == 0 {
 		return text
 	}
 
@@ -66,63 +66,100 @@ func HighlightSearchTermsWithColor(text, query, textColor string) string {
 	// Style for non-highlighted text - foreground only (background handled by styling.RenderLine)
 	normalStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(textColor)) // Apply status color to non-highlighted parts
-		// Background removed: handled by styling.RenderLine()
 
-	// Split query into individual words for multi-word highlighting
-	queryWords := strings.Fields(strings.ToLower(query))
-	if len(queryWords) == 0 {
-		return text
+	// Matches of every word are located in the plain text and rendered in one
+	// pass, so later words never match inside the escape codes of earlier ones
+	var ranges [][2]int
+	for _, word := range queryWords {
+		ranges = append(ranges, MatchRanges(text, word)...)
 	}
+	ranges = mergeRanges(ranges)
 
-	result := text
-
-	// Highlight each word in the query
-	for _, word := range queryWords {
-		if word == "" {
-			continue
+	var highlighted strings.Builder
+	lastEnd := 0
+	for _, match := range ranges {
+		if beforeText := text[lastEnd:match[0]]; beforeText != "" {
+			highlighted.WriteString(normalStyle.Render(beforeText))
 		}
+		highlighted.WriteString(highlightStyle.Render(text[match[0]:match[1]])) // Original case preserved
+		lastEnd = match[1]
+	}
+	if remainingText := text[lastEnd:]; remainingText != "" {
+		highlighted.WriteString(normalStyle.Render(remainingText))
+	}
+	return highlighted.String()
+}
 
-		// Find all occurrences of this word (case-insensitive)
-		lowerText := strings.ToLower(result)
-		lowerWord := strings.ToLower(word)
-
-		var highlighted strings.Builder
-		lastEnd := 0
-
-		for {
-			// Find next occurrence
-			index := strings.Index(lowerText[lastEnd:], lowerWord)
-			if index == -1 {
-				// No more matches, append remaining text with status color
-				remainingText := result[lastEnd:]
-				if remainingText != "" {
-					highlighted.WriteString(normalStyle.Render(remainingText))
-				}
-				break
-			}
-
-			// Adjust index to absolute position
-			absoluteIndex := lastEnd + index
-
-			// Add text before the match with status color
-			beforeText := result[lastEnd:absoluteIndex]
-			if beforeText != "" {
-				highlighted.WriteString(normalStyle.Render(beforeText))
-			}
+// MatchRanges returns the byte ranges [start, end) of the case-insensitive,
+// non-overlapping matches of query in text, in order.
+//
+// Case is folded rune by rune instead of lowercasing whole strings, because
+// lowercasing can change a character's byte length ("İ", "K") and offsets
+// found in the lowered copy would then cut the original mid-rune. Each range
+// is widened to whole grapheme clusters, so a match never separates a
+// combining accent or half of an emoji sequence from its base character.
+func MatchRanges(text, query string) [][2]int {
+	needle := []rune(query)
+	for i, r := range needle {
+		needle[i] = unicode.ToLower(r)
+	}
+	if len(needle) == 0 {
+		return nil
+	}
 
-			// Add highlighted match (preserve original case)
-			matchText := result[absoluteIndex : absoluteIndex+len(word)]
-			highlighted.WriteString(highlightStyle.Render(matchText))
+	haystack := make([]rune, 0, len(text))
+	offsets := make([]int, 0, len(text)+1) // Byte offset of each rune, then len(text)
+	for i, r := range text {
+		haystack = append(haystack, unicode.ToLower(r))
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(text))
 
-			// Move past this match
-			lastEnd = absoluteIndex + len(word)
+	var ranges [][2]int
+	for i := 0; i+len(needle) <= len(haystack); {
+		if !slices.Equal(haystack[i:i+len(needle)], needle) {
+			i++
+			continue
 		}
+		ranges = append(ranges, [2]int{offsets[i], offsets[i+len(needle)]})
+		i += len(needle)
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+	return mergeRanges(snapToGraphemes(text, ranges))
+}
 
-		result = highlighted.String()
-		// Note: lowerText will be recalculated in next iteration if needed
+// snapToGraphemes widens each range to the grapheme clusters it touches
+func snapToGraphemes(text string, ranges [][2]int) [][2]int {
+	boundaries := []int{0}
+	graphemes := uniseg.NewGraphemes(text)
+	for graphemes.Next() {
+		_, end := graphemes.Positions()
+		boundaries = append(boundaries, end)
 	}
 
-	return result
+	for i, r := range ranges {
+		// Last boundary at or before the start, first boundary at or after the end
+		start, _ := slices.BinarySearch(boundaries, r[0]+1)
+		end, _ := slices.BinarySearch(boundaries, r[1])
+		ranges[i] = [2]int{boundaries[start-1], boundaries[end]}
+	}
+	return ranges
+}
+
+// mergeRanges sorts ranges and joins those that overlap
+func mergeRanges(ranges [][2]int) [][2]int {
+	slices.SortFunc(ranges, func(a, b [2]int) int { return a[0] - b[0] })
+	var merged [][2]int
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] < merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
 }
 
 // StripANSI removes ANSI escape sequences from text for accurate length calculation
@@ -166,27 +203,6 @@ func TruncatePreservingANSI(text string, maxWidth int) string {
 		return "…"
 	}
 
-	// For ANSI text, we need to be careful about truncation
-	// This is a simplified approach - for more complex cases,
-	// we'd need to parse ANSI sequences properly
-	plainText := StripANSI(text)
-	if len(plainText) <= maxWidth-1 {
-		return text // ANSI codes don't add much, keep original
-	}
-
-	// Truncate the plain text and try to preserve some styling
-	truncatedPlain := plainText[:maxWidth-1] + "…"
-
-	// Try to extract any styling from the beginning of the original text
-	// and apply it to the truncated text
-	if strings.HasPrefix(text, "\x1b[") {
-		// Find the end of the first ANSI sequence
-		endIdx := strings.Index(text, "m")
-		if endIdx != -1 && endIdx < 20 { // Reasonable limit for ANSI sequence
-			ansiPrefix := text[:endIdx+1]
-			return ansiPrefix + truncatedPlain + "\x1b[0m" // Reset at end
-		}
-	}
-
-	return truncatedPlain
+	// Cut on grapheme boundaries so wide and multi-byte characters stay whole
+	return ansi.Truncate(text, maxWidth, "…")
 }
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestMatchRanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  []string // Matched substrings of text
+	}{
+		{"ascii, case-insensitive", "Fix Login and LOGIN page", "login", []string{"Login", "LOGIN"}},
+		{"cjk", "修复登录页面的登录错误", "登录", []string{"登录", "登录"}},
+		{"emoji neighbours", "🚀 Launch 🚀 launch", "launch", []string{"Launch", "launch"}},
+		{"lowercase changes byte length", "ẞ Straße", "ß", []string{"ẞ", "ß"}},
+		{"kelvin sign", "200 K reading", "k", []string{"K"}},
+		{"dotted capital I", "İİ ok", "i", []string{"İ", "İ"}},
+		{"combining accent stays with its letter", "café menu", "cafe", []string{"café"}},
+		{"emoji zwj sequence stays whole", "dev 👩‍💻 team", "👩", []string{"👩‍💻"}},
+		{"no match", "日本語", "中文", nil},
+		{"empty query", "anything", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range MatchRanges(tt.text, tt.query) {
+				if !utf8.ValidString(tt.text[r[0]:r[1]]) {
+					t.Fatalf("Range %v cuts a rune in %q", r, tt.text)
+				}
+				got = append(got, tt.text[r[0]:r[1]])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchRanges(%q, %q) = %q, want %q", tt.text, tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightSearchTermsKeepsUnicodeIntact(t *testing.T) {
+	titles := []string{
+		"修复登录页面的登录错误",
+		"🚀 Ship the 🚀 launcher",
+		"İstanbul office move",
+		"naïve café search",
+	}
+	for _, title := range titles {
+		for _, query := range []string{"登录", "🚀", "launch", "i", "cafe é", "m"} {
+			out := HighlightSearchTermsWithColor(title, query, "7")
+			if plain := ansi.Strip(out); plain != title {
+				t.Errorf("Highlighting %q in %q changed the text to %q", query, title, plain)
+			}
+			if !utf8.ValidString(out) {
+				t.Errorf("Highlighting %q in %q produced invalid UTF-8", query, title)
+			}
+		}
+	}
+
+	// Later words must not match inside the escape codes of earlier ones
+	out := HighlightSearchTermsWithColor("登录 1 m", "登录 1 m", "7")
+	if plain := ansi.Strip(out); plain != "登录 1 m" {
+		t.Errorf("Expected multi-word highlighting to leave the text intact, got %q", plain)
+	}
+}
+
+func TestTruncatePreservingANSIKeepsRunesWhole(t *testing.T) {
+	out := TruncatePreservingANSI("\x1b[31m修复登录页面\x1b[0m", 7)
+	if !utf8.ValidString(out) || !strings.HasSuffix(ansi.Strip(out), "…") {
+		t.Errorf("Expected valid UTF-8 ending in an ellipsis, got %q", out)
+	}
+	if width := ansi.StringWidth(out); width > 7 {
+		t.Errorf("Expected at most 7 cells, got %d", width)
+	}
+}
@@ -58,3 +58,37 @@ func WordWrap(text string, width int) string {
 
 	return strings.Join(lines, "\n")
 }
+
+// TidyText trims trailing whitespace and collapses runs of blank lines into
+// one, dropping blank lines at the start and end. Lines inside fenced code
+// blocks (``` or ~~~) are kept exactly as written.
+func TidyText(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	tidy := make([]string, 0, len(lines))
+	fence := "" // Marker of the open code fence, "" outside one
+	blank := false
+	for _, line := range lines {
+		if fence != "" {
+			tidy = append(tidy, line)
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				fence = ""
+			}
+			continue
+		}
+
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			blank = len(tidy) > 0
+			continue
+		}
+		if blank {
+			tidy = append(tidy, "")
+			blank = false
+		}
+		tidy = append(tidy, line)
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+		}
+	}
+	return strings.Join(tidy, "\n")
+}
@@ -0,0 +1,30 @@
+package view
+
+import "testing"
+
+func TestTidyText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"trailing blank lines", "Fix login\n\n\n\n", "Fix login"},
+		{"leading blank lines", "\n  \n\nFix login", "Fix login"},
+		{"blank runs", "One   \n\n \n\t\nTwo\r\n\r\nThree", "One\n\nTwo\n\nThree"},
+		{
+			"fenced code kept",
+			"Run:\n\n\n```sh\nmake   \n\n\n\ntest\n```\n\n\nDone",
+			"Run:\n\n```sh\nmake   \n\n\n\ntest\n```\n\nDone",
+		},
+		{"tilde fence", "~~~\na  \n\n\nb\n~~~", "~~~\na  \n\n\nb\n~~~"},
+		{"unclosed fence", "```\nx\n\n\n", "```\nx\n\n\n"},
+		{"empty", "\n \n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TidyText(tt.in); got != tt.want {
+				t.Errorf("TidyText(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
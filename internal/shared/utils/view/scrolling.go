@@ -176,6 +176,11 @@ func RenderScrollBar(currentPos, totalItems, viewportHeight int) []string {
 // RenderScrollBarExact generates ASCII scroll bar using exact height without reduction
 // This is used when the calling component has already calculated the exact usable height
 func RenderScrollBarExact(currentPos, totalItems, exactHeight int) []string {
+	return RenderScrollBarGlyphs(currentPos, totalItems, exactHeight, "▓", "░")
+}
+
+// RenderScrollBarGlyphs generates a scroll bar of exact height using custom thumb and track glyphs
+func RenderScrollBarGlyphs(currentPos, totalItems, exactHeight int, thumb, track string) []string {
 	if totalItems <= exactHeight {
 		return nil // No scroll bar needed when all items fit
 	}
@@ -195,9 +200,9 @@ func RenderScrollBarExact(currentPos, totalItems, exactHeight int) []string {
 	var scrollBar []string
 	for i := 0; i < trackHeight; i++ {
 		if i >= thumbPos && i < thumbPos+thumbSize {
-			scrollBar = append(scrollBar, "▓") // Thumb (filled)
+			scrollBar = append(scrollBar, thumb) // Thumb (filled)
 		} else {
-			scrollBar = append(scrollBar, "░") // Track (light)
+			scrollBar = append(scrollBar, track) // Track (light)
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 )
 
 // ComposeWithScrollbar combines content with a scrollbar column
@@ -19,9 +20,12 @@ import (
 //
 // Note: Always uses default scrollbar options (gap char, width=4)
 func ComposeWithScrollbar(content string, scrollbar []string, panelWidth int, targetHeight int) string {
-	// Use default options
-	opts := DefaultScrollbarOptions()
+	return ComposeWithScrollbarOptions(content, scrollbar, panelWidth, targetHeight, DefaultScrollbarOptions())
+}
 
+// ComposeWithScrollbarOptions combines content with a scrollbar column using opts.
+// When the scrollbar is disabled no column is reserved and content uses the full width.
+func ComposeWithScrollbarOptions(content string, scrollbar []string, panelWidth int, targetHeight int, opts ScrollbarOptions) string {
 	// Split content into lines
 	lines := strings.Split(content, "\n")
 
@@ -34,7 +38,7 @@ func ComposeWithScrollbar(content string, scrollbar []string, panelWidth int, ta
 
 	lineCount := len(lines)
 	panelContentWidth := panelWidth - 2 // Account for panel borders
-	scrollbarColWidth := opts.Width
+	scrollbarColWidth := opts.ColumnWidth()
 	targetContentWidth := panelContentWidth - scrollbarColWidth
 
 	// Build each line: padded content + scrollbar column
@@ -49,12 +53,15 @@ func ComposeWithScrollbar(content string, scrollbar []string, panelWidth int, ta
 
 		// Append scrollbar column
 		var scrollbarCol string
-		if scrollbar != nil && i < len(scrollbar) {
+		switch {
+		case scrollbarColWidth == 0:
+			// Scrollbar disabled: no column reserved
+		case scrollbar != nil && i < len(scrollbar):
 			// Build scrollbar column: gap + thumb/track + padding
 			scrollbarCol = opts.GapChar +
 				scrollbar[i] +
 				strings.Repeat(" ", opts.Width-2)
-		} else {
+		default:
 			// Empty scrollbar area
 			scrollbarCol = strings.Repeat(" ", opts.Width)
 		}
@@ -64,3 +71,30 @@ func ComposeWithScrollbar(content string, scrollbar []string, panelWidth int, ta
 
 	return strings.Join(combined, "\n")
 }
+
+// ColumnWidth returns the width reserved for the scrollbar column (0 when disabled)
+func (o ScrollbarOptions) ColumnWidth() int {
+	if !o.Enabled {
+		return 0
+	}
+	return o.Width
+}
+
+// Render generates the styled scrollbar glyphs for a viewport position.
+// Returns nil when the scrollbar is disabled or all content fits.
+func (o ScrollbarOptions) Render(currentPos, totalLines, viewportHeight int) []string {
+	if !o.Enabled {
+		return nil
+	}
+
+	// Style the glyphs once; the scrollbar is built from the pre-rendered strings
+	thumb, track := o.ThumbChar, o.TrackChar
+	if o.ThumbColor != "" {
+		thumb = lipgloss.NewStyle().Foreground(lipgloss.Color(o.ThumbColor)).Render(thumb)
+	}
+	if o.TrackColor != "" {
+		track = lipgloss.NewStyle().Foreground(lipgloss.Color(o.TrackColor)).Render(track)
+	}
+
+	return view.RenderScrollBarGlyphs(currentPos, totalLines, viewportHeight, thumb, track)
+}
@@ -21,6 +21,9 @@ type ScrollbarOptions struct {
 	GapChar   string // Character for gap before scrollbar (default " ")
 	ThumbChar string // Character for scrollbar thumb (default "▓")
 	TrackChar string // Character for scrollbar track (default "░")
+
+	ThumbColor string // Foreground color for the thumb (empty = terminal default)
+	TrackColor string // Foreground color for the track (empty = terminal default)
 }
 
 // DefaultScrollbarOptions returns the default scrollbar configuration
@@ -0,0 +1,255 @@
+// Package sprints groups tasks into sprints by a task field. Archon has no
+// sprint concept, so teams tag tasks with a sprint value (e.g. "sprint-12")
+// in a field; ui.sprint names the field and a pattern telling sprint values
+// apart from other values of it.
+//
+// Everything that asks "which sprint is this task in" (the sprint filter,
+// the overview and the rollover) goes through one Resolver so they agree on
+// membership.
+package sprints
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// FieldFeature takes the sprint from the task's feature, the one free-form
+// task field Archon offers
+const FieldFeature = "feature"
+
+// Resolver decides which sprint a task belongs to
+type Resolver struct {
+	pattern *regexp.Regexp
+	current string // Configured current sprint ("" = the latest one found)
+}
+
+// NewResolver creates a resolver for sprint values of field that match
+// pattern. An empty pattern turns sprints off and returns nil.
+func NewResolver(field, pattern, current string) (*Resolver, error) {
+	if field != "" && field != FieldFeature {
+		return nil, fmt.Errorf("sprint field %q is not supported (only %q)", field, FieldFeature)
+	}
+	if pattern == "" {
+		return nil, nil
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint pattern %q: %w", pattern, err)
+	}
+	if current != "" && !compiled.MatchString(current) {
+		return nil, fmt.Errorf("current sprint %q does not match the sprint pattern %q", current, pattern)
+	}
+	return &Resolver{pattern: compiled, current: current}, nil
+}
+
+// Enabled reports whether sprints are configured
+func (r *Resolver) Enabled() bool {
+	return r != nil
+}
+
+// Sprint returns the sprint task belongs to
+func (r *Resolver) Sprint(task archon.Task) (string, bool) {
+	if r == nil || task.Feature == nil {
+		return "", false
+	}
+	value := strings.TrimSpace(*task.Feature)
+	if value == "" || !r.pattern.MatchString(value) {
+		return "", false
+	}
+	return value, true
+}
+
+// Current returns the current sprint: the configured one, otherwise the
+// latest sprint any of tasks is in ("" when none is)
+func (r *Resolver) Current(tasks []archon.Task) string {
+	if r == nil {
+		return ""
+	}
+	if r.current != "" {
+		return r.current
+	}
+	latest := ""
+	for _, task := range tasks {
+		if sprint, ok := r.Sprint(task); ok && (latest == "" || Compare(sprint, latest) > 0) {
+			latest = sprint
+		}
+	}
+	return latest
+}
+
+// Totals counts the tasks of one sprint
+type Totals struct {
+	Sprint string
+	Total  int
+	Open   int // Not done yet
+	Done   int
+}
+
+// Totals counts the tasks of every sprint, oldest sprint first
+func (r *Resolver) Totals(tasks []archon.Task) []Totals {
+	bySprint := map[string]*Totals{}
+	for _, task := range tasks {
+		sprint, ok := r.Sprint(task)
+		if !ok {
+			continue
+		}
+		totals := bySprint[sprint]
+		if totals == nil {
+			totals = &Totals{Sprint: sprint}
+			bySprint[sprint] = totals
+		}
+		totals.Total++
+		if task.Status == archon.TaskStatusDone {
+			totals.Done++
+		} else {
+			totals.Open++
+		}
+	}
+
+	result := make([]Totals, 0, len(bySprint))
+	for _, totals := range bySprint {
+		result = append(result, *totals)
+	}
+	slices.SortFunc(result, func(a, b Totals) int { return Compare(a.Sprint, b.Sprint) })
+	return result
+}
+
+// Unfinished returns the tasks of sprint that are not done
+func (r *Resolver) Unfinished(tasks []archon.Task, sprint string) []archon.Task {
+	var unfinished []archon.Task
+	for _, task := range tasks {
+		if got, ok := r.Sprint(task); ok && got == sprint && task.Status != archon.TaskStatusDone {
+			unfinished = append(unfinished, task)
+		}
+	}
+	return unfinished
+}
+
+// MaxBurndownDays bounds the burndown to the most recent days of a sprint
+const MaxBurndownDays = 31
+
+// Day is the number of tasks of a sprint still open at the end of a day
+type Day struct {
+	Date      time.Time // Start of the day, in now's location
+	Remaining int
+}
+
+// Burndown returns the open tasks of sprint at the end of each day, from the
+// day its first task was created until now (at most MaxBurndownDays). Archon
+// keeps no status history, so a done task counts as finished on the day it
+// was last updated.
+func (r *Resolver) Burndown(tasks []archon.Task, sprint string, now time.Time) []Day {
+	var members []archon.Task
+	var first time.Time
+	for _, task := range tasks {
+		if got, ok := r.Sprint(task); ok && got == sprint {
+			members = append(members, task)
+			if created := task.CreatedAt.Time; !created.IsZero() && (first.IsZero() || created.Before(first)) {
+				first = created
+			}
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	today := startOfDay(now)
+	start := today
+	if !first.IsZero() {
+		start = startOfDay(first.In(now.Location()))
+	}
+	if earliest := today.AddDate(0, 0, -(MaxBurndownDays - 1)); start.Before(earliest) {
+		start = earliest
+	}
+
+	var days []Day
+	for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		remaining := 0
+		for _, task := range members {
+			if created := task.CreatedAt.Time; !created.IsZero() && !created.Before(end) {
+				continue // Not created yet
+			}
+			if task.Status == archon.TaskStatusDone && task.UpdatedAt.Time.Before(end) {
+				continue // Done by then
+			}
+			remaining++
+		}
+		days = append(days, Day{Date: day, Remaining: remaining})
+	}
+	return days
+}
+
+// startOfDay returns midnight of t's day in t's location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// Next returns the sprint after sprint by incrementing its last number
+// ("sprint-12" → "sprint-13", "2024-W09" → "2024-W10"). False when sprint
+// has no number to increment.
+func Next(sprint string) (string, bool) {
+	end := strings.LastIndexFunc(sprint, isDigit) + 1
+	if end == 0 {
+		return "", false
+	}
+	start := strings.LastIndexFunc(sprint[:end], func(r rune) bool { return !isDigit(r) }) + 1
+	digits := sprint[start:end]
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return "", false
+	}
+	next := fmt.Sprintf("%0*d", len(digits), n+1) // Keep zero padding
+	return sprint[:start] + next + sprint[end:], true
+}
+
+// Compare orders sprint values naturally, comparing runs of digits by value
+// so "sprint-9" comes before "sprint-10"
+func Compare(a, b string) int {
+	for a != "" && b != "" {
+		if isDigit(rune(a[0])) && isDigit(rune(b[0])) {
+			numA, restA := leadingNumber(a)
+			numB, restB := leadingNumber(b)
+			if c := compareNumbers(numA, numB); c != 0 {
+				return c
+			}
+			a, b = restA, restB
+			continue
+		}
+		if a[0] != b[0] {
+			return strings.Compare(a[:1], b[:1])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+// leadingNumber splits the run of digits off the start of s
+func leadingNumber(s string) (number, rest string) {
+	end := strings.IndexFunc(s, func(r rune) bool { return !isDigit(r) })
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], s[end:]
+}
+
+// compareNumbers compares digit strings by value, without overflowing
+func compareNumbers(a, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+// isDigit reports whether r is an ASCII digit
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,138 @@
+package sprints
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+var now = time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+// task builds a task in feature, created and last updated days before now
+func task(id, feature, status string, createdDaysAgo, updatedDaysAgo int) archon.Task {
+	t := archon.Task{
+		ID:        id,
+		Status:    status,
+		CreatedAt: archon.FlexibleTime{Time: now.AddDate(0, 0, -createdDaysAgo)},
+		UpdatedAt: archon.FlexibleTime{Time: now.AddDate(0, 0, -updatedDaysAgo)},
+	}
+	if feature != "" {
+		t.Feature = &feature
+	}
+	return t
+}
+
+func resolver(t *testing.T, current string) *Resolver {
+	t.Helper()
+	r, err := NewResolver(FieldFeature, `^sprint-\d+$`, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestNewResolver(t *testing.T) {
+	if r, err := NewResolver("", "", ""); r != nil || err != nil || r.Enabled() {
+		t.Errorf("Expected no resolver without a pattern, got %v, %v", r, err)
+	}
+	for _, bad := range []struct{ field, pattern, current string }{
+		{"labels", `^sprint-\d+$`, ""},
+		{"feature", `sprint-(`, ""},
+		{"feature", `^sprint-\d+$`, "next"},
+	} {
+		if _, err := NewResolver(bad.field, bad.pattern, bad.current); err == nil {
+			t.Errorf("Expected %+v to be rejected", bad)
+		}
+	}
+}
+
+func TestMembership(t *testing.T) {
+	r := resolver(t, "")
+	tasks := []archon.Task{
+		task("a", "sprint-9", archon.TaskStatusDone, 20, 15),
+		task("b", "sprint-10", archon.TaskStatusTodo, 5, 5),
+		task("c", "sprint-10", archon.TaskStatusDone, 5, 1),
+		task("d", "auth", archon.TaskStatusTodo, 5, 5),
+		task("e", "", archon.TaskStatusTodo, 5, 5),
+	}
+
+	if sprint, ok := r.Sprint(tasks[1]); !ok || sprint != "sprint-10" {
+		t.Errorf("Expected sprint-10, got %q", sprint)
+	}
+	if _, ok := r.Sprint(tasks[3]); ok {
+		t.Error("Expected a feature not matching the pattern to be no sprint")
+	}
+	if current := r.Current(tasks); current != "sprint-10" {
+		t.Errorf("Expected the latest sprint to be current, got %q", current)
+	}
+	if current := resolver(t, "sprint-9").Current(tasks); current != "sprint-9" {
+		t.Errorf("Expected the configured sprint to be current, got %q", current)
+	}
+
+	want := []Totals{{Sprint: "sprint-9", Total: 1, Done: 1}, {Sprint: "sprint-10", Total: 2, Open: 1, Done: 1}}
+	if got := r.Totals(tasks); !reflect.DeepEqual(got, want) {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+	if unfinished := r.Unfinished(tasks, "sprint-10"); len(unfinished) != 1 || unfinished[0].ID != "b" {
+		t.Errorf("Expected only b unfinished, got %+v", unfinished)
+	}
+}
+
+func TestBurndown(t *testing.T) {
+	r := resolver(t, "")
+	tasks := []archon.Task{
+		task("a", "sprint-3", archon.TaskStatusDone, 3, 2),
+		task("b", "sprint-3", archon.TaskStatusDone, 3, 0),
+		task("c", "sprint-3", archon.TaskStatusTodo, 3, 3),
+		task("d", "sprint-3", archon.TaskStatusTodo, 1, 1), // Added mid-sprint
+		task("e", "sprint-2", archon.TaskStatusTodo, 9, 9),
+	}
+
+	var remaining []int
+	for _, day := range r.Burndown(tasks, "sprint-3", now) {
+		remaining = append(remaining, day.Remaining)
+	}
+	if want := []int{3, 2, 3, 2}; !reflect.DeepEqual(remaining, want) {
+		t.Errorf("Burndown = %v, want %v", remaining, want)
+	}
+	if days := r.Burndown(tasks, "sprint-1", now); days != nil {
+		t.Errorf("Expected no burndown for an empty sprint, got %+v", days)
+	}
+
+	// Long sprints show only the most recent days
+	old := []archon.Task{task("x", "sprint-1", archon.TaskStatusTodo, 90, 90)}
+	if days := r.Burndown(old, "sprint-1", now); len(days) != MaxBurndownDays {
+		t.Errorf("Expected %d days, got %d", MaxBurndownDays, len(days))
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := map[string]string{
+		"sprint-12": "sprint-13",
+		"sprint-9":  "sprint-10",
+		"2024-W09":  "2024-W10",
+		"s07-final": "s08-final",
+	}
+	for sprint, want := range tests {
+		if got, ok := Next(sprint); !ok || got != want {
+			t.Errorf("Next(%q) = %q, want %q", sprint, got, want)
+		}
+	}
+	if _, ok := Next("backlog"); ok {
+		t.Error("Expected no next sprint without a number")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	ordered := []string{"sprint-2", "sprint-9", "sprint-10", "sprint-10a", "sprint-100"}
+	for i := 1; i < len(ordered); i++ {
+		if Compare(ordered[i-1], ordered[i]) >= 0 || Compare(ordered[i], ordered[i-1]) <= 0 {
+			t.Errorf("Expected %q before %q", ordered[i-1], ordered[i])
+		}
+	}
+	if Compare("sprint-07", "sprint-7") != 0 {
+		t.Error("Expected zero padding not to matter")
+	}
+}
@@ -0,0 +1,108 @@
+// Package state persists sticky UI preferences that should survive restarts,
+// such as the feature filter last applied in the feature modal.
+//
+// Unlike session snapshots, which are crash-recovery data offered once and
+// cleared on a clean quit, state is always applied at startup.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/atomicfile"
+)
+
+// SchemaVersion is the state file format written by this build
+const SchemaVersion = 1
+
+// ErrUnsupportedVersion is returned when state was written with another schema version
+var ErrUnsupportedVersion = errors.New("unsupported state version")
+
+// State is the persisted set of sticky preferences
+type State struct {
+	FeatureFilter []string `json:"feature_filter,omitempty"` // Features shown; empty = no feature filter
+
+	// When unread tracking started; tasks not updated since then count as seen
+	ReadSince time.Time `json:"read_since,omitzero"`
+
+	// Task ID → the task's updated-at when its details were last shown
+	LastSeen map[string]time.Time `json:"last_seen,omitempty"`
+
+	// The task details panel was hidden, leaving the width to the task list
+	DetailsHidden bool `json:"details_hidden,omitempty"`
+
+	// The task selected at the last quit (nil = none or not remembered)
+	Selection *Selection `json:"selection,omitempty"`
+}
+
+// Selection is a selected task and the project it was selected in
+type Selection struct {
+	TaskID    string `json:"task_id"`
+	ProjectID string `json:"project_id,omitempty"` // Empty = All Tasks
+}
+
+// file is the on-disk representation of State
+type file struct {
+	Version int `json:"version"`
+	State
+}
+
+// Store reads and writes state at a fixed path
+type Store struct {
+	path string
+}
+
+// NewStore creates a store that keeps state at path
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the state file location in the user's config directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "lazyarchon", "state.json"), nil
+}
+
+// Load reads the state; returns empty state without error when none was saved yet
+func (s *Store) Load() (State, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return State{}, nil
+	}
+	if err != nil {
+		return State{}, fmt.Errorf("failed to read state: %w", err)
+	}
+
+	var stored file
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return State{}, fmt.Errorf("failed to parse state: %w", err)
+	}
+	if stored.Version != SchemaVersion {
+		return State{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, stored.Version)
+	}
+	return stored.State, nil
+}
+
+// Save writes the state atomically
+func (s *Store) Save(state State) error {
+	data, err := json.MarshalIndent(file{Version: SchemaVersion, State: state}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so a crash mid-write never loses preferences
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	return nil
+}
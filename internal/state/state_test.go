@@ -0,0 +1,39 @@
+package state
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", "state.json"))
+
+	loaded, err := store.Load()
+	if err != nil || loaded.FeatureFilter != nil {
+		t.Fatalf("Expected empty state before the first save, got %+v (err %v)", loaded, err)
+	}
+
+	seen := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	saved := State{FeatureFilter: []string{"auth", "ui"}, ReadSince: seen, LastSeen: map[string]time.Time{"t1": seen}, DetailsHidden: true,
+		Selection: &Selection{TaskID: "t1", ProjectID: "p1"}}
+	if err := store.Save(saved); err != nil {
+		t.Fatalf("Unexpected save error: %v", err)
+	}
+	loaded, err = store.Load()
+	if err != nil || !slices.Equal(loaded.FeatureFilter, saved.FeatureFilter) ||
+		!loaded.ReadSince.Equal(seen) || !loaded.LastSeen["t1"].Equal(seen) || !loaded.DetailsHidden ||
+		loaded.Selection == nil || *loaded.Selection != *saved.Selection {
+		t.Errorf("Expected %+v, got %+v (err %v)", saved, loaded, err)
+	}
+
+	if err := os.WriteFile(store.path, []byte(`{"version": 99}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+}
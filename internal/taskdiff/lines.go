@@ -0,0 +1,234 @@
+package taskdiff
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Op says how a line or span appears in a text diff
+type Op int
+
+const (
+	Equal  Op = iota // In both versions
+	Delete           // Only in the old version
+	Insert           // Only in the new version
+)
+
+// maxDiffCells bounds the comparison table of one diff. Past it the changed
+// middle is reported as deleted and re-inserted as a whole, which is still
+// correct, just less precise.
+const maxDiffCells = 4 << 20
+
+// Span is a run of text within a modified line
+type Span struct {
+	Text    string
+	Changed bool // Differs from the paired line
+}
+
+// Line is one line of a text diff
+type Line struct {
+	Op   Op
+	Text string
+
+	// Set on a deleted line paired with the inserted line replacing it (and
+	// vice versa): Text split into unchanged and changed parts
+	Spans []Span
+}
+
+// Modified reports whether the line has intra-line change information
+func (l Line) Modified() bool {
+	return len(l.Spans) > 0
+}
+
+// Text returns the line diff from old to current. Within each block of
+// deleted lines followed by inserted lines, the lines are paired in order and
+// carry word-level Spans showing what changed inside them.
+func Text(old, current string) []Line {
+	before, after := splitLines(old), splitLines(current)
+	ops := diff(before, after)
+
+	lines := make([]Line, 0, len(ops))
+	i, j := 0, 0
+	for _, op := range ops {
+		switch op {
+		case Equal:
+			lines = append(lines, Line{Op: Equal, Text: before[i]})
+			i++
+			j++
+		case Delete:
+			lines = append(lines, Line{Op: Delete, Text: before[i]})
+			i++
+		case Insert:
+			lines = append(lines, Line{Op: Insert, Text: after[j]})
+			j++
+		}
+	}
+	pairModified(lines)
+	return lines
+}
+
+// Words splits old and current into spans, marking the words that differ
+func Words(old, current string) (oldSpans, currentSpans []Span) {
+	before, after := tokenize(old), tokenize(current)
+	i, j := 0, 0
+	for _, op := range diff(before, after) {
+		switch op {
+		case Equal:
+			oldSpans = appendSpan(oldSpans, before[i], false)
+			currentSpans = appendSpan(currentSpans, after[j], false)
+			i++
+			j++
+		case Delete:
+			oldSpans = appendSpan(oldSpans, before[i], true)
+			i++
+		case Insert:
+			currentSpans = appendSpan(currentSpans, after[j], true)
+			j++
+		}
+	}
+	return oldSpans, currentSpans
+}
+
+// pairModified adds word spans to deleted/inserted lines that replace each other
+func pairModified(lines []Line) {
+	for start := 0; start < len(lines); {
+		if lines[start].Op != Delete {
+			start++
+			continue
+		}
+		deletes := start
+		for deletes < len(lines) && lines[deletes].Op == Delete {
+			deletes++
+		}
+		inserts := deletes
+		for inserts < len(lines) && lines[inserts].Op == Insert {
+			inserts++
+		}
+		for k := 0; k < min(deletes-start, inserts-deletes); k++ {
+			old, current := &lines[start+k], &lines[deletes+k]
+			old.Spans, current.Spans = Words(old.Text, current.Text)
+		}
+		start = inserts
+	}
+}
+
+// appendSpan adds text to spans, merging it into the last span when both are
+// changed or both unchanged
+func appendSpan(spans []Span, text string, changed bool) []Span {
+	if n := len(spans); n > 0 && spans[n-1].Changed == changed {
+		spans[n-1].Text += text
+		return spans
+	}
+	return append(spans, Span{Text: text, Changed: changed})
+}
+
+// splitLines splits text into lines; an empty text has none
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// tokenize splits a line into words, runs of spaces and single other runes
+func tokenize(line string) []string {
+	var tokens []string
+	runes := []rune(line)
+	for start := 0; start < len(runes); {
+		end := start + 1
+		switch {
+		case isWordRune(runes[start]):
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[start]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		tokens = append(tokens, string(runes[start:end]))
+		start = end
+	}
+	return tokens
+}
+
+// isWordRune reports whether r belongs to a word token
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// diff returns the edit script turning a into b, using the longest common
+// subsequence of the part between their common prefix and suffix
+func diff(a, b []string) []Op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b))
+	for range prefix {
+		ops = append(ops, Equal)
+	}
+	ops = append(ops, lcs(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for range suffix {
+		ops = append(ops, Equal)
+	}
+	return ops
+}
+
+// lcs diffs a and b through a longest-common-subsequence table
+func lcs(a, b []string) []Op {
+	n, m := len(a), len(b)
+	ops := make([]Op, 0, n+m)
+	if n == 0 || m == 0 || (n+1)*(m+1) > maxDiffCells {
+		for range n {
+			ops = append(ops, Delete)
+		}
+		for range m {
+			ops = append(ops, Insert)
+		}
+		return ops
+	}
+
+	// table[i][j] is the LCS length of a[i:] and b[j:]
+	table := make([][]int32, n+1)
+	for i := range table {
+		table[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, Equal)
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			ops = append(ops, Delete)
+			i++
+		default:
+			ops = append(ops, Insert)
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, Delete)
+	}
+	for ; j < m; j++ {
+		ops = append(ops, Insert)
+	}
+	return ops
+}
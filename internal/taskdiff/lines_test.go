@@ -0,0 +1,97 @@
+package taskdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// render prints a line diff as a unified diff, changed spans in [brackets]
+func render(lines []Line) string {
+	var out strings.Builder
+	for _, line := range lines {
+		out.WriteString([]string{"  ", "- ", "+ "}[line.Op])
+		if line.Modified() {
+			for _, span := range line.Spans {
+				if span.Changed {
+					out.WriteString("[" + span.Text + "]")
+				} else {
+					out.WriteString(span.Text)
+				}
+			}
+		} else {
+			out.WriteString(line.Text)
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name, old, current, want string
+	}{
+		{"unchanged", "a\nb\n", "a\nb", "  a\n  b\n"},
+		{"added", "", "one\ntwo", "+ one\n+ two\n"},
+		{"removed", "one", "", "- one\n"},
+		{"inserted line", "a\nc", "a\nb\nc", "  a\n+ b\n  c\n"},
+		{
+			"modified line",
+			"# Goal\nShip the login page by Friday.\nNotes",
+			"# Goal\nShip the signup page by Monday.\nNotes",
+			"  # Goal\n- Ship the [login] page by [Friday].\n+ Ship the [signup] page by [Monday].\n  Notes\n",
+		},
+		{
+			"unpaired lines stay whole",
+			"keep\nold one\nold two",
+			"keep\nnew one",
+			"  keep\n- [old] one\n- old two\n+ [new] one\n",
+		},
+		{"unicode words", "Prüfung läuft", "Prüfung fertig", "- Prüfung [läuft]\n+ Prüfung [fertig]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := render(Text(tt.old, tt.current)); got != tt.want {
+				t.Errorf("Text diff:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	old, current := Words("set timeout=30s", "set timeout=45s")
+	wantOld := []Span{{Text: "set timeout="}, {Text: "30s", Changed: true}}
+	wantCurrent := []Span{{Text: "set timeout="}, {Text: "45s", Changed: true}}
+	if !reflect.DeepEqual(old, wantOld) || !reflect.DeepEqual(current, wantCurrent) {
+		t.Errorf("Unexpected spans %+v / %+v", old, current)
+	}
+}
+
+func TestTextLargeInputFallsBack(t *testing.T) {
+	// Past maxDiffCells the changed middle is replaced wholesale, but the
+	// common prefix and suffix still line up and the result stays a valid diff
+	var before, after []string
+	for i := 0; i < 3000; i++ {
+		before = append(before, "old line "+strings.Repeat("x", i%7))
+		after = append(after, "new line "+strings.Repeat("y", i%5))
+	}
+	old := "head\n" + strings.Join(before, "\n") + "\ntail"
+	current := "head\n" + strings.Join(after, "\n") + "\ntail"
+
+	lines := Text(old, current)
+	var rebuiltOld, rebuiltCurrent []string
+	for _, line := range lines {
+		if line.Op != Insert {
+			rebuiltOld = append(rebuiltOld, line.Text)
+		}
+		if line.Op != Delete {
+			rebuiltCurrent = append(rebuiltCurrent, line.Text)
+		}
+	}
+	if strings.Join(rebuiltOld, "\n") != old || strings.Join(rebuiltCurrent, "\n") != current {
+		t.Fatal("Expected the diff to rebuild both versions")
+	}
+	if lines[0].Op != Equal || lines[len(lines)-1].Op != Equal {
+		t.Error("Expected the common head and tail to stay unchanged")
+	}
+}
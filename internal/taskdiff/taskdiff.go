@@ -0,0 +1,102 @@
+// Package taskdiff compares two loads of the task list and reports which
+// tasks were added, removed or changed, and which fields changed.
+package taskdiff
+
+import (
+	"strconv"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// Kind classifies a change
+type Kind string
+
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Updated Kind = "updated"
+)
+
+// Compared fields, in the order they are reported
+const (
+	FieldTitle       = "title"
+	FieldStatus      = "status"
+	FieldAssignee    = "assignee"
+	FieldFeature     = "feature"
+	FieldTaskOrder   = "task_order"
+	FieldDescription = "description"
+	FieldArchived    = "archived"
+)
+
+// FieldChange is one field that differs between two versions of a task
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// Change is one task that differs between two loads
+type Change struct {
+	Kind   Kind          `json:"kind"`
+	Task   archon.Task   `json:"task"`             // Current version (previous version when removed)
+	Fields []FieldChange `json:"fields,omitempty"` // Changed fields when updated
+}
+
+// Diff returns the changes from previous to current: additions and updates in
+// current's order, then removals in previous's order. Tasks that only differ
+// in fields not compared here (e.g. updated_at) are not reported.
+func Diff(previous, current []archon.Task) []Change {
+	before := make(map[string]archon.Task, len(previous))
+	for _, task := range previous {
+		before[task.ID] = task
+	}
+
+	var changes []Change
+	seen := make(map[string]bool, len(current))
+	for _, task := range current {
+		seen[task.ID] = true
+		old, ok := before[task.ID]
+		if !ok {
+			changes = append(changes, Change{Kind: Added, Task: task})
+			continue
+		}
+		if fields := Fields(old, task); len(fields) > 0 {
+			changes = append(changes, Change{Kind: Updated, Task: task, Fields: fields})
+		}
+	}
+	for _, task := range previous {
+		if !seen[task.ID] {
+			changes = append(changes, Change{Kind: Removed, Task: task})
+		}
+	}
+	return changes
+}
+
+// Fields returns the compared fields that differ between old and current.
+// Descriptions are reported as changed without their (possibly long) text.
+func Fields(old, current archon.Task) []FieldChange {
+	var fields []FieldChange
+	add := func(field, before, after string) {
+		if before != after {
+			fields = append(fields, FieldChange{Field: field, Old: before, New: after})
+		}
+	}
+	add(FieldTitle, old.Title, current.Title)
+	add(FieldStatus, old.Status, current.Status)
+	add(FieldAssignee, old.Assignee, current.Assignee)
+	add(FieldFeature, feature(old), feature(current))
+	add(FieldTaskOrder, strconv.Itoa(old.TaskOrder), strconv.Itoa(current.TaskOrder))
+	if old.Description != current.Description {
+		fields = append(fields, FieldChange{Field: FieldDescription})
+	}
+	add(FieldArchived, strconv.FormatBool(old.Archived), strconv.FormatBool(current.Archived))
+	return fields
+}
+
+// feature returns the task's feature, "" when unset
+func feature(task archon.Task) string {
+	if task.Feature == nil {
+		return ""
+	}
+	return *task.Feature
+}
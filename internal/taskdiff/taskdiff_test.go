@@ -0,0 +1,54 @@
+package taskdiff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func TestDiff(t *testing.T) {
+	auth := "auth"
+	previous := []archon.Task{
+		{ID: "1", Title: "Fix login", Status: "doing", Assignee: "alice"},
+		{ID: "2", Title: "Write docs", Status: "todo", Description: "old"},
+		{ID: "3", Title: "Ship v1", Status: "review"},
+	}
+	current := []archon.Task{
+		{ID: "4", Title: "Dark mode", Status: "todo"},
+		{ID: "1", Title: "Fix login", Status: "review", Assignee: "bob", Feature: &auth},
+		{ID: "2", Title: "Write docs", Status: "todo", Description: "new"},
+	}
+
+	changes := Diff(previous, current)
+	var kinds []Kind
+	var ids []string
+	for _, change := range changes {
+		kinds, ids = append(kinds, change.Kind), append(ids, change.Task.ID)
+	}
+	if !reflect.DeepEqual(kinds, []Kind{Added, Updated, Updated, Removed}) || !reflect.DeepEqual(ids, []string{"4", "1", "2", "3"}) {
+		t.Fatalf("Unexpected changes %v %v", kinds, ids)
+	}
+
+	want := []FieldChange{
+		{Field: FieldStatus, Old: "doing", New: "review"},
+		{Field: FieldAssignee, Old: "alice", New: "bob"},
+		{Field: FieldFeature, Old: "", New: "auth"},
+	}
+	if !reflect.DeepEqual(changes[1].Fields, want) {
+		t.Errorf("Unexpected fields %+v", changes[1].Fields)
+	}
+	if !reflect.DeepEqual(changes[2].Fields, []FieldChange{{Field: FieldDescription}}) {
+		t.Errorf("Expected the description reported without its text, got %+v", changes[2].Fields)
+	}
+}
+
+func TestDiffIgnoresUncomparedFields(t *testing.T) {
+	before := archon.Task{ID: "1", Title: "Same"}
+	after := before
+	after.UpdatedAt = archon.FlexibleTime{}
+	after.Sources = []archon.Source{{}}
+	if changes := Diff([]archon.Task{before}, []archon.Task{after}); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", changes)
+	}
+}
@@ -3,6 +3,7 @@ package base
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
 )
 
@@ -16,6 +17,12 @@ const (
 	FeatureModalComponent          ComponentType = "feature_modal"
 	TaskEditModalComponent         ComponentType = "task_edit_modal"
 	ConfirmationModalComponent     ComponentType = "confirmation_modal"
+	LinkPickerModalComponent       ComponentType = "link_picker_modal"
+	DigestModalComponent           ComponentType = "digest_modal"
+	BookmarkListModalComponent     ComponentType = "bookmark_list_modal"
+	UnblockModalComponent          ComponentType = "unblock_modal"
+	APIKeyModalComponent           ComponentType = "api_key_modal"
+	DescDiffModalComponent         ComponentType = "desc_diff_modal"
 	SearchComponent                ComponentType = "search"
 	TableComponent                 ComponentType = "table"
 	SidebarComponent               ComponentType = "sidebar"
@@ -39,6 +46,12 @@ const (
 	ModalTypeStatusFilter ModalType = "status_filter" // Status filter modal
 	ModalTypeTaskEdit     ModalType = "task_edit"     // Task edit modal
 	ModalTypeConfirmation ModalType = "confirmation"  // Confirmation modal
+	ModalTypeLinkPicker   ModalType = "link_picker"   // Link picker modal
+	ModalTypeDigest       ModalType = "digest"        // Away digest modal
+	ModalTypeBookmarkList ModalType = "bookmark_list" // Bookmark list modal
+	ModalTypeUnblock      ModalType = "unblock"       // Unblock report modal
+	ModalTypeAPIKey       ModalType = "api_key"       // API key prompt after a 401
+	ModalTypeDescDiff     ModalType = "desc_diff"     // Description changes since last viewed
 )
 
 // Layout constants for component rendering
@@ -260,3 +273,19 @@ func (c *BaseComponent) SendMessage(targetID string, targetType ComponentType, p
 func (c *BaseComponent) BroadcastMessage(payload tea.Msg) tea.Cmd {
 	return c.SendMessage("", "", payload)
 }
+
+// ScrollbarOptions returns scrollbar rendering options from the user's configuration.
+// Falls back to the defaults when no configuration is available (e.g., in tests).
+func (c *ComponentContext) ScrollbarOptions() sharedviewport.ScrollbarOptions {
+	opts := sharedviewport.DefaultScrollbarOptions()
+	if c == nil || c.ProgramContext == nil || c.ProgramContext.Config == nil {
+		return opts
+	}
+
+	cfg := c.ProgramContext.Config
+	opts.Enabled = cfg.IsScrollbarEnabled()
+	opts.ThumbChar, opts.TrackChar = cfg.GetScrollbarGlyphs()
+	opts.ThumbColor = cfg.UI.Scrollbar.ThumbColor
+	opts.TrackColor = cfg.UI.Scrollbar.TrackColor
+	return opts
+}
@@ -4,7 +4,6 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/layout"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
-	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 )
 
@@ -18,12 +17,16 @@ type DetailsPanelCore struct {
 
 	// Calculated content width (accounting for scrollbar)
 	contentWidth int
+
+	// Scrollbar appearance and whether its column is reserved
+	scrollbar sharedviewport.ScrollbarOptions
 }
 
 // CoreOptions contains configuration for creating a details panel core
 type CoreOptions struct {
-	Width  int
-	Height int
+	Width     int
+	Height    int
+	Scrollbar *sharedviewport.ScrollbarOptions // Optional, defaults to DefaultScrollbarOptions
 }
 
 // NewCore creates a new details panel core with viewport infrastructure
@@ -35,12 +38,16 @@ func NewCore(opts CoreOptions) DetailsPanelCore {
 	if opts.Height == 0 {
 		opts.Height = 20
 	}
+	scrollbar := sharedviewport.DefaultScrollbarOptions()
+	if opts.Scrollbar != nil {
+		scrollbar = *opts.Scrollbar
+	}
 
 	// Calculate dimensions using dimension calculator
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	// Details panels have no static headers/footers, so no reserved lines needed
 	calc := layout.NewCalculator(opts.Width, opts.Height, layout.PanelComponent).
-		WithScrollbar() // Reserve space for scrollbar (4 chars)
+		WithScrollbarEnabled(scrollbar.Enabled)
 	dims := calc.Calculate()
 
 	// Create viewport with calculated dimensions
@@ -49,6 +56,7 @@ func NewCore(opts CoreOptions) DetailsPanelCore {
 	return DetailsPanelCore{
 		viewport:     viewportInstance,
 		contentWidth: dims.Content,
+		scrollbar:    scrollbar,
 	}
 }
 
@@ -58,7 +66,7 @@ func (c *DetailsPanelCore) UpdateDimensions(width, height int) {
 	// Recalculate using dimension calculator
 	// Details panels have no static headers/footers, so no reserved lines needed
 	calc := layout.NewCalculator(width, height, layout.PanelComponent).
-		WithScrollbar()
+		WithScrollbarEnabled(c.scrollbar.Enabled)
 	dims := calc.Calculate()
 
 	// Update calculated dimensions
@@ -128,6 +136,16 @@ func (c *DetailsPanelCore) GetScrollPosition() string {
 	return ScrollPositionScrolled
 }
 
+// ScrollOffset returns the index of the first visible content line
+func (c *DetailsPanelCore) ScrollOffset() int {
+	return c.viewport.YOffset
+}
+
+// SetScrollOffset scrolls to offset, clamped to the content
+func (c *DetailsPanelCore) SetScrollOffset(offset int) {
+	c.viewport.SetYOffset(offset)
+}
+
 // GetViewport returns the underlying viewport for direct access (e.g., mouse events)
 func (c *DetailsPanelCore) GetViewport() *viewport.Model {
 	return &c.viewport
@@ -156,12 +174,12 @@ func (c DetailsPanelCore) RenderPanelWithScrollbar(
 	if totalLines > viewportHeight {
 		// Generate scrollbar matching viewport height
 		// The scrollbar height must match the viewport content height for proper alignment
-		scrollbar = view.RenderScrollBarExact(c.viewport.YOffset, totalLines, viewportHeight)
+		scrollbar = c.scrollbar.Render(c.viewport.YOffset, totalLines, viewportHeight)
 	}
 
 	// Always compose with scrollbar column (even if nil) to fill reserved scrollbar space
-	// When scrollbar is nil, ComposeWithScrollbar fills the space with empty characters
-	viewportContent = sharedviewport.ComposeWithScrollbar(viewportContent, scrollbar, width, viewportHeight)
+	// When scrollbar is nil, ComposeWithScrollbarOptions fills the space with empty characters
+	viewportContent = sharedviewport.ComposeWithScrollbarOptions(viewportContent, scrollbar, width, viewportHeight, c.scrollbar)
 
 	// Render with panel styling (using isActive parameter, not cached state)
 	panelFactory := styleContext.Factory()
@@ -90,6 +90,11 @@ func (m *HeaderModel) collectHeaderParts() []string {
 	// Project name (always shown)
 	parts = append(parts, m.ctx().GetCurrentProjectName())
 
+	// Safe mode stays visible for the whole session
+	if m.ctx().SafeMode {
+		parts = append(parts, "⛑ SAFE MODE (read-only)")
+	}
+
 	// Active search query (if searching)
 	if searchQuery := m.getSearchIndicator(); searchQuery != "" {
 		parts = append(parts, searchQuery)
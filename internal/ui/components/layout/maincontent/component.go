@@ -31,6 +31,17 @@ func (m *MainContentModel) GetSelectedTask() *archon.Task {
 	return m.taskListComponent.GetSelectedTask()
 }
 
+// ScrollOffsets returns the task list and task details viewport offsets
+func (m *MainContentModel) ScrollOffsets() (taskList, taskDetails int) {
+	return m.taskListComponent.ScrollOffset(), m.taskDetailsComponent.ScrollOffset()
+}
+
+// RestoreScrollOffsets applies previously saved task list and task details offsets
+func (m *MainContentModel) RestoreScrollOffsets(taskList, taskDetails int) {
+	m.taskListComponent.SetScrollOffset(taskList)
+	m.taskDetailsComponent.SetScrollOffset(taskDetails)
+}
+
 // NewModel creates a new main content component with owned panel components
 func NewModel(context *base.ComponentContext) *MainContentModel {
 	baseComponent := base.NewBaseComponent(ComponentID, base.MainContentComponent, context)
@@ -99,6 +110,12 @@ func (m *MainContentModel) Update(msg tea.Msg) tea.Cmd {
 		leftPanelWidth := msg.Width / 2
 		rightPanelWidth := msg.Width - leftPanelWidth
 
+		// The task list takes the whole width while task details are hidden
+		taskListWidth := leftPanelWidth
+		if m.GetContext().UIState.HideDetails {
+			taskListWidth = msg.Width
+		}
+
 		// Always resize all components - ensures correct dimensions regardless of current mode
 		// This is simpler and guarantees components have proper dimensions when mode switches
 		var cmds []tea.Cmd
@@ -118,7 +135,7 @@ func (m *MainContentModel) Update(msg tea.Msg) tea.Cmd {
 		}
 
 		if cmd := m.taskListComponent.Update(tea.WindowSizeMsg{
-			Width:  leftPanelWidth,
+			Width:  taskListWidth,
 			Height: msg.Height,
 		}); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -170,7 +187,8 @@ func (m *MainContentModel) Update(msg tea.Msg) tea.Cmd {
 		return m.taskDetailsComponent.Update(updateMsg)
 
 	case taskdetails.TaskDetailsScrollMsg, taskdetails.TaskDetailsUpdateMsg,
-		taskdetails.TaskDetailsResizeMsg:
+		taskdetails.TaskDetailsResizeMsg, taskdetails.TaskDetailsToggleDescriptionMsg,
+		taskdetails.TaskDetailsSnapshotChangedMsg:
 		return m.taskDetailsComponent.Update(msg)
 
 	case projectdetails.ProjectDetailsScrollMsg, projectdetails.ProjectDetailsUpdateMsg,
@@ -185,6 +203,13 @@ func (m *MainContentModel) Update(msg tea.Msg) tea.Cmd {
 		}
 		return m.taskListComponent.Update(msg)
 
+	case messages.YankCommitRefMsg, messages.YankPathMsg:
+		// Commit references and hierarchy paths only make sense for tasks
+		if m.GetContext().UIState.IsProjectView() {
+			return nil
+		}
+		return m.taskListComponent.Update(msg)
+
 		// NOTE: ProjectTaskCountsMsg handler removed - ProjectList computes task counts on-demand
 	}
 
@@ -202,8 +227,11 @@ func (m *MainContentModel) View() string {
 		leftView = m.projectListComponent.View()
 		rightView = m.projectDetailsComponent.View()
 	} else {
-		// Task mode: left = task list, right = task details
+		// Task mode: left = task list, right = task details unless hidden
 		leftView = m.taskListComponent.View()
+		if m.GetContext().UIState.HideDetails {
+			return leftView
+		}
 		rightView = m.taskDetailsComponent.View()
 	}
 
@@ -2,12 +2,16 @@ package statusbar
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -68,6 +72,15 @@ type StatusBarModel struct {
 	// ===================================================================
 	statusMessage     string
 	statusMessageTime time.Time
+
+	// Rolling summaries of running operations, oldest first (see OperationProgressMsg)
+	operations []operationStatus
+}
+
+// operationStatus is the latest summary of a running operation
+type operationStatus struct {
+	id      string
+	summary string
 }
 
 // NewModel creates a new status bar component
@@ -120,6 +133,9 @@ func (m *StatusBarModel) Update(msg tea.Msg) tea.Cmd {
 	case messages.StatusFeedbackMsg:
 		m.statusMessage = msg.Message
 		m.statusMessageTime = time.Now()
+
+	case messages.OperationProgressMsg:
+		m.updateOperation(msg)
 	}
 
 	return nil
@@ -162,8 +178,8 @@ func (m *StatusBarModel) buildBlockingStatus() (string, StatusType) {
 	// TODO: Need to track active modal - consider adding to ProgramContext or passing via MainModel
 	// For now, this will need to be handled differently
 
-	// Loading state (blocks actions)
-	if ctx.Loading {
+	// Loading state (blocks actions), once it outlasts ui.display.loading_delay
+	if ctx.IsLoadingShown() {
 		return m.buildLoadingStatus(), StatusLoading
 	}
 
@@ -176,14 +192,56 @@ func (m *StatusBarModel) buildBlockingStatus() (string, StatusType) {
 }
 
 // buildTransientFeedbackStatus handles Tier 2: Transient user feedback
-// These messages show even in project mode, then auto-expire after 3 seconds
+// These messages show even in project mode, then auto-expire after 3 seconds.
+// While an operation runs its rolling summary shows once they expire.
 func (m *StatusBarModel) buildTransientFeedbackStatus() string {
 	if m.hasTemporaryMessage() {
 		return m.buildTemporaryMessageStatus()
 	}
+	if len(m.operations) > 0 {
+		return m.buildOperationStatus()
+	}
 	return ""
 }
 
+// updateOperation replaces the summary of a running operation in place. A
+// finished operation's final summary shows as a temporary message.
+func (m *StatusBarModel) updateOperation(msg messages.OperationProgressMsg) {
+	for i, op := range m.operations {
+		if op.id != msg.ID {
+			continue
+		}
+		if msg.Finished {
+			m.operations = append(m.operations[:i], m.operations[i+1:]...)
+		} else {
+			m.operations[i].summary = msg.Summary
+		}
+		break
+	}
+	if msg.Finished {
+		m.statusMessage = msg.Summary
+		m.statusMessageTime = time.Now()
+		return
+	}
+	if !slices.ContainsFunc(m.operations, func(op operationStatus) bool { return op.id == msg.ID }) {
+		m.operations = append(m.operations, operationStatus{id: msg.ID, summary: msg.Summary})
+	}
+}
+
+// buildOperationStatus creates status text for the most recent running
+// operation, counting any others
+func (m *StatusBarModel) buildOperationStatus() string {
+	text := m.operations[len(m.operations)-1].summary
+	if others := len(m.operations) - 1; others > 0 {
+		text += fmt.Sprintf(" (+%d more)", others)
+	}
+	prefix := "[Tasks]"
+	if m.GetContext().UIState.IsProjectView() {
+		prefix = "[Project]"
+	}
+	return fmt.Sprintf("%s %s | ?: help | q: quit", prefix, text)
+}
+
 // buildModeContextStatus handles Tier 3: Mode/Context fallback status
 func (m *StatusBarModel) buildModeContextStatus() (string, StatusType) {
 	// Project mode context (read from UIState)
@@ -199,9 +257,9 @@ func (m *StatusBarModel) buildModeContextStatus() (string, StatusType) {
 func (m *StatusBarModel) buildProjectModeStatus() string {
 	projectCount := len(m.ctx().Projects)
 	if projectCount > 0 {
-		return fmt.Sprintf("[Project] %d projects available | l: select | h: back | q: quit", projectCount)
+		return m.withShortcuts(fmt.Sprintf("[Project] %d projects available", projectCount), "l: select | h: back | q: quit")
 	}
-	return "Project Selection | ?: help | q: quit"
+	return m.withShortcuts("Project Selection", "?: help | q: quit")
 }
 
 // buildLoadingStatus creates status text for loading state
@@ -220,6 +278,9 @@ func (m *StatusBarModel) buildErrorStatus() string {
 	if len(errorMsg) > 80 {
 		errorMsg = errorMsg[:77] + "..."
 	}
+	if note := m.ctx().FailedAttemptsNote(); note != "" {
+		return fmt.Sprintf("[Tasks] Error (%s): %s | r: retry | q: quit", note, errorMsg)
+	}
 	return fmt.Sprintf("[Tasks] Error: %s | r: retry | q: quit", errorMsg)
 }
 
@@ -253,7 +314,7 @@ func (m *StatusBarModel) buildContextAwareStatus() string {
 	case "Task Details":
 		return m.buildDetailsContextStatus()
 	default:
-		return fmt.Sprintf("[%s] Ready | ?: help | q: quit", activeViewName)
+		return m.withShortcuts(fmt.Sprintf("[%s] Ready", activeViewName), "?: help | q: quit")
 	}
 }
 
@@ -263,30 +324,23 @@ func (m *StatusBarModel) buildTasksContextStatus() string {
 	todo, doing, review, done := m.ctx().GetTaskStatusCounts()
 	totalTasks := todo + doing + review + done
 
-	// Connection status indicator (read from context)
-	connectionStatus := "●" // Connected
-	if !m.ctx().Connected {
-		connectionStatus = "○" // Disconnected
-	}
+	connectionStatus := m.connectionIndicator()
 
 	if totalTasks == 0 {
-		return fmt.Sprintf("[Tasks] %s No tasks found | r: refresh | q: quit", connectionStatus)
+		return m.withShortcuts(fmt.Sprintf("[Tasks] %sNo tasks found", connectionStatus), "r: refresh | q: quit")
 	}
 
 	// Build task information (pass computed values directly)
 	sortModeName := m.ctx().GetCurrentSortModeName()
 	statusInfo := m.buildTaskStatusInfo(todo, doing, review, done, totalTasks, sortModeName)
 
-	// Build shortcuts
-	shortcutText := m.buildTaskShortcuts()
-
-	return fmt.Sprintf("[Tasks] %s %s | %s", connectionStatus, statusInfo, shortcutText)
+	return m.withShortcuts(fmt.Sprintf("[Tasks] %s%s", connectionStatus, statusInfo), m.buildTaskShortcuts())
 }
 
 // buildTaskStatusInfo creates the task status information part of the status bar
 func (m *StatusBarModel) buildTaskStatusInfo(todo, doing, review, _ /* done */, totalTasks int, sortMode string) string {
 	statusParts := make([]string, 0, 5) // Preallocate: items, doing, review, todo, sort, search
-	statusParts = append(statusParts, fmt.Sprintf("%d items", totalTasks))
+	statusParts = append(statusParts, m.formatTaskCount(totalTasks))
 
 	// Add status distribution if there are active tasks
 	if doing > 0 || review > 0 {
@@ -306,17 +360,43 @@ func (m *StatusBarModel) buildTaskStatusInfo(todo, doing, review, _ /* done */,
 	// Add sort mode
 	statusParts = append(statusParts, fmt.Sprintf("Sort: %s", sortMode))
 
+	// Add feature filter so a quick single-feature filter is visible where the counts are
+	if m.ctx().FeatureFilterActive {
+		statusParts = append(statusParts, fmt.Sprintf("Feature: %s", m.ctx().GetFeatureFilterSummary()))
+	}
+	if m.ctx().TagFilters != nil {
+		statusParts = append(statusParts, fmt.Sprintf("Tags: %s", m.ctx().GetTagFilterSummary()))
+	}
+
+	// Add created-today view indicator
+	if m.ctx().CreatedSince != nil {
+		statusParts = append(statusParts, "Created: today")
+	}
+	if marked := len(m.ctx().MarkedTasks); marked > 0 {
+		statusParts = append(statusParts, fmt.Sprintf("Marked: %d", marked))
+	}
+	if m.ctx().ChangedOnly {
+		statusParts = append(statusParts, "Changed: last refresh")
+	}
+	if minPriority := m.ctx().MinPriority; minPriority > 0 {
+		statusParts = append(statusParts, fmt.Sprintf("Priority: ≥%d", minPriority))
+	}
+
+	// Warn before the server starts rejecting requests
+	if rateLimit := m.ctx().RateLimit; rateLimit.Low() {
+		statusParts = append(statusParts, formatRateLimit(rateLimit, m.ctx().Now()))
+	}
+
 	// Add search match information if search is active (call context method)
 	// Need to get selectedIndex from UIState to compute current match
 	selectedIndex := m.GetContext().UIState.GetSelectedTaskIndex()
 	searchActive, searchQuery, _, currentMatch, totalMatches := m.GetContext().UIState.GetTaskSearchState(selectedIndex)
 	if searchActive && searchQuery != "" {
+		matchInfo := "No matches"
 		if totalMatches > 0 {
-			matchInfo := fmt.Sprintf("Match %d/%d", currentMatch+1, totalMatches)
-			statusParts = append(statusParts, matchInfo)
-		} else {
-			statusParts = append(statusParts, "No matches")
+			matchInfo = fmt.Sprintf("Match %d/%d", currentMatch+1, totalMatches)
 		}
+		statusParts = append(statusParts, m.searchModeLabel()+matchInfo)
 	}
 
 	// Join parts with bullet separator using lipgloss
@@ -338,11 +418,23 @@ func (m *StatusBarModel) buildTaskStatusInfo(todo, doing, review, _ /* done */,
 	return lipgloss.JoinHorizontal(lipgloss.Left, parts...)
 }
 
+// formatRateLimit describes the remaining API requests, e.g. "API: 12/100 left, resets in 45s"
+func formatRateLimit(rateLimit *archon.RateLimit, now time.Time) string {
+	text := fmt.Sprintf("API: %d left", rateLimit.Remaining)
+	if rateLimit.Limit > 0 {
+		text = fmt.Sprintf("API: %d/%d left", rateLimit.Remaining, rateLimit.Limit)
+	}
+	if !rateLimit.Reset.IsZero() && rateLimit.Reset.After(now) {
+		text += ", resets in " + clock.FormatDuration(rateLimit.Reset.Sub(now))
+	}
+	return text
+}
+
 // buildTaskShortcuts creates the shortcuts part of the tasks status bar
 func (m *StatusBarModel) buildTaskShortcuts() string {
 	shortcuts := make([]string, 0, 5) // Preallocate: features, search, next/prev, clear, help
 	// Get feature count from context
-	featureCount := len(m.ctx().GetUniqueFeatures())
+	featureCount := m.ctx().GetFeatureCount()
 	if featureCount > 0 {
 		shortcuts = append(shortcuts, "f: features")
 	}
@@ -380,13 +472,45 @@ func (m *StatusBarModel) buildTaskShortcuts() string {
 func (m *StatusBarModel) buildDetailsContextStatus() string {
 	position := m.getCurrentPosition()
 
-	// Connection status indicator (read from context)
-	connectionStatus := "●" // Connected
+	return m.withShortcuts(fmt.Sprintf("[Details] %s%s", m.connectionIndicator(), position), "?: help")
+}
+
+// searchModeLabel tells server search results from local matches when
+// ui.display.search_mode is "server" ("" with local search configured)
+func (m *StatusBarModel) searchModeLabel() string {
+	if m.ctx().ServerSearchResults != nil {
+		return "Server search: "
+	}
+	if provider := m.GetContext().ConfigProvider; provider != nil {
+		if display := provider.GetDisplay(); display != nil && display.SearchMode == config.SearchServer {
+			return "Local search: "
+		}
+	}
+	return ""
+}
+
+// connectionIndicator returns the connection dot with its trailing space: ●
+// while connected, ○ while not. With ui.display.connection_indicator set to
+// "disconnected" only the ○ is shown.
+func (m *StatusBarModel) connectionIndicator() string {
 	if !m.ctx().Connected {
-		connectionStatus = "○" // Disconnected
+		return "○ "
+	}
+	if provider := m.GetContext().ConfigProvider; provider != nil {
+		if display := provider.GetDisplay(); display != nil && display.ConnectionIndicator == config.ConnectionIndicatorDisconnected {
+			return ""
+		}
 	}
+	return "● "
+}
 
-	return fmt.Sprintf("[Details] %s %s | ?: help", connectionStatus, position)
+// withShortcuts appends the shortcut hints to status unless they were hidden
+// (ui.display.shortcut_hints, toggled with '!'), leaving the width to status
+func (m *StatusBarModel) withShortcuts(status, shortcuts string) string {
+	if m.GetContext().UIState.HideShortcutHints {
+		return status
+	}
+	return status + " | " + shortcuts
 }
 
 // renderWithStatus renders the final status bar with styling and truncation
@@ -401,6 +525,23 @@ func (m *StatusBarModel) renderWithStatus(statusText string, statusType StatusTy
 // HELPER METHODS
 // ===================================================================
 
+// formatTaskCount shows how many of the tasks the filters let through, led
+// by the shown or the total count as toggled with '#'. Unfiltered lists show
+// the total alone.
+func (m *StatusBarModel) formatTaskCount(totalTasks int) string {
+	if m.GetContext().GetSortedTasks == nil {
+		return fmt.Sprintf("%d items", totalTasks)
+	}
+	shown := len(m.GetContext().GetSortedTasks())
+	if shown == totalTasks {
+		return fmt.Sprintf("%d items", totalTasks)
+	}
+	if m.GetContext().UIState.CountTotalFirst {
+		return fmt.Sprintf("%d tasks (%d shown)", totalTasks, shown)
+	}
+	return fmt.Sprintf("%d of %d tasks", shown, totalTasks)
+}
+
 // getCurrentPosition returns position info for the current selection
 func (m *StatusBarModel) getCurrentPosition() string {
 	// Get sorted tasks from context callback (complex filtering logic in MainModel)
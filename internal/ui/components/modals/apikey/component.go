@@ -0,0 +1,201 @@
+package apikey
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "api-key-modal"
+
+// maxKeyLength caps the input; real keys are far shorter
+const maxKeyLength = 512
+
+// APIKeyModel asks for a new API key after the server rejected the current one
+// and it could not be re-read from the environment, command or config file
+// Architecture: Follows four-tier state pattern
+// - No source data caching (reason passed via ShowAPIKeyModalMsg)
+// - No display parameters (single masked input)
+// - Owned state only (typed key, reason)
+// - No transient feedback (applying the key is handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type APIKeyModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	input  []rune // Typed key, never rendered in clear
+	reason string // Why the prompt was opened
+}
+
+// NewModel creates a new API key prompt component
+func NewModel(context *base.ComponentContext) *APIKeyModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.APIKeyModalComponent,
+		context,
+	)
+
+	model := &APIKeyModel{
+		BaseModal: baseModal,
+	}
+	model.SetDimensions(60, 11)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *APIKeyModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the API key prompt component
+func (m *APIKeyModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the API key prompt component
+func (m *APIKeyModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowAPIKeyModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.input = nil
+		m.reason = msg.Reason
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeAPIKey),
+			Active: true,
+		})
+
+	case HideAPIKeyModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		m.input = nil // Do not keep the key around longer than needed
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeAPIKey),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the API key prompt
+func (m *APIKeyModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// handleKeyPress processes keyboard input. Every printable key, 'q' and '?'
+// included, is part of the key being typed.
+func (m *APIKeyModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	switch key.String() {
+	case keys.KeyEscape:
+		return tea.Batch(
+			m.BroadcastMessage(APIKeyCanceledMsg{}),
+			m.BroadcastMessage(HideAPIKeyModalMsg{}),
+		)
+
+	case keys.KeyEnter:
+		apiKey := strings.TrimSpace(string(m.input))
+		if apiKey == "" {
+			return nil
+		}
+		return tea.Batch(
+			m.BroadcastMessage(APIKeyEnteredMsg{Key: apiKey}),
+			m.BroadcastMessage(HideAPIKeyModalMsg{}),
+		)
+
+	case keys.KeyBackspace:
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+		return nil
+
+	case keys.KeyCtrlU:
+		m.input = nil
+		return nil
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+	}
+
+	// Typed or pasted characters
+	if key.Type == tea.KeyRunes && len(m.input)+len(key.Runes) <= maxKeyLength {
+		m.input = append(m.input, key.Runes...)
+	}
+	return nil
+}
+
+// updateDimensions fits the modal within the screen width
+func (m *APIKeyModel) updateDimensions(screenWidth int) {
+	m.SetDimensions(min(70, screenWidth-4), 11)
+}
+
+// renderModal renders the complete API key prompt
+func (m *APIKeyModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("214")). // Orange: something needs attention
+		Width(m.GetWidth()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content
+func (m *APIKeyModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+	content.WriteString(titleStyle.Render("API key rejected"))
+	content.WriteString("\n\n")
+
+	if m.reason != "" {
+		reasonStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Width(innerWidth)
+		content.WriteString(reasonStyle.Render(m.reason))
+		content.WriteString("\n\n")
+	}
+
+	content.WriteString("New key: ")
+	masked := strings.Repeat("•", min(len(m.input), innerWidth-10))
+	content.WriteString(masked + "█")
+	content.WriteString("\n\n")
+
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	content.WriteString(helpStyle.Render("Enter use for this session • Ctrl+U clear • Esc cancel"))
+
+	return content.String()
+}
+
+// Helper functions
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
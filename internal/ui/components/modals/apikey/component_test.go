@@ -0,0 +1,190 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	// Create a mock ProgramContext with screen dimensions
+	mockProgramContext := &context.ProgramContext{
+		ScreenWidth:  80,
+		ScreenHeight: 24,
+	}
+
+	return &base.ComponentContext{
+		ProgramContext:       mockProgramContext,
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.APIKeyModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.APIKeyModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected API key prompt to be initially inactive")
+	}
+}
+
+func TestTypingIsMasked(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowAPIKeyModalMsg{Reason: "The server rejected the API key"})
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected API key prompt to be active and focused after show message")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("secret-q?")})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+	view := model.View()
+	if strings.Contains(view, "secret") {
+		t.Errorf("Expected the key to be masked, got:\n%s", view)
+	}
+	if !strings.Contains(view, strings.Repeat("•", 8)+"█") || !strings.Contains(view, "rejected the API key") {
+		t.Errorf("Expected 8 mask characters and the reason, got:\n%s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	if cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("Expected Enter to do nothing with an empty key")
+	}
+}
+
+func TestEnterSubmitsKey(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowAPIKeyModalMsg{})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" new-key ")})
+
+	var entered *APIKeyEnteredMsg
+	hidden := false
+	for _, msg := range collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyEnter})) {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		switch msg := msg.(type) {
+		case APIKeyEnteredMsg:
+			entered = &msg
+		case HideAPIKeyModalMsg:
+			hidden = true
+		}
+	}
+	if entered == nil || entered.Key != "new-key" {
+		t.Errorf("Expected APIKeyEnteredMsg with the trimmed key, got %+v", entered)
+	}
+	if !hidden {
+		t.Error("Expected the prompt to hide after Enter")
+	}
+
+	// The typed key is not kept once hidden
+	model.Update(HideAPIKeyModalMsg{})
+	if len(model.input) != 0 {
+		t.Error("Expected the input cleared when hidden")
+	}
+}
+
+func TestEscapeCancels(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowAPIKeyModalMsg{})
+
+	canceled := false
+	for _, msg := range collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyEscape})) {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		if _, ok := msg.(APIKeyCanceledMsg); ok {
+			canceled = true
+		}
+	}
+	if !canceled {
+		t.Error("Expected APIKeyCanceledMsg from Escape")
+	}
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShowAPIKeyModalMsg is sent when the user should be asked for an API key
+type ShowAPIKeyModalMsg struct {
+	Reason string // Why the current key was not usable, shown above the input
+}
+
+// HideAPIKeyModalMsg is sent when the API key prompt should be hidden
+type HideAPIKeyModalMsg struct{}
+
+// APIKeyModalShownMsg is sent when the API key prompt has been shown and is active
+type APIKeyModalShownMsg struct{}
+
+// APIKeyModalHiddenMsg is sent when the API key prompt has been hidden and is inactive
+type APIKeyModalHiddenMsg struct{}
+
+// APIKeyEnteredMsg is sent when the user submitted a key
+type APIKeyEnteredMsg struct {
+	Key string
+}
+
+// APIKeyCanceledMsg is sent when the user dismissed the prompt without a key
+type APIKeyCanceledMsg struct{}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowAPIKeyModalMsg{}
+	_ tea.Msg = HideAPIKeyModalMsg{}
+	_ tea.Msg = APIKeyModalShownMsg{}
+	_ tea.Msg = APIKeyModalHiddenMsg{}
+	_ tea.Msg = APIKeyEnteredMsg{}
+	_ tea.Msg = APIKeyCanceledMsg{}
+)
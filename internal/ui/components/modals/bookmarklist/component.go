@@ -0,0 +1,234 @@
+package bookmarklist
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "bookmark-list-modal"
+
+// BookmarkListModel lists bookmarked tasks by slot
+// Architecture: Follows four-tier state pattern
+// - No source data caching (receives entries via ShowBookmarkListModalMsg)
+// - No display parameters (simple selection modal)
+// - Owned state only (selection, entries)
+// - No transient feedback (jumping and clearing are handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type BookmarkListModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	selectedIndex int               // Currently selected entry
+	entries       []bookmarks.Entry // Bookmarks being shown (passed via message)
+}
+
+// NewModel creates a new bookmark list modal component
+func NewModel(context *base.ComponentContext) *BookmarkListModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.BookmarkListModalComponent,
+		context,
+	)
+
+	model := &BookmarkListModel{
+		BaseModal: baseModal,
+	}
+	model.SetDimensions(60, 10)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *BookmarkListModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the bookmark list modal component
+func (m *BookmarkListModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the bookmark list modal component
+func (m *BookmarkListModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowBookmarkListModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.entries = msg.Entries
+		m.selectedIndex = 0
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeBookmarkList),
+			Active: true,
+		})
+
+	case HideBookmarkListModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeBookmarkList),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width, msg.Height)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the bookmark list modal
+func (m *BookmarkListModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// GetSelectedEntry returns the currently highlighted bookmark, if any
+func (m *BookmarkListModel) GetSelectedEntry() (bookmarks.Entry, bool) {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.entries) {
+		return bookmarks.Entry{}, false
+	}
+	return m.entries[m.selectedIndex], true
+}
+
+// handleKeyPress processes keyboard input for the bookmark list modal
+func (m *BookmarkListModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	switch key.String() {
+	case keys.KeyEscape, keys.KeyQ:
+		return m.BroadcastMessage(HideBookmarkListModalMsg{})
+
+	case keys.KeyJ, keys.KeyArrowDown:
+		if m.selectedIndex < len(m.entries)-1 {
+			m.selectedIndex++
+		}
+		return nil
+
+	case keys.KeyK, keys.KeyArrowUp:
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+		return nil
+
+	case keys.KeyEnter, keys.KeyL:
+		entry, ok := m.GetSelectedEntry()
+		if !ok {
+			return m.BroadcastMessage(HideBookmarkListModalMsg{})
+		}
+		return tea.Batch(
+			m.BroadcastMessage(BookmarkChosenMsg{Slot: entry.Slot}),
+			m.BroadcastMessage(HideBookmarkListModalMsg{}),
+		)
+
+	case keys.KeyD:
+		entry, ok := m.GetSelectedEntry()
+		if !ok {
+			return nil
+		}
+		m.entries = append(m.entries[:m.selectedIndex:m.selectedIndex], m.entries[m.selectedIndex+1:]...)
+		if m.selectedIndex >= len(m.entries) && m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+		return m.BroadcastMessage(BookmarkClearedMsg{Slot: entry.Slot})
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+
+	default:
+		return nil
+	}
+}
+
+// updateDimensions sizes the modal to fit the entries within the screen
+func (m *BookmarkListModel) updateDimensions(screenWidth, screenHeight int) {
+	// Title + blank + entries + blank + instructions, plus padding
+	height := max(len(m.entries), 1) + 6
+	m.SetDimensions(min(70, screenWidth-4), min(height, screenHeight-4))
+}
+
+// renderModal renders the complete bookmark list modal
+func (m *BookmarkListModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
+		Width(m.GetWidth()).
+		Height(m.GetHeight()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content, keeping the selection in view
+func (m *BookmarkListModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
+	content.WriteString(titleStyle.Render(fmt.Sprintf("Bookmarks (%d)", len(m.entries))))
+	content.WriteString("\n\n")
+
+	if len(m.entries) == 0 {
+		content.WriteString("No bookmarks. Press m then a letter to set one.\n")
+	}
+
+	// Rows left for entries after title, blank lines and instructions
+	visible := max(m.GetHeight()-6, 1)
+	start := 0
+	if m.selectedIndex >= visible {
+		start = m.selectedIndex - visible + 1
+	}
+	end := min(start+visible, len(m.entries))
+
+	for i := start; i < end; i++ {
+		entry := m.entries[i]
+		prefix := "  "
+		if i == m.selectedIndex {
+			prefix = "▶ "
+		}
+		line := view.TruncatePreservingANSI(fmt.Sprintf("%s%s  %s", prefix, entry.Slot, m.entryTitle(entry)), innerWidth)
+		if i == m.selectedIndex {
+			line = lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("15")).Render(line)
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	content.WriteString(helpStyle.Render("↑/↓ navigate • Enter jump • d delete • Esc close"))
+
+	return content.String()
+}
+
+// entryTitle returns the task's current title when loaded, else the title saved with the bookmark
+func (m *BookmarkListModel) entryTitle(entry bookmarks.Entry) string {
+	if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+		if task := ctx.ProgramContext.FindTask(entry.TaskID); task != nil {
+			return task.Title
+		}
+	}
+	return entry.Title + " (not loaded)"
+}
@@ -0,0 +1,185 @@
+package bookmarklist
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	// Create a mock ProgramContext with screen dimensions
+	mockProgramContext := &context.ProgramContext{
+		ScreenWidth:  80,
+		ScreenHeight: 24,
+		Tasks:        []archon.Task{{ID: "t1", Title: "Ship login (renamed)"}},
+	}
+
+	return &base.ComponentContext{
+		ProgramContext:       mockProgramContext,
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func testEntries() []bookmarks.Entry {
+	return []bookmarks.Entry{
+		{Slot: "a", Bookmark: bookmarks.Bookmark{TaskID: "t1", Title: "Ship login"}},
+		{Slot: "b", Bookmark: bookmarks.Bookmark{TaskID: "t9", Title: "Elsewhere"}},
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.BookmarkListModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.BookmarkListModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected bookmark list to be initially inactive")
+	}
+}
+
+func TestShowAndRender(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowBookmarkListModalMsg{Entries: testEntries()})
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected bookmark list to be active and focused after show message")
+	}
+
+	view := model.View()
+	for _, expected := range []string{"Bookmarks (2)", "Ship login (renamed)", "Elsewhere (not loaded)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+}
+
+func TestEnterChoosesAndDeleteClears(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowBookmarkListModalMsg{Entries: testEntries()})
+
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	var cleared *BookmarkClearedMsg
+	for _, msg := range unwrap(collectMessages(cmd)) {
+		if msg, ok := msg.(BookmarkClearedMsg); ok {
+			cleared = &msg
+		}
+	}
+	if cleared == nil || cleared.Slot != "a" {
+		t.Errorf("Expected BookmarkClearedMsg for slot a, got %+v", cleared)
+	}
+
+	cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	var chosen *BookmarkChosenMsg
+	hidden := false
+	for _, msg := range unwrap(collectMessages(cmd)) {
+		switch msg := msg.(type) {
+		case BookmarkChosenMsg:
+			chosen = &msg
+		case HideBookmarkListModalMsg:
+			hidden = true
+		}
+	}
+	if chosen == nil || chosen.Slot != "b" {
+		t.Errorf("Expected BookmarkChosenMsg for remaining slot b, got %+v", chosen)
+	}
+	if !hidden {
+		t.Error("Expected the list to close after choosing a bookmark")
+	}
+}
+
+// unwrap extracts payloads from broadcast component messages
+func unwrap(msgs []tea.Msg) []tea.Msg {
+	for i, msg := range msgs {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msgs[i] = wrapped.Payload
+		}
+	}
+	return msgs
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,40 @@
+package bookmarklist
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+)
+
+// ShowBookmarkListModalMsg is sent when the bookmark list should be shown
+type ShowBookmarkListModalMsg struct {
+	Entries []bookmarks.Entry
+}
+
+// HideBookmarkListModalMsg is sent when the bookmark list should be hidden
+type HideBookmarkListModalMsg struct{}
+
+// BookmarkListModalShownMsg is sent when the bookmark list has been shown and is active
+type BookmarkListModalShownMsg struct{}
+
+// BookmarkListModalHiddenMsg is sent when the bookmark list has been hidden and is inactive
+type BookmarkListModalHiddenMsg struct{}
+
+// BookmarkChosenMsg is sent when the user picks a bookmark to jump to
+type BookmarkChosenMsg struct {
+	Slot string
+}
+
+// BookmarkClearedMsg is sent when the user removes a bookmark from the list
+type BookmarkClearedMsg struct {
+	Slot string
+}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowBookmarkListModalMsg{}
+	_ tea.Msg = HideBookmarkListModalMsg{}
+	_ tea.Msg = BookmarkListModalShownMsg{}
+	_ tea.Msg = BookmarkListModalHiddenMsg{}
+	_ tea.Msg = BookmarkChosenMsg{}
+	_ tea.Msg = BookmarkClearedMsg{}
+)
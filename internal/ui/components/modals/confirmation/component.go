@@ -1,10 +1,12 @@
 package confirmation
 
 import (
+	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -28,10 +30,16 @@ type ConfirmationModel struct {
 	// ===================================================================
 	// OWNED STATE - Component manages these directly
 	// ===================================================================
-	selectedIndex int    // Currently selected option (0=confirm, 1=cancel)
-	message       string // The confirmation message to display
-	confirmText   string // Text for confirm button
-	cancelText    string // Text for cancel button
+	selectedIndex int      // Currently selected option (0=confirm, 1=cancel)
+	message       string   // The confirmation message to display
+	confirmText   string   // Text for confirm button
+	cancelText    string   // Text for cancel button
+	altKey        string   // Key for the optional third choice; empty = none
+	altText       string   // Hint shown for altKey
+	details       []string // Lines shown under the message; the modal grows to fit them
+
+	screenWidth  int // Last known screen size, to resize when details change
+	screenHeight int
 }
 
 // NewModel creates a new confirmation modal component
@@ -71,6 +79,12 @@ func (m *ConfirmationModel) Update(msg tea.Msg) tea.Cmd {
 		if msg.CancelText != "" {
 			m.cancelText = msg.CancelText
 		}
+		m.altKey = msg.AltKey
+		m.altText = msg.AltText
+		m.details = msg.Details
+		if m.screenWidth > 0 {
+			m.updateDimensions(m.screenWidth, m.screenHeight)
+		}
 		m.selectedIndex = 0 // Reset to confirm option
 		return m.BroadcastMessage(messages.ModalStateMsg{
 			Type:   string(base.ModalTypeConfirmation),
@@ -136,6 +150,17 @@ func (m *ConfirmationModel) SetConfirmationInfo(message, confirmText, cancelText
 func (m *ConfirmationModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
 	keyString := key.String()
 
+	if m.altKey != "" && strings.EqualFold(keyString, m.altKey) {
+		// Alternate choice
+		return tea.Batch(
+			m.BroadcastMessage(ConfirmationSelectedMsg{
+				Alternate: true,
+				Message:   m.message,
+			}),
+			m.BroadcastMessage(HideConfirmationModalMsg{}),
+		)
+	}
+
 	switch keyString {
 	case keys.KeyQuestion, keys.KeyEscape, keys.KeyQ:
 		// Cancel action
@@ -228,9 +253,19 @@ func (m *ConfirmationModel) navigateRight() {
 
 // updateDimensions updates the modal dimensions based on screen size
 func (m *ConfirmationModel) updateDimensions(screenWidth, screenHeight int) {
+	m.screenWidth, m.screenHeight = screenWidth, screenHeight
+
 	// Modal should be compact but readable - confirmation modals should be small
-	width := min(45, screenWidth-6)  // Leave more margin for better centering
-	height := min(9, screenHeight-6) // Height to accommodate proper spacing
+	width, height := 45, 9
+	if len(m.details) > 0 {
+		// Grow to fit the details: one line each plus a blank line above them
+		for _, line := range m.details {
+			width = max(width, lipgloss.Width(line)+6) // Border and padding
+		}
+		height += len(m.details) + 1
+	}
+	width = min(width, screenWidth-6)    // Leave more margin for better centering
+	height = min(height, screenHeight-6) // Height to accommodate proper spacing
 	m.SetDimensions(width, height)
 }
 
@@ -246,7 +281,7 @@ func (m *ConfirmationModel) renderModal() string {
 
 	// Create the modal with border (similar to other modal components)
 	modal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
 		Width(modalWidth).
 		Height(modalHeight).
@@ -277,6 +312,13 @@ func (m *ConfirmationModel) renderContent() string {
 	content.WriteString(message)
 	content.WriteString("\n\n")
 
+	// Details - a left-aligned block, centered as a whole
+	if len(m.details) > 0 {
+		details := lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Render(strings.Join(m.details, "\n"))
+		content.WriteString(details)
+		content.WriteString("\n\n")
+	}
+
 	// Options - centered for better visual appeal
 	optionsLine := m.renderOptions()
 	centeredOptions := lipgloss.NewStyle().Align(lipgloss.Center).Render(optionsLine)
@@ -285,7 +327,11 @@ func (m *ConfirmationModel) renderContent() string {
 
 	// Instructions - centered and more compact
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Align(lipgloss.Center)
-	instructions := helpStyle.Render("←/→ • Enter • Y/N • Esc")
+	hint := "←/→ • Enter • Y/N • Esc"
+	if m.altKey != "" {
+		hint = fmt.Sprintf("Y/N • %s %s • Esc", strings.ToUpper(m.altKey), m.altText)
+	}
+	instructions := helpStyle.Render(hint)
 	content.WriteString(instructions)
 
 	// Add some bottom spacing
@@ -1,6 +1,7 @@
 package confirmation
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -313,6 +314,76 @@ func TestConfirmationModalKeyHandling(t *testing.T) {
 	}
 }
 
+func TestConfirmationModalAltKey(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowConfirmationModalMsg{
+		Message: "Copy 312KB to clipboard?",
+		AltKey:  "s",
+		AltText: "save to file",
+	})
+
+	if view := model.View(); !strings.Contains(view, "S save to file") {
+		t.Errorf("Expected alternate choice hint in view, got %q", view)
+	}
+
+	var selected *ConfirmationSelectedMsg
+	for _, msg := range collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})) {
+		if sel, ok := msg.(base.ComponentMessage).Payload.(ConfirmationSelectedMsg); ok {
+			selected = &sel
+		}
+	}
+	if selected == nil || !selected.Alternate || selected.Confirmed {
+		t.Fatalf("Expected alternate selection, got %+v", selected)
+	}
+
+	// The alternate choice does not carry over to the next prompt
+	model.Update(ShowConfirmationModalMsg{Message: "Quit?"})
+	if model.altKey != "" {
+		t.Errorf("Expected alternate choice cleared, got %q", model.altKey)
+	}
+}
+
+func TestConfirmationModalDetails(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model.Update(ShowConfirmationModalMsg{
+		Message: "Apply 2 changes?",
+		Details: []string{"status: doing → review", "title: Fix login → Fix the login redirect on Safari"},
+	})
+
+	view := model.View()
+	for _, want := range []string{"status: doing → review", "Fix the login redirect on Safari"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got %q", want, view)
+		}
+	}
+	if model.GetHeight() != 12 || model.GetWidth() <= 45 {
+		t.Errorf("Expected the modal to grow for the details, got %dx%d", model.GetWidth(), model.GetHeight())
+	}
+
+	// The next prompt without details shrinks back
+	model.Update(ShowConfirmationModalMsg{Message: "Quit?"})
+	if model.GetHeight() != 9 || model.GetWidth() != 45 {
+		t.Errorf("Expected the default size, got %dx%d", model.GetWidth(), model.GetHeight())
+	}
+}
+
+// collectMessages runs cmd and flattens batches into their messages
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
 func TestSetConfirmationInfo(t *testing.T) {
 	context := createTestContext()
 	model := NewModel(context)
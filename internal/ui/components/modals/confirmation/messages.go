@@ -4,9 +4,13 @@ import tea "github.com/charmbracelet/bubbletea"
 
 // ShowConfirmationModalMsg is sent when the confirmation modal should be shown
 type ShowConfirmationModalMsg struct {
-	Message     string // The confirmation message to display
-	ConfirmText string // Text for the confirm button (default: "Yes")
-	CancelText  string // Text for the cancel button (default: "No")
+	Message     string   // The confirmation message to display
+	ConfirmText string   // Text for the confirm button (default: "Yes")
+	CancelText  string   // Text for the cancel button (default: "No")
+	AltKey      string   // Optional key for a third choice (e.g., "s"); empty = none
+	AltText     string   // Hint shown for AltKey (e.g., "save to file")
+	Details     []string // Optional lines shown left-aligned under the message (e.g., a change preview)
+	Destructive bool     // Confirming deletes or declining throws work away (see ui.display.destructive_modal_timeout)
 }
 
 // HideConfirmationModalMsg is sent when the confirmation modal should be hidden
@@ -21,6 +25,7 @@ type ConfirmationModalHiddenMsg struct{}
 // ConfirmationSelectedMsg is sent when a confirmation choice has been made
 type ConfirmationSelectedMsg struct {
 	Confirmed bool   // true if confirmed, false if canceled
+	Alternate bool   // true if the AltKey choice was made (Confirmed is false)
 	Message   string // The original message that was confirmed/canceled
 }
 
@@ -0,0 +1,304 @@
+package descdiff
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "desc-diff-modal"
+
+// contextLines is how many unchanged lines show around each change
+const contextLines = 2
+
+// Diff colors
+var (
+	unchangedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	deletedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("203"))
+	insertedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("114"))
+	deletedWord    = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("88"))
+	insertedWord   = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("22"))
+)
+
+// DescDiffModel shows a unified diff between the description a task had when
+// the user last viewed it and its current one
+// Architecture: Follows four-tier state pattern
+// - No source data caching (receives both versions via ShowDescDiffModalMsg)
+// - Display parameters: rendered lines, rebuilt on resize
+// - Owned state only (scroll offset)
+// - No transient feedback (acknowledging is handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type DescDiffModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	show   ShowDescDiffModalMsg // Versions being compared (passed via message)
+	diff   []taskdiff.Line      // Line diff of show.Old and show.New
+	lines  []string             // diff rendered for the current width
+	offset int                  // First visible line
+}
+
+// NewModel creates a new description diff modal component
+func NewModel(context *base.ComponentContext) *DescDiffModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.DescDiffModalComponent,
+		context,
+	)
+
+	model := &DescDiffModel{
+		BaseModal: baseModal,
+	}
+	model.SetDimensions(80, 20)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *DescDiffModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the description diff modal component
+func (m *DescDiffModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the description diff modal component
+func (m *DescDiffModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowDescDiffModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.show = msg
+		m.diff = taskdiff.Text(msg.Old, msg.New)
+		m.offset = 0
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+		} else {
+			m.lines = diffLines(m.diff, m.GetWidth()-4)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeDescDiff),
+			Active: true,
+		})
+
+	case HideDescDiffModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeDescDiff),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width, msg.Height)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the description diff modal
+func (m *DescDiffModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// handleKeyPress processes keyboard input for the description diff modal
+func (m *DescDiffModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	switch key.String() {
+	case keys.KeyEscape, keys.KeyQ:
+		return m.BroadcastMessage(HideDescDiffModalMsg{})
+
+	case keys.KeyEnter:
+		return tea.Batch(
+			m.BroadcastMessage(DescriptionAcknowledgedMsg{TaskID: m.show.TaskID, Description: m.show.Current}),
+			m.BroadcastMessage(HideDescDiffModalMsg{}),
+		)
+
+	case keys.KeyJ, keys.KeyArrowDown:
+		m.scroll(1)
+	case keys.KeyK, keys.KeyArrowUp:
+		m.scroll(-1)
+	case keys.KeyCtrlD, keys.KeyPgDn:
+		m.scroll(max(m.visibleLines()/2, 1))
+	case keys.KeyCtrlU, keys.KeyPgUp:
+		m.scroll(-max(m.visibleLines()/2, 1))
+	case keys.KeyG, keys.KeyHome:
+		m.offset = 0
+	case keys.KeyGCap, keys.KeyEnd:
+		m.scroll(len(m.lines))
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+	}
+	return nil
+}
+
+// scroll moves the view by delta lines, staying within the diff
+func (m *DescDiffModel) scroll(delta int) {
+	m.offset = max(min(m.offset+delta, len(m.lines)-m.visibleLines()), 0)
+}
+
+// updateDimensions sizes the modal to fit the diff within the screen
+func (m *DescDiffModel) updateDimensions(screenWidth, screenHeight int) {
+	width := min(100, screenWidth-4)
+	m.lines = diffLines(m.diff, width-4)
+	m.SetDimensions(width, min(len(m.lines)+m.chromeLines()+2, screenHeight-4))
+	m.scroll(0)
+}
+
+// chromeLines counts the content lines around the diff: title, legend,
+// notes, blank lines and instructions
+func (m *DescDiffModel) chromeLines() int {
+	return len(m.notes()) + 5
+}
+
+// visibleLines returns how many diff lines fit in the modal
+func (m *DescDiffModel) visibleLines() int {
+	return max(m.GetHeight()-2-m.chromeLines(), 1) // Height includes the padding
+}
+
+// notes explains what the diff leaves out
+func (m *DescDiffModel) notes() []string {
+	var notes []string
+	if m.show.Truncated {
+		notes = append(notes, "⚠ Long description: only its start was remembered, later changes are not shown")
+		if m.show.Old == m.show.New {
+			notes = append(notes, "  The compared part is unchanged; the description changed further down")
+		}
+	}
+	return notes
+}
+
+// renderModal renders the complete description diff modal
+func (m *DescDiffModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
+		Width(m.GetWidth()).
+		Height(m.GetHeight()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content at the current scroll offset
+func (m *DescDiffModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
+	content.WriteString(titleStyle.Render(ansi.Truncate("Description changes: "+m.show.Title, innerWidth, "…")))
+	content.WriteString("\n")
+	content.WriteString(deletedStyle.Render("- as last viewed") + "  " + insertedStyle.Render("+ now"))
+	content.WriteString("\n")
+
+	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	for _, note := range m.notes() {
+		content.WriteString(warningStyle.Render(ansi.Truncate(note, innerWidth, "…")))
+		content.WriteString("\n")
+	}
+	content.WriteString("\n")
+
+	end := min(m.offset+m.visibleLines(), len(m.lines))
+	for _, line := range m.lines[m.offset:end] {
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	position := ""
+	if len(m.lines) > m.visibleLines() {
+		position = fmt.Sprintf(" • %d-%d/%d", m.offset+1, end, len(m.lines))
+	}
+	content.WriteString(helpStyle.Render("↑/↓ scroll • Enter mark as seen • Esc close" + position))
+
+	return content.String()
+}
+
+// diffLines renders diff as unified diff lines wrapped to width. Unchanged
+// lines further than contextLines from a change collapse into one line.
+func diffLines(diff []taskdiff.Line, width int) []string {
+	keep := make([]bool, len(diff))
+	for i, line := range diff {
+		if line.Op == taskdiff.Equal {
+			continue
+		}
+		for j := max(i-contextLines, 0); j <= min(i+contextLines, len(diff)-1); j++ {
+			keep[j] = true
+		}
+	}
+
+	var lines []string
+	for i := 0; i < len(diff); {
+		if !keep[i] {
+			hidden := 0
+			for ; i < len(diff) && !keep[i]; i++ {
+				hidden++
+			}
+			lines = append(lines, unchangedStyle.Render(fmt.Sprintf("  ⋯ %d unchanged line(s)", hidden)))
+			continue
+		}
+		lines = append(lines, renderLine(diff[i], width)...)
+		i++
+	}
+	return lines
+}
+
+// renderLine renders one diff line with its marker, highlighting the changed
+// words of modified lines, and wraps it to width
+func renderLine(line taskdiff.Line, width int) []string {
+	marker, style, word := "  ", unchangedStyle, unchangedStyle
+	switch line.Op {
+	case taskdiff.Delete:
+		marker, style, word = "- ", deletedStyle, deletedWord
+	case taskdiff.Insert:
+		marker, style, word = "+ ", insertedStyle, insertedWord
+	}
+
+	var body strings.Builder
+	if line.Modified() {
+		for _, span := range line.Spans {
+			if span.Changed {
+				body.WriteString(word.Render(span.Text))
+			} else {
+				body.WriteString(style.Render(span.Text))
+			}
+		}
+	} else {
+		body.WriteString(style.Render(line.Text))
+	}
+
+	wrapped := strings.Split(ansi.Wrap(body.String(), max(width-len(marker), 1), " "), "\n")
+	for i := range wrapped {
+		if i == 0 {
+			wrapped[i] = style.Render(marker) + wrapped[i]
+		} else {
+			wrapped[i] = strings.Repeat(" ", len(marker)) + wrapped[i]
+		}
+	}
+	return wrapped
+}
@@ -0,0 +1,263 @@
+package descdiff
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	return &base.ComponentContext{
+		ProgramContext:       &context.ProgramContext{ScreenWidth: 100, ScreenHeight: 30},
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func testShow() ShowDescDiffModalMsg {
+	old := "# Goal\nShip the login page by Friday.\n\nSteps:\n1. Form\n2. Validation\n3. Tests\n4. Docs\n5. Release"
+	current := strings.Replace(old, "login page by Friday", "signup page by Monday", 1)
+	return ShowDescDiffModalMsg{TaskID: "t1", Title: "Auth page", Old: old, New: current, Current: current}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.DescDiffModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.DescDiffModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected description diff to be initially inactive")
+	}
+}
+
+func TestShowAndRender(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(testShow())
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected description diff to be active and focused after show message")
+	}
+
+	view := ansi.Strip(model.View())
+	for _, expected := range []string{
+		"Description changes: Auth page",
+		"  # Goal",
+		"- Ship the login page by Friday.",
+		"+ Ship the signup page by Monday.",
+		"  Steps:",
+		"⋯ 5 unchanged line(s)", // Lines past the context collapse
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, "3. Tests") || strings.Contains(view, "⚠") {
+		t.Errorf("Expected distant lines collapsed and no truncation note, got:\n%s", view)
+	}
+}
+
+func TestIntraLineHighlight(t *testing.T) {
+	// Colors are dropped without a terminal; force them so highlights are visible
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	lines := strings.Join(diffLines(showDiff(testShow()), 80), "\n")
+	for _, expected := range []string{
+		deletedWord.Render("login"), deletedWord.Render("Friday"),
+		insertedWord.Render("signup"), insertedWord.Render("Monday"),
+		deletedStyle.Render(" page by "),
+	} {
+		if !strings.Contains(lines, expected) {
+			t.Errorf("Expected %q in the rendered diff:\n%q", expected, lines)
+		}
+	}
+	if strings.Contains(lines, deletedWord.Render("Ship")) {
+		t.Error("Expected unchanged words not to be highlighted")
+	}
+}
+
+func TestLongLinesWrap(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+	for _, line := range diffLines(showDiff(ShowDescDiffModalMsg{New: long}), 40) {
+		if width := ansi.StringWidth(line); width > 40 {
+			t.Errorf("Expected wrapped lines within 40 columns, got %d: %q", width, ansi.Strip(line))
+		}
+	}
+}
+
+func TestTruncatedNote(t *testing.T) {
+	model := NewModel(createTestContext())
+	show := testShow()
+	show.New, show.Truncated = show.Old, true // Only the part past the bound changed
+	model.Update(show)
+
+	view := ansi.Strip(model.View())
+	for _, expected := range []string{"only its start was remembered", "changed further down"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+}
+
+func TestEnterAcknowledgesAndEscapeKeeps(t *testing.T) {
+	model := NewModel(createTestContext())
+	show := testShow()
+	model.Update(show)
+
+	msgs := unwrap(collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyEscape})))
+	for _, msg := range msgs {
+		if _, ok := msg.(DescriptionAcknowledgedMsg); ok {
+			t.Error("Expected Esc to close without acknowledging")
+		}
+	}
+	if len(msgs) != 1 || msgs[0] != (HideDescDiffModalMsg{}) {
+		t.Errorf("Expected Esc to hide the modal, got %v", msgs)
+	}
+
+	var acknowledged *DescriptionAcknowledgedMsg
+	hidden := false
+	for _, msg := range unwrap(collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyEnter}))) {
+		switch msg := msg.(type) {
+		case DescriptionAcknowledgedMsg:
+			acknowledged = &msg
+		case HideDescDiffModalMsg:
+			hidden = true
+		}
+	}
+	if acknowledged == nil || acknowledged.TaskID != "t1" || acknowledged.Description != show.Current {
+		t.Errorf("Expected the current description acknowledged, got %+v", acknowledged)
+	}
+	if !hidden {
+		t.Error("Expected the modal to close after acknowledging")
+	}
+}
+
+func TestScrolling(t *testing.T) {
+	ctx := createTestContext()
+	ctx.ProgramContext.ScreenHeight = 16
+	model := NewModel(ctx)
+
+	var old, current []string
+	for i := 0; i < 40; i++ {
+		old = append(old, "old line")
+		current = append(current, "new line")
+	}
+	model.Update(ShowDescDiffModalMsg{Old: strings.Join(old, "\n"), New: strings.Join(current, "\n")})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if model.offset != len(model.lines)-model.visibleLines() || model.offset == 0 {
+		t.Errorf("Expected G to scroll to the end, got offset %d of %d", model.offset, len(model.lines))
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if model.offset != len(model.lines)-model.visibleLines() {
+		t.Error("Expected scrolling to stop at the end")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if model.offset != 0 {
+		t.Errorf("Expected g to scroll to the top, got %d", model.offset)
+	}
+}
+
+// showDiff computes the line diff the modal shows for msg
+func showDiff(msg ShowDescDiffModalMsg) []taskdiff.Line {
+	return taskdiff.Text(msg.Old, msg.New)
+}
+
+// unwrap extracts payloads from broadcast component messages
+func unwrap(msgs []tea.Msg) []tea.Msg {
+	for i, msg := range msgs {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msgs[i] = wrapped.Payload
+		}
+	}
+	return msgs
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
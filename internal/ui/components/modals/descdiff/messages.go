@@ -0,0 +1,40 @@
+package descdiff
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShowDescDiffModalMsg is sent when a description's changes should be shown
+type ShowDescDiffModalMsg struct {
+	TaskID    string
+	Title     string // Task title for the modal heading
+	Old       string // Description as last viewed (possibly cut to the snapshot bound)
+	New       string // Current description, cut the same way
+	Current   string // Whole current description, remembered when acknowledged
+	Truncated bool   // Old and New are only the start of the descriptions
+}
+
+// HideDescDiffModalMsg is sent when the description diff should be hidden
+type HideDescDiffModalMsg struct{}
+
+// DescDiffModalShownMsg is sent when the description diff has been shown and is active
+type DescDiffModalShownMsg struct{}
+
+// DescDiffModalHiddenMsg is sent when the description diff has been hidden and is inactive
+type DescDiffModalHiddenMsg struct{}
+
+// DescriptionAcknowledgedMsg is sent when the user marks the changes as seen;
+// Description becomes the version later changes are compared with
+type DescriptionAcknowledgedMsg struct {
+	TaskID      string
+	Description string
+}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowDescDiffModalMsg{}
+	_ tea.Msg = HideDescDiffModalMsg{}
+	_ tea.Msg = DescDiffModalShownMsg{}
+	_ tea.Msg = DescDiffModalHiddenMsg{}
+	_ tea.Msg = DescriptionAcknowledgedMsg{}
+)
@@ -0,0 +1,228 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "digest-modal"
+
+// kindLabels are the section markers shown before each item
+var kindLabels = map[away.Kind]string{
+	away.KindAssigned: "→ you",
+	away.KindStatus:   "✓ moved",
+	away.KindNew:      "+ new",
+}
+
+// DigestModel lists what changed while the user was away
+// Architecture: Follows four-tier state pattern
+// - No source data caching (receives the digest via ShowDigestModalMsg)
+// - No display parameters (simple selection modal)
+// - Owned state only (selection, digest)
+// - No transient feedback (jumping to the task is handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type DigestModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	selectedIndex int         // Currently selected item
+	digest        away.Digest // Digest being shown (passed via message)
+}
+
+// NewModel creates a new away digest modal component
+func NewModel(context *base.ComponentContext) *DigestModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.DigestModalComponent,
+		context,
+	)
+
+	model := &DigestModel{
+		BaseModal: baseModal,
+	}
+	model.SetDimensions(70, 12)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *DigestModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the digest modal component
+func (m *DigestModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the digest modal component
+func (m *DigestModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowDigestModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.digest = msg.Digest
+		m.selectedIndex = 0
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeDigest),
+			Active: true,
+		})
+
+	case HideDigestModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeDigest),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width, msg.Height)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the digest modal
+func (m *DigestModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// GetSelectedItem returns the currently highlighted item, if any
+func (m *DigestModel) GetSelectedItem() (away.Item, bool) {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.digest.Items) {
+		return away.Item{}, false
+	}
+	return m.digest.Items[m.selectedIndex], true
+}
+
+// handleKeyPress processes keyboard input for the digest modal
+func (m *DigestModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	switch key.String() {
+	case keys.KeyEscape, keys.KeyQ:
+		return m.BroadcastMessage(HideDigestModalMsg{})
+
+	case keys.KeyJ, keys.KeyArrowDown:
+		if m.selectedIndex < len(m.digest.Items)-1 {
+			m.selectedIndex++
+		}
+		return nil
+
+	case keys.KeyK, keys.KeyArrowUp:
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+		return nil
+
+	case keys.KeyEnter, keys.KeyL:
+		item, ok := m.GetSelectedItem()
+		if !ok {
+			return m.BroadcastMessage(HideDigestModalMsg{})
+		}
+		return tea.Batch(
+			m.BroadcastMessage(DigestTaskChosenMsg{TaskID: item.TaskID}),
+			m.BroadcastMessage(HideDigestModalMsg{}),
+		)
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+
+	default:
+		return nil
+	}
+}
+
+// updateDimensions sizes the modal to fit the items within the screen
+func (m *DigestModel) updateDimensions(screenWidth, screenHeight int) {
+	// Title + blank + items + blank + instructions, plus padding
+	height := len(m.digest.Items) + 6
+	m.SetDimensions(min(80, screenWidth-4), min(height, screenHeight-4))
+}
+
+// renderModal renders the complete digest modal
+func (m *DigestModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
+		Width(m.GetWidth()).
+		Height(m.GetHeight()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content, keeping the selection in view
+func (m *DigestModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
+	title := fmt.Sprintf("While you were away (%s)", clock.FormatDuration(m.digest.AwayFor))
+	content.WriteString(titleStyle.Render(view.TruncatePreservingANSI(title, innerWidth)))
+	content.WriteString("\n\n")
+
+	// Rows left for items after title, blank lines and instructions
+	visible := max(m.GetHeight()-6, 1)
+	start := 0
+	if m.selectedIndex >= visible {
+		start = m.selectedIndex - visible + 1
+	}
+	end := min(start+visible, len(m.digest.Items))
+
+	for i := start; i < end; i++ {
+		item := m.digest.Items[i]
+		prefix := "  "
+		if i == m.selectedIndex {
+			prefix = "▶ "
+		}
+		line := fmt.Sprintf("%s%-8s %s — %s", prefix, kindLabels[item.Kind], item.Title, item.Detail)
+		if m.isReadOnly(item.ProjectID) {
+			line += " (read-only)"
+		}
+		line = view.TruncatePreservingANSI(line, innerWidth)
+		if i == m.selectedIndex {
+			line = lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("15")).Render(line)
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	content.WriteString(helpStyle.Render("↑/↓ navigate • Enter jump to task • Esc dismiss • A reopen"))
+
+	return content.String()
+}
+
+// isReadOnly reports whether the item's project is known to be read-only
+func (m *DigestModel) isReadOnly(projectID string) bool {
+	ctx := m.GetContext()
+	return ctx != nil && ctx.ProgramContext != nil && ctx.ProgramContext.IsProjectReadOnly(projectID)
+}
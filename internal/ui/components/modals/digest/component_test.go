@@ -0,0 +1,173 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	// Create a mock ProgramContext with screen dimensions
+	mockProgramContext := &context.ProgramContext{
+		ScreenWidth:      80,
+		ScreenHeight:     24,
+		ReadOnlyProjects: map[string]bool{"p2": true},
+	}
+
+	return &base.ComponentContext{
+		ProgramContext:       mockProgramContext,
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func testDigest() away.Digest {
+	return away.Digest{
+		AwayFor: 72 * time.Hour,
+		Items: []away.Item{
+			{Kind: away.KindAssigned, TaskID: "t1", ProjectID: "p1", Title: "Handover", Detail: "assigned to you (todo)"},
+			{Kind: away.KindStatus, TaskID: "t2", ProjectID: "p2", Title: "Ship login", Detail: "doing → review"},
+		},
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.DigestModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.DigestModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected digest modal to be initially inactive")
+	}
+}
+
+func TestShowAndRender(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowDigestModalMsg{Digest: testDigest()})
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected digest modal to be active and focused after show message")
+	}
+
+	view := model.View()
+	for _, expected := range []string{"While you were away (3d)", "Handover", "doing → review"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+	if strings.Count(view, "(read-only)") != 1 {
+		t.Errorf("Expected only the read-only project's item to be marked, got:\n%s", view)
+	}
+}
+
+func TestEnterChoosesTask(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowDigestModalMsg{Digest: testDigest()})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	var chosen *DigestTaskChosenMsg
+	hidden := false
+	for _, msg := range collectMessages(cmd) {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		switch msg := msg.(type) {
+		case DigestTaskChosenMsg:
+			chosen = &msg
+		case HideDigestModalMsg:
+			hidden = true
+		}
+	}
+	if chosen == nil || chosen.TaskID != "t2" {
+		t.Errorf("Expected DigestTaskChosenMsg for t2, got %+v", chosen)
+	}
+	if !hidden {
+		t.Error("Expected the digest to close after choosing a task")
+	}
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,34 @@
+package digest
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+)
+
+// ShowDigestModalMsg is sent when the away digest should be shown
+type ShowDigestModalMsg struct {
+	Digest away.Digest
+}
+
+// HideDigestModalMsg is sent when the away digest should be hidden
+type HideDigestModalMsg struct{}
+
+// DigestModalShownMsg is sent when the away digest has been shown and is active
+type DigestModalShownMsg struct{}
+
+// DigestModalHiddenMsg is sent when the away digest has been hidden and is inactive
+type DigestModalHiddenMsg struct{}
+
+// DigestTaskChosenMsg is sent when the user picks a task to jump to
+type DigestTaskChosenMsg struct {
+	TaskID string
+}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowDigestModalMsg{}
+	_ tea.Msg = HideDigestModalMsg{}
+	_ tea.Msg = DigestModalShownMsg{}
+	_ tea.Msg = DigestModalHiddenMsg{}
+	_ tea.Msg = DigestTaskChosenMsg{}
+)
@@ -10,8 +10,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/layout"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
-	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -46,6 +46,17 @@ type FeatureModel struct {
 	backupFeatures       map[string]bool // Backup for cancel functionality
 	featureColorsEnabled bool            // Whether to show feature colors
 
+	// Mode state: filter the task list or assign a feature to taskIDs
+	mode    Mode
+	taskIDs []string
+	tags    bool // Listing tags rather than features (filter mode only)
+
+	// Scope state: the selected project's features, widened to every project with 'w'
+	scopeName string
+	scoped    featureScope
+	wide      featureScope // No features = no wider scope
+	widened   bool
+
 	// Navigation state
 	selectedIndex    int      // Currently highlighted feature
 	filteredFeatures []string // Features after search filtering
@@ -61,6 +72,12 @@ type FeatureModel struct {
 	viewport viewport.Model // Viewport for smooth scrolling
 }
 
+// featureScope is the feature list of one scope and its task counts
+type featureScope struct {
+	features []string
+	counts   map[string]int
+}
+
 // NewModel creates a new feature modal component
 func NewModel(context *base.ComponentContext) *FeatureModel {
 	baseModal := base.NewBaseModal(
@@ -112,7 +129,20 @@ func (m *FeatureModel) Update(msg tea.Msg) tea.Cmd {
 		m.SetActive(true)
 		m.SetFocus(true)
 		m.allFeatures = msg.AllFeatures
+		m.scopeName = msg.ScopeName
+		m.scoped = featureScope{features: msg.AllFeatures, counts: msg.FeatureCounts}
+		m.wide = featureScope{features: msg.WideFeatures, counts: msg.WideFeatureCounts}
+		m.widened = false
 		m.featureColorsEnabled = msg.FeatureColorsEnabled
+		m.taskIDs = msg.TaskIDs
+		m.mode = msg.Mode
+		m.tags = msg.Tags
+		if m.tags {
+			m.taskIDs = nil
+		}
+		if len(m.taskIDs) == 0 {
+			m.mode = ModeFilter
+		}
 
 		// Create backup of current selection for cancel functionality
 		m.backupFeatures = make(map[string]bool)
@@ -128,6 +158,7 @@ func (m *FeatureModel) Update(msg tea.Msg) tea.Cmd {
 		m.searchInput = ""
 		m.searchQuery = ""
 		m.updateFilteredFeatures()
+		m.updateViewport() // The mode indicator takes list space
 
 		return m.BroadcastMessage(messages.ModalStateMsg{
 			Type:   string(base.ModalTypeFeature),
@@ -303,20 +334,85 @@ func (m *FeatureModel) handleModalKeys(keyString string) tea.Cmd {
 		return m.BroadcastMessage(HideFeatureModalMsg{})
 
 	case keys.KeyEnter:
+		if m.mode == ModeAssign {
+			return m.assignHighlighted()
+		}
 		// Apply selection and close modal
+		var applied tea.Msg = FeatureSelectionAppliedMsg{SelectedFeatures: m.copySelectedFeatures()}
+		if m.tags {
+			applied = TagSelectionAppliedMsg{SelectedTags: m.copySelectedFeatures()}
+		}
 		return tea.Batch(
-			m.BroadcastMessage(FeatureSelectionAppliedMsg{
-				SelectedFeatures: m.copySelectedFeatures(),
-			}),
+			m.BroadcastMessage(applied),
 			m.BroadcastMessage(HideFeatureModalMsg{}),
 		)
 
+	case keys.KeyTab:
+		m.toggleMode()
+		return nil
+
+	case keys.KeyW:
+		m.toggleScope()
+		return nil
+
 	case keys.KeyCtrlC:
 		return tea.Quit
 	}
 	return nil
 }
 
+// toggleMode switches between filter and assign mode; assign mode needs target tasks
+func (m *FeatureModel) toggleMode() {
+	if len(m.taskIDs) == 0 {
+		return
+	}
+	if m.mode == ModeAssign {
+		m.mode = ModeFilter
+	} else {
+		m.mode = ModeAssign
+	}
+	m.selectedIndex = 0
+	m.updateFilteredFeatures()
+}
+
+// toggleScope switches the list between the selected project's features and
+// those of every project; selections are kept across the switch
+func (m *FeatureModel) toggleScope() {
+	if len(m.wide.features) == 0 {
+		return
+	}
+	m.widened = !m.widened
+	m.allFeatures = m.currentScope().features
+	m.selectedIndex = 0
+	m.updateFilteredFeatures()
+}
+
+// currentScope returns the scope whose features are listed
+func (m *FeatureModel) currentScope() featureScope {
+	if m.widened {
+		return m.wide
+	}
+	return m.scoped
+}
+
+// assignHighlighted assigns the highlighted feature to the target tasks and closes the modal
+func (m *FeatureModel) assignHighlighted() tea.Cmd {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.filteredFeatures) {
+		return nil
+	}
+	feature := m.filteredFeatures[m.selectedIndex]
+	if feature == NoFeatureOption {
+		feature = ""
+	}
+	return tea.Batch(
+		m.BroadcastMessage(FeatureAssignedMsg{
+			TaskIDs: append([]string(nil), m.taskIDs...),
+			Feature: feature,
+		}),
+		m.BroadcastMessage(HideFeatureModalMsg{}),
+	)
+}
+
 // handleNavigationKeys handles navigation keys (j/k, J/K, gg/G, ctrl+u/d)
 func (m *FeatureModel) handleNavigationKeys(keyString string) tea.Cmd {
 	switch keyString {
@@ -392,8 +488,12 @@ func (m *FeatureModel) handleSearchKeys(keyString string) tea.Cmd {
 	return nil
 }
 
-// handleSelectionKeys handles feature selection keys (space, a, A)
+// handleSelectionKeys handles feature selection keys (space, a, A, i)
 func (m *FeatureModel) handleSelectionKeys(keyString string) tea.Cmd {
+	if m.mode == ModeAssign {
+		// Assign mode picks a single feature with Enter; checkboxes don't apply
+		return nil
+	}
 	switch keyString {
 	case keys.KeySpace:
 		// Toggle current feature
@@ -412,6 +512,11 @@ func (m *FeatureModel) handleSelectionKeys(keyString string) tea.Cmd {
 		// Shift+A: Always deselect all features
 		m.deselectAll()
 		return nil
+
+	case "i":
+		// Invert the selection of the visible features
+		m.invertVisible()
+		return nil
 	}
 	return nil
 }
@@ -511,6 +616,13 @@ func (m *FeatureModel) deselectAll() {
 	}
 }
 
+// invertVisible flips the selection of the currently filtered/visible features
+func (m *FeatureModel) invertVisible() {
+	for _, feature := range m.filteredFeatures {
+		m.toggleFeature(feature)
+	}
+}
+
 func (m *FeatureModel) areAllVisibleSelected() bool {
 	if len(m.filteredFeatures) == 0 {
 		return false
@@ -533,6 +645,17 @@ func (m *FeatureModel) smartToggleAll() {
 	}
 }
 
+// selectedCount returns how many available features are selected
+func (m *FeatureModel) selectedCount() int {
+	count := 0
+	for _, feature := range m.allFeatures {
+		if m.selectedFeatures[feature] {
+			count++
+		}
+	}
+	return count
+}
+
 func (m *FeatureModel) copySelectedFeatures() map[string]bool {
 	result := make(map[string]bool)
 	maps.Copy(result, m.selectedFeatures)
@@ -571,6 +694,10 @@ func (m *FeatureModel) updateFilteredFeatures() {
 
 	// Sort filtered features for consistent display
 	sort.Strings(m.filteredFeatures)
+	if m.mode == ModeAssign && m.searchQuery == "" {
+		// Offer clearing the feature first
+		m.filteredFeatures = append([]string{NoFeatureOption}, m.filteredFeatures...)
+	}
 
 	// Update matching indices for n/N navigation
 	m.updateMatchingIndices()
@@ -628,13 +755,18 @@ func (m *FeatureModel) updateViewport() {
 	modalWidth := min(m.GetWidth()-4, 80)   // Maximum 80 chars wide, with margins
 	modalHeight := min(m.GetHeight()-4, 40) // Maximum 40 lines high, with margins
 
+	reservedLines := 14 // Title (3) + search (2) + summary (2) + help (3) + spacing (4)
+	if len(m.taskIDs) > 0 {
+		reservedLines += 2 // Mode indicator
+	}
+
 	// Calculate viewport dimensions using dimension calculator
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	// Modal has Padding(1, 2) = vertical 1, horizontal 2 -> total horizontal padding = 4
 	calc := layout.NewCalculator(modalWidth, modalHeight, layout.ModalComponent).
-		WithScrollbar().      // Reserve space for scrollbar (4 chars)
-		WithPadding(2).       // Horizontal padding (left + right)
-		WithReservedLines(12) // Title (3) + search (2) + help (3) + spacing (4)
+		WithScrollbarEnabled(m.GetContext().ScrollbarOptions().Enabled).
+		WithPadding(2). // Horizontal padding (left + right)
+		WithReservedLines(reservedLines)
 
 	dims := calc.Calculate()
 
@@ -657,7 +789,7 @@ func (m *FeatureModel) renderModal() string {
 
 	// Create the modal with border
 	modal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like other modals
 		Width(modalWidth).
 		Height(modalHeight).
@@ -678,9 +810,26 @@ func (m *FeatureModel) renderContent() string {
 		Foreground(lipgloss.Color("51")).
 		Align(lipgloss.Center).
 		MarginBottom(1)
-	title := titleStyle.Render("Select Features")
+	titleText := "Select Features"
+	if m.tags {
+		titleText = "Select Tags"
+	}
+	if m.mode == ModeAssign {
+		titleText = "Assign Feature"
+	}
+	switch {
+	case m.widened:
+		titleText += " · all projects"
+	case m.scopeName != "":
+		titleText += " · " + m.scopeName
+	}
+	title := titleStyle.Render(titleText)
 	content.WriteString(title)
 	content.WriteString("\n")
+	if len(m.taskIDs) > 0 {
+		content.WriteString(m.renderModeIndicator())
+		content.WriteString("\n\n")
+	}
 
 	// Search section
 	content.WriteString(m.renderSearchSection())
@@ -689,6 +838,15 @@ func (m *FeatureModel) renderContent() string {
 	// Feature list
 	content.WriteString(m.renderFeatureList())
 
+	// Selection summary
+	content.WriteString("\n\n")
+	summaryStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	if m.mode == ModeAssign {
+		content.WriteString(summaryStyle.Render("Enter sets the highlighted feature on " + taskCount(len(m.taskIDs))))
+	} else {
+		content.WriteString(summaryStyle.Render(strconv.Itoa(m.selectedCount()) + " of " + strconv.Itoa(len(m.allFeatures)) + " " + m.noun() + "s selected"))
+	}
+
 	// Instructions (with extra spacing for better visual separation)
 	content.WriteString("\n\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Align(lipgloss.Center)
@@ -697,14 +855,56 @@ func (m *FeatureModel) renderContent() string {
 		content.WriteString(instructions)
 	} else {
 		// Multi-line help for better readability
-		line1 := helpStyle.Render("j/k: navigate • J/K: fast scroll • gg/G: first/last • ctrl+u/d: half-page")
-		line2 := helpStyle.Render("Space: toggle • a: smart select • A: deselect visible • /: search • Enter: apply • Esc: cancel")
+		navigation := "j/k: navigate • J/K: fast scroll • gg/G: first/last • ctrl+u/d: half-page"
+		if len(m.wide.features) > 0 {
+			if m.widened {
+				navigation += " • w: " + m.scopeName + " only"
+			} else {
+				navigation += " • w: all projects"
+			}
+		}
+		line1 := helpStyle.Render(navigation)
+		line2 := helpStyle.Render("Space: toggle • a: smart select • A: deselect visible • i: invert • /: search • Enter: apply • Esc: cancel")
+		if m.mode == ModeAssign {
+			line2 = helpStyle.Render("/: search • Tab: filter mode • Enter: assign • Esc: cancel")
+		}
 		content.WriteString(line1 + "\n" + line2)
 	}
 
 	return content.String()
 }
 
+// renderModeIndicator shows both modes with the active one highlighted
+func (m *FeatureModel) renderModeIndicator() string {
+	activeStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("51")).Padding(0, 1)
+	inactiveStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Padding(0, 1)
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	filter, assign := inactiveStyle.Render("Filter"), inactiveStyle.Render("Assign to "+taskCount(len(m.taskIDs)))
+	if m.mode == ModeAssign {
+		assign = activeStyle.Render("Assign to " + taskCount(len(m.taskIDs)))
+	} else {
+		filter = activeStyle.Render("Filter")
+	}
+	return filter + " " + assign + hintStyle.Render("  (Tab to switch)")
+}
+
+// noun is what the modal lists, for labels
+func (m *FeatureModel) noun() string {
+	if m.tags {
+		return "tag"
+	}
+	return "feature"
+}
+
+// taskCount formats a task count for the assign mode labels
+func taskCount(n int) string {
+	if n == 1 {
+		return "1 task"
+	}
+	return strconv.Itoa(n) + " tasks"
+}
+
 // renderSearchSection renders the search input and status
 func (m *FeatureModel) renderSearchSection() string {
 	var content strings.Builder
@@ -737,7 +937,7 @@ func (m *FeatureModel) renderSearchSection() string {
 		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
 		matches := len(m.filteredFeatures)
 		total := len(m.allFeatures)
-		status := statusStyle.Render(" (" + strconv.Itoa(matches) + "/" + strconv.Itoa(total) + " features)")
+		status := statusStyle.Render(" (" + strconv.Itoa(matches) + "/" + strconv.Itoa(total) + " " + m.noun() + "s)")
 		content.WriteString(status)
 	}
 
@@ -764,15 +964,16 @@ func (m *FeatureModel) renderFeatureList() string {
 	// Add scrollbar if content is scrollable
 	totalLines := m.viewport.TotalLineCount()
 	viewportHeight := m.viewport.Height
-	if totalLines > viewportHeight {
+	scrollbarOpts := m.GetContext().ScrollbarOptions()
+	if totalLines > viewportHeight && scrollbarOpts.Enabled {
 		// Generate scrollbar
-		scrollbar := view.RenderScrollBarExact(m.viewport.YOffset, totalLines, viewportHeight)
+		scrollbar := scrollbarOpts.Render(m.viewport.YOffset, totalLines, viewportHeight)
 
 		// Compose content with scrollbar
 		// Calculate width with scrollbar offset
 		// The +2 accounts for visual alignment with scrollbar positioning
 		// which includes 1 char gap + scrollbar width in the composition
-		viewportContent = sharedviewport.ComposeWithScrollbar(viewportContent, scrollbar, m.viewport.Width+2, 0)
+		viewportContent = sharedviewport.ComposeWithScrollbarOptions(viewportContent, scrollbar, m.viewport.Width+2, 0, scrollbarOpts)
 	}
 
 	return viewportContent
@@ -785,11 +986,15 @@ func (m *FeatureModel) renderFeatureOption(index int, feature string) string {
 
 	// Checkbox with improved visibility
 	var checkbox string
-	if isChecked {
+	switch {
+	case m.mode == ModeAssign:
+		// Single choice: no selection state to show
+		checkbox = lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render("○")
+	case isChecked:
 		// Green filled square for selected features
 		checkboxStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("46")) // Bright green
 		checkbox = checkboxStyle.Render("■")
-	} else {
+	default:
 		// Empty square for unselected features
 		checkboxStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244")) // Light gray
 		checkbox = checkboxStyle.Render("□")
@@ -797,11 +1002,14 @@ func (m *FeatureModel) renderFeatureOption(index int, feature string) string {
 
 	// Feature color (if enabled)
 	featureText := feature
-	if m.featureColorsEnabled {
+	if m.featureColorsEnabled && feature != NoFeatureOption {
 		// Add some visual variety to features (placeholder for actual color logic)
 		colorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("215")) // Orange
 		featureText = colorStyle.Render(feature)
 	}
+	if counts := m.currentScope().counts; counts != nil && feature != NoFeatureOption {
+		featureText += lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(" (" + strconv.Itoa(counts[feature]) + ")")
+	}
 
 	// Build the core line content first with individual element styling
 	// Format: "checkbox feature-name"
@@ -826,7 +1034,7 @@ func (m *FeatureModel) renderFeatureOption(index int, feature string) string {
 // Selection indicators (► ◄) are baked into strings, requiring rebuild on every render
 func (m *FeatureModel) buildViewportContent() {
 	if len(m.filteredFeatures) == 0 {
-		m.viewport.SetContent("No features found")
+		m.viewport.SetContent("No " + m.noun() + "s found")
 		return
 	}
 
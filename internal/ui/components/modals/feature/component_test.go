@@ -1,6 +1,7 @@
 package feature
 
 import (
+	"maps"
 	"strings"
 	"testing"
 	"time"
@@ -579,6 +580,36 @@ func TestDeselectAllFunctionality(t *testing.T) {
 	}
 }
 
+// Test invert selection, alone and combined with a search filter
+func TestInvertSelection(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"authentication", "ui", "backend", "auth-service"},
+		SelectedFeatures: map[string]bool{"authentication": true, "ui": true},
+	})
+
+	invert := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}}
+	model.Update(invert)
+
+	want := map[string]bool{"backend": true, "auth-service": true}
+	if !maps.Equal(model.selectedFeatures, want) {
+		t.Errorf("Expected %v after invert, got %v", want, model.selectedFeatures)
+	}
+
+	// Only the visible features are flipped while a search is active
+	model.searchQuery = "auth"
+	model.updateFilteredFeatures()
+	model.Update(invert)
+
+	want = map[string]bool{"backend": true, "authentication": true}
+	if !maps.Equal(model.selectedFeatures, want) {
+		t.Errorf("Expected %v after filtered invert, got %v", want, model.selectedFeatures)
+	}
+	if view := model.View(); !strings.Contains(view, "2 of 4 features selected") {
+		t.Error("Expected summary to reflect the inverted selection")
+	}
+}
+
 // Test parent-child architecture dimension handling
 func TestViewWithDimensions(t *testing.T) {
 	model := createTestModel()
@@ -667,6 +698,26 @@ func TestViewRendering(t *testing.T) {
 	}
 }
 
+// Test the selection summary updates as features are toggled
+func TestSelectionSummary(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"feature1", "feature2", "feature3"},
+		SelectedFeatures: map[string]bool{"feature1": true},
+	})
+
+	if view := model.View(); !strings.Contains(view, "1 of 3 features selected") {
+		t.Error("Expected summary for one selected feature")
+	}
+
+	model.selectedIndex = 1
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+
+	if view := model.View(); !strings.Contains(view, "2 of 3 features selected") {
+		t.Error("Expected summary to update after toggling")
+	}
+}
+
 // Test edge cases
 func TestEdgeCases(t *testing.T) {
 	model := createTestModel()
@@ -709,3 +760,196 @@ func TestEdgeCases(t *testing.T) {
 		t.Error("Expected 'No features found' message for empty search results")
 	}
 }
+
+// assignedMsg runs cmd and returns the FeatureAssignedMsg it broadcasts, if any
+func assignedMsg(cmd tea.Cmd) (FeatureAssignedMsg, bool) {
+	if cmd == nil {
+		return FeatureAssignedMsg{}, false
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			if assigned, ok := assignedMsg(c); ok {
+				return assigned, true
+			}
+		}
+		return FeatureAssignedMsg{}, false
+	}
+	if componentMsg, ok := msg.(base.ComponentMessage); ok {
+		msg = componentMsg.Payload
+	}
+	assigned, ok := msg.(FeatureAssignedMsg)
+	return assigned, ok
+}
+
+// Test assign mode: Enter sets the highlighted feature instead of applying a filter
+func TestAssignMode(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"ui", "auth"},
+		SelectedFeatures: map[string]bool{"ui": true},
+		TaskIDs:          []string{"task-1"},
+	})
+	if model.mode != ModeFilter {
+		t.Fatal("Expected the modal to open in filter mode")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.mode != ModeAssign {
+		t.Fatal("Expected Tab to switch to assign mode")
+	}
+	if got := strings.Join(model.filteredFeatures, ","); got != NoFeatureOption+",auth,ui" {
+		t.Errorf("Expected (none) first in assign mode, got %s", got)
+	}
+	if view := model.View(); !strings.Contains(view, "Assign Feature") || !strings.Contains(view, "Assign to 1 task") {
+		t.Errorf("Expected the assign mode indicator, got:\n%s", view)
+	}
+
+	// Space doesn't touch the filter selection in assign mode
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	if len(model.selectedFeatures) != 1 || !model.selectedFeatures["ui"] {
+		t.Errorf("Expected the filter selection untouched, got %v", model.selectedFeatures)
+	}
+
+	model.selectedIndex = 1
+	assigned, ok := assignedMsg(model.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if !ok || assigned.Feature != "auth" || len(assigned.TaskIDs) != 1 || assigned.TaskIDs[0] != "task-1" {
+		t.Errorf("Expected auth assigned to task-1, got %+v (ok=%v)", assigned, ok)
+	}
+}
+
+// Test that "(none)" clears the feature and that filter mode never assigns
+func TestAssignModeClearAndFilterMode(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"auth"},
+		SelectedFeatures: map[string]bool{"auth": true},
+		Mode:             ModeAssign,
+		TaskIDs:          []string{"task-1", "task-2"},
+	})
+	assigned, ok := assignedMsg(model.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if !ok || assigned.Feature != "" || len(assigned.TaskIDs) != 2 {
+		t.Errorf("Expected (none) to clear the feature on both tasks, got %+v (ok=%v)", assigned, ok)
+	}
+
+	// Without target tasks assign mode is unavailable
+	model.Update(ShowFeatureModalMsg{AllFeatures: []string{"auth"}, Mode: ModeAssign})
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.mode != ModeFilter {
+		t.Error("Expected filter mode without target tasks")
+	}
+	if _, ok := assignedMsg(model.Update(tea.KeyMsg{Type: tea.KeyEnter})); ok {
+		t.Error("Expected Enter in filter mode not to assign")
+	}
+}
+
+// Test that cancelling assign mode changes nothing
+func TestAssignModeCancel(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"auth", "ui"},
+		SelectedFeatures: map[string]bool{"ui": true},
+		Mode:             ModeAssign,
+		TaskIDs:          []string{"task-1"},
+	})
+	model.selectedIndex = 1
+
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if _, ok := assignedMsg(cmd); ok {
+		t.Error("Expected cancel not to assign a feature")
+	}
+	if componentMsg, ok := cmd().(base.ComponentMessage); !ok {
+		t.Error("Expected cancel to hide the modal")
+	} else if _, ok := componentMsg.Payload.(HideFeatureModalMsg); !ok {
+		t.Errorf("Expected HideFeatureModalMsg, got %T", componentMsg.Payload)
+	}
+	if len(model.selectedFeatures) != 1 || !model.selectedFeatures["ui"] {
+		t.Errorf("Expected the filter selection unchanged, got %v", model.selectedFeatures)
+	}
+}
+
+// Test that 'w' widens the project's features to every project, with matching counts
+func TestFeatureScope(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:       []string{"auth"},
+		SelectedFeatures:  map[string]bool{"auth": true, "billing": true},
+		FeatureCounts:     map[string]int{"auth": 2},
+		ScopeName:         "Backend",
+		WideFeatures:      []string{"auth", "billing"},
+		WideFeatureCounts: map[string]int{"auth": 5, "billing": 1},
+	})
+	if view := model.View(); !strings.Contains(view, "Select Features · Backend") || !strings.Contains(view, "auth (2)") ||
+		!strings.Contains(view, "1 of 1 features selected") {
+		t.Errorf("Expected the project's features and counts, got:\n%s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if got := strings.Join(model.filteredFeatures, ","); got != "auth,billing" {
+		t.Errorf("Expected every project's features after w, got %s", got)
+	}
+	if view := model.View(); !strings.Contains(view, "all projects") || !strings.Contains(view, "auth (5)") ||
+		!strings.Contains(view, "2 of 2 features selected") {
+		t.Errorf("Expected every project's counts, got:\n%s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if got := strings.Join(model.filteredFeatures, ","); got != "auth" {
+		t.Errorf("Expected w again to return to the project, got %s", got)
+	}
+
+	// Without a wider scope w does nothing
+	model.Update(ShowFeatureModalMsg{AllFeatures: []string{"auth"}, SelectedFeatures: map[string]bool{}})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if model.widened || strings.Contains(model.View(), "w: all projects") {
+		t.Error("Expected no scope toggle without a selected project")
+	}
+}
+
+// Test tag mode: the modal lists tags and applying sends the tag selection
+func TestTagMode(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowFeatureModalMsg{
+		AllFeatures:      []string{"db", "urgent"},
+		SelectedFeatures: map[string]bool{"db": true},
+		FeatureCounts:    map[string]int{"db": 3, "urgent": 1},
+		TaskIDs:          []string{"t1"},
+		Tags:             true,
+	})
+	view := model.View()
+	if !strings.Contains(view, "Select Tags") || !strings.Contains(view, "1 of 2 tags selected") || !strings.Contains(view, "db (3)") {
+		t.Errorf("Expected the tag list, got:\n%s", view)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.mode != ModeFilter || strings.Contains(model.View(), "Assign") {
+		t.Error("Expected no assign mode for tags")
+	}
+
+	var applied *TagSelectionAppliedMsg
+	var walk func(cmd tea.Cmd)
+	walk = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				walk(c)
+			}
+			return
+		}
+		if componentMsg, ok := msg.(base.ComponentMessage); ok {
+			msg = componentMsg.Payload
+		}
+		if tags, ok := msg.(TagSelectionAppliedMsg); ok {
+			applied = &tags
+		}
+		if _, ok := msg.(FeatureSelectionAppliedMsg); ok {
+			t.Error("Expected no feature selection from the tag modal")
+		}
+	}
+	walk(model.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if applied == nil || len(applied.SelectedTags) != 1 || !applied.SelectedTags["db"] {
+		t.Errorf("Expected the tag selection applied, got %+v", applied)
+	}
+}
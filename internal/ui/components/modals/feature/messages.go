@@ -2,11 +2,31 @@ package feature
 
 import tea "github.com/charmbracelet/bubbletea"
 
+// Mode is what choosing a feature in the modal does
+type Mode int
+
+const (
+	// ModeFilter selects the features the task list is filtered by
+	ModeFilter Mode = iota
+	// ModeAssign sets the highlighted feature on the target tasks
+	ModeAssign
+)
+
+// NoFeatureOption is the assign mode entry that clears the feature
+const NoFeatureOption = "(none)"
+
 // ShowFeatureModalMsg is sent to show the feature selection modal
 type ShowFeatureModalMsg struct {
 	AllFeatures          []string        // All available features
 	SelectedFeatures     map[string]bool // Currently selected features
 	FeatureColorsEnabled bool            // Whether to show feature colors
+	Mode                 Mode            // Mode the modal opens in (default: ModeFilter)
+	TaskIDs              []string        // Tasks assign mode applies to (none: assign mode unavailable)
+	FeatureCounts        map[string]int  // Tasks per feature among the tasks AllFeatures come from (nil: no counts)
+	ScopeName            string          // Project AllFeatures are limited to ("" = not limited)
+	WideFeatures         []string        // Features of every project, listed after 'w' widens the scope (nil = no wider scope)
+	WideFeatureCounts    map[string]int  // Tasks per feature among the tasks WideFeatures come from
+	Tags                 bool            // AllFeatures are task tags; applying sends TagSelectionAppliedMsg
 }
 
 // HideFeatureModalMsg is sent to hide the feature selection modal
@@ -23,6 +43,17 @@ type FeatureSelectionAppliedMsg struct {
 	SelectedFeatures map[string]bool // Final selected features
 }
 
+// TagSelectionAppliedMsg is sent when the selection of a tag modal is applied
+type TagSelectionAppliedMsg struct {
+	SelectedTags map[string]bool // Final selected tags
+}
+
+// FeatureAssignedMsg is sent when a feature is chosen in assign mode
+type FeatureAssignedMsg struct {
+	TaskIDs []string // Tasks to update
+	Feature string   // Feature to set ("" clears it)
+}
+
 // FeatureModalSearchMsg is sent when search query changes
 type FeatureModalSearchMsg struct {
 	Query string // Search query
@@ -54,6 +85,8 @@ var (
 	_ tea.Msg = FeatureModalShownMsg{}
 	_ tea.Msg = FeatureModalHiddenMsg{}
 	_ tea.Msg = FeatureSelectionAppliedMsg{}
+	_ tea.Msg = TagSelectionAppliedMsg{}
+	_ tea.Msg = FeatureAssignedMsg{}
 	_ tea.Msg = FeatureModalSearchMsg{}
 	_ tea.Msg = FeatureModalScrollMsg{}
 	_ tea.Msg = FeatureModalToggleMsg{}
@@ -7,7 +7,6 @@ import (
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/layout"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
-	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -39,10 +38,10 @@ func NewModel(context *base.ComponentContext) *HelpModel {
 	// Calculate initial dimensions using dimension calculator
 	defaultWidth := 70
 	defaultHeight := 25
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	calc := layout.NewCalculator(defaultWidth, defaultHeight, layout.ModalComponent).
-		WithScrollbar(). // Reserve space for scrollbar (4 chars)
-		WithPadding(1)   // Modal padding
+		WithScrollbarEnabled(context.ScrollbarOptions().Enabled).
+		WithPadding(1) // Modal padding
 
 	dims := calc.Calculate()
 
@@ -123,22 +122,23 @@ func (m *HelpModel) View() string {
 	// Add scrollbar if content is scrollable
 	totalLines := m.viewport.TotalLineCount()
 	viewportHeight := m.viewport.Height
-	if totalLines > viewportHeight {
+	scrollbarOpts := m.GetContext().ScrollbarOptions()
+	if totalLines > viewportHeight && scrollbarOpts.Enabled {
 		// Generate scrollbar
-		scrollbar := view.RenderScrollBarExact(m.viewport.YOffset, totalLines, viewportHeight)
+		scrollbar := scrollbarOpts.Render(m.viewport.YOffset, totalLines, viewportHeight)
 
 		// Compose content with scrollbar
 		// Modal width includes border (2) and padding (2), so content area is width - 4
 		// The viewport content fits in this area, we need to account for modal structure
 		contentWidth := m.GetWidth() - 4 // Border (2) + Padding (2)
-		viewportContent = sharedviewport.ComposeWithScrollbar(viewportContent, scrollbar, contentWidth+2, 0)
+		viewportContent = sharedviewport.ComposeWithScrollbarOptions(viewportContent, scrollbar, contentWidth+2, 0, scrollbarOpts)
 	}
 
 	// Use stored modal dimensions (calculated in updateDimensions)
 	// Create help modal with border
 	// Parent handles positioning - modal just returns its content
 	helpModal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
 		Width(m.GetWidth()).
 		Height(m.GetHeight()).
@@ -161,10 +161,10 @@ func (m *HelpModel) updateDimensions(width, height int) {
 	m.SetDimensions(modalWidth, modalHeight)
 
 	// Calculate viewport dimensions using dimension calculator
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	calc := layout.NewCalculator(modalWidth, modalHeight, layout.ModalComponent).
-		WithScrollbar(). // Reserve space for scrollbar (4 chars)
-		WithPadding(1)   // Modal padding
+		WithScrollbarEnabled(m.GetContext().ScrollbarOptions().Enabled).
+		WithPadding(1) // Modal padding
 
 	dims := calc.Calculate()
 
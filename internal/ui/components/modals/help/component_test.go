@@ -1,12 +1,17 @@
 package help
 
 import (
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
 )
@@ -200,6 +205,29 @@ func TestHelpModalKeyHandling(t *testing.T) {
 	}
 }
 
+func TestHelpContentListsEveryAction(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowHelpModalMsg{})
+	model.viewport.Width, model.viewport.Height = 200, 1000 // Whole content, untruncated
+	model.updateContent()
+	content := ansi.Strip(model.viewport.View())
+
+	for _, action := range keys.Actions() {
+		if !slices.Contains(action.Contexts, keys.ContextMain) {
+			continue
+		}
+		row := regexp.MustCompile(`(?m)^  ` + regexp.QuoteMeta(action.Label()) + ` +` + regexp.QuoteMeta(action.Description) + ` *$`)
+		if !row.MatchString(content) {
+			t.Errorf("Expected a help row for %s (%s  %s)", action.ID, action.Label(), action.Description)
+		}
+	}
+	for _, title := range []string{"Project Management:", "Application Controls:", "Task Status Symbols:"} {
+		if n := strings.Count(content, title); n != 1 {
+			t.Errorf("Expected section %q once, got %d", title, n)
+		}
+	}
+}
+
 // Helper function to check if text contains a substring (case-insensitive)
 func containsText(text, substr string) bool {
 	// Simple substring check - in a real implementation you might want
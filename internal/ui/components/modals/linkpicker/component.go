@@ -0,0 +1,219 @@
+package linkpicker
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "link-picker-modal"
+
+// LinkPickerModel lets the user choose one of several links matched for a task
+// Architecture: Follows four-tier state pattern
+// - No source data caching (receives links via ShowLinkPickerModalMsg)
+// - No display parameters (simple selection modal)
+// - Owned state only (selection, link list)
+// - No transient feedback (opening the link is handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type LinkPickerModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	selectedIndex int          // Currently selected link
+	links         []links.Link // Links to choose from (passed via message)
+	taskTitle     string       // Title of the task the links belong to
+}
+
+// NewModel creates a new link picker modal component
+func NewModel(context *base.ComponentContext) *LinkPickerModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.LinkPickerModalComponent,
+		context,
+	)
+
+	model := &LinkPickerModel{
+		BaseModal: baseModal,
+	}
+	model.SetDimensions(60, 10)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *LinkPickerModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the link picker modal component
+func (m *LinkPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the link picker modal component
+func (m *LinkPickerModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowLinkPickerModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.links = msg.Links
+		m.taskTitle = msg.TaskTitle
+		m.selectedIndex = 0
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeLinkPicker),
+			Active: true,
+		})
+
+	case HideLinkPickerModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeLinkPicker),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width, msg.Height)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the link picker modal
+func (m *LinkPickerModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// GetSelectedLink returns the currently highlighted link, if any
+func (m *LinkPickerModel) GetSelectedLink() (links.Link, bool) {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.links) {
+		return links.Link{}, false
+	}
+	return m.links[m.selectedIndex], true
+}
+
+// handleKeyPress processes keyboard input for the link picker modal
+func (m *LinkPickerModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	keyString := key.String()
+
+	switch keyString {
+	case keys.KeyEscape, keys.KeyQ:
+		return m.BroadcastMessage(HideLinkPickerModalMsg{})
+
+	case keys.KeyJ, keys.KeyArrowDown:
+		if m.selectedIndex < len(m.links)-1 {
+			m.selectedIndex++
+		}
+		return nil
+
+	case keys.KeyK, keys.KeyArrowUp:
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+		return nil
+
+	case keys.KeyEnter, keys.KeyL:
+		link, ok := m.GetSelectedLink()
+		if !ok {
+			return m.BroadcastMessage(HideLinkPickerModalMsg{})
+		}
+		return tea.Batch(
+			m.BroadcastMessage(LinkSelectedMsg{Link: link}),
+			m.BroadcastMessage(HideLinkPickerModalMsg{}),
+		)
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+
+	default:
+		// Number keys for direct selection (1-9)
+		if len(keyString) == 1 && keyString[0] >= '1' && keyString[0] <= '9' {
+			index := int(keyString[0] - '1')
+			if index < len(m.links) {
+				m.selectedIndex = index
+			}
+		}
+		return nil
+	}
+}
+
+// updateDimensions sizes the modal to fit the links within the screen
+func (m *LinkPickerModel) updateDimensions(screenWidth, screenHeight int) {
+	// Title + blank + links + blank + instructions, plus padding
+	height := len(m.links) + 6
+	m.SetDimensions(min(70, screenWidth-4), min(height, screenHeight-4))
+}
+
+// renderModal renders the complete link picker modal
+func (m *LinkPickerModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
+		Width(m.GetWidth()).
+		Height(m.GetHeight()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content
+func (m *LinkPickerModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
+	content.WriteString(titleStyle.Render(view.TruncatePreservingANSI("Open link: "+m.taskTitle, innerWidth)))
+	content.WriteString("\n\n")
+
+	for i, link := range m.links {
+		prefix := "  "
+		if i == m.selectedIndex {
+			prefix = "▶ "
+		}
+		line := view.TruncatePreservingANSI(fmt.Sprintf("%s%d. %s — %s", prefix, i+1, link.Name, link.URL), innerWidth)
+		if i == m.selectedIndex {
+			line = lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("15")).Render(line)
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	content.WriteString(helpStyle.Render("↑/↓ navigate • Enter open • Esc cancel"))
+
+	return content.String()
+}
+
+// Helper functions
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
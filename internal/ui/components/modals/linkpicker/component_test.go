@@ -0,0 +1,190 @@
+package linkpicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	// Create a mock ProgramContext with screen dimensions
+	mockProgramContext := &context.ProgramContext{
+		ScreenWidth:  80,
+		ScreenHeight: 24,
+	}
+
+	return &base.ComponentContext{
+		ProgramContext:       mockProgramContext,
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func testLinks() []links.Link {
+	return []links.Link{
+		{Name: "Open PR search", URL: "https://github.com/org/repo/pulls?q=auth-1", Match: "auth-1"},
+		{Name: "Open branch", URL: "https://github.com/org/repo/tree/auth-1", Match: "auth-1"},
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.LinkPickerModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.LinkPickerModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected link picker to be initially inactive")
+	}
+}
+
+func TestShowAndNavigate(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowLinkPickerModalMsg{Links: testLinks(), TaskTitle: "Fix auth-1"})
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected link picker to be active and focused after show message")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if link, _ := model.GetSelectedLink(); link.Name != "Open branch" {
+		t.Errorf("Expected second link after j, got %s", link.Name)
+	}
+
+	// Navigation clamps at the last link
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if link, _ := model.GetSelectedLink(); link.Name != "Open branch" {
+		t.Errorf("Expected selection to stay on last link, got %s", link.Name)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if link, _ := model.GetSelectedLink(); link.Name != "Open PR search" {
+		t.Errorf("Expected first link after 1, got %s", link.Name)
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Open PR search") || !strings.Contains(view, "Fix auth-1") {
+		t.Errorf("Expected view to list links and task title, got:\n%s", view)
+	}
+}
+
+func TestEnterSelectsLink(t *testing.T) {
+	ctx := createTestContext()
+	model := NewModel(ctx)
+	model.Update(ShowLinkPickerModalMsg{Links: testLinks(), TaskTitle: "Fix auth-1"})
+
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected command from Enter")
+	}
+
+	var selected *LinkSelectedMsg
+	for _, msg := range collectMessages(cmd) {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		if linkMsg, ok := msg.(LinkSelectedMsg); ok {
+			selected = &linkMsg
+		}
+	}
+	if selected == nil || selected.Link.URL != testLinks()[0].URL {
+		t.Errorf("Expected LinkSelectedMsg for first link, got %+v", selected)
+	}
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestWideTitleFitsModal(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(ShowLinkPickerModalMsg{Links: testLinks(), TaskTitle: strings.Repeat("修复登录页面", 20)})
+
+	innerWidth := model.GetWidth() - 4
+	for _, line := range strings.Split(model.renderContent(), "\n") {
+		if width := lipgloss.Width(line); width > innerWidth {
+			t.Errorf("Expected lines within %d cells, got %d: %q", innerWidth, width, line)
+		}
+	}
+}
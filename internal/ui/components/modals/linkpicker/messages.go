@@ -0,0 +1,35 @@
+package linkpicker
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
+)
+
+// ShowLinkPickerModalMsg is sent when the link picker should be shown
+type ShowLinkPickerModalMsg struct {
+	Links     []links.Link // Links matched for the task (at least two)
+	TaskTitle string       // Title of the task the links belong to
+}
+
+// HideLinkPickerModalMsg is sent when the link picker should be hidden
+type HideLinkPickerModalMsg struct{}
+
+// LinkPickerModalShownMsg is sent when the link picker has been shown and is active
+type LinkPickerModalShownMsg struct{}
+
+// LinkPickerModalHiddenMsg is sent when the link picker has been hidden and is inactive
+type LinkPickerModalHiddenMsg struct{}
+
+// LinkSelectedMsg is sent when a link has been chosen and should be opened
+type LinkSelectedMsg struct {
+	Link links.Link
+}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowLinkPickerModalMsg{}
+	_ tea.Msg = HideLinkPickerModalMsg{}
+	_ tea.Msg = LinkPickerModalShownMsg{}
+	_ tea.Msg = LinkPickerModalHiddenMsg{}
+	_ tea.Msg = LinkSelectedMsg{}
+)
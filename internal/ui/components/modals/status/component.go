@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -30,9 +31,10 @@ type StatusModel struct {
 	// ===================================================================
 	// OWNED STATE - Component manages these directly
 	// ===================================================================
-	selectedIndex int    // Currently selected status option (0-3)
-	taskID        string // ID of the task being updated (passed via message)
-	currentStatus string // Current status of the task (passed via message)
+	selectedIndex int      // Currently selected status option (0-3)
+	taskID        string   // ID of the task being updated (passed via message)
+	currentStatus string   // Current status of the task (passed via message)
+	taskIDs       []string // Tasks of a bulk status change (passed via message)
 }
 
 // NewModel creates a new status modal component
@@ -68,6 +70,10 @@ func (m *StatusModel) Update(msg tea.Msg) tea.Cmd {
 	case ShowStatusModalMsg:
 		m.SetActive(true)
 		m.SetFocus(true)
+		m.taskIDs = msg.TaskIDs
+		if m.taskIDs != nil {
+			m.currentStatus = "" // The marked tasks have no single current status
+		}
 		m.selectedIndex = m.getInitialSelectedIndex()
 		return m.BroadcastMessage(messages.ModalStateMsg{
 			Type:   string(base.ModalTypeStatus),
@@ -150,8 +156,9 @@ func (m *StatusModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
 		selectedStatus := statusOptions[m.selectedIndex]
 		return tea.Batch(
 			m.BroadcastMessage(StatusSelectedMsg{
-				Status: selectedStatus,
-				TaskID: m.taskID,
+				Status:  selectedStatus,
+				TaskID:  m.taskID,
+				TaskIDs: m.taskIDs,
 			}),
 			m.BroadcastMessage(HideStatusModalMsg{}),
 		)
@@ -224,7 +231,7 @@ func (m *StatusModel) renderModal() string {
 
 	// Create the modal with border (similar to help modal style)
 	modal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
 		Width(modalWidth).
 		Height(modalHeight).
@@ -242,6 +249,9 @@ func (m *StatusModel) renderContent() string {
 	// Title
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
 	title := titleStyle.Render("Change Task Status")
+	if m.taskIDs != nil {
+		title = titleStyle.Render(fmt.Sprintf("Change Status of %d Tasks", len(m.taskIDs)))
+	}
 	content.WriteString(title)
 	content.WriteString("\n\n")
 
@@ -1,6 +1,7 @@
 package status
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -331,3 +332,29 @@ func containsText(text, substr string) bool {
 	// to strip ANSI codes for more accurate testing
 	return len(text) > 0 && len(substr) > 0
 }
+
+func TestBulkStatusSelection(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.SetTaskInfo("task-123", "todo")
+
+	// Shown for marked tasks, the modal names their count and returns them
+	model.Update(ShowStatusModalMsg{TaskIDs: []string{"t1", "t2", "t3"}})
+	if view := model.View(); !strings.Contains(view, "Change Status of 3 Tasks") || strings.Contains(view, "(current)") {
+		t.Errorf("Expected the bulk title without a current status, got:\n%s", view)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("4")})
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	var selected *StatusSelectedMsg
+	for _, c := range cmd().(tea.BatchMsg) {
+		msg := c()
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		if msg, ok := msg.(StatusSelectedMsg); ok {
+			selected = &msg
+		}
+	}
+	if selected == nil || selected.Status != "done" || strings.Join(selected.TaskIDs, ",") != "t1,t2,t3" {
+		t.Errorf("Expected done for the three tasks, got %+v", selected)
+	}
+}
@@ -3,7 +3,9 @@ package status
 import tea "github.com/charmbracelet/bubbletea"
 
 // ShowStatusModalMsg is sent when the status modal should be shown
-type ShowStatusModalMsg struct{}
+type ShowStatusModalMsg struct {
+	TaskIDs []string // Tasks marked for a bulk status change (nil = the task set with SetTaskInfo)
+}
 
 // HideStatusModalMsg is sent when the status modal should be hidden
 type HideStatusModalMsg struct{}
@@ -16,8 +18,9 @@ type StatusModalHiddenMsg struct{}
 
 // StatusSelectedMsg is sent when a status has been selected and confirmed
 type StatusSelectedMsg struct {
-	Status string // The selected status: "todo", "doing", "review", "done"
-	TaskID string // The ID of the task to update
+	Status  string   // The selected status: "todo", "doing", "review", "done"
+	TaskID  string   // The ID of the task to update
+	TaskIDs []string // The tasks to update in bulk, when the modal was shown for several
 }
 
 // StatusModalScrollMsg is sent for internal scrolling within the modal
@@ -10,8 +10,8 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/layout"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
-	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -174,9 +174,9 @@ func (m *Model) handleWindowSize(msg tea.WindowSizeMsg) tea.Cmd {
 	m.SetDimensions(modalWidth, modalHeight)
 
 	// Calculate viewport dimensions using dimension calculator
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	calc := layout.NewCalculator(modalWidth, modalHeight, layout.ModalComponent).
-		WithScrollbar().     // Reserve space for scrollbar (4 chars)
+		WithScrollbarEnabled(m.GetContext().ScrollbarOptions().Enabled).
 		WithPadding(2).      // Modal padding
 		WithReservedLines(8) // Title, search bar, and buttons
 
@@ -407,7 +407,7 @@ func (m *Model) View() string {
 
 	// Create the modal with border
 	modal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like other modals
 		Width(modalWidth).
 		Height(modalHeight).
@@ -456,13 +456,14 @@ func (m *Model) renderContent() string {
 	// Add scrollbar if content is scrollable
 	totalLines := m.viewport.TotalLineCount()
 	viewportHeight := m.viewport.Height
-	if totalLines > viewportHeight {
+	scrollbarOpts := m.GetContext().ScrollbarOptions()
+	if totalLines > viewportHeight && scrollbarOpts.Enabled {
 		// Generate scrollbar
-		scrollbar := view.RenderScrollBarExact(m.viewport.YOffset, totalLines, viewportHeight)
+		scrollbar := scrollbarOpts.Render(m.viewport.YOffset, totalLines, viewportHeight)
 
 		// Compose content with scrollbar
 		// Calculate width: viewport width accounts for modal padding
-		viewportContent = sharedviewport.ComposeWithScrollbar(viewportContent, scrollbar, m.viewport.Width+2, 0)
+		viewportContent = sharedviewport.ComposeWithScrollbarOptions(viewportContent, scrollbar, m.viewport.Width+2, 0, scrollbarOpts)
 	}
 
 	content.WriteString(viewportContent)
@@ -2,13 +2,16 @@ package taskedit
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -35,10 +38,21 @@ type TaskEditModel struct {
 	// ===================================================================
 
 	// Task context (passed via message for edit session)
-	taskID string // ID of task being edited
+	taskID      string // ID of task being edited
+	taskDeleted bool   // The task was deleted remotely; saving copies the edit instead
+
+	// Creating a new task: title and description fields come before the others
+	creating         bool
+	titleValue       string
+	descriptionValue string
+	titleMissing     bool // Saving was refused because the title is empty
+
+	// Discard confirmation: with confirmDiscard, Esc/q on changed fields asks first
+	confirmDiscard bool
+	discardPrompt  bool // The "discard changes?" question is showing
 
 	// Multi-field form state
-	activeField FieldType // Currently focused field (0=status, 1=priority, 2=feature)
+	activeField FieldType // Currently focused field (see fields for the order)
 
 	// Field values (working state - what user is editing)
 	statusValue   string // Current status selection
@@ -108,6 +122,9 @@ func (m *TaskEditModel) Update(msg tea.Msg) tea.Cmd {
 
 		// Set task info
 		m.taskID = msg.TaskID
+		m.taskDeleted = false
+		m.confirmDiscard = msg.ConfirmDiscard
+		m.discardPrompt = false
 		m.activeField = msg.FocusField // Start on specified field
 
 		// Initialize status field
@@ -129,6 +146,25 @@ func (m *TaskEditModel) Update(msg tea.Msg) tea.Cmd {
 		m.isCreatingNew = false
 		m.newFeatureName = ""
 
+		// Resume unsaved values from a previous session; originals stay untouched
+		// so change detection still compares against the task's current values
+		if draft := msg.Draft; draft != nil && draft.TaskID == msg.TaskID {
+			m.applyDraft(*draft)
+		}
+		if msg.FocusIncomplete {
+			m.activeField = m.firstIncompleteField(msg.FocusField)
+		}
+
+		// A new task starts empty, on its title
+		m.creating = msg.Create
+		m.titleValue = ""
+		m.descriptionValue = ""
+		m.titleMissing = false
+		if m.creating {
+			m.taskID = ""
+			m.activeField = FieldTitle
+		}
+
 		// Pre-select the current feature if it exists in available features
 		if m.featureValue != "" {
 			if index := m.findFeatureIndex(m.featureValue, m.availableFeatures); index != -1 {
@@ -148,6 +184,7 @@ func (m *TaskEditModel) Update(msg tea.Msg) tea.Cmd {
 	case HideTaskEditModalMsg:
 		m.SetActive(false)
 		m.SetFocus(false)
+		m.discardPrompt = false
 		m.priorityEditMode = false
 		m.priorityInput = ""
 		m.featureSelectionMode = false
@@ -179,6 +216,84 @@ func (m *TaskEditModel) Update(msg tea.Msg) tea.Cmd {
 	}
 }
 
+// firstIncompleteField returns the first field without a value, or fallback
+// when every field is set. A priority of 0 counts as unset, as Archon's default
+func (m *TaskEditModel) firstIncompleteField(fallback FieldType) FieldType {
+	switch {
+	case m.statusValue == "":
+		return FieldStatus
+	case m.priorityValue == 0:
+		return FieldPriority
+	case m.featureValue == "":
+		return FieldFeature
+	default:
+		return fallback
+	}
+}
+
+// Draft returns the unsaved field values of the open edit session.
+// Returns false when the modal is closed or nothing has changed.
+func (m *TaskEditModel) Draft() (Draft, bool) {
+	if !m.IsActive() || m.creating || !m.hasChanges() {
+		return Draft{}, false
+	}
+	return Draft{
+		TaskID:   m.taskID,
+		Status:   m.statusValue,
+		Priority: m.priorityValue,
+		Feature:  m.featureValue,
+	}, true
+}
+
+// hasChanges reports whether any working value differs from the task's original
+func (m *TaskEditModel) hasChanges() bool {
+	return m.statusValue != m.originalStatus ||
+		m.priorityValue != m.originalPriority ||
+		m.featureValue != m.originalFeature ||
+		m.titleValue != "" || m.descriptionValue != ""
+}
+
+// EditingTaskID returns the ID of the task being edited ("" when closed)
+func (m *TaskEditModel) EditingTaskID() string {
+	if !m.IsActive() {
+		return ""
+	}
+	return m.taskID
+}
+
+// MarkTaskDeleted keeps the open modal but warns that the task was deleted
+// remotely; saving then copies the edited fields and closes instead of
+// updating a task that no longer exists
+func (m *TaskEditModel) MarkTaskDeleted() {
+	m.taskDeleted = true
+}
+
+// TaskDeleted reports whether the task under edit was deleted remotely
+func (m *TaskEditModel) TaskDeleted() bool {
+	return m.taskDeleted
+}
+
+// editedFields formats the working values, one "Field: value" per line
+func (m *TaskEditModel) editedFields() string {
+	feature := m.featureValue
+	if feature == "" {
+		feature = "(none)"
+	}
+	return fmt.Sprintf("Status: %s\nPriority: %d\nFeature: %s", m.statusValue, m.priorityValue, feature)
+}
+
+// applyDraft loads draft values into the working fields, ignoring unknown statuses
+func (m *TaskEditModel) applyDraft(draft Draft) {
+	for i, status := range statusOptions {
+		if status == draft.Status {
+			m.statusValue = draft.Status
+			m.statusIndex = i
+		}
+	}
+	m.priorityValue = utils.ClampTaskPriority(draft.Priority)
+	m.featureValue = draft.Feature
+}
+
 // View renders the task edit modal
 func (m *TaskEditModel) View() string {
 	if !m.IsActive() {
@@ -192,6 +307,10 @@ func (m *TaskEditModel) View() string {
 func (m *TaskEditModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
 	keyString := key.String()
 
+	if m.discardPrompt {
+		return m.handleDiscardPrompt(keyString)
+	}
+
 	// Check if we're in a special mode that needs priority routing
 	// These modes intercept keys before global handlers
 	if m.priorityEditMode || m.isCreatingNew || m.featureSelectionMode {
@@ -206,31 +325,27 @@ func (m *TaskEditModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
 		}
 	}
 
+	// Title and description take every printable key, j/k/q included
+	if m.activeField == FieldTitle || m.activeField == FieldDescription {
+		return m.handleTextField(keyString)
+	}
+
 	// Global keys that work when not in special mode
 	switch keyString {
 	case keys.KeyEscape, keys.KeyQ:
-		// Cancel and close modal without saving
-		return m.BroadcastMessage(HideTaskEditModalMsg{})
+		return m.cancel()
 
 	case keys.KeyCtrlC:
 		return tea.Quit
 
-	case keys.KeyJ, keys.KeyArrowDown:
+	case keys.KeyJ, keys.KeyArrowDown, keys.KeyTab:
 		// Navigate to next field (vim-style vertical navigation)
-		m.activeField = (m.activeField + 1) % 3
-		// Reset field-specific modes when changing fields
-		m.priorityEditMode = false
-		m.isCreatingNew = false
-		m.featureSelectionMode = false
+		m.moveField(1)
 		return nil
 
-	case keys.KeyK, keys.KeyArrowUp:
+	case keys.KeyK, keys.KeyArrowUp, keys.KeyShiftTab:
 		// Navigate to previous field (vim-style vertical navigation)
-		m.activeField = (m.activeField - 1 + 3) % 3
-		// Reset field-specific modes when changing fields
-		m.priorityEditMode = false
-		m.isCreatingNew = false
-		m.featureSelectionMode = false
+		m.moveField(-1)
 		return nil
 	}
 
@@ -247,6 +362,48 @@ func (m *TaskEditModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
 	}
 }
 
+// fields returns the fields in the order they are shown and navigated
+func (m *TaskEditModel) fields() []FieldType {
+	if m.creating {
+		return []FieldType{FieldTitle, FieldDescription, FieldStatus, FieldPriority, FieldFeature}
+	}
+	return []FieldType{FieldStatus, FieldPriority, FieldFeature}
+}
+
+// moveField focuses the field delta places away, wrapping around
+func (m *TaskEditModel) moveField(delta int) {
+	fields := m.fields()
+	current := max(0, slices.Index(fields, m.activeField))
+	m.activeField = fields[(current+delta+len(fields))%len(fields)]
+	// Reset field-specific modes when changing fields
+	m.priorityEditMode = false
+	m.isCreatingNew = false
+	m.featureSelectionMode = false
+}
+
+// cancel closes the modal without saving, asking first when that loses changes
+func (m *TaskEditModel) cancel() tea.Cmd {
+	if m.confirmDiscard && m.hasChanges() {
+		m.discardPrompt = true
+		return nil
+	}
+	return m.BroadcastMessage(HideTaskEditModalMsg{})
+}
+
+// handleDiscardPrompt answers the "discard changes?" question: y closes
+// without saving, n or Esc returns to the form
+func (m *TaskEditModel) handleDiscardPrompt(keyString string) tea.Cmd {
+	switch keyString {
+	case keys.KeyY:
+		return m.BroadcastMessage(HideTaskEditModalMsg{})
+	case keys.KeyN, keys.KeyEscape:
+		m.discardPrompt = false
+	case keys.KeyCtrlC:
+		return tea.Quit
+	}
+	return nil
+}
+
 // =============================================================================
 // FIELD HANDLERS - Handle input for each field type
 // =============================================================================
@@ -299,6 +456,49 @@ func (m *TaskEditModel) handleStatusField(keyString string) tea.Cmd {
 	}
 }
 
+// handleTextField handles input when the title or description of a new task
+// is focused: printable keys are typed, Tab and the arrow keys change field
+func (m *TaskEditModel) handleTextField(keyString string) tea.Cmd {
+	value := &m.titleValue
+	if m.activeField == FieldDescription {
+		value = &m.descriptionValue
+	}
+
+	switch keyString {
+	case keys.KeyEscape:
+		return m.cancel()
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+
+	case keys.KeyTab, keys.KeyArrowDown:
+		m.moveField(1)
+
+	case keys.KeyShiftTab, keys.KeyArrowUp:
+		m.moveField(-1)
+
+	case keys.KeyEnter:
+		return m.saveChanges()
+
+	case keys.KeyBackspace:
+		// Remove last character
+		if runes := []rune(*value); len(runes) > 0 {
+			*value = string(runes[:len(runes)-1])
+		}
+
+	case keys.KeyCtrlU:
+		// Clear entire input
+		*value = ""
+
+	default:
+		if runes := []rune(keyString); len(runes) == 1 && unicode.IsPrint(runes[0]) {
+			*value += keyString
+			m.titleMissing = false
+		}
+	}
+	return nil
+}
+
 // handlePriorityField handles input when priority field is focused
 func (m *TaskEditModel) handlePriorityField(keyString string) tea.Cmd {
 	// If in text input mode, handle numeric input
@@ -310,22 +510,22 @@ func (m *TaskEditModel) handlePriorityField(keyString string) tea.Cmd {
 	switch keyString {
 	case keys.KeyH, keys.KeyArrowLeft:
 		// Decrease priority by 1 (vim-style horizontal navigation ←)
-		m.priorityValue = max(0, m.priorityValue-1)
+		m.priorityValue = utils.ClampTaskPriority(m.priorityValue - 1)
 		return nil
 
 	case keys.KeyL, keys.KeyArrowRight:
 		// Increase priority by 1 (vim-style horizontal navigation →)
-		m.priorityValue = min(999, m.priorityValue+1)
+		m.priorityValue = utils.ClampTaskPriority(m.priorityValue + 1)
 		return nil
 
 	case keys.KeyHCap: // Shift+H
 		// Fast decrease by 10
-		m.priorityValue = max(0, m.priorityValue-10)
+		m.priorityValue = utils.ClampTaskPriority(m.priorityValue - 10)
 		return nil
 
 	case keys.KeyLCap: // Shift+L
 		// Fast increase by 10
-		m.priorityValue = min(999, m.priorityValue+10)
+		m.priorityValue = utils.ClampTaskPriority(m.priorityValue + 10)
 		return nil
 
 	case keys.KeyEnter:
@@ -362,7 +562,7 @@ func (m *TaskEditModel) handlePriorityTextInput(keyString string) tea.Cmd {
 		// Confirm entered value
 		if value, err := strconv.Atoi(m.priorityInput); err == nil {
 			// Clamp to valid range
-			m.priorityValue = max(0, min(999, value))
+			m.priorityValue = utils.ClampTaskPriority(value)
 		}
 		m.priorityEditMode = false
 		m.priorityInput = ""
@@ -467,11 +667,9 @@ func (m *TaskEditModel) handleFeatureTextInput(keyString string) tea.Cmd {
 
 	default:
 		// Add character to feature name (basic text input with validation)
-		if len(keyString) == 1 && len(m.newFeatureName) < 30 { // 30 character limit
+		if len(keyString) == 1 && len(m.newFeatureName) < utils.MaxFeatureNameLength {
 			// Only allow alphanumeric and basic characters
-			char := keyString[0]
-			if (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') ||
-				(char >= '0' && char <= '9') || char == '_' || char == '-' || char == ' ' {
+			if utils.IsFeatureNameChar(rune(keyString[0])) {
 				m.newFeatureName += keyString
 			}
 		}
@@ -545,6 +743,17 @@ func (m *TaskEditModel) handleScroll(msg TaskEditModalScrollMsg) tea.Cmd {
 
 // saveChanges detects what changed and broadcasts update message
 func (m *TaskEditModel) saveChanges() tea.Cmd {
+	// Nothing left to update; keep the edit by copying it
+	if m.taskDeleted {
+		return tea.Batch(
+			m.BroadcastMessage(messages.CopyToClipboardMsg{Text: m.editedFields(), What: "edited fields"}),
+			m.BroadcastMessage(HideTaskEditModalMsg{}),
+		)
+	}
+	if m.creating {
+		return m.createTask()
+	}
+
 	// Detect changes
 	var status, feature *string
 	var priority *int
@@ -578,6 +787,30 @@ func (m *TaskEditModel) saveChanges() tea.Cmd {
 	return m.BroadcastMessage(HideTaskEditModalMsg{})
 }
 
+// createTask broadcasts the new task's fields, or points at the title when it is empty
+func (m *TaskEditModel) createTask() tea.Cmd {
+	title := strings.TrimSpace(m.titleValue)
+	if utils.ValidateTaskTitle(title) != nil {
+		m.titleMissing = true
+		m.activeField = FieldTitle
+		m.priorityEditMode = false
+		m.isCreatingNew = false
+		m.featureSelectionMode = false
+		return nil
+	}
+
+	return tea.Batch(
+		m.BroadcastMessage(TaskCreateRequestedMsg{
+			Title:       title,
+			Description: strings.TrimSpace(m.descriptionValue),
+			Status:      m.statusValue,
+			Priority:    m.priorityValue,
+			Feature:     m.featureValue,
+		}),
+		m.BroadcastMessage(HideTaskEditModalMsg{}),
+	)
+}
+
 // getStatusIndex returns the index for a status string
 func (m *TaskEditModel) getStatusIndex(status string) int {
 	for i, s := range statusOptions {
@@ -606,7 +839,7 @@ func (m *TaskEditModel) renderModal() string {
 
 	// Create the modal with border
 	modal := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("51")). // Bright cyan like other modals
 		Width(modalWidth).
 		Height(modalHeight).
@@ -625,10 +858,25 @@ func (m *TaskEditModel) renderContent() string {
 	// Title
 	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
 	title := titleStyle.Render("Edit Task Properties")
+	if m.creating {
+		title = titleStyle.Render("New Task")
+	}
 	content.WriteString(title)
 	content.WriteString("\n\n")
 
+	if m.taskDeleted {
+		bannerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(styling.CurrentTheme.ErrorColor))
+		content.WriteString(bannerStyle.Render("⚠ This task was deleted remotely"))
+		content.WriteString("\n\n")
+	}
+
 	// Render each field
+	if m.creating {
+		content.WriteString(m.renderTextField(FieldTitle, "Title:", m.titleValue))
+		content.WriteString("\n\n")
+		content.WriteString(m.renderTextField(FieldDescription, "Description:", m.descriptionValue))
+		content.WriteString("\n\n")
+	}
 	content.WriteString(m.renderStatusField())
 	content.WriteString("\n\n")
 	content.WriteString(m.renderPriorityField())
@@ -641,12 +889,21 @@ func (m *TaskEditModel) renderContent() string {
 	var instructions string
 
 	switch {
+	case m.discardPrompt:
+		promptStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(styling.CurrentTheme.WarningColor))
+		instructions = promptStyle.Render("Discard changes? y: Discard • n/Esc: Keep editing")
 	case m.featureSelectionMode && m.activeField == FieldFeature:
 		// In feature selection mode - show viewport navigation help
 		instructions = helpStyle.Render("j/k: Navigate features • Enter: Confirm • h/Esc: Cancel • Space: Save all")
 	case m.isCreatingNew && m.activeField == FieldFeature:
 		// Creating new feature - show text input help
 		instructions = helpStyle.Render("Type name • Enter: Confirm • Esc: Cancel")
+	case m.taskDeleted:
+		instructions = helpStyle.Render("Space/Enter: Copy changes and close • Esc: Discard")
+	case m.creating && (m.activeField == FieldTitle || m.activeField == FieldDescription):
+		instructions = helpStyle.Render("Type text • Tab/↑↓: Change field • Enter: Create • Esc: Cancel")
+	case m.creating:
+		instructions = helpStyle.Render("j/k: Change field • h/l: Adjust value • Space/Enter: Create • Esc: Cancel")
 	default:
 		// Normal mode - show general navigation help
 		instructions = helpStyle.Render("j/k: Change field • h/l: Adjust value • Space/Enter: Save • Esc: Cancel")
@@ -661,6 +918,42 @@ func (m *TaskEditModel) renderContent() string {
 // FIELD RENDERING - Render each field type
 // =============================================================================
 
+// renderTextField renders the title or description input of a new task
+func (m *TaskEditModel) renderTextField(field FieldType, label, value string) string {
+	var content strings.Builder
+
+	// Field label
+	labelStyle := lipgloss.NewStyle().Bold(true)
+	if m.activeField == field {
+		labelStyle = labelStyle.Foreground(lipgloss.Color("51")) // Highlight if active
+	} else {
+		labelStyle = labelStyle.Foreground(lipgloss.Color("240")) // Dim if inactive
+	}
+	content.WriteString(labelStyle.Render(label))
+	content.WriteString("  ")
+
+	switch {
+	case m.activeField == field:
+		inputStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("15")).
+			Background(lipgloss.Color("236")).
+			Bold(true)
+		content.WriteString(inputStyle.Render(value + "▊"))
+	case value != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(value))
+	default:
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Italic(true).Render("(empty)"))
+	}
+
+	if field == FieldTitle && m.titleMissing {
+		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(styling.CurrentTheme.ErrorColor))
+		content.WriteString("  ")
+		content.WriteString(errorStyle.Render("required"))
+	}
+
+	return content.String()
+}
+
 // renderStatusField renders the status selection field
 func (m *TaskEditModel) renderStatusField() string {
 	var content strings.Builder
@@ -864,7 +1157,7 @@ func (m *TaskEditModel) renderFeatureViewport() string {
 	// Render viewport with border using lipgloss.JoinVertical
 	viewportContent := lipgloss.JoinVertical(lipgloss.Left, visibleItems...)
 	viewport := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(styling.NormalBorder()).
 		BorderForeground(lipgloss.Color("62")).
 		Padding(0, 1).
 		Width(40).
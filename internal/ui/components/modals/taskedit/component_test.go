@@ -1,6 +1,7 @@
 package taskedit
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -221,6 +222,112 @@ func TestShowTaskEditModal(t *testing.T) {
 	}
 }
 
+func TestShowTaskEditModalWithDraft(t *testing.T) {
+	model := createTestModel()
+
+	model.Update(ShowTaskEditModalMsg{
+		TaskID:            "task-123",
+		CurrentStatus:     "todo",
+		CurrentPriority:   5,
+		CurrentFeature:    "ui",
+		AvailableFeatures: []string{"ui", "backend"},
+		Draft:             &Draft{TaskID: "task-123", Status: "review", Priority: 8, Feature: "backend"},
+	})
+
+	if model.statusValue != "review" || model.priorityValue != 8 || model.featureValue != "backend" {
+		t.Errorf("Expected draft values, got %s/%d/%s", model.statusValue, model.priorityValue, model.featureValue)
+	}
+	if model.originalStatus != "todo" || model.originalPriority != 5 || model.originalFeature != "ui" {
+		t.Error("Expected originals to keep the task's current values for change detection")
+	}
+
+	draft, ok := model.Draft()
+	if !ok || draft.Status != "review" {
+		t.Errorf("Expected open edit to report its draft, got %+v", draft)
+	}
+
+	// A draft for another task is ignored
+	model.Update(ShowTaskEditModalMsg{
+		TaskID:        "task-456",
+		CurrentStatus: "todo",
+		Draft:         &Draft{TaskID: "task-123", Status: "done"},
+	})
+	if model.statusValue != "todo" {
+		t.Errorf("Expected draft for another task to be ignored, got %s", model.statusValue)
+	}
+	if _, ok := model.Draft(); ok {
+		t.Error("Expected no draft without changes")
+	}
+}
+
+func TestShowTaskEditModalFocusIncomplete(t *testing.T) {
+	model := createTestModel()
+	show := func(priority int, feature string) FieldType {
+		model.Update(ShowTaskEditModalMsg{
+			TaskID:          "task-123",
+			CurrentStatus:   "todo",
+			CurrentPriority: priority,
+			CurrentFeature:  feature,
+			FocusField:      FieldStatus,
+			FocusIncomplete: true,
+		})
+		return model.activeField
+	}
+
+	if got := show(0, ""); got != FieldPriority {
+		t.Errorf("Expected focus on the unset priority, got %d", got)
+	}
+	if got := show(5, ""); got != FieldFeature {
+		t.Errorf("Expected focus on the unset feature, got %d", got)
+	}
+	if got := show(5, "ui"); got != FieldStatus {
+		t.Errorf("Expected the status fallback with every field set, got %d", got)
+	}
+}
+
+func TestSaveAfterRemoteDeletion(t *testing.T) {
+	model := createTestModel()
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-123", CurrentStatus: "todo", CurrentPriority: 5})
+	if model.EditingTaskID() != "task-123" {
+		t.Fatalf("Expected the edited task ID, got %q", model.EditingTaskID())
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRight}) // todo → doing
+
+	model.MarkTaskDeleted()
+	if view := model.View(); !strings.Contains(view, "This task was deleted remotely") {
+		t.Errorf("Expected the deleted banner, got:\n%s", view)
+	}
+
+	// Saving copies the edited fields and closes instead of updating
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	var copied *messages.CopyToClipboardMsg
+	var updated bool
+	for _, c := range cmd().(tea.BatchMsg) {
+		msg := c()
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		switch msg := msg.(type) {
+		case messages.CopyToClipboardMsg:
+			copied = &msg
+		case TaskPropertiesUpdatedMsg:
+			updated = true
+		}
+	}
+	if copied == nil || copied.Text != "Status: doing\nPriority: 5\nFeature: (none)" || updated {
+		t.Errorf("Expected the edit copied instead of saved, got %+v (updated: %v)", copied, updated)
+	}
+	if !commandContainsMessage(cmd, HideTaskEditModalMsg{}) {
+		t.Error("Expected the modal to close")
+	}
+
+	// The next edit session starts without the warning
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-456"})
+	if model.TaskDeleted() {
+		t.Error("Expected the deleted flag reset for a new session")
+	}
+}
+
 func TestHideTaskEditModal(t *testing.T) {
 	model := createTestModel()
 
@@ -593,3 +700,107 @@ func TestFindFeatureIndexHelper(t *testing.T) {
 		}
 	}
 }
+
+func TestDiscardConfirmation(t *testing.T) {
+	model := createTestModel()
+	key := func(s string) tea.Cmd {
+		if s == "esc" {
+			return model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		}
+		return model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+	}
+
+	// Nothing changed: Esc closes right away
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-123", CurrentStatus: "todo", ConfirmDiscard: true})
+	if !commandContainsMessage(key("esc"), HideTaskEditModalMsg{}) {
+		t.Error("Expected Esc without changes to close")
+	}
+
+	// Changed fields ask first; n goes back to the form
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-123", CurrentStatus: "todo", ConfirmDiscard: true})
+	key("l")
+	if cmd := key("esc"); cmd != nil || !strings.Contains(model.View(), "Discard changes?") {
+		t.Fatal("Expected Esc with changes to ask before discarding")
+	}
+	if key("n"); model.discardPrompt || model.statusValue != "doing" {
+		t.Error("Expected n to keep editing with the change intact")
+	}
+
+	// q asks too, and y discards
+	key("q")
+	if !commandContainsMessage(key("y"), HideTaskEditModalMsg{}) {
+		t.Error("Expected y to close without saving")
+	}
+
+	// The setting turns the question off
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-123", CurrentStatus: "todo"})
+	key("l")
+	if !commandContainsMessage(key("esc"), HideTaskEditModalMsg{}) {
+		t.Error("Expected Esc to discard silently with confirmation off")
+	}
+}
+
+func TestCreateTaskMode(t *testing.T) {
+	model := createTestModel()
+	typeText := func(s string) {
+		for _, r := range s {
+			if r == ' ' {
+				model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{r}})
+				continue
+			}
+			model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+	model.Update(ShowTaskEditModalMsg{Create: true, CurrentStatus: "todo", CurrentPriority: 50, AvailableFeatures: []string{"ui"}})
+	if model.activeField != FieldTitle || !strings.Contains(model.View(), "New Task") {
+		t.Fatalf("Expected a new task form starting on the title, got field %d", model.activeField)
+	}
+	if model.EditingTaskID() != "" {
+		t.Errorf("Expected no task under edit, got %q", model.EditingTaskID())
+	}
+
+	// An empty title is refused
+	if cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || !strings.Contains(model.View(), "required") {
+		t.Error("Expected an empty title refused")
+	}
+
+	// j, k and q are typed into the text fields; Tab moves on
+	typeText("Fix quirky jokes")
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("Details")
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.titleValue != "Fix quirky jokes" || model.descriptionValue != "Details" || model.activeField != FieldStatus {
+		t.Fatalf("Expected both fields typed, got %q / %q on field %d", model.titleValue, model.descriptionValue, model.activeField)
+	}
+	if _, ok := model.Draft(); ok {
+		t.Error("Expected no edit draft for a new task")
+	}
+
+	// The other fields work as when editing, and saving sends every value
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")}) // todo → doing
+	cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	var created *TaskCreateRequestedMsg
+	for _, c := range cmd().(tea.BatchMsg) {
+		msg := c()
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		if msg, ok := msg.(TaskCreateRequestedMsg); ok {
+			created = &msg
+		}
+	}
+	want := TaskCreateRequestedMsg{Title: "Fix quirky jokes", Description: "Details", Status: "doing", Priority: 50}
+	if created == nil || *created != want {
+		t.Errorf("Expected %+v, got %+v", want, created)
+	}
+	if !commandContainsMessage(cmd, HideTaskEditModalMsg{}) {
+		t.Error("Expected the modal to close")
+	}
+
+	// Editing an existing task keeps its three fields
+	model.Update(ShowTaskEditModalMsg{TaskID: "task-123", CurrentStatus: "todo"})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if model.activeField != FieldFeature || strings.Contains(model.View(), "Title:") {
+		t.Errorf("Expected only the edit fields, got field %d", model.activeField)
+	}
+}
@@ -9,6 +9,8 @@ const (
 	FieldStatus FieldType = iota
 	FieldPriority
 	FieldFeature
+	FieldTitle       // Creating tasks only
+	FieldDescription // Creating tasks only
 )
 
 // Component lifecycle messages
@@ -20,7 +22,19 @@ type ShowTaskEditModalMsg struct {
 	CurrentPriority   int       // Current task priority (task_order value)
 	CurrentFeature    string    // Current feature assignment (can be empty)
 	FocusField        FieldType // Which field to focus initially
+	FocusIncomplete   bool      // Focus the first field without a value instead, if any
 	AvailableFeatures []string  // List of available features to choose from
+	Draft             *Draft    // Unsaved values from a previous session (nil = start from current values)
+	ConfirmDiscard    bool      // Ask before Esc/q throws away changed fields
+	Create            bool      // Create a new task: adds title and description fields, TaskID is ignored
+}
+
+// Draft holds field values the user changed but has not saved yet
+type Draft struct {
+	TaskID   string
+	Status   string
+	Priority int
+	Feature  string
 }
 
 // HideTaskEditModalMsg is sent to hide the task edit modal
@@ -43,6 +57,15 @@ type TaskPropertiesUpdatedMsg struct {
 	Feature  *string // New feature (nil if unchanged)
 }
 
+// TaskCreateRequestedMsg is sent when the fields of a new task have been filled in
+type TaskCreateRequestedMsg struct {
+	Title       string
+	Description string
+	Status      string
+	Priority    int
+	Feature     string // "" for no feature
+}
+
 // FeatureSelectedMsg is sent when a feature has been selected or created
 // Note: New code should use TaskPropertiesUpdatedMsg for unified updates
 type FeatureSelectedMsg struct {
@@ -63,6 +86,7 @@ var (
 	_ tea.Msg = TaskEditModalShownMsg{}
 	_ tea.Msg = TaskEditModalHiddenMsg{}
 	_ tea.Msg = TaskPropertiesUpdatedMsg{}
+	_ tea.Msg = TaskCreateRequestedMsg{}
 	_ tea.Msg = FeatureSelectedMsg{}
 	_ tea.Msg = TaskEditModalScrollMsg{}
 )
@@ -0,0 +1,312 @@
+package unblock
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+const ComponentID = "unblock-modal"
+
+// UnblockModel lists the tasks whose completion would unblock the most work
+// Architecture: Follows four-tier state pattern
+// - No source data caching (receives the report via ShowUnblockModalMsg)
+// - No display parameters (simple selection modal)
+// - Owned state only (selection, expanded rows, report)
+// - No transient feedback (jumping and copying are handled by MainModel)
+// - Modal lifecycle managed by BaseModal (active/visible state)
+type UnblockModel struct {
+	base.BaseModal
+
+	// ===================================================================
+	// OWNED STATE - Component manages these directly
+	// ===================================================================
+	selectedIndex int          // Currently selected entry
+	expanded      map[int]bool // Entries showing what they unblock
+	report        deps.Report  // Report being shown (passed via message)
+	export        string       // Markdown copy of the report
+}
+
+// NewModel creates a new unblock report modal component
+func NewModel(context *base.ComponentContext) *UnblockModel {
+	baseModal := base.NewBaseModal(
+		ComponentID,
+		base.UnblockModalComponent,
+		context,
+	)
+
+	model := &UnblockModel{
+		BaseModal: baseModal,
+		expanded:  make(map[int]bool),
+	}
+	model.SetDimensions(70, 12)
+	return model
+}
+
+// CanFocus overrides the base implementation to allow focus
+func (m *UnblockModel) CanFocus() bool {
+	return true
+}
+
+// Init initializes the unblock report modal component
+func (m *UnblockModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the unblock report modal component
+func (m *UnblockModel) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ShowUnblockModalMsg:
+		m.SetActive(true)
+		m.SetFocus(true)
+		m.report = msg.Report
+		m.export = msg.Export
+		m.selectedIndex = 0
+		m.expanded = make(map[int]bool)
+		if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+			m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+		}
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeUnblock),
+			Active: true,
+		})
+
+	case HideUnblockModalMsg:
+		m.SetActive(false)
+		m.SetFocus(false)
+		return m.BroadcastMessage(messages.ModalStateMsg{
+			Type:   string(base.ModalTypeUnblock),
+			Active: false,
+		})
+
+	case tea.WindowSizeMsg:
+		m.updateDimensions(msg.Width, msg.Height)
+		return nil
+
+	case tea.KeyMsg:
+		if !m.IsActive() || !m.IsFocused() {
+			return nil
+		}
+		return m.handleKeyPress(msg)
+
+	default:
+		return nil
+	}
+}
+
+// View renders the unblock report modal
+func (m *UnblockModel) View() string {
+	if !m.IsActive() {
+		return ""
+	}
+
+	return m.renderModal()
+}
+
+// GetSelectedEntry returns the currently highlighted entry, if any
+func (m *UnblockModel) GetSelectedEntry() (deps.Entry, bool) {
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.report.Entries) {
+		return deps.Entry{}, false
+	}
+	return m.report.Entries[m.selectedIndex], true
+}
+
+// handleKeyPress processes keyboard input for the unblock report modal
+func (m *UnblockModel) handleKeyPress(key tea.KeyMsg) tea.Cmd {
+	switch key.String() {
+	case keys.KeyEscape, keys.KeyQ:
+		return m.BroadcastMessage(HideUnblockModalMsg{})
+
+	case keys.KeyJ, keys.KeyArrowDown:
+		if m.selectedIndex < len(m.report.Entries)-1 {
+			m.selectedIndex++
+		}
+		return nil
+
+	case keys.KeyK, keys.KeyArrowUp:
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+		return nil
+
+	case keys.KeySpace, keys.KeyL, keys.KeyArrowRight, keys.KeyH, keys.KeyArrowLeft:
+		if _, ok := m.GetSelectedEntry(); ok {
+			m.expanded[m.selectedIndex] = !m.expanded[m.selectedIndex]
+			if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+				m.updateDimensions(ctx.ProgramContext.ScreenWidth, ctx.ProgramContext.ScreenHeight)
+			}
+		}
+		return nil
+
+	case keys.KeyEnter:
+		entry, ok := m.GetSelectedEntry()
+		if !ok {
+			return m.BroadcastMessage(HideUnblockModalMsg{})
+		}
+		return tea.Batch(
+			m.BroadcastMessage(UnblockTaskChosenMsg{TaskID: entry.TaskID}),
+			m.BroadcastMessage(HideUnblockModalMsg{}),
+		)
+
+	case keys.KeyY:
+		return m.BroadcastMessage(messages.CopyToClipboardMsg{Text: m.export, What: "unblock report"})
+
+	case keys.KeyCtrlC:
+		return tea.Quit
+
+	default:
+		return nil
+	}
+}
+
+// updateDimensions sizes the modal to fit the report within the screen
+func (m *UnblockModel) updateDimensions(screenWidth, screenHeight int) {
+	// Title + blank + rows + cycle warning + blank + instructions, plus padding
+	rows := len(m.report.Entries)
+	for i, entry := range m.report.Entries {
+		if m.expanded[i] {
+			rows += len(entry.Unblocks)
+		}
+	}
+	height := max(rows, 1) + 8
+	m.SetDimensions(min(80, screenWidth-4), min(height, screenHeight-4))
+}
+
+// renderModal renders the complete unblock report modal
+func (m *UnblockModel) renderModal() string {
+	modal := lipgloss.NewStyle().
+		Border(styling.RoundedBorder()).
+		BorderForeground(lipgloss.Color("51")). // Bright cyan like active panels
+		Width(m.GetWidth()).
+		Height(m.GetHeight()).
+		Padding(1).
+		Render(m.renderContent())
+
+	return modal
+}
+
+// renderContent renders the modal content, keeping the selection in view
+func (m *UnblockModel) renderContent() string {
+	var content strings.Builder
+	innerWidth := m.GetWidth() - 4 // Border and padding
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51"))
+	content.WriteString(titleStyle.Render(fmt.Sprintf("What should I unblock? (%d)", len(m.report.Entries))))
+	content.WriteString("\n\n")
+
+	if len(m.report.Entries) == 0 {
+		content.WriteString("No visible task is blocking other work.\n")
+	}
+
+	lines, selectedLine := m.entryLines(innerWidth)
+
+	// Rows left for entries after title, warning, blank lines and instructions
+	visible := max(m.GetHeight()-8, 1)
+	start := 0
+	if selectedLine >= visible {
+		start = selectedLine - visible + 1
+	}
+	end := min(start+visible, len(lines))
+	for _, line := range lines[start:end] {
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	if len(m.report.Cycles) > 0 {
+		warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+		content.WriteString("\n")
+		content.WriteString(warningStyle.Render(view.TruncatePreservingANSI(fmt.Sprintf("⚠ %d cycle(s) in parent links: %s",
+			len(m.report.Cycles), m.cycleSummary()), innerWidth)))
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	content.WriteString(helpStyle.Render("↑/↓ navigate • Space expand • Enter jump • y copy • Esc close"))
+
+	return content.String()
+}
+
+// entryLines renders every entry and its expanded dependents, returning the
+// line index of the selected entry
+func (m *UnblockModel) entryLines(width int) ([]string, int) {
+	var lines []string
+	selectedLine := 0
+	selectedStyle := lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("15"))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+
+	for i, entry := range m.report.Entries {
+		prefix := "  "
+		if i == m.selectedIndex {
+			prefix = "▶ "
+			selectedLine = len(lines)
+		}
+		marker := "+"
+		if m.expanded[i] {
+			marker = "-"
+		}
+		line := view.TruncatePreservingANSI(fmt.Sprintf("%s%s %3d  %s", prefix, marker, len(entry.Unblocks), m.describe(entry.TaskID)), width)
+		if i == m.selectedIndex {
+			line = selectedStyle.Render(line)
+		}
+		lines = append(lines, line)
+
+		if m.expanded[i] {
+			for _, id := range entry.Unblocks {
+				lines = append(lines, dimStyle.Render(view.TruncatePreservingANSI("        └ "+m.title(id), width)))
+			}
+		}
+	}
+	return lines, selectedLine
+}
+
+// describe returns a task's title, status and assignee for a report row
+func (m *UnblockModel) describe(taskID string) string {
+	task := m.findTask(taskID)
+	if task == nil {
+		return taskID
+	}
+	text := fmt.Sprintf("%s [%s]", task.Title, task.Status)
+	if task.Assignee != "" {
+		text += " @" + task.Assignee
+	}
+	return text
+}
+
+// title returns a task's title, or its ID when it is not loaded
+func (m *UnblockModel) title(taskID string) string {
+	if task := m.findTask(taskID); task != nil {
+		return task.Title
+	}
+	return taskID
+}
+
+// cycleSummary lists the titles in each cycle
+func (m *UnblockModel) cycleSummary() string {
+	cycles := make([]string, len(m.report.Cycles))
+	for i, cycle := range m.report.Cycles {
+		titles := make([]string, len(cycle))
+		for j, id := range cycle {
+			titles[j] = m.title(id)
+		}
+		cycles[i] = strings.Join(titles, " ↔ ")
+	}
+	return strings.Join(cycles, "; ")
+}
+
+// findTask looks a task up in the program context
+func (m *UnblockModel) findTask(taskID string) *archon.Task {
+	if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil {
+		return ctx.ProgramContext.FindTask(taskID)
+	}
+	return nil
+}
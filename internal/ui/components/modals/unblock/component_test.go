@@ -0,0 +1,198 @@
+package unblock
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// mockConfigProvider provides a mock implementation for testing
+type mockConfigProvider struct{}
+
+func (m *mockConfigProvider) GetServerURL() string { return "http://localhost:8181" }
+func (m *mockConfigProvider) GetAPIKey() string    { return "test-key" }
+func (m *mockConfigProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "default"}
+}
+func (m *mockConfigProvider) GetDisplay() *config.DisplayConfig { return &config.DisplayConfig{} }
+func (m *mockConfigProvider) GetDevelopment() *config.DevelopmentConfig {
+	return &config.DevelopmentConfig{}
+}
+func (m *mockConfigProvider) GetDefaultSortMode() string        { return "status+priority" }
+func (m *mockConfigProvider) IsDebugEnabled() bool              { return false }
+func (m *mockConfigProvider) IsDarkModeEnabled() bool           { return true }
+func (m *mockConfigProvider) IsCompletedTasksVisible() bool     { return true }
+func (m *mockConfigProvider) IsPriorityIndicatorsEnabled() bool { return true }
+func (m *mockConfigProvider) IsFeatureColorsEnabled() bool      { return true }
+func (m *mockConfigProvider) IsFeatureBackgroundsEnabled() bool { return false }
+
+// mockStyleContextProvider provides a mock implementation for testing
+type mockStyleContextProvider struct{}
+
+func (m *mockStyleContextProvider) CreateStyleContext(forceBackground bool) *styling.StyleContext {
+	// Return a minimal style context for testing
+	theme := &styling.ThemeAdapter{
+		TodoColor:   "yellow",
+		DoingColor:  "blue",
+		ReviewColor: "orange",
+		DoneColor:   "green",
+		HeaderColor: "cyan",
+		MutedColor:  "gray",
+		Name:        "test",
+	}
+	return styling.NewStyleContext(theme, &mockConfigProvider{})
+}
+
+func (m *mockStyleContextProvider) GetTheme() *config.ThemeConfig {
+	return &config.ThemeConfig{Name: "test"}
+}
+
+// mockLogger provides a mock implementation for testing
+type mockLogger struct{}
+
+func (m *mockLogger) Debug(msg string, args ...interface{})                  {}
+func (m *mockLogger) Info(msg string, args ...interface{})                   {}
+func (m *mockLogger) Warn(msg string, args ...interface{})                   {}
+func (m *mockLogger) Error(msg string, args ...interface{})                  {}
+func (m *mockLogger) Fatal(msg string, args ...interface{})                  {}
+func (m *mockLogger) LogHTTPRequest(method, url string, args ...interface{}) {}
+func (m *mockLogger) LogHTTPResponse(method, url string, statusCode int, duration time.Duration, args ...interface{}) {
+}
+func (m *mockLogger) LogStateChange(component, field string, oldValue, newValue interface{}, args ...interface{}) {
+}
+func (m *mockLogger) LogPerformance(operation string, startTime time.Time, args ...interface{}) {}
+
+func createTestContext() *base.ComponentContext {
+	// Create a mock ProgramContext with screen dimensions
+	mockProgramContext := &context.ProgramContext{
+		ScreenWidth:  100,
+		ScreenHeight: 30,
+		Tasks: []archon.Task{
+			{ID: "leaf", Title: "Write migration", Status: "doing", Assignee: "alice"},
+			{ID: "epic", Title: "Auth epic", Status: "todo"},
+		},
+	}
+
+	return &base.ComponentContext{
+		ProgramContext:       mockProgramContext,
+		ConfigProvider:       &mockConfigProvider{},
+		StyleContextProvider: &mockStyleContextProvider{},
+		Logger:               &mockLogger{},
+		MessageChan:          make(chan tea.Msg, 10),
+	}
+}
+
+func testReport() ShowUnblockModalMsg {
+	return ShowUnblockModalMsg{
+		Report: deps.Report{
+			Entries: []deps.Entry{{TaskID: "leaf", Unblocks: []string{"epic"}}},
+			Cycles:  [][]string{{"a", "b"}},
+		},
+		Export: "# What should I unblock?\n",
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	model := NewModel(createTestContext())
+
+	if model.GetID() != ComponentID {
+		t.Errorf("Expected component ID %s, got %s", ComponentID, model.GetID())
+	}
+	if model.GetType() != base.UnblockModalComponent {
+		t.Errorf("Expected component type %s, got %s", base.UnblockModalComponent, model.GetType())
+	}
+	if model.IsActive() {
+		t.Error("Expected unblock report to be initially inactive")
+	}
+}
+
+func TestShowExpandAndRender(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(testReport())
+
+	if !model.IsActive() || !model.IsFocused() {
+		t.Fatal("Expected unblock report to be active and focused after show message")
+	}
+
+	view := model.View()
+	for _, expected := range []string{"What should I unblock? (1)", "Write migration [doing] @alice", "1 cycle(s)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, "└ Auth epic") {
+		t.Error("Expected dependents to stay collapsed until expanded")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if view := model.View(); !strings.Contains(view, "└ Auth epic") {
+		t.Errorf("Expected expanded row to list what it unblocks, got:\n%s", view)
+	}
+}
+
+func TestEnterChoosesAndYankCopies(t *testing.T) {
+	model := NewModel(createTestContext())
+	model.Update(testReport())
+
+	var copied *messages.CopyToClipboardMsg
+	for _, msg := range unwrap(collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}))) {
+		if msg, ok := msg.(messages.CopyToClipboardMsg); ok {
+			copied = &msg
+		}
+	}
+	if copied == nil || copied.Text != "# What should I unblock?\n" {
+		t.Errorf("Expected the report export to be copied, got %+v", copied)
+	}
+
+	var chosen *UnblockTaskChosenMsg
+	hidden := false
+	for _, msg := range unwrap(collectMessages(model.Update(tea.KeyMsg{Type: tea.KeyEnter}))) {
+		switch msg := msg.(type) {
+		case UnblockTaskChosenMsg:
+			chosen = &msg
+		case HideUnblockModalMsg:
+			hidden = true
+		}
+	}
+	if chosen == nil || chosen.TaskID != "leaf" {
+		t.Errorf("Expected UnblockTaskChosenMsg for leaf, got %+v", chosen)
+	}
+	if !hidden {
+		t.Error("Expected the report to close after choosing a task")
+	}
+}
+
+// unwrap extracts payloads from broadcast component messages
+func unwrap(msgs []tea.Msg) []tea.Msg {
+	for i, msg := range msgs {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msgs[i] = wrapped.Payload
+		}
+	}
+	return msgs
+}
+
+// collectMessages runs a command and flattens batched results
+func collectMessages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMessages(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,35 @@
+package unblock
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+)
+
+// ShowUnblockModalMsg is sent when the unblock report should be shown
+type ShowUnblockModalMsg struct {
+	Report deps.Report
+	Export string // Markdown rendering of the report, copied with 'y'
+}
+
+// HideUnblockModalMsg is sent when the unblock report should be hidden
+type HideUnblockModalMsg struct{}
+
+// UnblockModalShownMsg is sent when the unblock report has been shown and is active
+type UnblockModalShownMsg struct{}
+
+// UnblockModalHiddenMsg is sent when the unblock report has been hidden and is inactive
+type UnblockModalHiddenMsg struct{}
+
+// UnblockTaskChosenMsg is sent when the user picks a task to jump to
+type UnblockTaskChosenMsg struct {
+	TaskID string
+}
+
+// Compile-time check to ensure our messages implement tea.Msg
+var (
+	_ tea.Msg = ShowUnblockModalMsg{}
+	_ tea.Msg = HideUnblockModalMsg{}
+	_ tea.Msg = UnblockModalShownMsg{}
+	_ tea.Msg = UnblockModalHiddenMsg{}
+	_ tea.Msg = UnblockTaskChosenMsg{}
+)
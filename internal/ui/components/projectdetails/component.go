@@ -53,9 +53,11 @@ func NewModel(opts Options) ProjectDetailsModel {
 	baseComponent := base.NewBaseComponent(ComponentID, base.TableComponent, opts.Context)
 
 	// Create shared panel core for viewport infrastructure and rendering
+	scrollbar := opts.Context.ScrollbarOptions()
 	panelCore := detailspanel.NewCore(detailspanel.CoreOptions{
-		Width:  opts.Width,
-		Height: opts.Height,
+		Width:     opts.Width,
+		Height:    opts.Height,
+		Scrollbar: &scrollbar,
 	})
 
 	// Create project-specific content generator
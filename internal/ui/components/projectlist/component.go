@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
@@ -15,6 +15,9 @@ import (
 
 const ComponentID = "projectlist"
 
+// readOnlyGlyph marks projects the current user cannot modify
+const readOnlyGlyph = "🔒"
+
 // ProjectList component now focuses solely on project selection
 // Help functionality has been moved to the global help modal
 
@@ -33,6 +36,7 @@ type ProjectListModel struct {
 	// OWNED STATE - Component manages these directly
 	// ===================================================================
 	selectedIndex int // Currently selected project index
+	scrollOffset  int // First visible line when the list overflows the panel
 
 	// NOTE: Display parameters removed - compute on-demand from context:
 	// - displayProjectTaskCounts → ctx().GetTaskCountForProject(projectID)
@@ -103,8 +107,10 @@ func (m *ProjectListModel) View() string {
 	lines = append(lines, "Projects:")
 	lines = append(lines, "")
 
+	scrollbarOpts := m.GetContext().ScrollbarOptions()
+	overflows := m.lineCount() > m.visibleLines()
 	for i, project := range projects {
-		line := m.renderProjectLine(project, i)
+		line := m.renderProjectLine(project, i, overflows)
 		lines = append(lines, line)
 	}
 
@@ -113,8 +119,19 @@ func (m *ProjectListModel) View() string {
 	lines = append(lines, "")
 	lines = append(lines, allTasksLine)
 
-	// Create final panel
+	// Window the lines to the panel height, keeping the selection visible
 	content := strings.Join(lines, "\n")
+	if overflows {
+		visible := m.visibleLines()
+		offset := max(0, min(m.scrollOffset, len(lines)-visible))
+		content = strings.Join(lines[offset:offset+visible], "\n")
+		if scrollbarOpts.Enabled {
+			scrollbar := scrollbarOpts.Render(offset, len(lines), visible)
+			content = sharedviewport.ComposeWithScrollbarOptions(content, scrollbar, m.GetWidth(), visible, scrollbarOpts)
+		}
+	}
+
+	// Create final panel
 	styleContext := m.createStyleContext(false)
 	factory := styleContext.Factory()
 	// Read active state from UIState (single source of truth)
@@ -138,11 +155,14 @@ func (m *ProjectListModel) renderSpecialStates() string {
 	// Read state from ProgramContext (single source of truth)
 	ctx := m.ctx()
 
-	if ctx.Loading {
+	if ctx.IsLoadingShown() {
 		return listStyle.Render("Loading projects...")
 	}
 
 	if ctx.Error != "" {
+		if note := ctx.FailedAttemptsNote(); note != "" {
+			return listStyle.Render(fmt.Sprintf("Error: %s\n\nPress 'r' to retry (%s)", ctx.Error, note))
+		}
 		return listStyle.Render(fmt.Sprintf("Error: %s\n\nPress 'r' to retry", ctx.Error))
 	}
 
@@ -153,13 +173,54 @@ func (m *ProjectListModel) renderSpecialStates() string {
 	return ""
 }
 
-func (m *ProjectListModel) renderProjectLine(project archon.Project, index int) string {
+// lineCount returns the number of lines the full list occupies
+func (m *ProjectListModel) lineCount() int {
+	// Header + spacing + projects + spacing + "All Tasks"
+	return len(m.ctx().Projects) + 4
+}
+
+// visibleLines returns the number of list lines that fit inside the panel borders
+func (m *ProjectListModel) visibleLines() int {
+	return max(1, m.GetHeight()-base.PanelBorderLines)
+}
+
+// selectedLine returns the line index of the current selection
+func (m *ProjectListModel) selectedLine() int {
+	if m.selectedIndex >= len(m.ctx().Projects) {
+		return m.lineCount() - 1 // "All Tasks" sits on the last line
+	}
+	return m.selectedIndex + 2 // Skip header and spacing
+}
+
+// ensureSelectionVisible scrolls the list so the selected line stays in view
+func (m *ProjectListModel) ensureSelectionVisible() {
+	visible := m.visibleLines()
+	line := m.selectedLine()
+	if line < m.scrollOffset {
+		m.scrollOffset = line
+	} else if line >= m.scrollOffset+visible {
+		m.scrollOffset = line - visible + 1
+	}
+	if m.selectedIndex == 0 {
+		m.scrollOffset = 0 // Keep the header visible at the top of the list
+	}
+	m.scrollOffset = max(0, min(m.scrollOffset, m.lineCount()-visible))
+}
+
+func (m *ProjectListModel) renderProjectLine(project archon.Project, index int, overflows bool) string {
 	// Compute task count on-demand from ProgramContext
 	taskCount := m.ctx().GetTaskCountForProject(project.ID)
 
 	line := fmt.Sprintf("%s (%d)", project.Title, taskCount)
-	if len(line) > m.GetWidth()-8 {
-		line = line[:m.GetWidth()-11] + "..."
+	if m.ctx().IsProjectReadOnly(project.ID) {
+		line = readOnlyGlyph + " " + line
+	}
+	maxWidth := m.GetWidth() - 8
+	if overflows {
+		maxWidth -= m.GetContext().ScrollbarOptions().ColumnWidth()
+	}
+	if maxWidth > 3 && len(line) > maxWidth {
+		line = line[:maxWidth-3] + "..."
 	}
 
 	// Apply selection styling
@@ -264,8 +325,7 @@ func (m *ProjectListModel) Update(msg tea.Msg) tea.Cmd {
 	// Handle window resize
 	if windowMsg, ok := msg.(tea.WindowSizeMsg); ok {
 		m.HandleWindowResize(windowMsg)
-		// When calculating content for scrolling, account for Panel borders:
-		// contentArea := m.GetHeight() - base.PanelBorderLines
+		m.ensureSelectionVisible()
 		return nil
 	}
 
@@ -282,6 +342,7 @@ func (m *ProjectListModel) Update(msg tea.Msg) tea.Cmd {
 		if msg.Index >= 0 && msg.Index <= projectCount { // Allow selecting "All Tasks" option
 			m.selectedIndex = msg.Index
 		}
+		m.ensureSelectionVisible()
 		return func() tea.Msg { return ProjectListSelectionChangedMsg{Index: m.selectedIndex} }
 
 	case ProjectListScrollMsg:
@@ -301,6 +362,7 @@ func (m *ProjectListModel) Update(msg tea.Msg) tea.Cmd {
 		case ScrollToBottom:
 			m.selectedIndex = projectCount // "All Tasks" option
 		}
+		m.ensureSelectionVisible()
 		return func() tea.Msg { return ProjectListSelectionChangedMsg{Index: m.selectedIndex} }
 
 	// NOTE: ProjectListSetActiveMsg handler removed - components read active state from UIState directly
@@ -360,17 +422,8 @@ func (m *ProjectListModel) handleYankID() tea.Cmd {
 		}
 	}
 
-	err := clipboard.WriteAll(project.ID)
-	if err != nil {
-		return func() tea.Msg {
-			return messages.StatusFeedbackMsg{Message: "Failed to copy project ID"}
-		}
-	}
-
 	return func() tea.Msg {
-		return messages.StatusFeedbackMsg{
-			Message: fmt.Sprintf("Copied project ID: %s", project.ID),
-		}
+		return messages.CopyToClipboardMsg{Text: project.ID, What: "project ID"}
 	}
 }
 
@@ -383,16 +436,7 @@ func (m *ProjectListModel) handleYankTitle() tea.Cmd {
 		}
 	}
 
-	err := clipboard.WriteAll(project.Title)
-	if err != nil {
-		return func() tea.Msg {
-			return messages.StatusFeedbackMsg{Message: "Failed to copy project title"}
-		}
-	}
-
 	return func() tea.Msg {
-		return messages.StatusFeedbackMsg{
-			Message: fmt.Sprintf("Copied project title: %s", project.Title),
-		}
+		return messages.CopyToClipboardMsg{Text: project.Title, What: "project title"}
 	}
 }
@@ -0,0 +1,62 @@
+package projectlist
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+func newTestModel(selectedIndex int) ProjectListModel {
+	programContext := context.NewProgramContext(nil, nil, nil, nil, nil)
+	programContext.Projects = []archon.Project{
+		{ID: "p1", Title: "Website"},
+		{ID: "p2", Title: "Mobile App"},
+	}
+	return NewModel(Options{
+		SelectedIndex: selectedIndex,
+		Context:       &base.ComponentContext{ProgramContext: programContext},
+	})
+}
+
+func runCmd(t *testing.T, cmd tea.Cmd) tea.Msg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("Expected a command, got nil")
+	}
+	return cmd()
+}
+
+func TestYankProject(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tea.Msg
+		want messages.CopyToClipboardMsg
+	}{
+		{"ID", messages.YankIDMsg{}, messages.CopyToClipboardMsg{Text: "p2", What: "project ID"}},
+		{"title", messages.YankTitleMsg{}, messages.CopyToClipboardMsg{Text: "Mobile App", What: "project title"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newTestModel(1)
+			got, ok := runCmd(t, model.Update(tt.msg)).(messages.CopyToClipboardMsg)
+			if !ok || got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestYankAllTasks(t *testing.T) {
+	// "All Tasks" follows the projects and has nothing to copy
+	for _, msg := range []tea.Msg{messages.YankIDMsg{}, messages.YankTitleMsg{}} {
+		model := newTestModel(2)
+		feedback, ok := runCmd(t, model.Update(msg)).(messages.StatusFeedbackMsg)
+		if !ok || feedback.Message != "No project selected" {
+			t.Errorf("Expected no-project feedback for %T, got %+v", msg, feedback)
+		}
+	}
+}
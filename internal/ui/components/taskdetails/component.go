@@ -5,6 +5,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/detailspanel"
@@ -26,6 +27,13 @@ type TaskdetailsModel struct {
 	// Domain-specific: Task data and content generation
 	selectedTask     *archon.Task
 	contentGenerator TaskContentGenerator
+
+	// Long description shown in full; reset when another task is selected
+	descriptionExpanded bool
+
+	// details_panel "manual" and the selected task was not opened: show a
+	// placeholder instead of rendering its details
+	closed bool
 }
 
 // Options contains configuration options for creating a task details component
@@ -56,9 +64,11 @@ func NewModel(opts Options) TaskdetailsModel {
 	baseComponent := base.NewBaseComponent(ComponentID, base.TableComponent, opts.Context)
 
 	// Create shared panel core for viewport infrastructure and rendering
+	scrollbar := opts.Context.ScrollbarOptions()
 	panelCore := detailspanel.NewCore(detailspanel.CoreOptions{
-		Width:  opts.Width,
-		Height: opts.Height,
+		Width:     opts.Width,
+		Height:    opts.Height,
+		Scrollbar: &scrollbar,
 	})
 
 	// Create task-specific content generator
@@ -110,11 +120,21 @@ func (m *TaskdetailsModel) Update(msg tea.Msg) tea.Cmd {
 	// Handle task details specific messages
 	switch msg := msg.(type) {
 	case TaskDetailsUpdateMsg:
+		// A different task starts with its description collapsed again
+		if taskID(m.selectedTask) != taskID(msg.SelectedTask) {
+			m.descriptionExpanded = false
+			m.contentGenerator.SetDescriptionExpanded(false)
+		}
+
 		// Update selected task
 		m.selectedTask = msg.SelectedTask
+		m.closed = !m.detailsOpen(msg.SelectedTask)
 
 		// Update content generator with new task and search parameters
 		m.contentGenerator.SetTask(msg.SelectedTask)
+		if !m.closed {
+			m.contentGenerator.SetDescriptionChanged(m.observeDescription(msg.SelectedTask))
+		}
 		m.contentGenerator.SetSearch(msg.SearchQuery, msg.SearchActive)
 
 		// Generate new content and update viewport
@@ -132,6 +152,19 @@ func (m *TaskdetailsModel) Update(msg tea.Msg) tea.Cmd {
 		// Broadcast scroll position change
 		return m.broadcastScrollPosition()
 
+	case TaskDetailsSnapshotChangedMsg:
+		if m.selectedTask != nil && m.selectedTask.ID == msg.TaskID && !m.closed {
+			m.contentGenerator.SetDescriptionChanged(m.observeDescription(m.selectedTask))
+			m.updateContent()
+		}
+		return nil
+
+	case TaskDetailsToggleDescriptionMsg:
+		m.descriptionExpanded = !m.descriptionExpanded
+		m.contentGenerator.SetDescriptionExpanded(m.descriptionExpanded)
+		m.updateContent()
+		return m.broadcastScrollPosition()
+
 	case TaskDetailsResizeMsg:
 		// Update core dimensions
 		m.panelCore.UpdateDimensions(msg.Width, msg.Height)
@@ -176,6 +209,40 @@ func (m TaskdetailsModel) View() string {
 	)
 }
 
+// ScrollOffset returns the current scroll offset of the details viewport
+func (m *TaskdetailsModel) ScrollOffset() int {
+	return m.panelCore.ScrollOffset()
+}
+
+// SetScrollOffset restores a scroll offset, e.g. from a saved session
+func (m *TaskdetailsModel) SetScrollOffset(offset int) {
+	m.panelCore.SetScrollOffset(offset)
+}
+
+// detailsOpen reports whether task's details should render: always with
+// details_panel "auto", only once opened for the task with "manual"
+func (m *TaskdetailsModel) detailsOpen(task *archon.Task) bool {
+	ctx := m.GetContext()
+	if task == nil || ctx == nil || ctx.ConfigProvider == nil {
+		return true
+	}
+	display := ctx.ConfigProvider.GetDisplay()
+	if display == nil || display.DetailsPanel != config.DetailsManual {
+		return true
+	}
+	return ctx.UIState != nil && ctx.UIState.DetailsOpen(task.ID)
+}
+
+// observeDescription records that task's description is being viewed and
+// reports whether it changed since the remembered snapshot
+func (m *TaskdetailsModel) observeDescription(task *archon.Task) bool {
+	ctx := m.GetContext()
+	if task == nil || ctx == nil || ctx.ProgramContext == nil {
+		return false
+	}
+	return ctx.ProgramContext.DescriptionSnapshots.Observe(task.ID, task.Description)
+}
+
 // updateContent generates new content and updates the viewport via core
 func (m *TaskdetailsModel) updateContent() {
 	if m.selectedTask == nil {
@@ -183,6 +250,10 @@ func (m *TaskdetailsModel) updateContent() {
 		m.panelCore.SetContent("No task selected")
 		return
 	}
+	if m.closed {
+		m.panelCore.SetContent("Press Enter or l to show details")
+		return
+	}
 
 	// Generate content using the TaskContentGenerator
 	m.contentGenerator.SetTask(m.selectedTask)
@@ -203,6 +274,19 @@ func (m TaskdetailsModel) GetSelectedTask() *archon.Task {
 	return m.selectedTask
 }
 
+// IsDescriptionExpanded returns whether a long description is shown in full
+func (m TaskdetailsModel) IsDescriptionExpanded() bool {
+	return m.descriptionExpanded
+}
+
+// taskID returns the task's ID, or "" for no task
+func taskID(task *archon.Task) string {
+	if task == nil {
+		return ""
+	}
+	return task.ID
+}
+
 // GetContentWidth returns the calculated content width from core
 func (m TaskdetailsModel) GetContentWidth() int {
 	return m.panelCore.GetContentWidth()
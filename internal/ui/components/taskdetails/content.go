@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 )
@@ -19,6 +20,15 @@ type TaskContentGenerator struct {
 	searchActive bool
 	contentWidth int
 
+	// Show the whole description even past description_max_lines
+	descriptionExpanded bool
+
+	// The description differs from the one remembered when the task was first viewed
+	descriptionChanged bool
+
+	// Rendered descriptions of recently shown tasks
+	markdown *markdownCache
+
 	// Component context for accessing dependencies
 	context *base.ComponentContext
 }
@@ -27,6 +37,7 @@ type TaskContentGenerator struct {
 func NewTaskContentGenerator(contentWidth int, context *base.ComponentContext) TaskContentGenerator {
 	return TaskContentGenerator{
 		contentWidth: contentWidth,
+		markdown:     newMarkdownCache(markdownCacheSize),
 		context:      context,
 	}
 }
@@ -48,8 +59,20 @@ func (c *TaskContentGenerator) SetSearch(query string, active bool) {
 	c.searchActive = active
 }
 
+// SetDescriptionExpanded shows or collapses descriptions past description_max_lines
+func (c *TaskContentGenerator) SetDescriptionExpanded(expanded bool) {
+	c.descriptionExpanded = expanded
+}
+
+// SetDescriptionChanged shows or hides the "changed since you viewed it" hint
+func (c *TaskContentGenerator) SetDescriptionChanged(changed bool) {
+	c.descriptionChanged = changed
+}
+
 // GenerateLines produces all content lines for the task
-// This replaces the scattered render methods with a single clean interface
+// Fields appear in the order of ui.display.detail_fields. Consecutive
+// label/value fields form one aligned block; blocks and sections are
+// separated by a blank line.
 func (c *TaskContentGenerator) GenerateLines() []string {
 	if c.task == nil {
 		return []string{}
@@ -58,19 +81,65 @@ func (c *TaskContentGenerator) GenerateLines() []string {
 	// Create style factory
 	factory := c.createStyleFactory()
 
-	// Build all content by calling focused generation functions
-	var allContent []string
-	allContent = append(allContent, c.generateTaskHeader(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskMetadata(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskTags(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskDescription(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskTimestamps(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskSources(c.task, factory)...)
-	allContent = append(allContent, c.generateTaskCodeExamples(c.task, factory)...)
+	var sections [][]string
+	var fields []detailField
+	flushFields := func() {
+		if len(fields) > 0 {
+			sections = append(sections, c.generateFieldBlock(fields, factory))
+			fields = nil
+		}
+	}
+	for _, name := range c.detailFieldOrder() {
+		if rows, ok := c.taskFields(name, c.task, factory); ok {
+			fields = append(fields, rows...)
+			continue
+		}
+		flushFields()
+		if section := c.generateSection(name, c.task, factory); len(section) > 0 {
+			sections = append(sections, section)
+		}
+	}
+	flushFields()
 
+	allContent := c.generateTaskHeader(factory)
+	for i, section := range sections {
+		if i > 0 {
+			allContent = append(allContent, styling.RenderLine("", c.contentWidth))
+		}
+		allContent = append(allContent, section...)
+	}
 	return allContent
 }
 
+// detailFieldOrder returns the configured detail fields, or the default order
+func (c *TaskContentGenerator) detailFieldOrder() []string {
+	if c.context != nil && c.context.ConfigProvider != nil {
+		if display := c.context.ConfigProvider.GetDisplay(); display != nil && len(display.DetailFields) > 0 {
+			return display.DetailFields
+		}
+	}
+	return config.DefaultDetailFields
+}
+
+// generateSection renders a multi-line detail field (nil when the task has
+// nothing to show or name is not a section)
+func (c *TaskContentGenerator) generateSection(name string, task *archon.Task, factory *styling.StyleFactory) []string {
+	switch name {
+	case config.DetailFieldTitle:
+		return c.generateTaskTitle(task, factory)
+	case config.DetailFieldDescription:
+		return c.generateTaskDescription(task, factory)
+	case config.DetailFieldSources:
+		return c.generateTaskSources(task, factory)
+	case config.DetailFieldLinks:
+		return c.generateTaskLinks(task, factory)
+	case config.DetailFieldCodeExamples:
+		return c.generateTaskCodeExamples(task, factory)
+	default:
+		return nil // Unknown names are skipped
+	}
+}
+
 // createStyleFactory creates a style factory for task rendering with search state
 func (c *TaskContentGenerator) createStyleFactory() *styling.StyleFactory {
 	styleContext := c.CreateStyleContext(false).
@@ -98,14 +167,18 @@ func (c *TaskContentGenerator) CreateStyleContext(selected bool) *styling.StyleC
 	return styling.NewStyleContext(theme, styleProvider)
 }
 
-// generateTaskHeader generates the task header and title with search highlighting
-func (c *TaskContentGenerator) generateTaskHeader(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, 8) // Preallocate for header, title, spacing
-
-	// Task Details header
+// generateTaskHeader generates the panel header
+func (c *TaskContentGenerator) generateTaskHeader(factory *styling.StyleFactory) []string {
 	taskDetailsHeader := factory.Header().Render("Task Details")
-	content = append(content, styling.RenderLine(taskDetailsHeader, c.contentWidth))
-	content = append(content, styling.RenderLine("", c.contentWidth))
+	return []string{
+		styling.RenderLine(taskDetailsHeader, c.contentWidth),
+		styling.RenderLine("", c.contentWidth),
+	}
+}
+
+// generateTaskTitle generates the task title with search highlighting
+func (c *TaskContentGenerator) generateTaskTitle(task *archon.Task, factory *styling.StyleFactory) []string {
+	content := make([]string, 0, 4) // Preallocate for title header + lines
 
 	// Title with proper styling and search highlighting using status color
 	titleHeader := factory.Header().Render("Title:")
@@ -119,80 +192,126 @@ func (c *TaskContentGenerator) generateTaskHeader(task *archon.Task, factory *st
 	// Use lipgloss Width() for proper word wrapping with full style preservation
 	// Width() wraps at word boundaries AND preserves styling on all lines (including highlights)
 	styledTitle := factory.Text(statusColor).Width(c.contentWidth - 2).Render(title)
-	titleLines := strings.Split(styledTitle, "\n")
-
-	for _, line := range titleLines {
+	for _, line := range strings.Split(styledTitle, "\n") {
 		content = append(content, styling.RenderLine(line, c.contentWidth))
 	}
-	content = append(content, styling.RenderLine("", c.contentWidth))
 
 	return content
 }
 
-// generateTaskMetadata generates status, assignee, and priority information
-func (c *TaskContentGenerator) generateTaskMetadata(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, 4) // Preallocate for status, assignee, priority/order
-
-	// Status and assignee with colors - use lipgloss.JoinHorizontal
-	statusLabel := factory.Text(styling.CurrentTheme.MutedColor).Render("Status:")
-	statusSymbol := factory.Text(styling.GetThemeStatusColor(task.Status)).Render(task.GetStatusSymbol())
-	statusText := factory.Text(styling.GetThemeStatusColor(task.Status)).Render(strings.ToUpper(task.Status))
-	statusLine := lipgloss.JoinHorizontal(lipgloss.Left, statusLabel, " ", statusSymbol, " ", statusText)
-	content = append(content, styling.RenderLine(statusLine, c.contentWidth))
-
-	assigneeLabel := factory.Text(styling.CurrentTheme.MutedColor).Render("Assignee:")
-	assigneeName := factory.Text(styling.CurrentTheme.HeaderColor).Render(task.Assignee)
-	assigneeLine := lipgloss.JoinHorizontal(lipgloss.Left, assigneeLabel, " ", assigneeName)
-	content = append(content, styling.RenderLine(assigneeLine, c.contentWidth))
-
-	// Priority information with color and symbol (if enabled)
-	if c.context != nil && c.context.ConfigProvider != nil && c.context.ConfigProvider.IsPriorityIndicatorsEnabled() {
-		priority := styling.GetTaskPriority(task.TaskOrder, nil)
-		prioritySymbol := styling.GetPrioritySymbol(priority)
-		priorityColor := styling.GetPriorityColor(priority)
-
-		var priorityText string
-		switch priority {
-		case styling.PriorityHigh:
-			priorityText = "High"
-		case styling.PriorityMedium:
-			priorityText = "Medium"
-		case styling.PriorityLow:
-			priorityText = "Low"
-		default:
-			priorityText = "Unknown"
+// generateFieldBlock lays out label/value fields as one aligned block
+func (c *TaskContentGenerator) generateFieldBlock(fields []detailField, factory *styling.StyleFactory) []string {
+	lines := layoutFields(fields, c.contentWidth-2, labelStyle(factory))
+	for i, line := range lines {
+		lines[i] = styling.RenderLine(line, c.contentWidth)
+	}
+	return lines
+}
+
+// taskFields returns the label/value rows of a single-line detail field.
+// ok is false when name is not such a field; rows may be empty when the task
+// has no value for it.
+func (c *TaskContentGenerator) taskFields(name string, task *archon.Task, factory *styling.StyleFactory) (rows []detailField, ok bool) {
+	muted := textStyle(factory, styling.CurrentTheme.MutedColor)
+
+	switch name {
+	case config.DetailFieldStatus:
+		statusColor := styling.GetThemeStatusColor(task.Status)
+		return []detailField{{Label: "Status", Value: task.GetStatusSymbol() + " " + strings.ToUpper(task.Status), Render: textStyle(factory, statusColor)}}, true
+
+	case config.DetailFieldAssignee:
+		return []detailField{{Label: "Assignee", Value: task.Assignee, Render: textStyle(factory, styling.CurrentTheme.HeaderColor)}}, true
+
+	case config.DetailFieldData:
+		// Payloads altered on ingest: say what was cleaned so the task can be fixed at the source
+		if !task.IsMalformed() {
+			return nil, true
+		}
+		return []detailField{{
+			Label:  "Data",
+			Value:  strings.TrimSpace(styling.MalformedBadge) + " malformed (" + strings.Join(task.Issues, ", ") + ")",
+			Render: textStyle(factory, styling.CurrentTheme.WarningColor),
+		}}, true
+
+	case config.DetailFieldPriority:
+		return []detailField{c.priorityField(task, factory)}, true
+
+	case config.DetailFieldFeature:
+		if task.Feature == nil || *task.Feature == "" {
+			return nil, true
+		}
+		return []detailField{{Label: "Feature", Value: "#" + *task.Feature, Render: textStyle(factory, styling.GetFeatureColor(*task.Feature))}}, true
+
+	case config.DetailFieldTags:
+		if len(task.Tags) == 0 {
+			return nil, true
 		}
+		return []detailField{{Label: "Tags", Value: styling.TagChips(task.Tags), Render: muted}}, true
 
-		priorityLabel := factory.Text(styling.CurrentTheme.MutedColor).Render("Priority:")
-		styledSymbol := factory.Text(priorityColor).Render(prioritySymbol)
-		styledText := factory.Text(priorityColor).Render(priorityText)
-		orderText := factory.Text(styling.CurrentTheme.MutedColor).Render(fmt.Sprintf("(order: %d)", task.TaskOrder))
-		priorityLine := lipgloss.JoinHorizontal(lipgloss.Left, priorityLabel, " ", styledSymbol, " ", styledText, " ", orderText)
-		content = append(content, styling.RenderLine(priorityLine, c.contentWidth))
-	} else {
-		// Just show the raw task order when priority indicators are disabled
-		taskOrderLabel := factory.Text(styling.CurrentTheme.MutedColor).Render("Task Order:")
-		taskOrderValue := factory.Text(styling.CurrentTheme.MutedColor).Render(fmt.Sprintf("%d", task.TaskOrder))
-		taskOrderLine := lipgloss.JoinHorizontal(lipgloss.Left, taskOrderLabel, " ", taskOrderValue)
-		content = append(content, styling.RenderLine(taskOrderLine, c.contentWidth))
-	}
+	case config.DetailFieldID:
+		id := task.ID
+		if ctx := c.context; ctx != nil && ctx.ProgramContext != nil {
+			id = ctx.ProgramContext.DisplayID(id)
+		}
+		return []detailField{{Label: "ID", Value: id, Render: muted}}, true
 
-	return content
+	case config.DetailFieldCreated:
+		return []detailField{{Label: "Created", Value: task.CreatedAt.Format("2006-01-02 15:04"), Render: muted}}, true
+
+	case config.DetailFieldUpdated:
+		updated := task.UpdatedAt.Format("2006-01-02 15:04")
+		if ctx := c.context; ctx != nil && ctx.ProgramContext != nil && !task.UpdatedAt.IsZero() {
+			// Anchored to server time so a skewed local clock cannot produce negative ages
+			updated += " (" + clock.FormatAge(task.UpdatedAt.Time, ctx.ProgramContext.Now()) + ")"
+		}
+		return []detailField{{Label: "Updated", Value: updated, Render: muted}}, true
+
+	default:
+		return nil, false
+	}
 }
 
-// generateTaskTags generates feature tags and metadata
-func (c *TaskContentGenerator) generateTaskTags(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, 2) // Preallocate for tags + spacing
+// priorityField shows the priority level, or the raw task order when
+// priority indicators are disabled
+func (c *TaskContentGenerator) priorityField(task *archon.Task, factory *styling.StyleFactory) detailField {
+	if c.context == nil || c.context.ConfigProvider == nil || !c.context.ConfigProvider.IsPriorityIndicatorsEnabled() {
+		return detailField{
+			Label:  "Task Order",
+			Value:  fmt.Sprintf("%d", task.TaskOrder),
+			Render: textStyle(factory, styling.CurrentTheme.MutedColor),
+		}
+	}
 
-	if task.Feature != nil && *task.Feature != "" {
-		tagsLabel := factory.Text(styling.CurrentTheme.MutedColor).Render("Tags:")
-		featureTag := factory.Text(styling.GetFeatureColor(*task.Feature)).Render(fmt.Sprintf("#%s", *task.Feature))
-		tagsLine := lipgloss.JoinHorizontal(lipgloss.Left, tagsLabel, " ", featureTag)
-		content = append(content, styling.RenderLine(tagsLine, c.contentWidth))
+	priority := styling.GetTaskPriority(task.TaskOrder, nil)
+
+	var priorityText string
+	switch priority {
+	case styling.PriorityHigh:
+		priorityText = "High"
+	case styling.PriorityMedium:
+		priorityText = "Medium"
+	case styling.PriorityLow:
+		priorityText = "Low"
+	default:
+		priorityText = "Unknown"
 	}
-	content = append(content, styling.RenderLine("", c.contentWidth))
 
-	return content
+	return detailField{
+		Label:  "Priority",
+		Value:  fmt.Sprintf("%s %s (order: %d)", styling.GetPrioritySymbol(priority), priorityText, task.TaskOrder),
+		Render: textStyle(factory, styling.GetPriorityColor(priority)),
+	}
+}
+
+// labelStyle styles the field label column
+func labelStyle(factory *styling.StyleFactory) func(string) string {
+	return textStyle(factory, styling.CurrentTheme.MutedColor)
+}
+
+// textStyle returns a line renderer in the given color
+func textStyle(factory *styling.StyleFactory, color string) func(string) string {
+	style := factory.Text(color)
+	return func(line string) string { return style.Render(line) }
 }
 
 // generateTaskDescription generates the task description with markdown
@@ -202,37 +321,127 @@ func (c *TaskContentGenerator) generateTaskDescription(task *archon.Task, factor
 	if task.Description != "" {
 		descriptionHeader := factory.Header().Render("Description:")
 		content = append(content, styling.RenderLine(descriptionHeader, c.contentWidth))
-		descriptionContent := view.RenderMarkdown(task.Description, c.contentWidth-2)
-		descriptionLines := strings.Split(descriptionContent, "\n")
+		descriptionLines := c.renderDescription(task.ID, task.Description)
+
+		// Long descriptions stop at description_max_lines unless expanded ('x')
+		// or the search query only matches in the collapsed part
+		limit := c.descriptionMaxLines()
+		collapsible := limit > 0 && len(descriptionLines) > limit
+		var toggleHint string
+		if collapsible {
+			if c.descriptionExpanded || c.searchMatchesBeyond(descriptionLines, limit) {
+				toggleHint = "… show less (press x)"
+			} else {
+				hidden := len(descriptionLines) - limit
+				descriptionLines = descriptionLines[:limit]
+				toggleHint = fmt.Sprintf("… show more (press x, %d more lines)", hidden)
+			}
+		}
 
 		// Pad each description line to full width (markdown provides foreground styling)
 		for _, line := range descriptionLines {
 			content = append(content, styling.RenderLine(line, c.contentWidth))
 		}
-		content = append(content, styling.RenderLine("", c.contentWidth))
+		if toggleHint != "" {
+			hint := factory.Text(styling.CurrentTheme.MutedColor).Render("  " + toggleHint)
+			content = append(content, styling.RenderLine(hint, c.contentWidth))
+		}
+	} else if c.descriptionChanged {
+		descriptionHeader := factory.Header().Render("Description:")
+		content = append(content, styling.RenderLine(descriptionHeader, c.contentWidth))
+	}
+
+	// The description was edited since the task was first viewed ('D' shows how)
+	if c.descriptionChanged {
+		hint := "Δ changed since you viewed it (press D to see changes)"
+		if task.Description == "" {
+			hint = "Δ removed since you viewed it (press D to see changes)"
+		}
+		styled := factory.Text(styling.CurrentTheme.MutedColor).Render("  " + hint)
+		content = append(content, styling.RenderLine(styled, c.contentWidth))
 	}
 
 	return content
 }
 
-// generateTaskTimestamps generates created and updated timestamps
-func (c *TaskContentGenerator) generateTaskTimestamps(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, 2) // Preallocate for created + updated
+// renderDescription renders a description as markdown. Unless
+// description_whitespace is "exact", stray whitespace is tidied first and the
+// blank lines the renderer adds around the text are dropped.
+func (c *TaskContentGenerator) renderDescription(taskID, description string) []string {
+	exact := c.descriptionWhitespace() == config.DescriptionExact
+	if !exact {
+		description = view.TidyText(description)
+	}
+	lines := strings.Split(c.markdown.Render(taskID, description, c.contentWidth-2), "\n")
+	if exact {
+		return lines
+	}
 
-	createdText := factory.Text(styling.CurrentTheme.MutedColor).Render(fmt.Sprintf("Created: %s", task.CreatedAt.Format("2006-01-02 15:04")))
-	content = append(content, styling.RenderLine(createdText, c.contentWidth))
-	updatedText := factory.Text(styling.CurrentTheme.MutedColor).Render(fmt.Sprintf("Updated: %s", task.UpdatedAt.Format("2006-01-02 15:04")))
-	content = append(content, styling.RenderLine(updatedText, c.contentWidth))
+	blank := func(line string) bool { return strings.TrimSpace(view.StripANSI(line)) == "" }
+	for len(lines) > 1 && blank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 1 && blank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
 
-	return content
+// descriptionWhitespace returns the configured description whitespace handling
+func (c *TaskContentGenerator) descriptionWhitespace() string {
+	if c.context == nil || c.context.ConfigProvider == nil {
+		return config.DescriptionTidy
+	}
+	if display := c.context.ConfigProvider.GetDisplay(); display != nil && display.DescriptionWhitespace == config.DescriptionExact {
+		return config.DescriptionExact
+	}
+	return config.DescriptionTidy
+}
+
+// descriptionMaxLines returns the configured description line limit (0 = no limit)
+func (c *TaskContentGenerator) descriptionMaxLines() int {
+	if c.context == nil || c.context.ConfigProvider == nil {
+		return 0
+	}
+	if display := c.context.ConfigProvider.GetDisplay(); display != nil {
+		return max(display.DescriptionMaxLines, 0)
+	}
+	return 0
+}
+
+// searchMatchesBeyond reports whether the active search query matches text
+// that would be hidden after the first limit rendered lines. Lines are joined
+// with spaces so matches wrapped across lines are still found.
+func (c *TaskContentGenerator) searchMatchesBeyond(lines []string, limit int) bool {
+	query := strings.ToLower(strings.TrimSpace(c.searchQuery))
+	if !c.searchActive || query == "" {
+		return false
+	}
+
+	plain := make([]string, len(lines))
+	for i, line := range lines {
+		plain[i] = strings.TrimSpace(view.StripANSI(line))
+	}
+	visible := len(strings.ToLower(strings.Join(plain[:limit], " ")))
+	text := strings.ToLower(strings.Join(plain, " "))
+
+	for offset := 0; ; {
+		index := strings.Index(text[offset:], query)
+		if index < 0 {
+			return false
+		}
+		if offset+index+len(query) > visible {
+			return true
+		}
+		offset += index + 1
+	}
 }
 
 // generateTaskSources generates the task sources list
 func (c *TaskContentGenerator) generateTaskSources(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, len(task.Sources)+2) // Preallocate for header + sources + spacing
+	content := make([]string, 0, len(task.Sources)+1) // Preallocate for header + sources
 
 	if len(task.Sources) > 0 {
-		content = append(content, styling.RenderLine("", c.contentWidth))
 		sourcesHeader := factory.Header().Render("Sources:")
 		content = append(content, styling.RenderLine(sourcesHeader, c.contentWidth))
 		for _, source := range task.Sources {
@@ -244,12 +453,33 @@ func (c *TaskContentGenerator) generateTaskSources(task *archon.Task, factory *s
 	return content
 }
 
+// generateTaskLinks lists links matched by integrations.links rules (opened with 'o')
+func (c *TaskContentGenerator) generateTaskLinks(task *archon.Task, factory *styling.StyleFactory) []string {
+	if c.context == nil || c.context.ProgramContext == nil {
+		return nil
+	}
+
+	matched := c.context.ProgramContext.Links.MatchTask(*task)
+	if len(matched) == 0 {
+		return nil
+	}
+
+	content := make([]string, 0, len(matched)+1) // Preallocate for header + links
+	linksHeader := factory.Header().Render("Links (o to open):")
+	content = append(content, styling.RenderLine(linksHeader, c.contentWidth))
+	for _, link := range matched {
+		linkText := factory.Text(styling.CurrentTheme.MutedColor).Render(fmt.Sprintf("• %s → %s", link.Name, link.URL))
+		content = append(content, styling.RenderLine(linkText, c.contentWidth))
+	}
+
+	return content
+}
+
 // generateTaskCodeExamples generates the task code examples list
 func (c *TaskContentGenerator) generateTaskCodeExamples(task *archon.Task, factory *styling.StyleFactory) []string {
-	content := make([]string, 0, len(task.CodeExamples)+2) // Preallocate for header + examples + spacing
+	content := make([]string, 0, len(task.CodeExamples)+1) // Preallocate for header + examples
 
 	if len(task.CodeExamples) > 0 {
-		content = append(content, styling.RenderLine("", c.contentWidth))
 		examplesHeader := factory.Header().Render("Code Examples:")
 		content = append(content, styling.RenderLine(examplesHeader, c.contentWidth))
 		for _, example := range task.CodeExamples {
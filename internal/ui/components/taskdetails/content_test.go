@@ -0,0 +1,132 @@
+package taskdetails
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+)
+
+func TestDescriptionCollapse(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.UI.Display.DescriptionMaxLines = 3
+
+	paragraphs := []string{"Step one", "Step two", "Step three", "Step four", "Rollback plan"}
+	task := &archon.Task{ID: "t1", Title: "Migrate", Status: "todo", Description: strings.Join(paragraphs, "\n\n")}
+
+	generator := NewTaskContentGenerator(60, &base.ComponentContext{ConfigProvider: cfg})
+	generator.SetTask(task)
+	render := func() string { return view.StripANSI(strings.Join(generator.GenerateLines(), "\n")) }
+
+	collapsed := render()
+	if strings.Contains(collapsed, "Rollback plan") || !strings.Contains(collapsed, "show more (press x") {
+		t.Errorf("Expected the description cut at 3 lines with a show more hint, got:\n%s", collapsed)
+	}
+
+	// A search hit in the collapsed part opens the description
+	generator.SetSearch("rollback", true)
+	if searched := render(); !strings.Contains(searched, "Rollback plan") || !strings.Contains(searched, "show less") {
+		t.Errorf("Expected the hidden match to expand the description, got:\n%s", searched)
+	}
+	generator.SetSearch("step one", true)
+	if strings.Contains(render(), "Rollback plan") {
+		t.Error("Expected a visible match to keep the description collapsed")
+	}
+
+	generator.SetSearch("", false)
+	generator.SetDescriptionExpanded(true)
+	if expanded := render(); !strings.Contains(expanded, "Rollback plan") {
+		t.Errorf("Expected the full description when expanded, got:\n%s", expanded)
+	}
+
+	cfg.UI.Display.DescriptionMaxLines = 0
+	generator.SetDescriptionExpanded(false)
+	if full := render(); !strings.Contains(full, "Rollback plan") || strings.Contains(full, "show more") {
+		t.Errorf("Expected no collapsing when description_max_lines is 0, got:\n%s", full)
+	}
+}
+
+func TestDescriptionChangedHint(t *testing.T) {
+	task := &archon.Task{ID: "t1", Title: "Migrate", Status: "todo", Description: "Use the new schema"}
+	generator := NewTaskContentGenerator(80, &base.ComponentContext{ConfigProvider: &config.Config{}})
+	generator.SetTask(task)
+	render := func() string { return view.StripANSI(strings.Join(generator.GenerateLines(), "\n")) }
+
+	if strings.Contains(render(), "press D") {
+		t.Error("Expected no hint for an unchanged description")
+	}
+
+	generator.SetDescriptionChanged(true)
+	if changed := render(); !strings.Contains(changed, "Δ changed since you viewed it (press D") {
+		t.Errorf("Expected the changed hint, got:\n%s", changed)
+	}
+
+	// A removed description keeps its section so the hint has a place
+	task.Description = ""
+	if removed := render(); !strings.Contains(removed, "Description:") || !strings.Contains(removed, "Δ removed since you viewed it") {
+		t.Errorf("Expected the removed hint, got:\n%s", removed)
+	}
+}
+
+func TestDescriptionWhitespace(t *testing.T) {
+	cfg := &config.Config{}
+	task := &archon.Task{ID: "t1", Title: "Migrate", Status: "todo", Description: "\n\nRun:   \n\n\n\n```\nmake\n\n\n\ntest\n```\n\n\n\n"}
+	generator := NewTaskContentGenerator(60, &base.ComponentContext{ConfigProvider: cfg})
+	descriptionLines := func() []string {
+		lines := generator.renderDescription(task.ID, task.Description)
+		for i, line := range lines {
+			lines[i] = strings.TrimSpace(view.StripANSI(line))
+		}
+		return lines
+	}
+
+	// Tidy by default: no blank edges, code block blank lines kept
+	tidy := descriptionLines()
+	if tidy[0] != "Run:" || tidy[len(tidy)-1] == "" {
+		t.Errorf("Expected no blank lines around the description, got %q", tidy)
+	}
+	if !strings.Contains(strings.Join(tidy, "|"), "make||||test") {
+		t.Errorf("Expected the code block untouched, got %q", tidy)
+	}
+
+	cfg.UI.Display.DescriptionWhitespace = config.DescriptionExact
+	if exact := descriptionLines(); len(exact) <= len(tidy) {
+		t.Errorf("Expected exact whitespace to keep more lines than tidy, got %q", exact)
+	}
+}
+
+func TestDetailFieldOrder(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.UI.Display.DetailFields = []string{"priority", "feature", "tags", "bogus", "title", "status", "created"}
+
+	feature := "auth"
+	task := &archon.Task{ID: "t1-full-id", Title: "Migrate", Status: "todo", Assignee: "alice", Feature: &feature, Tags: []string{"db", "urgent"}, Description: "Long text"}
+	generator := NewTaskContentGenerator(60, &base.ComponentContext{ConfigProvider: cfg})
+	generator.SetTask(task)
+	lines := strings.Split(view.StripANSI(strings.Join(generator.GenerateLines(), "\n")), "\n")
+
+	var got []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			got = append(got, line)
+		}
+	}
+	want := []string{"Task Details", "Task Order: 0", "Feature: #auth", "Tags: [db] [urgent]", "Title:", "Migrate", "Status: ○ TODO", "Created: 0001-01-01 00:00"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	// Fields left out of the list are not shown
+	joined := strings.Join(lines, "\n")
+	if !strings.Contains(joined, "Task Order: 0") || strings.Contains(joined, "Assignee") || strings.Contains(joined, "Long text") {
+		t.Errorf("Expected only the configured fields, got:\n%s", joined)
+	}
+
+	cfg.UI.Display.DetailFields = []string{"id"}
+	if out := view.StripANSI(strings.Join(generator.GenerateLines(), "\n")); !strings.Contains(out, "ID: t1-full-id") {
+		t.Errorf("Expected the full task ID, got:\n%s", out)
+	}
+}
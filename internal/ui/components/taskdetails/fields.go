@@ -0,0 +1,89 @@
+package taskdetails
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+const (
+	// fieldLabelWidth is the label column width; longer labels widen it
+	fieldLabelWidth = 10
+	// fieldMinValueWidth is the narrowest value column before fields stack
+	fieldMinValueWidth = 16
+	// fieldStackIndent indents stacked values under their label
+	fieldStackIndent = 2
+)
+
+// detailField is one label/value row of the details panel
+type detailField struct {
+	Label string
+	Value string // Plain text; styling is applied per wrapped line by Render
+
+	// Render styles one wrapped line of the value (nil leaves it as is)
+	Render func(line string) string
+}
+
+// layoutFields renders fields as a two-column block within width cells:
+//
+//	  Status: ● DOING
+//	Assignee: alice.longname@exam
+//	          ple-company.com
+//
+// Labels are right-aligned in a shared column and values wrap with a hanging
+// indent under their own first line. Below fieldMinValueWidth value cells the
+// block stacks each label above its indented value. labelStyle styles the
+// padded label (nil leaves it as is).
+func layoutFields(fields []detailField, width int, labelStyle func(string) string) []string {
+	labelWidth := fieldLabelWidth
+	for _, field := range fields {
+		labelWidth = max(labelWidth, ansi.StringWidth(field.Label)+1) // +1 for the colon
+	}
+	if labelStyle == nil {
+		labelStyle = func(s string) string { return s }
+	}
+
+	valueWidth := width - labelWidth - 1
+	stacked := valueWidth < fieldMinValueWidth
+
+	lines := make([]string, 0, len(fields))
+	for _, field := range fields {
+		label := field.Label + ":"
+		if stacked {
+			lines = append(lines, labelStyle(label))
+			for _, line := range wrapValue(field.Value, width-fieldStackIndent) {
+				lines = append(lines, strings.Repeat(" ", fieldStackIndent)+field.render(line))
+			}
+			continue
+		}
+
+		padded := strings.Repeat(" ", labelWidth-ansi.StringWidth(label)) + label
+		hanging := strings.Repeat(" ", labelWidth+1)
+		for i, line := range wrapValue(field.Value, valueWidth) {
+			if i == 0 {
+				lines = append(lines, labelStyle(padded)+" "+field.render(line))
+			} else {
+				lines = append(lines, hanging+field.render(line))
+			}
+		}
+	}
+	return lines
+}
+
+// render applies the field's line style
+func (f detailField) render(line string) string {
+	if f.Render == nil || line == "" {
+		return line
+	}
+	return f.Render(line)
+}
+
+// wrapValue wraps text at word boundaries to width cells, breaking words that
+// do not fit on a line of their own; an empty value yields one empty line
+func wrapValue(text string, width int) []string {
+	lines := strings.Split(ansi.Wrap(text, max(width, 1), ""), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
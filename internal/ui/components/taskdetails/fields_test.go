@@ -0,0 +1,79 @@
+package taskdetails
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLayoutFields(t *testing.T) {
+	fields := []detailField{
+		{Label: "Status", Value: "● DOING"},
+		{Label: "Assignee", Value: "alice.longname@example-company.com"},
+		{Label: "Tags", Value: "#authentication-and-authorization"},
+		{Label: "担当", Value: "日本語のテキストです"},
+	}
+
+	tests := []struct {
+		name  string
+		width int
+		want  string
+	}{
+		{
+			name:  "wide",
+			width: 60,
+			want: `
+   Status: ● DOING
+ Assignee: alice.longname@example-company.com
+     Tags: #authentication-and-authorization
+     担当: 日本語のテキストです`,
+		},
+		{
+			name:  "hanging indent",
+			width: 30,
+			want: `
+   Status: ● DOING
+ Assignee: alice.longname@exam
+           ple-company.com
+     Tags: #authentication-
+           and-authorization
+     担当: 日本語のテキストで
+           す`,
+		},
+		{
+			name:  "stacked below threshold",
+			width: 24,
+			want: `
+Status:
+  ● DOING
+Assignee:
+  alice.longname@example
+  -company.com
+Tags:
+  #authentication-and-
+  authorization
+担当:
+  日本語のテキストです`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "\n" + strings.Join(layoutFields(fields, tt.width, nil), "\n")
+			if got != tt.want {
+				t.Errorf("layoutFields(width %d):\n%s\nwant:\n%s", tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutFieldsWidensLabelColumn(t *testing.T) {
+	lines := layoutFields([]detailField{
+		{Label: "Status", Value: "todo"},
+		{Label: "Estimated effort", Value: "3d"},
+	}, 60, func(label string) string { return "[" + label + "]" })
+
+	want := []string{"[          Status:] todo", "[Estimated effort:] 3d"}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the label column to fit the longest label, got:\n%s", strings.Join(lines, "\n"))
+	}
+}
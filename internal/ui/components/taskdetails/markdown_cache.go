@@ -0,0 +1,80 @@
+package taskdetails
+
+import (
+	"container/list"
+	"crypto/sha256"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
+)
+
+// markdownCacheSize is the number of tasks whose rendered description is kept
+const markdownCacheSize = 50
+
+// markdownCache keeps the rendered description of recently shown tasks, so
+// moving the selection back to a task does not run glamour again. A rendering
+// is reused only while the description, the width and the theme are the ones
+// it was made with; anything else renders afresh and replaces it. At most
+// capacity tasks are kept, dropping the least recently shown.
+//
+// Not safe for concurrent use; Bubble Tea calls Update from one goroutine.
+type markdownCache struct {
+	capacity int
+	order    *list.List // Of *markdownEntry, most recently shown first
+	entries  map[string]*list.Element
+	render   func(text string, width int) string
+}
+
+// markdownEntry is one task's rendered description
+type markdownEntry struct {
+	taskID     string
+	hash       [sha256.Size]byte // Of the rendered text
+	width      int
+	generation uint64 // styling.ThemeGeneration at render time
+	rendered   string
+}
+
+// newMarkdownCache creates a cache of capacity tasks rendering with view.RenderMarkdown
+func newMarkdownCache(capacity int) *markdownCache {
+	return &markdownCache{
+		capacity: max(capacity, 1),
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		render:   view.RenderMarkdown,
+	}
+}
+
+// Render returns text rendered as markdown at width for taskID
+func (c *markdownCache) Render(taskID, text string, width int) string {
+	hash := sha256.Sum256([]byte(text))
+	generation := styling.ThemeGeneration()
+	if element, ok := c.entries[taskID]; ok {
+		entry := element.Value.(*markdownEntry)
+		if entry.hash == hash && entry.width == width && entry.generation == generation {
+			c.order.MoveToFront(element)
+			return entry.rendered
+		}
+		c.order.Remove(element)
+		delete(c.entries, taskID)
+	}
+
+	rendered := c.render(text, width)
+	c.entries[taskID] = c.order.PushFront(&markdownEntry{
+		taskID:     taskID,
+		hash:       hash,
+		width:      width,
+		generation: generation,
+		rendered:   rendered,
+	})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*markdownEntry).taskID)
+	}
+	return rendered
+}
+
+// Len returns the number of tasks with a cached rendering
+func (c *markdownCache) Len() int {
+	return c.order.Len()
+}
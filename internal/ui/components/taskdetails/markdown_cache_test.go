@@ -0,0 +1,101 @@
+package taskdetails
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+)
+
+// countingCache returns a cache that counts how often it renders
+func countingCache(capacity int) (*markdownCache, *int) {
+	renders := 0
+	cache := newMarkdownCache(capacity)
+	cache.render = func(text string, width int) string {
+		renders++
+		return fmt.Sprintf("%d:%s", width, text)
+	}
+	return cache, &renders
+}
+
+func TestMarkdownCacheInvalidation(t *testing.T) {
+	cache, renders := countingCache(10)
+	cache.Render("t1", "Fix **login**", 40)
+	cache.Render("t1", "Fix **login**", 40)
+	if *renders != 1 {
+		t.Fatalf("Expected a repeated selection to reuse the rendering, got %d renders", *renders)
+	}
+
+	steps := []struct {
+		name   string
+		change func()
+		taskID string
+		text   string
+		width  int
+	}{
+		{"task update", func() {}, "t1", "Fix **logout**", 40},
+		{"resize", func() {}, "t1", "Fix **logout**", 60},
+		{"theme switch", func() { styling.InitializeTheme(&config.Config{}) }, "t1", "Fix **logout**", 60},
+		{"other task", func() {}, "t2", "Fix **logout**", 60},
+	}
+	for _, step := range steps {
+		before := *renders
+		step.change()
+		if got := cache.Render(step.taskID, step.text, step.width); got != fmt.Sprintf("%d:%s", step.width, step.text) {
+			t.Errorf("%s: unexpected rendering %q", step.name, got)
+		}
+		if *renders != before+1 {
+			t.Errorf("%s: expected a fresh render", step.name)
+		}
+	}
+
+	// A changed rendering replaces the task's old one
+	if cache.Len() != 2 {
+		t.Errorf("Expected one entry per task, got %d", cache.Len())
+	}
+}
+
+func TestMarkdownCacheEvictsLeastRecentlyShown(t *testing.T) {
+	cache, renders := countingCache(2)
+	cache.Render("t1", "one", 40)
+	cache.Render("t2", "two", 40)
+	cache.Render("t1", "one", 40) // t2 is now the least recently shown
+	cache.Render("t3", "three", 40)
+
+	if cache.Len() != 2 {
+		t.Fatalf("Expected the cache capped at 2, got %d", cache.Len())
+	}
+	before := *renders
+	cache.Render("t1", "one", 40)
+	if *renders != before {
+		t.Error("Expected t1 kept")
+	}
+	cache.Render("t2", "two", 40)
+	if *renders != before+1 {
+		t.Error("Expected t2 evicted")
+	}
+}
+
+// benchmarkDescription is a medium-sized description with the usual markdown
+var benchmarkDescription = strings.Repeat("## Step\n\nUpdate the **schema** and run `make migrate`.\n\n- check logs\n- verify rows\n\n", 20)
+
+// BenchmarkRenderDescriptionCold renders the description on every selection
+func BenchmarkRenderDescriptionCold(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		cache := newMarkdownCache(markdownCacheSize)
+		cache.Render("t1", benchmarkDescription, 80)
+	}
+}
+
+// BenchmarkRenderDescriptionWarm reselects a task whose rendering is cached
+func BenchmarkRenderDescriptionWarm(b *testing.B) {
+	cache := newMarkdownCache(markdownCacheSize)
+	cache.Render("t1", benchmarkDescription, 80)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Render("t1", benchmarkDescription, 80)
+	}
+}
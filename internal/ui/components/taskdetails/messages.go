@@ -58,6 +58,15 @@ func ScrollTaskDetails(direction viewport.ScrollDirection) tea.Cmd {
 	}
 }
 
+// TaskDetailsToggleDescriptionMsg shows more or less of a collapsed description
+type TaskDetailsToggleDescriptionMsg struct{}
+
+// TaskDetailsSnapshotChangedMsg says the remembered description of a task was
+// replaced, so its "changed since you viewed it" hint may be gone
+type TaskDetailsSnapshotChangedMsg struct {
+	TaskID string
+}
+
 // TaskDetailsScrollPositionChangedMsg is broadcast when scroll position changes
 type TaskDetailsScrollPositionChangedMsg struct {
 	Position string // Use detailspanel.ScrollPosition* constants
@@ -69,5 +78,7 @@ var (
 	// NOTE: TaskDetailsSetActiveMsg interface check removed - message type deleted
 	_ tea.Msg = TaskDetailsResizeMsg{}
 	_ tea.Msg = TaskDetailsScrollMsg{}
+	_ tea.Msg = TaskDetailsToggleDescriptionMsg{}
+	_ tea.Msg = TaskDetailsSnapshotChangedMsg{}
 	_ tea.Msg = TaskDetailsScrollPositionChangedMsg{}
 )
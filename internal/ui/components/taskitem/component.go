@@ -99,17 +99,46 @@ func (m *Model) View() string {
 	// Add components in order (following existing pattern from TaskList)
 	taskContent := builder.AddPriorityIndicator(m.task).
 		AddStatusIndicator(m.task).
+		AddUnreadIndicator(m.isUnread()).
 		AddTitle(m.task, m.searchQuery, m.isHighlighted).
 		AddFeatureTag(m.task).
+		AddProjectLabel(m.projectLabel()).
+		AddTagChips(m.task).
+		AddMalformedBadge(m.task).
 		Build(m.searchQuery, m.isHighlighted)
 
 	// Add selection indicator (TaskItem owns this responsibility)
 	if m.isSelected {
 		return styling.SelectionIndicator + taskContent
 	}
+	if m.isMarked() {
+		return styling.MarkIndicator + taskContent
+	}
 	return styling.NoSelection + taskContent
 }
 
+// isMarked reports whether the task is marked for a bulk status change
+func (m *Model) isMarked() bool {
+	programContext := m.GetContext().ProgramContext
+	return programContext != nil && programContext.MarkedTasks[m.task.ID]
+}
+
+// isUnread reports whether the task changed since its details were last shown
+func (m *Model) isUnread() bool {
+	programContext := m.GetContext().ProgramContext
+	return programContext != nil && programContext.IsUnread(m.task)
+}
+
+// projectLabel returns the project title shown on rows of All Tasks, where
+// tasks of every project mix ("" when scoped to a project or hidden with 'P')
+func (m *Model) projectLabel() string {
+	ctx := m.GetContext()
+	if ctx.ProgramContext == nil || ctx.ProgramContext.SelectedProjectID != nil || (ctx.UIState != nil && ctx.UIState.HideProjectLabels) {
+		return ""
+	}
+	return ctx.ProgramContext.GetProjectTitle(m.task.ProjectID)
+}
+
 // renderFallback provides a basic rendering when dependencies are not available
 func (m *Model) renderFallback() string {
 	status := m.task.Status
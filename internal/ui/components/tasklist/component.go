@@ -2,19 +2,20 @@ package tasklist
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
-	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/layout"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
-	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/view"
 	sharedviewport "github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/taskitem"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 )
 
@@ -64,6 +65,15 @@ func (m *TaskListModel) ctx() *context.ProgramContext {
 	return m.GetContext().ProgramContext
 }
 
+// taskPages returns how much of a paged task list is loaded; without a
+// program context (isolated component tests) the whole list counts as loaded
+func (m *TaskListModel) taskPages() context.TaskPaging {
+	if m.GetContext() == nil || m.ctx() == nil {
+		return context.TaskPaging{}
+	}
+	return m.ctx().TaskPages
+}
+
 // getSortedTasks queries parent for current sorted/filtered task list
 // This is the ONLY way to get task data - no caching, always current
 func (m *TaskListModel) getSortedTasks() []archon.Task {
@@ -110,9 +120,9 @@ func NewModel(opts Options) TaskListModel {
 	baseComponent := base.NewBaseComponent(ComponentID, base.TableComponent, opts.Context)
 
 	// Calculate dimensions using dimension calculator
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	calc := layout.NewCalculator(opts.Width, opts.Height, layout.PanelComponent).
-		WithScrollbar().     // Reserve space for scrollbar (4 chars)
+		WithScrollbarEnabled(opts.Context.ScrollbarOptions().Enabled).
 		WithReservedLines(4) // Header (2) + position info (2)
 	dims := calc.Calculate()
 
@@ -152,7 +162,7 @@ func (m *TaskListModel) Update(msg tea.Msg) tea.Cmd {
 		return m.handleDataMessages(msg)
 	case TaskListScrollMsg:
 		return m.handleScrollMessages(msg)
-	case messages.YankIDMsg, messages.YankTitleMsg:
+	case messages.YankIDMsg, messages.YankTitleMsg, messages.YankCommitRefMsg, messages.YankPathMsg:
 		return m.handleYankMessages(msg)
 	}
 	return nil
@@ -182,7 +192,10 @@ func (m *TaskListModel) handleDataMessages(msg tea.Msg) tea.Cmd {
 	case TaskListSelectMsg:
 		// Use helper to ensure viewport regeneration and scroll adjustment
 		m.setSelectedIndex(msg.Index)
-		return func() tea.Msg { return TaskListSelectionChangedMsg{Index: m.selectedIndex} }
+		return tea.Batch(
+			func() tea.Msg { return TaskListSelectionChangedMsg{Index: m.selectedIndex} },
+			m.loadMoreIfNearEnd(),
+		)
 
 	case TaskListSearchMsg:
 		// Update owned state for search highlighting
@@ -231,18 +244,25 @@ func (m *TaskListModel) handleScrollMessages(msg TaskListScrollMsg) tea.Cmd {
 
 	// Note: viewport regeneration and scroll adjustment now handled by setSelectedIndex()
 
-	return func() tea.Msg { return TaskListSelectionChangedMsg{Index: m.selectedIndex} }
+	return tea.Batch(
+		func() tea.Msg { return TaskListSelectionChangedMsg{Index: m.selectedIndex} },
+		m.loadMoreIfNearEnd(),
+	)
 }
 
-// handleYankMessages processes ID and title copy operations
+// handleYankMessages processes ID, title, commit reference and path copy operations
 // Note: Parent (MainContent) routes yank messages based on mode, so this component
 // only receives yank messages when in task mode
 func (m *TaskListModel) handleYankMessages(msg tea.Msg) tea.Cmd {
-	switch msg.(type) {
+	switch msg := msg.(type) {
 	case messages.YankIDMsg:
 		return m.handleYankID()
 	case messages.YankTitleMsg:
 		return m.handleYankTitle()
+	case messages.YankCommitRefMsg:
+		return m.handleYankCommitRef(msg)
+	case messages.YankPathMsg:
+		return m.handleYankPath(msg)
 	}
 	return nil
 }
@@ -280,7 +300,7 @@ func (m *TaskListModel) View() string {
 
 	// Add position info if needed
 	taskCount := len(m.getSortedTasks())
-	if taskCount > m.maxLines {
+	if taskCount > m.maxLines || m.taskPages().HasMore {
 		positionInfo := m.buildPositionInfoFromViewport()
 		viewportContent += "\n\n" + positionInfo
 	}
@@ -288,13 +308,14 @@ func (m *TaskListModel) View() string {
 	// Add scrollbar if content is scrollable
 	totalLines := m.viewport.TotalLineCount()
 	viewportHeight := m.viewport.Height
-	if totalLines > viewportHeight {
+	scrollbarOpts := m.GetContext().ScrollbarOptions()
+	if totalLines > viewportHeight && scrollbarOpts.Enabled {
 		// Generate scrollbar
-		scrollbar := view.RenderScrollBarExact(m.viewport.YOffset, totalLines, viewportHeight)
+		scrollbar := scrollbarOpts.Render(m.viewport.YOffset, totalLines, viewportHeight)
 
 		// Compose viewport content with scrollbar
 		// Note: Headers are outside viewport, so no header offset needed
-		viewportContent = sharedviewport.ComposeWithScrollbar(viewportContent, scrollbar, m.GetWidth(), 0)
+		viewportContent = sharedviewport.ComposeWithScrollbarOptions(viewportContent, scrollbar, m.GetWidth(), 0, scrollbarOpts)
 	}
 
 	// Combine static headers with scrollable viewport content
@@ -355,12 +376,7 @@ func (m *TaskListModel) followSelection() {
 		return
 	}
 
-	// Dynamic scroll margin: 25% of viewport height
-	// Provides proportional lookahead/context regardless of terminal size
-	scrollMargin := m.viewport.Height / 4
-	if scrollMargin < 1 {
-		scrollMargin = 1 // Minimum 1 line on very small viewports
-	}
+	scrollMargin := m.scrollMargin()
 
 	// Calculate line position of selected task in viewport content
 	// Headers are now outside viewport, so task index maps directly to line number
@@ -386,6 +402,38 @@ func (m *TaskListModel) followSelection() {
 	// If in safe zone (between margins), don't scroll
 }
 
+// scrollMargin is the lookahead kept around the selection: 25% of viewport
+// height, proportional regardless of terminal size
+func (m *TaskListModel) scrollMargin() int {
+	return max(1, m.viewport.Height/4) // Minimum 1 line on very small viewports
+}
+
+// loadMoreIfNearEnd asks for the next page of a paged task list once the
+// selection is within the scroll margin of the last loaded task
+func (m *TaskListModel) loadMoreIfNearEnd() tea.Cmd {
+	if !m.taskPages().CanLoadMore() {
+		return nil
+	}
+	if m.selectedIndex < len(m.getSortedTasks())-1-m.scrollMargin() {
+		return nil
+	}
+	return func() tea.Msg { return TaskListLoadMoreMsg{} }
+}
+
+// ScrollOffset returns the index of the first visible task line
+func (m *TaskListModel) ScrollOffset() int {
+	return m.viewport.YOffset
+}
+
+// SetScrollOffset restores a scroll offset, e.g. from a saved session.
+// The offset is clamped to the content and adjusted so the selection stays visible.
+func (m *TaskListModel) SetScrollOffset(offset int) {
+	m.viewport.SetYOffset(offset)
+	if m.selectedIndex < m.viewport.YOffset || m.selectedIndex >= m.viewport.YOffset+m.viewport.Height {
+		m.followSelection()
+	}
+}
+
 func (m *TaskListModel) renderSpecialStates() string {
 	styleContext := m.createStyleContext(false)
 	factory := styleContext.Factory()
@@ -396,11 +444,14 @@ func (m *TaskListModel) renderSpecialStates() string {
 	// Read state from ProgramContext (single source of truth)
 	ctx := m.ctx()
 
-	if ctx.Loading {
+	if ctx.IsLoadingShown() {
 		return listStyle.Render("Loading tasks...")
 	}
 
 	if ctx.Error != "" {
+		if note := ctx.FailedAttemptsNote(); note != "" {
+			return listStyle.Render(fmt.Sprintf("Error: %s\n\nPress 'r' to retry (%s)", ctx.Error, note))
+		}
 		return listStyle.Render(fmt.Sprintf("Error: %s\n\nPress 'r' to retry", ctx.Error))
 	}
 
@@ -421,7 +472,9 @@ func (m *TaskListModel) getEffectiveContentWidth() int {
 	return panelContentWidth
 }
 
-// buildPositionInfoFromViewport creates position info based on viewport state
+// buildPositionInfoFromViewport creates position info based on viewport state.
+// While pages of the task list remain on the server, positions count against
+// the server's total rather than the loaded tasks.
 func (m *TaskListModel) buildPositionInfoFromViewport() string {
 	// Query parent for current task count
 	taskCount := len(m.getSortedTasks())
@@ -431,18 +484,27 @@ func (m *TaskListModel) buildPositionInfoFromViewport() string {
 	firstVisibleTask := max(0, m.viewport.YOffset)
 	lastVisibleTask := min(taskCount-1, firstVisibleTask+m.maxLines-1)
 
+	total, totalText := taskCount, strconv.Itoa(taskCount)
+	if paging := m.taskPages(); paging.HasMore {
+		if paging.Total > 0 {
+			total, totalText = max(paging.Total, taskCount), strconv.Itoa(max(paging.Total, taskCount))
+		} else {
+			totalText += "+" // The server did not say how many remain
+		}
+	}
+
 	// Calculate percentage
-	percentage := ((lastVisibleTask + 1) * 100) / taskCount
-	if percentage > 100 {
-		percentage = 100
+	percentage := 0
+	if total > 0 {
+		percentage = min(100, ((lastVisibleTask+1)*100)/total)
 	}
 
-	positionText := fmt.Sprintf("Showing %d-%d of %d tasks (%d%%)",
-		firstVisibleTask+1, lastVisibleTask+1, taskCount, percentage)
+	positionText := fmt.Sprintf("Showing %d-%d of %s tasks (%d%%)",
+		firstVisibleTask+1, lastVisibleTask+1, totalText, percentage)
 
 	// Add selected task position indicator
 	selectedPos := m.selectedIndex + 1
-	positionText += fmt.Sprintf(" | Task %d selected", selectedPos)
+	positionText += fmt.Sprintf(" | Task %d of %s selected", selectedPos, totalText)
 
 	// Style the position info
 	styleContext := m.createStyleContext(false)
@@ -515,18 +577,19 @@ func (m *TaskListModel) matchesSearch(task archon.Task) bool {
 	}
 
 	query := strings.ToLower(m.searchQuery)
-	return strings.Contains(strings.ToLower(task.Title), query) ||
-		strings.Contains(strings.ToLower(task.Status), query) ||
-		(task.Feature != nil && strings.Contains(strings.ToLower(*task.Feature), query)) ||
-		strings.Contains(strings.ToLower(task.ID), query)
+	// Server search results are not the synced tasks the index describes
+	if ctx := m.GetContext(); ctx != nil && ctx.ProgramContext != nil && ctx.ProgramContext.ServerSearchResults == nil {
+		return ctx.ProgramContext.SearchIndex.Matches(task, query)
+	}
+	return helpers.MatchesTaskFields(task, query)
 }
 
 // updateDimensions recalculates all dimensions using the dimension calculator
 // This ensures consistent calculations across resize events
 func (m *TaskListModel) updateDimensions() {
-	// Always reserve scrollbar space to prevent content overflow when scrollbar appears
+	// Always reserve scrollbar space (when enabled) to prevent content overflow when scrollbar appears
 	calc := layout.NewCalculator(m.GetWidth(), m.GetHeight(), layout.PanelComponent).
-		WithScrollbar().     // Reserve space for scrollbar (4 chars)
+		WithScrollbarEnabled(m.GetContext().ScrollbarOptions().Enabled).
 		WithReservedLines(4) // Header (2) + position info (2)
 	dims := calc.Calculate()
 
@@ -576,22 +639,28 @@ func (m *TaskListModel) handleYankID() tea.Cmd {
 		}
 	}
 
-	err := clipboard.WriteAll(task.ID)
-	if err != nil {
+	return func() tea.Msg {
+		return messages.CopyToClipboardMsg{Text: task.ID, What: "task ID"}
+	}
+}
+
+// handleYankTitle copies the selected task title to clipboard
+func (m *TaskListModel) handleYankTitle() tea.Cmd {
+	task := m.GetSelectedTask()
+	if task == nil {
 		return func() tea.Msg {
-			return messages.StatusFeedbackMsg{Message: "Failed to copy task ID"}
+			return messages.StatusFeedbackMsg{Message: "No task selected"}
 		}
 	}
 
 	return func() tea.Msg {
-		return messages.StatusFeedbackMsg{
-			Message: fmt.Sprintf("Copied task ID: %s", task.ID),
-		}
+		// Copy the full title as received, not the display-capped one
+		return messages.CopyToClipboardMsg{Text: task.Original().Title, What: "task title"}
 	}
 }
 
-// handleYankTitle copies the selected task title to clipboard
-func (m *TaskListModel) handleYankTitle() tea.Cmd {
+// handleYankCommitRef copies the selected task formatted as a commit reference
+func (m *TaskListModel) handleYankCommitRef(msg messages.YankCommitRefMsg) tea.Cmd {
 	task := m.GetSelectedTask()
 	if task == nil {
 		return func() tea.Msg {
@@ -599,16 +668,29 @@ func (m *TaskListModel) handleYankTitle() tea.Cmd {
 		}
 	}
 
-	err := clipboard.WriteAll(task.Title)
+	reference, err := export.FormatCommitReference(msg.Template, task.Original(), msg.ShortIDLength)
 	if err != nil {
 		return func() tea.Msg {
-			return messages.StatusFeedbackMsg{Message: "Failed to copy task title"}
+			return messages.StatusFeedbackMsg{Message: "Invalid commit template: " + err.Error()}
 		}
 	}
 
 	return func() tea.Msg {
-		return messages.StatusFeedbackMsg{
-			Message: fmt.Sprintf("Copied task title: %s", task.Title),
+		return messages.CopyToClipboardMsg{Text: reference, What: "commit reference"}
+	}
+}
+
+// handleYankPath copies the selected task's breadcrumb path from its root parent
+func (m *TaskListModel) handleYankPath(msg messages.YankPathMsg) tea.Cmd {
+	task := m.GetSelectedTask()
+	if task == nil {
+		return func() tea.Msg {
+			return messages.StatusFeedbackMsg{Message: "No task selected"}
 		}
 	}
+
+	path := export.FormatTaskPath(*task, m.ctx().FindTask, msg.Separator)
+	return func() tea.Msg {
+		return messages.CopyToClipboardMsg{Text: path, What: "task path"}
+	}
 }
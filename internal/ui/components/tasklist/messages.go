@@ -22,6 +22,10 @@ type TaskListSelectionChangedMsg struct {
 	Index int // New selected index
 }
 
+// TaskListLoadMoreMsg is sent when the selection nears the last loaded task
+// of a paged task list, asking for the next page
+type TaskListLoadMoreMsg struct{}
+
 // TaskListSearchMsg is sent to update search state
 type TaskListSearchMsg struct {
 	Query  string // Search query
@@ -125,6 +129,7 @@ var (
 	_ tea.Msg = TaskListUpdateMsg{}
 	_ tea.Msg = TaskListSelectMsg{}
 	_ tea.Msg = TaskListSelectionChangedMsg{}
+	_ tea.Msg = TaskListLoadMoreMsg{}
 	_ tea.Msg = TaskListSearchMsg{}
 	_ tea.Msg = TaskListFilterMsg{}
 	_ tea.Msg = TaskListScrollMsg{}
@@ -1,14 +1,24 @@
 package context
 
 import (
+	gocontext "context"
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
+	"github.com/yousfisaad/lazyarchon/v2/internal/sprints"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
 )
 
 // TaskState represents the state of a background task
@@ -75,6 +85,15 @@ type ProgramContext struct {
 	RepoPath string         // Repository path
 	Version  string         // Application version
 
+	// Compiled integrations.links rules (nil = no rules or invalid rules)
+	Links *links.Engine
+
+	// Sprint grouping from ui.sprint (nil = sprints off or invalid settings)
+	Sprints *sprints.Resolver
+
+	// Local-vs-server clock offset measured from API responses
+	ClockSkew *clock.SkewEstimator
+
 	// =============================================================================
 	// 2. INTERFACE DEPENDENCIES (Clean Architecture / Dependency Injection)
 	// =============================================================================
@@ -95,6 +114,21 @@ type ProgramContext struct {
 	Projects          []archon.Project // All projects from Archon server (SOURCE OF TRUTH)
 	SelectedProjectID *string          // Currently selected project (nil = "All Tasks", UUID = specific project)
 
+	// How much of a paged task list is loaded (see server.page_size; zero = all of it)
+	TaskPages TaskPaging
+
+	// Search index derived from Tasks, kept in sync incrementally by SetTasks
+	SearchIndex *helpers.SearchIndex
+
+	// Unique short task IDs derived from Tasks (nil = not computed since the last SetTasks)
+	shortIDs map[string]string
+
+	// Sorted unique features derived from Tasks (nil = not computed since the last SetTasks)
+	uniqueFeatures []string
+
+	// Descriptions of the tasks viewed this session, to show what changed since
+	DescriptionSnapshots *helpers.DescriptionSnapshots
+
 	// =============================================================================
 	// 4. GLOBAL UI STATE
 	// =============================================================================
@@ -102,11 +136,22 @@ type ProgramContext struct {
 	// NOTE: UI presentation details (spinner animations, frame indices, etc.) are
 	// component-local concerns and live in the components themselves (e.g., StatusBar)
 
-	Connected      bool   // Connection status to Archon server (affects entire UI)
-	Loading        bool   // Whether the application is loading data (affects entire UI)
-	LoadingMessage string // Context-specific loading message (e.g., "Loading tasks...")
-	Error          string // Current error message (displayed globally)
-	LastRetryError string // Last error for retry functionality
+	Connected      bool      // Connection status to Archon server (affects entire UI)
+	Loading        bool      // Whether the application is loading data (affects entire UI)
+	LoadingMessage string    // Context-specific loading message (e.g., "Loading tasks...")
+	LoadingSince   time.Time // When Loading last turned on
+	Error          string    // Current error message (displayed globally)
+	LastRetryError string    // Last error for retry functionality
+	FailedAttempts int       // Task loads failed in a row since tasks last loaded (shown with the error)
+
+	// Projects learned to be read-only from 403 responses (session-local, never persisted)
+	ReadOnlyProjects map[string]bool
+
+	// Started with --safe-mode: every project is read-only and persisted state is ignored
+	SafeMode bool
+
+	// Rate-limit state from the latest response that reported one (nil = never reported)
+	RateLimit *archon.RateLimit
 
 	// =============================================================================
 	// 5. USER PREFERENCES (Persistent Settings)
@@ -114,13 +159,28 @@ type ProgramContext struct {
 	// Settings that represent user preferences and should persist across the session.
 	// These are GLOBAL settings that affect how data is displayed everywhere.
 
-	SortMode            int             // Current task sorting mode (STATUS+PRIORITY, PRIORITY, TIME, ALPHABETICAL)
-	StatusFilters       map[string]bool // Status visibility filters (todo, doing, review, done)
-	StatusFilterActive  bool            // Whether custom status filtering is active (computed from StatusFilters)
-	FeatureFilters      map[string]bool // Feature visibility filters (which features to show)
-	FeatureFilterActive bool            // Whether custom feature filtering is active (computed from FeatureFilters)
-	SearchHistory       []string        // Recent search queries for history navigation (persistent across searches)
-	ShowCompletedTasks  bool            // User preference for showing completed tasks (persistent setting)
+	SortMode int // Current task sorting mode (STATUS+PRIORITY, PRIORITY, TIME, ALPHABETICAL, RECENT)
+
+	// Local time of the user's last interaction with each task this session
+	// (edits, copies, opened links, reloads); orders the recent sort mode
+	LastInteraction     map[string]time.Time
+	LastSeen            map[string]time.Time // Task ID → updated-at when its details were last shown
+	ReadSince           time.Time            // When unread tracking started (zero = not yet)
+	StatusFilters       map[string]bool      // Status visibility filters (todo, doing, review, done)
+	StatusFilterActive  bool                 // Whether custom status filtering is active (computed from StatusFilters)
+	FeatureFilters      map[string]bool      // Feature visibility filters (which features to show)
+	FeatureFilterActive bool                 // Whether custom feature filtering is active (computed from FeatureFilters)
+	TagFilters          map[string]bool      // Tags shown (nil = no tag filter); untagged tasks are always shown
+	CreatedSince        *time.Time           // Only show tasks created since this time (nil = off, set by the created-today view)
+	HiddenTasks         map[string]bool      // Done tasks hidden with X until exit (never persisted)
+	MinPriority         int                  // Only show tasks with at least this priority (task_order; 0 = all)
+	RefreshChanges      map[string]bool      // Tasks added or changed by the latest refresh (nil = none)
+	ChangedOnly         bool                 // Only show RefreshChanges (the 'u' view; the next refresh turns it off)
+	MarkedTasks         map[string]bool      // Tasks marked with 'v' for a bulk status change (by ID, so sorting keeps them)
+	SearchHistory       []string             // Recent search queries for history navigation (persistent across searches)
+	ServerSearchResults []archon.Task        // Tasks the server found for the committed search, shown instead of Tasks (nil = local search)
+	ServerSearchOff     bool                 // The server cannot search; search stays local until exit
+	ShowCompletedTasks  bool                 // User preference for showing completed tasks (persistent setting)
 
 	// =============================================================================
 	// 6. BACKGROUND TASK MANAGEMENT
@@ -128,9 +188,59 @@ type ProgramContext struct {
 	// System for tracking long-running background operations
 
 	StartTask       func(task Task) tea.Cmd // Function to start a background task
-	BackgroundTasks []Task                  // Active background tasks
+	BackgroundTasks []Task                  // Recent background tasks, oldest first (see RecordBackgroundTask)
+
+	// Recent task changes that ctrl+z can revert, oldest first (see PushUndo)
+	UndoStack []UndoEntry
+
+	// The latest list load per resource (see BeginLoad)
+	loads map[string]load
+}
+
+// load is a cancellable list load
+type load struct {
+	ctx    gocontext.Context
+	cancel gocontext.CancelFunc
+}
+
+// Resources whose loads BeginLoad keeps one of at a time
+const (
+	LoadTasks    = "tasks"
+	LoadProjects = "projects"
+)
+
+// TaskPaging tracks the pages of the task list loaded so far
+type TaskPaging struct {
+	NextOffset int  // Offset the next page is requested at
+	Total      int  // Tasks on the server across all pages (0 = not reported)
+	HasMore    bool // More pages remain on the server
+	Loading    bool // A page request is in flight
 }
 
+// CanLoadMore reports whether another page can be requested now
+func (p TaskPaging) CanLoadMore() bool {
+	return p.HasMore && !p.Loading
+}
+
+// UndoEntry is one task operation that can be reverted
+type UndoEntry struct {
+	Kind    string       // What was done, e.g. "status change" or "deletion"
+	Changes []UndoChange // One per task the operation changed
+}
+
+// UndoChange is the part of an undoable operation that changed one task
+type UndoChange struct {
+	Before  archon.Task              // The task as it was, whole so a deletion can re-create it
+	Revert  archon.UpdateTaskRequest // Sets the changed fields back (unused for deletions)
+	Summary string                   // What reverting does, e.g. "doing → todo"
+}
+
+// maxBackgroundTasks bounds the background task history
+const maxBackgroundTasks = 50
+
+// maxUndoEntries bounds the undo stack
+const maxUndoEntries = 20
+
 // NewProgramContext creates a new program context with default values
 func NewProgramContext(cfg *config.Config, archonClient interfaces.ArchonClient, configProvider interfaces.ConfigProvider, styleContextProvider interfaces.StyleContextProvider, logger interfaces.Logger) *ProgramContext {
 	return &ProgramContext{
@@ -146,9 +256,14 @@ func NewProgramContext(cfg *config.Config, archonClient interfaces.ArchonClient,
 		Logger:               logger,
 
 		// Initialize collections
-		Tasks:           make([]archon.Task, 0),
-		Projects:        make([]archon.Project, 0),
-		BackgroundTasks: make([]Task, 0),
+		Tasks:            make([]archon.Task, 0),
+		Projects:         make([]archon.Project, 0),
+		BackgroundTasks:  make([]Task, 0),
+		ReadOnlyProjects: make(map[string]bool),
+		SearchIndex:      helpers.NewSearchIndex(),
+		ClockSkew:        clock.NewSkewEstimator(clock.DefaultSkewThreshold),
+
+		DescriptionSnapshots: newDescriptionSnapshots(cfg),
 
 		// Initialize user preferences
 		SearchHistory: make([]string, 0),
@@ -162,6 +277,20 @@ func NewProgramContext(cfg *config.Config, archonClient interfaces.ArchonClient,
 	}
 }
 
+// newDescriptionSnapshots sizes the description snapshot store from the config
+func newDescriptionSnapshots(cfg *config.Config) *helpers.DescriptionSnapshots {
+	if cfg == nil {
+		return helpers.NewDescriptionSnapshots(0, 0)
+	}
+	return helpers.NewDescriptionSnapshots(cfg.GetDescriptionSnapshots(), 0)
+}
+
+// Now returns the current time, anchored to the server clock when the local clock is skewed.
+// Use this instead of time.Now() for anything compared against server timestamps.
+func (ctx *ProgramContext) Now() time.Time {
+	return ctx.ClockSkew.Now()
+}
+
 // UpdateScreenDimensions updates screen dimensions for reference
 // Components now manage their own dimensions through WindowSizeMsg
 func (ctx *ProgramContext) UpdateScreenDimensions(width, height int) {
@@ -173,9 +302,38 @@ func (ctx *ProgramContext) UpdateScreenDimensions(width, height int) {
 // NOTE: GetContentHeight, GetLeftPanelWidth, and GetRightPanelWidth methods removed
 // Components now manage their own dimensions through WindowSizeMsg
 
-// SetTasks updates the tasks data in the context
+// SetTasks updates the tasks data in the context and re-indexes changed tasks for search
 func (ctx *ProgramContext) SetTasks(tasks []archon.Task) {
+	// Selection and lookups go by ID, so a server repeating one keeps a single copy
+	tasks, duplicates := archon.DedupeTasks(tasks)
+	if len(duplicates) > 0 && ctx.Logger != nil {
+		ctx.Logger.Warn("Server returned duplicate task IDs; kept the most recently updated copy",
+			"count", len(duplicates), "ids", strings.Join(duplicates, ","))
+	}
 	ctx.Tasks = tasks
+	ctx.SearchIndex.Sync(tasks)
+	ctx.shortIDs = nil
+	ctx.uniqueFeatures = nil
+}
+
+// DisplayID returns taskID as shown in the UI: a prefix unique among the
+// loaded tasks with ui.display.id_display "short", otherwise the full ID.
+// Copies always use the full ID.
+func (ctx *ProgramContext) DisplayID(taskID string) string {
+	if ctx.Config == nil || ctx.Config.GetIDDisplay() != config.IDDisplayShort {
+		return taskID
+	}
+	if _, ok := ctx.shortIDs[taskID]; !ok {
+		ids := make([]string, len(ctx.Tasks))
+		for i, task := range ctx.Tasks {
+			ids[i] = task.ID
+		}
+		ctx.shortIDs = export.UniqueShortIDs(ids, ctx.Config.GetShortIDLength())
+	}
+	if short, ok := ctx.shortIDs[taskID]; ok {
+		return short
+	}
+	return taskID // Not loaded: no way to tell it is unambiguous
 }
 
 // SetProjects updates the projects data in the context
@@ -209,6 +367,16 @@ func (ctx *ProgramContext) GetCurrentProjectName() string {
 	return "Unknown Project"
 }
 
+// GetProjectTitle returns the title of a loaded project, or "" if unknown
+func (ctx *ProgramContext) GetProjectTitle(projectID string) string {
+	for _, project := range ctx.Projects {
+		if project.ID == projectID {
+			return project.Title
+		}
+	}
+	return ""
+}
+
 // Search History Management Methods
 // Note: Active search state (searchMode, searchInput, searchQuery) lives in UIState
 // as transient UI state. Only persistent search history belongs in ProgramContext.
@@ -316,6 +484,44 @@ func (ctx *ProgramContext) ResetFeatureFilters() {
 	ctx.FeatureFilterActive = false
 }
 
+// PruneFeatureFilters drops filtered features that no task uses any more and
+// returns them sorted. When nothing selected remains, filtering is reset so
+// the list is never left empty because its features disappeared.
+func (ctx *ProgramContext) PruneFeatureFilters() []string {
+	if len(ctx.FeatureFilters) == 0 {
+		return nil
+	}
+
+	used := make(map[string]bool)
+	for _, task := range ctx.Tasks {
+		if task.Feature != nil && *task.Feature != "" {
+			used[*task.Feature] = true
+		}
+	}
+
+	var removed []string
+	remaining := 0
+	for feature, visible := range ctx.FeatureFilters {
+		if !used[feature] {
+			removed = append(removed, feature)
+			delete(ctx.FeatureFilters, feature)
+		} else if visible {
+			remaining++
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	sort.Strings(removed)
+
+	if remaining == 0 {
+		ctx.ResetFeatureFilters()
+		return removed
+	}
+	ctx.updateFeatureFilterActiveState()
+	return removed
+}
+
 // updateFeatureFilterActiveState determines if any custom feature filtering is active
 func (ctx *ProgramContext) updateFeatureFilterActiveState() {
 	// Feature filtering is active if any features are explicitly set
@@ -326,10 +532,27 @@ func (ctx *ProgramContext) updateFeatureFilterActiveState() {
 
 // SetLoading updates the loading state and message
 func (ctx *ProgramContext) SetLoading(loading bool, message string) {
+	if loading && !ctx.Loading {
+		ctx.LoadingSince = time.Now()
+	}
 	ctx.Loading = loading
 	ctx.LoadingMessage = message
 }
 
+// IsLoadingShown reports whether loading has lasted past ui.display.loading_delay.
+// Loading indicators check this rather than Loading so quick loads never flash
+// them. Until the first data arrives there is nothing else to show, so they
+// show at once.
+func (ctx *ProgramContext) IsLoadingShown() bool {
+	if !ctx.Loading {
+		return false
+	}
+	if ctx.Config == nil || (len(ctx.Tasks) == 0 && len(ctx.Projects) == 0) {
+		return true
+	}
+	return time.Since(ctx.LoadingSince) >= ctx.Config.GetLoadingDelay()
+}
+
 // SetError updates the current error message
 func (ctx *ProgramContext) SetError(err string) {
 	ctx.Error = err
@@ -345,8 +568,60 @@ func (ctx *ProgramContext) SetLastRetryError(err string) {
 	ctx.LastRetryError = err
 }
 
+// RecordFailedAttempt counts a failed task load; the count is shown with the
+// error until ResetFailedAttempts
+func (ctx *ProgramContext) RecordFailedAttempt() {
+	ctx.FailedAttempts++
+}
+
+// ResetFailedAttempts starts counting failed attempts again, after a success
+func (ctx *ProgramContext) ResetFailedAttempts() {
+	ctx.FailedAttempts = 0
+}
+
+// FailedAttemptsNote describes repeated failures for error displays, e.g.
+// "failed 3 times"; "" for a first failure
+func (ctx *ProgramContext) FailedAttemptsNote() string {
+	if ctx.FailedAttempts < 2 {
+		return ""
+	}
+	return fmt.Sprintf("failed %d times", ctx.FailedAttempts)
+}
+
 // Sorting Management Methods
 
+// TouchTask records an interaction with a task now, for the recent sort mode
+func (ctx *ProgramContext) TouchTask(taskID string) {
+	if ctx.LastInteraction == nil {
+		ctx.LastInteraction = make(map[string]time.Time)
+	}
+	ctx.LastInteraction[taskID] = time.Now()
+}
+
+// IsUnread reports whether task was updated since its details were last shown
+func (ctx *ProgramContext) IsUnread(task archon.Task) bool {
+	if ctx.ReadSince.IsZero() || ctx.Config == nil || !ctx.Config.IsUnreadIndicatorEnabled() {
+		return false
+	}
+	seen, ok := ctx.LastSeen[task.ID]
+	if !ok {
+		seen = ctx.ReadSince
+	}
+	return task.UpdatedAt.After(seen)
+}
+
+// MarkSeen records that task's details were shown; reports whether it was unread
+func (ctx *ProgramContext) MarkSeen(task archon.Task) bool {
+	if !ctx.IsUnread(task) {
+		return false
+	}
+	if ctx.LastSeen == nil {
+		ctx.LastSeen = make(map[string]time.Time)
+	}
+	ctx.LastSeen[task.ID] = task.UpdatedAt.Time
+	return true
+}
+
 // SetSortMode updates the current sorting mode
 func (ctx *ProgramContext) SetSortMode(mode int) {
 	ctx.SortMode = mode
@@ -367,6 +642,145 @@ func (ctx *ProgramContext) ToggleShowCompletedTasks() {
 	ctx.ShowCompletedTasks = !ctx.ShowCompletedTasks
 }
 
+// Project Permission Methods
+
+// IsProjectReadOnly reports whether tasks in the given project must not be mutated.
+// A project is read-only in safe mode, if the API reports it as such, or if a previous
+// mutation was rejected with 403 during this session. Unknown permissions are treated as writable.
+func (ctx *ProgramContext) IsProjectReadOnly(projectID string) bool {
+	if ctx.SafeMode {
+		return true
+	}
+	if projectID == "" {
+		return false
+	}
+	if ctx.ReadOnlyProjects[projectID] {
+		return true
+	}
+	for _, project := range ctx.Projects {
+		if project.ID == projectID {
+			return project.IsReadOnly()
+		}
+	}
+	return false
+}
+
+// MarkProjectReadOnly records that the server refused a mutation for this project
+func (ctx *ProgramContext) MarkProjectReadOnly(projectID string) {
+	if projectID == "" {
+		return
+	}
+	if ctx.ReadOnlyProjects == nil {
+		ctx.ReadOnlyProjects = make(map[string]bool)
+	}
+	ctx.ReadOnlyProjects[projectID] = true
+}
+
+// HideTasks hides the given tasks from every task list until lazyarchon exits
+func (ctx *ProgramContext) HideTasks(taskIDs []string) {
+	if ctx.HiddenTasks == nil {
+		ctx.HiddenTasks = make(map[string]bool)
+	}
+	for _, taskID := range taskIDs {
+		ctx.HiddenTasks[taskID] = true
+	}
+}
+
+// ObserveResponseMeta records response metadata shared across the UI.
+// Responses without rate-limit headers keep the last known state.
+func (ctx *ProgramContext) ObserveResponseMeta(meta archon.ResponseMeta) {
+	if meta.RateLimit != nil {
+		ctx.RateLimit = meta.RateLimit
+	}
+}
+
+// RecordBackgroundTask adds task to the background task list, replacing an
+// entry with the same ID. The oldest entries are dropped beyond maxBackgroundTasks.
+func (ctx *ProgramContext) RecordBackgroundTask(task Task) {
+	for i := range ctx.BackgroundTasks {
+		if ctx.BackgroundTasks[i].ID == task.ID {
+			ctx.BackgroundTasks[i] = task
+			return
+		}
+	}
+	ctx.BackgroundTasks = append(ctx.BackgroundTasks, task)
+	if excess := len(ctx.BackgroundTasks) - maxBackgroundTasks; excess > 0 {
+		ctx.BackgroundTasks = append(ctx.BackgroundTasks[:0:0], ctx.BackgroundTasks[excess:]...)
+	}
+}
+
+// PushUndo records an operation ctrl+z can revert. The oldest entries are
+// dropped beyond maxUndoEntries.
+func (ctx *ProgramContext) PushUndo(entry UndoEntry) {
+	ctx.UndoStack = append(ctx.UndoStack, entry)
+	if excess := len(ctx.UndoStack) - maxUndoEntries; excess > 0 {
+		ctx.UndoStack = append(ctx.UndoStack[:0:0], ctx.UndoStack[excess:]...)
+	}
+}
+
+// PopUndo removes and returns the most recent undoable operation
+func (ctx *ProgramContext) PopUndo() (UndoEntry, bool) {
+	if len(ctx.UndoStack) == 0 {
+		return UndoEntry{}, false
+	}
+	entry := ctx.UndoStack[len(ctx.UndoStack)-1]
+	ctx.UndoStack = ctx.UndoStack[:len(ctx.UndoStack)-1]
+	return entry, true
+}
+
+// BeginLoad cancels the load of resource still in flight, if any, and
+// returns the context of the load replacing it, so a stale response cannot
+// overwrite a fresher one
+func (ctx *ProgramContext) BeginLoad(resource string) gocontext.Context {
+	if previous, ok := ctx.loads[resource]; ok {
+		previous.cancel()
+	}
+	if ctx.loads == nil {
+		ctx.loads = make(map[string]load)
+	}
+	loadCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	ctx.loads[resource] = load{ctx: loadCtx, cancel: cancel}
+	return loadCtx
+}
+
+// LoadContext returns the context of the latest load of resource, for the
+// requests that continue it (e.g. further pages), so they stop with it. It
+// begins a load when there is none.
+func (ctx *ProgramContext) LoadContext(resource string) gocontext.Context {
+	if load, ok := ctx.loads[resource]; ok {
+		return load.ctx
+	}
+	return ctx.BeginLoad(resource)
+}
+
+// CancelLoads cancels every load in flight, e.g. when quitting
+func (ctx *ProgramContext) CancelLoads() {
+	for _, load := range ctx.loads {
+		load.cancel()
+	}
+	ctx.loads = nil
+}
+
+// FindBackgroundTask returns the background task with the given ID, or nil
+func (ctx *ProgramContext) FindBackgroundTask(id string) *Task {
+	for i := range ctx.BackgroundTasks {
+		if ctx.BackgroundTasks[i].ID == id {
+			return &ctx.BackgroundTasks[i]
+		}
+	}
+	return nil
+}
+
+// FindTask returns the task with the given ID, or nil if it is not loaded
+func (ctx *ProgramContext) FindTask(taskID string) *archon.Task {
+	for i := range ctx.Tasks {
+		if ctx.Tasks[i].ID == taskID {
+			return &ctx.Tasks[i]
+		}
+	}
+	return nil
+}
+
 // =============================================================================
 // COMPUTED DATA METHODS
 // =============================================================================
@@ -405,44 +819,47 @@ func (ctx *ProgramContext) GetCurrentSortModeName() string {
 		return "Created"
 	case 3: // sorting.SortAlphabetical
 		return "Alpha"
+	case 4: // sorting.SortRecent
+		return "Recent"
 	default:
 		return "Unknown"
 	}
 }
 
-// GetUniqueFeatures returns a sorted list of unique features from current tasks
+// GetUniqueFeatures returns a sorted list of unique features from current tasks.
+// The status bar asks on every render, so the list is computed once per SetTasks.
 func (ctx *ProgramContext) GetUniqueFeatures() []string {
+	return slices.Clone(ctx.features())
+}
+
+// GetFeatureCount returns the number of unique features in current tasks
+func (ctx *ProgramContext) GetFeatureCount() int {
+	return len(ctx.features())
+}
+
+// features returns the cached unique features, computing them after SetTasks
+func (ctx *ProgramContext) features() []string {
+	if ctx.uniqueFeatures != nil {
+		return ctx.uniqueFeatures
+	}
 	featureSet := make(map[string]bool)
 	for _, task := range ctx.Tasks {
 		if task.Feature != nil && *task.Feature != "" {
 			featureSet[*task.Feature] = true
 		}
 	}
-
-	// Convert map to sorted slice
-	features := make([]string, 0, len(featureSet))
-	for feature := range featureSet {
-		features = append(features, feature)
+	features := slices.Sorted(maps.Keys(featureSet))
+	if features == nil {
+		features = []string{} // Cache an empty result too
 	}
-
-	// Sort alphabetically (simple string sort)
-	// Note: We can't import helpers package here due to import cycles
-	// Use a simple bubble sort for small lists
-	for i := 0; i < len(features); i++ {
-		for j := i + 1; j < len(features); j++ {
-			if features[i] > features[j] {
-				features[i], features[j] = features[j], features[i]
-			}
-		}
-	}
-
+	ctx.uniqueFeatures = features
 	return features
 }
 
 // GetFeatureFilterSummary returns a summary of active feature filters
 // Reads from ctx.FeatureFilters (user preference)
 func (ctx *ProgramContext) GetFeatureFilterSummary() string {
-	allFeatures := ctx.GetUniqueFeatures()
+	allFeatures := ctx.features()
 
 	if len(allFeatures) == 0 {
 		return "No features"
@@ -481,6 +898,24 @@ func (ctx *ProgramContext) GetFeatureFilterSummary() string {
 	return fmt.Sprintf("%d features", activeCount)
 }
 
+// GetTagFilterSummary returns a summary of the active tag filter
+func (ctx *ProgramContext) GetTagFilterSummary() string {
+	var selected []string
+	for tag, visible := range ctx.TagFilters {
+		if visible {
+			selected = append(selected, tag)
+		}
+	}
+	switch len(selected) {
+	case 0:
+		return "No tags"
+	case 1:
+		return selected[0]
+	default:
+		return fmt.Sprintf("%d tags", len(selected))
+	}
+}
+
 // GetTaskCountForProject returns the number of tasks for a specific project
 func (ctx *ProgramContext) GetTaskCountForProject(projectID string) int {
 	count := 0
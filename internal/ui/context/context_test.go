@@ -0,0 +1,102 @@
+package context
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+)
+
+func TestUniqueFeaturesCache(t *testing.T) {
+	ctx := NewProgramContext(nil, nil, nil, nil, nil)
+	feature := func(name string) *string { return &name }
+
+	if got := ctx.GetUniqueFeatures(); len(got) != 0 || ctx.GetFeatureCount() != 0 {
+		t.Fatalf("Expected no features before tasks load, got %v", got)
+	}
+
+	ctx.SetTasks([]archon.Task{
+		{ID: "t1", Feature: feature("ui")},
+		{ID: "t2", Feature: feature("auth")},
+		{ID: "t3", Feature: feature("ui")},
+		{ID: "t4"},
+	})
+	if got := ctx.GetUniqueFeatures(); !slices.Equal(got, []string{"auth", "ui"}) || ctx.GetFeatureCount() != 2 {
+		t.Fatalf("Expected [auth ui], got %v", got)
+	}
+
+	// Callers get a copy, so the cached list survives changes to it
+	ctx.GetUniqueFeatures()[0] = "changed"
+	if got := ctx.GetUniqueFeatures(); got[0] != "auth" {
+		t.Errorf("Expected the cache unaffected by callers, got %v", got)
+	}
+
+	// SetTasks invalidates the cache
+	ctx.SetTasks([]archon.Task{{ID: "t1", Feature: feature("billing")}})
+	if got := ctx.GetUniqueFeatures(); !slices.Equal(got, []string{"billing"}) || ctx.GetFeatureCount() != 1 {
+		t.Errorf("Expected [billing] after new tasks, got %v", got)
+	}
+	ctx.SetTasks(nil)
+	if ctx.GetFeatureCount() != 0 {
+		t.Errorf("Expected no features without tasks, got %v", ctx.GetUniqueFeatures())
+	}
+}
+
+func TestLoadingShownAfterDelay(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.UI.Display.LoadingDelay = 300 * time.Millisecond
+	ctx := NewProgramContext(cfg, nil, nil, nil, nil)
+
+	// Nothing loaded yet: nothing else to show
+	ctx.SetLoading(true, "")
+	if !ctx.IsLoadingShown() {
+		t.Error("Expected the first load shown at once")
+	}
+	ctx.SetLoading(false, "")
+	ctx.SetTasks([]archon.Task{{ID: "t1"}})
+
+	ctx.SetLoading(true, "Refreshing...")
+	if ctx.IsLoadingShown() {
+		t.Error("Expected a quick refresh hidden")
+	}
+	// Staying in loading keeps the original start
+	since := ctx.LoadingSince
+	ctx.SetLoading(true, "Still refreshing...")
+	if !ctx.LoadingSince.Equal(since) {
+		t.Error("Expected the loading start kept while loading")
+	}
+	ctx.LoadingSince = time.Now().Add(-time.Second)
+	if !ctx.IsLoadingShown() {
+		t.Error("Expected a slow refresh shown")
+	}
+	ctx.SetLoading(false, "")
+	if ctx.IsLoadingShown() {
+		t.Error("Expected nothing shown once loaded")
+	}
+}
+
+func TestBeginLoad(t *testing.T) {
+	ctx := NewProgramContext(&config.Config{}, nil, nil, nil, nil)
+
+	first := ctx.BeginLoad(LoadTasks)
+	projects := ctx.BeginLoad(LoadProjects)
+	second := ctx.BeginLoad(LoadTasks)
+	if first.Err() == nil {
+		t.Error("Expected a newer task load to cancel the older one")
+	}
+	if second.Err() != nil || projects.Err() != nil {
+		t.Error("Expected the newest task load and the project load to keep running")
+	}
+
+	// Requests continuing a load share its context
+	if ctx.LoadContext(LoadTasks) != second {
+		t.Error("Expected LoadContext to return the latest task load's context")
+	}
+
+	ctx.CancelLoads()
+	if second.Err() == nil || projects.Err() == nil {
+		t.Error("Expected CancelLoads to cancel every load")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 )
 
 // Styles holds centralized styling configuration for components
@@ -238,12 +239,12 @@ func (s *Styles) initCommonStyles() {
 		Padding(0, 1)
 
 	s.Common.PanelStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("#404040")).
 		Padding(1)
 
 	s.Common.BorderStyle = lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(styling.NormalBorder()).
 		BorderForeground(lipgloss.Color("#404040"))
 
 	s.Common.ErrorStyle = lipgloss.NewStyle().
@@ -290,12 +291,12 @@ func (s *Styles) initTableStyles() {
 
 	s.Table.HeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		BorderStyle(lipgloss.NormalBorder()).
+		BorderStyle(styling.NormalBorder()).
 		BorderBottom(true).
 		BorderForeground(lipgloss.Color("#404040"))
 
 	s.Table.RowStyle = lipgloss.NewStyle().
-		BorderStyle(lipgloss.NormalBorder()).
+		BorderStyle(styling.NormalBorder()).
 		BorderForeground(lipgloss.Color("#2A2A2A"))
 }
 
@@ -334,7 +335,7 @@ func (s *Styles) initModalStyles() {
 	s.Modal.ContentStyle = lipgloss.NewStyle().
 		Background(lipgloss.Color("#2A2A2A")).
 		Foreground(lipgloss.Color("#FFFFFF")).
-		Border(lipgloss.RoundedBorder()).
+		Border(styling.RoundedBorder()).
 		BorderForeground(lipgloss.Color("#404040")).
 		Padding(1, 2)
 
@@ -359,7 +360,7 @@ func (s *Styles) initSearchStyles() {
 	s.Search.InputStyle = lipgloss.NewStyle().
 		Background(lipgloss.Color("#2A2A2A")).
 		Foreground(lipgloss.Color("#FFFFFF")).
-		Border(lipgloss.NormalBorder()).
+		Border(styling.NormalBorder()).
 		BorderForeground(lipgloss.Color("#404040")).
 		Padding(0, 1)
 
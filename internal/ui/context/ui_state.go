@@ -52,6 +52,26 @@ type UIState struct {
 	// SelectedProjectIndex is the currently selected project index in project list
 	SelectedProjectIndex int
 
+	// DetailsOpenTaskID is the task whose details were opened with
+	// ui.display.details_panel "manual"; other tasks show a placeholder
+	DetailsOpenTaskID string
+
+	// CountTotalFirst leads the status bar count of a filtered list with the
+	// total ("57 tasks (12 shown)") instead of the shown tasks ("12 of 57 tasks")
+	CountTotalFirst bool
+
+	// HideShortcutHints leaves the "/: search | ?: help" hints out of the
+	// status bar (ui.display.shortcut_hints, toggled with '!')
+	HideShortcutHints bool
+
+	// HideProjectLabels leaves the project title off task rows in All Tasks
+	// (ui.display.project_labels, toggled with 'P')
+	HideProjectLabels bool
+
+	// HideDetails leaves the task details panel out of the task view and
+	// gives its width to the task list (toggled with 'z', kept in the state file)
+	HideDetails bool
+
 	// =============================================================================
 	// COMPUTED SEARCH STATE
 	// =============================================================================
@@ -115,6 +135,16 @@ func (s *UIState) IsRightPanelActive() bool {
 	return s.ActivePanel == RightPanel
 }
 
+// OpenDetails marks taskID's details as opened (details_panel "manual")
+func (s *UIState) OpenDetails(taskID string) {
+	s.DetailsOpenTaskID = taskID
+}
+
+// DetailsOpen reports whether taskID's details were opened
+func (s *UIState) DetailsOpen(taskID string) bool {
+	return taskID != "" && s.DetailsOpenTaskID == taskID
+}
+
 // SetViewMode updates the current view mode
 func (s *UIState) SetViewMode(mode ViewMode) {
 	s.CurrentViewMode = mode
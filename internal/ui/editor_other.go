@@ -0,0 +1,6 @@
+//go:build !windows
+
+package ui
+
+// defaultEditor is used when neither $VISUAL nor $EDITOR is set
+const defaultEditor = "vi"
@@ -6,11 +6,17 @@ import (
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/header"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/maincontent"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/statusbar"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/bookmarklist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/feature"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/help"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
 )
 
 // ModalComponents contains all modal components
@@ -20,6 +26,12 @@ type ModalComponents struct {
 	ConfirmationModel *confirmation.ConfirmationModel
 	TaskEditModel     *taskedit.TaskEditModel
 	FeatureModel      *feature.FeatureModel
+	LinkPickerModel   *linkpicker.LinkPickerModel
+	DigestModel       *digest.DigestModel
+	BookmarkListModel *bookmarklist.BookmarkListModel
+	UnblockModel      *unblock.UnblockModel
+	APIKeyModel       *apikey.APIKeyModel
+	DescDiffModel     *descdiff.DescDiffModel
 }
 
 // Update broadcasts messages to all modal components (hierarchical pattern)
@@ -43,10 +55,100 @@ func (mc *ModalComponents) Update(msg tea.Msg) tea.Cmd {
 	if mc.FeatureModel != nil {
 		cmds = append(cmds, mc.FeatureModel.Update(msg))
 	}
+	if mc.LinkPickerModel != nil {
+		cmds = append(cmds, mc.LinkPickerModel.Update(msg))
+	}
+	if mc.DigestModel != nil {
+		cmds = append(cmds, mc.DigestModel.Update(msg))
+	}
+	if mc.BookmarkListModel != nil {
+		cmds = append(cmds, mc.BookmarkListModel.Update(msg))
+	}
+	if mc.UnblockModel != nil {
+		cmds = append(cmds, mc.UnblockModel.Update(msg))
+	}
+	if mc.APIKeyModel != nil {
+		cmds = append(cmds, mc.APIKeyModel.Update(msg))
+	}
+	if mc.DescDiffModel != nil {
+		cmds = append(cmds, mc.DescDiffModel.Update(msg))
+	}
 
 	return tea.Batch(cmds...)
 }
 
+// modalView is the part of a modal needed to draw it
+type modalView interface {
+	GetID() string
+	IsActive() bool
+	View() string
+}
+
+// ActiveView returns the view of the modal to show, or "" when none is active.
+// When several are active the first wins, in order: help, status, confirmation,
+// task edit, feature, link picker, away digest, bookmark list, unblock report,
+// API key prompt, description diff.
+func (mc *ModalComponents) ActiveView() string {
+	for _, modal := range mc.modals() {
+		if !modal.IsActive() {
+			continue
+		}
+		if view := modal.View(); view != "" {
+			return view
+		}
+	}
+	return ""
+}
+
+// ActiveID returns the component ID of the frontmost active modal, or "" when none is active
+func (mc *ModalComponents) ActiveID() string {
+	for _, modal := range mc.modals() {
+		if modal.IsActive() {
+			return modal.GetID()
+		}
+	}
+	return ""
+}
+
+// modals lists the created modals in drawing priority order
+func (mc *ModalComponents) modals() []modalView {
+	var modals []modalView
+	if mc.HelpModel != nil {
+		modals = append(modals, mc.HelpModel)
+	}
+	if mc.StatusModel != nil {
+		modals = append(modals, mc.StatusModel)
+	}
+	if mc.ConfirmationModel != nil {
+		modals = append(modals, mc.ConfirmationModel)
+	}
+	if mc.TaskEditModel != nil {
+		modals = append(modals, mc.TaskEditModel)
+	}
+	if mc.FeatureModel != nil {
+		modals = append(modals, mc.FeatureModel)
+	}
+	if mc.LinkPickerModel != nil {
+		modals = append(modals, mc.LinkPickerModel)
+	}
+	if mc.DigestModel != nil {
+		modals = append(modals, mc.DigestModel)
+	}
+	if mc.BookmarkListModel != nil {
+		modals = append(modals, mc.BookmarkListModel)
+	}
+	if mc.UnblockModel != nil {
+		modals = append(modals, mc.UnblockModel)
+	}
+	if mc.APIKeyModel != nil {
+		modals = append(modals, mc.APIKeyModel)
+	}
+	if mc.DescDiffModel != nil {
+		modals = append(modals, mc.DescDiffModel)
+	}
+	return modals
+}
+
 // LayoutComponents contains all layout components
 type LayoutComponents struct {
 	Header      *header.HeaderModel
@@ -104,6 +206,12 @@ func CreateComponents(config ComponentConfig) *UIComponentSet {
 	confirmationModal := confirmation.NewModel(config.ComponentContext)
 	taskEditModal := taskedit.NewModel(config.ComponentContext)
 	featureModal := feature.NewModel(config.ComponentContext)
+	linkPickerModal := linkpicker.NewModel(config.ComponentContext)
+	digestModal := digest.NewModel(config.ComponentContext)
+	bookmarkListModal := bookmarklist.NewModel(config.ComponentContext)
+	unblockModal := unblock.NewModel(config.ComponentContext)
+	apiKeyModal := apikey.NewModel(config.ComponentContext)
+	descDiffModal := descdiff.NewModel(config.ComponentContext)
 
 	return &UIComponentSet{
 		Modals: ModalComponents{
@@ -112,6 +220,12 @@ func CreateComponents(config ComponentConfig) *UIComponentSet {
 			ConfirmationModel: confirmationModal,
 			TaskEditModel:     taskEditModal,
 			FeatureModel:      featureModal,
+			LinkPickerModel:   linkPickerModal,
+			DigestModel:       digestModal,
+			BookmarkListModel: bookmarkListModal,
+			UnblockModel:      unblockModal,
+			APIKeyModel:       apiKeyModal,
+			DescDiffModel:     descDiffModal,
 		},
 		Layout: LayoutComponents{
 			// Header, StatusBar, and MainContent are initialized separately
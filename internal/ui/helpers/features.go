@@ -39,6 +39,17 @@ func GetFeatureTaskCount(tasks []archon.Task, feature string) int {
 	return count
 }
 
+// CountTasksByFeature returns the number of tasks of each feature
+func CountTasksByFeature(tasks []archon.Task) map[string]int {
+	counts := make(map[string]int)
+	for _, task := range tasks {
+		if task.Feature != nil && *task.Feature != "" {
+			counts[*task.Feature]++
+		}
+	}
+	return counts
+}
+
 // GetFeatureFilterSummary returns a summary of active feature filters
 // Three-state logic:
 // - nil map: No filter active (show all)
@@ -0,0 +1,152 @@
+package helpers
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// DefaultFrameWindow is how many recent samples each frame key keeps for percentiles
+const DefaultFrameWindow = 256
+
+// maxSlowFrames bounds the list of recent frames that went over budget
+const maxSlowFrames = 32
+
+// Frame phases measured by FrameStats
+const (
+	FrameUpdate = "update" // MainModel.Update handling one message
+	FrameView   = "view"   // MainModel.View rendering the screen
+)
+
+// SlowFrame is one Update or View call that exceeded the frame budget
+type SlowFrame struct {
+	At       time.Time     `json:"at"`
+	Phase    string        `json:"phase"`             // FrameUpdate or FrameView
+	Message  string        `json:"message,omitempty"` // Message type for updates
+	View     string        `json:"view"`              // Active view or modal
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// FramePercentiles summarizes recent samples for one message type or view
+type FramePercentiles struct {
+	Key   string // "update <message type>" or "view <view>"
+	Count int    // Samples in the window
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// frameSamples is a fixed-size ring of recent durations
+type frameSamples struct {
+	values []time.Duration
+	next   int
+}
+
+// add records a duration, overwriting the oldest once the ring is full
+func (s *frameSamples) add(d time.Duration, window int) {
+	if len(s.values) < window {
+		s.values = append(s.values, d)
+		return
+	}
+	s.values[s.next] = d
+	s.next = (s.next + 1) % window
+}
+
+// FrameStats keeps rolling Update/View timings per message type and per active
+// view, and remembers recent frames that went over budget.
+// It is only created in debug/profiling mode; callers keep a nil *FrameStats
+// otherwise and skip timing entirely. Not safe for concurrent use: Bubble Tea
+// calls Update and View from the same goroutine.
+type FrameStats struct {
+	budget  time.Duration
+	window  int
+	samples map[string]*frameSamples
+	slow    []SlowFrame // Oldest first, at most maxSlowFrames
+
+	// OnSlow, when set, receives every frame over budget (e.g. to record it)
+	OnSlow func(SlowFrame)
+}
+
+// NewFrameStats creates frame statistics with the given budget per call and
+// samples kept per key (0 uses DefaultFrameWindow)
+func NewFrameStats(budget time.Duration, window int) *FrameStats {
+	if window <= 0 {
+		window = DefaultFrameWindow
+	}
+	return &FrameStats{
+		budget:  budget,
+		window:  window,
+		samples: make(map[string]*frameSamples),
+	}
+}
+
+// Budget returns the duration above which a frame counts as slow
+func (s *FrameStats) Budget() time.Duration {
+	return s.budget
+}
+
+// Observe records one Update (keyed by message type) or View (keyed by view)
+// and reports the frame when it exceeded the budget
+func (s *FrameStats) Observe(phase, message, view string, d time.Duration) (SlowFrame, bool) {
+	key := phase + " " + view
+	if phase == FrameUpdate {
+		key = phase + " " + message
+	}
+	samples, ok := s.samples[key]
+	if !ok {
+		samples = &frameSamples{}
+		s.samples[key] = samples
+	}
+	samples.add(d, s.window)
+
+	if d <= s.budget {
+		return SlowFrame{}, false
+	}
+	frame := SlowFrame{At: time.Now(), Phase: phase, Message: message, View: view, Duration: d}
+	s.slow = append(s.slow, frame)
+	if len(s.slow) > maxSlowFrames {
+		s.slow = s.slow[len(s.slow)-maxSlowFrames:]
+	}
+	if s.OnSlow != nil {
+		s.OnSlow(frame)
+	}
+	return frame, true
+}
+
+// Percentiles returns the summary for every key, slowest p95 first
+func (s *FrameStats) Percentiles() []FramePercentiles {
+	result := make([]FramePercentiles, 0, len(s.samples))
+	for key, samples := range s.samples {
+		sorted := append([]time.Duration(nil), samples.values...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		result = append(result, FramePercentiles{
+			Key:   key,
+			Count: len(sorted),
+			P50:   percentile(sorted, 50),
+			P95:   percentile(sorted, 95),
+			P99:   percentile(sorted, 99),
+			Max:   sorted[len(sorted)-1],
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].P95 != result[j].P95 {
+			return result[i].P95 > result[j].P95
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// Worst returns up to n recent slow frames, slowest first
+func (s *FrameStats) Worst(n int) []SlowFrame {
+	worst := append([]SlowFrame(nil), s.slow...)
+	sort.SliceStable(worst, func(i, j int) bool { return worst[i].Duration > worst[j].Duration })
+	return worst[:min(n, len(worst))]
+}
+
+// percentile returns the nearest-rank percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
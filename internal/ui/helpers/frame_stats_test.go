@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFrameStatsPercentiles(t *testing.T) {
+	stats := NewFrameStats(50*time.Millisecond, 0)
+	for i := 1; i <= 100; i++ {
+		stats.Observe(FrameUpdate, "tea.KeyMsg", "tasks", time.Duration(i)*time.Millisecond)
+	}
+	stats.Observe(FrameView, "", "feature-modal", 2*time.Millisecond)
+
+	percentiles := stats.Percentiles()
+	if len(percentiles) != 2 {
+		t.Fatalf("Expected one entry per message type and view, got %+v", percentiles)
+	}
+	keys := percentiles[0]
+	if keys.Key != "update tea.KeyMsg" || keys.Count != 100 {
+		t.Fatalf("Expected the slowest key first, got %+v", keys)
+	}
+	if keys.P50 != 50*time.Millisecond || keys.P95 != 95*time.Millisecond ||
+		keys.P99 != 99*time.Millisecond || keys.Max != 100*time.Millisecond {
+		t.Errorf("Expected nearest-rank percentiles 50/95/99/100ms, got %+v", keys)
+	}
+	if percentiles[1].Key != "view feature-modal" || percentiles[1].P99 != 2*time.Millisecond {
+		t.Errorf("Expected view timings keyed by view, got %+v", percentiles[1])
+	}
+}
+
+func TestFrameStatsRollingWindow(t *testing.T) {
+	stats := NewFrameStats(time.Second, 4)
+	for _, ms := range []int{100, 100, 100, 100, 1, 2, 3, 4} {
+		stats.Observe(FrameUpdate, "msg", "tasks", time.Duration(ms)*time.Millisecond)
+	}
+	if got := stats.Percentiles()[0]; got.Count != 4 || got.Max != 4*time.Millisecond {
+		t.Errorf("Expected only the last 4 samples, got %+v", got)
+	}
+}
+
+func TestFrameStatsSlowFrames(t *testing.T) {
+	stats := NewFrameStats(16*time.Millisecond, 0)
+	var recorded []SlowFrame
+	stats.OnSlow = func(frame SlowFrame) { recorded = append(recorded, frame) }
+
+	if _, slow := stats.Observe(FrameUpdate, "tea.KeyMsg", "tasks", 16*time.Millisecond); slow {
+		t.Error("Expected a frame at the budget not to count as slow")
+	}
+	stats.Observe(FrameView, "", "tasks", 40*time.Millisecond)
+	frame, slow := stats.Observe(FrameUpdate, "tasks.TasksLoadedMsg", "help-modal", 90*time.Millisecond)
+	if !slow || frame.Message != "tasks.TasksLoadedMsg" || frame.View != "help-modal" {
+		t.Errorf("Expected the slow update reported with its message and view, got %+v", frame)
+	}
+
+	if len(recorded) != 2 {
+		t.Errorf("Expected OnSlow for each slow frame, got %d", len(recorded))
+	}
+	worst := stats.Worst(5)
+	if len(worst) != 2 || worst[0].Duration != 90*time.Millisecond || worst[1].Phase != FrameView {
+		t.Errorf("Expected slow frames slowest first, got %+v", worst)
+	}
+}
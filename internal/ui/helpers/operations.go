@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OperationFailure is one item of an operation that failed
+type OperationFailure struct {
+	Item  string // What failed, e.g. a task title
+	Error string
+}
+
+// Operation is the rolled-up progress of an operation over many items, such
+// as a batch update of tasks
+type Operation struct {
+	ID       string
+	Label    string // What is being done, e.g. "Updating tasks"
+	Total    int    // Items expected (0 = unknown)
+	Done     int    // Items finished, failed ones included
+	Failures []OperationFailure
+}
+
+// Finished reports whether every expected item has reported
+func (op Operation) Finished() bool {
+	return op.Total > 0 && op.Done >= op.Total
+}
+
+// progressBarWidth is the number of cells in an operation's progress bar
+const progressBarWidth = 10
+
+// ProgressBar draws the share of items done: "[████░░░░░░] 40% (8/20)".
+// Empty when the total is unknown.
+func (op Operation) ProgressBar() string {
+	if op.Total <= 0 {
+		return ""
+	}
+	done := min(op.Done, op.Total)
+	filled := done * progressBarWidth / op.Total
+	return fmt.Sprintf("[%s%s] %d%% (%d/%d)",
+		strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled),
+		done*100/op.Total, done, op.Total)
+}
+
+// Summary describes the operation in one line: "Updating tasks [██████░░░░]
+// 64% (32/50), 2 failed" while running, "Updating tasks: 48 of 50 done, 2
+// failed" at the end
+func (op Operation) Summary() string {
+	failed := ""
+	if len(op.Failures) > 0 {
+		failed = fmt.Sprintf(", %d failed", len(op.Failures))
+	}
+	switch {
+	case op.Finished():
+		return fmt.Sprintf("%s: %d of %d done%s", op.Label, op.Done-len(op.Failures), op.Total, failed)
+	case op.Total > 0:
+		return fmt.Sprintf("%s %s%s", op.Label, op.ProgressBar(), failed)
+	default:
+		return fmt.Sprintf("%s… %d%s", op.Label, op.Done, failed)
+	}
+}
+
+// FailureDetails lists the failed items, one "item: error" per line
+func (op Operation) FailureDetails() string {
+	lines := make([]string, len(op.Failures))
+	for i, failure := range op.Failures {
+		lines[i] = failure.Item + ": " + failure.Error
+	}
+	return strings.Join(lines, "\n")
+}
+
+// OperationTracker folds the per-item feedback of running operations, tagged
+// with the operation's ID, into one summary per operation so a batch of 50
+// updates reports as one rolling status line instead of 50 messages.
+//
+// Not safe for concurrent use; Bubble Tea calls Update from one goroutine.
+type OperationTracker struct {
+	running map[string]*Operation
+}
+
+// NewOperationTracker creates a tracker with no running operations
+func NewOperationTracker() *OperationTracker {
+	return &OperationTracker{running: make(map[string]*Operation)}
+}
+
+// Start begins tracking operation id over total items (0 = unknown). Starting
+// an ID again restarts it.
+func (t *OperationTracker) Start(id, label string, total int) Operation {
+	op := &Operation{ID: id, Label: label, Total: max(total, 0)}
+	t.running[id] = op
+	return *op
+}
+
+// Record adds the outcome of one item (err nil = succeeded) to operation id.
+// ok is false when no such operation is running; the caller then treats the
+// feedback as untagged. A finished operation stops being tracked.
+func (t *OperationTracker) Record(id, item string, err error) (op Operation, ok bool) {
+	running, ok := t.running[id]
+	if !ok {
+		return Operation{}, false
+	}
+	running.Done++
+	if err != nil {
+		running.Failures = append(running.Failures, OperationFailure{Item: item, Error: err.Error()})
+	}
+	if running.Finished() {
+		delete(t.running, id)
+	}
+	return *running, true
+}
+
+// Running returns the number of operations still in progress
+func (t *OperationTracker) Running() int {
+	return len(t.running)
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOperationTrackerRollsUpFailures(t *testing.T) {
+	tracker := NewOperationTracker()
+	tracker.Start("bulk", "Updating tasks", 3)
+
+	op, ok := tracker.Record("bulk", "Write docs", nil)
+	if !ok || op.Summary() != "Updating tasks [███░░░░░░░] 33% (1/3)" {
+		t.Errorf("Unexpected running summary %q", op.Summary())
+	}
+	op, _ = tracker.Record("bulk", "Fix login", errors.New("forbidden"))
+	if op.Summary() != "Updating tasks [██████░░░░] 66% (2/3), 1 failed" {
+		t.Errorf("Unexpected running summary %q", op.Summary())
+	}
+	op, _ = tracker.Record("bulk", "Ship it", errors.New("timeout"))
+	if !op.Finished() || op.Summary() != "Updating tasks: 1 of 3 done, 2 failed" {
+		t.Errorf("Unexpected final summary %q", op.Summary())
+	}
+	if details := op.FailureDetails(); details != "Fix login: forbidden\nShip it: timeout" {
+		t.Errorf("Unexpected failure details %q", details)
+	}
+
+	// A finished operation is forgotten; late feedback counts as untagged
+	if tracker.Running() != 0 {
+		t.Errorf("Expected no running operations, got %d", tracker.Running())
+	}
+	if _, ok := tracker.Record("bulk", "late", nil); ok {
+		t.Error("Expected feedback for a finished operation to be rejected")
+	}
+}
+
+func TestOperationProgressBar(t *testing.T) {
+	tests := []struct {
+		done, total int
+		want        string
+	}{
+		{0, 20, "[░░░░░░░░░░] 0% (0/20)"},
+		{8, 20, "[████░░░░░░] 40% (8/20)"},
+		{20, 20, "[██████████] 100% (20/20)"},
+		{3, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := (Operation{Done: tt.done, Total: tt.total}).ProgressBar(); got != tt.want {
+			t.Errorf("ProgressBar(%d/%d) = %q, want %q", tt.done, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestOperationTrackerKeepsOperationsApart(t *testing.T) {
+	tracker := NewOperationTracker()
+	tracker.Start("a", "Archiving", 2)
+	tracker.Start("b", "Moving", 0)
+
+	tracker.Record("a", "one", nil)
+	op, _ := tracker.Record("b", "two", nil)
+	if op.Finished() || op.Summary() != "Moving… 1" {
+		t.Errorf("Expected an open-ended operation to keep running, got %q", op.Summary())
+	}
+	if op, _ := tracker.Record("a", "three", nil); !op.Finished() || op.Summary() != "Archiving: 2 of 2 done" {
+		t.Errorf("Unexpected final summary %q", op.Summary())
+	}
+	if tracker.Running() != 1 {
+		t.Errorf("Expected one running operation, got %d", tracker.Running())
+	}
+	if _, ok := tracker.Record("unknown", "x", nil); ok {
+		t.Error("Expected feedback for an unknown operation to be rejected")
+	}
+}
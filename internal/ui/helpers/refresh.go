@@ -0,0 +1,94 @@
+package helpers
+
+import "time"
+
+// DefaultRefreshSpacing is the minimum time between two full task reloads
+// when server.refresh_min_spacing is not set
+const DefaultRefreshSpacing = 2 * time.Second
+
+// Sources that request a full task reload, reported in debug logs
+const (
+	RefreshStartup    = "startup"    // First load after launch
+	RefreshPoll       = "poll"       // server.polling_interval tick
+	RefreshManual     = "manual"     // 'r' / F5
+	RefreshMutation   = "mutation"   // A task update or delete was confirmed by the server
+	RefreshNavigation = "navigation" // Project switch, bookmark jump, session restore
+	RefreshDeferred   = "deferred"   // A request held back by the spacing, now due
+)
+
+// RefreshCoordinator decides when full task reloads run, so that every source
+// (polling, 'r', post-mutation refreshes) goes through one place. Requests
+// arriving while a reload is in flight join it, and reloads start at least
+// spacing apart; a request inside that window is deferred, and later ones
+// join the deferred reload.
+//
+// A mutation confirmed while a reload is in flight may not be in that
+// reload's result, so it queues one more reload after it instead of joining.
+//
+// RefreshCoordinator does no I/O: the caller starts the reload when told to,
+// schedules a Fire after the returned delay, and calls Done when the reload
+// finished. Not safe for concurrent use; Bubble Tea calls Update from one
+// goroutine.
+type RefreshCoordinator struct {
+	spacing   time.Duration
+	now       func() time.Time
+	inFlight  bool      // A reload was started and Done was not called yet
+	deferred  bool      // A Fire is scheduled
+	stale     bool      // A mutation landed while the reload was in flight
+	lastStart time.Time // When the last reload started
+}
+
+// NewRefreshCoordinator creates a coordinator keeping reloads spacing apart
+// (0 uses DefaultRefreshSpacing), reading the time from now
+func NewRefreshCoordinator(spacing time.Duration, now func() time.Time) *RefreshCoordinator {
+	if spacing <= 0 {
+		spacing = DefaultRefreshSpacing
+	}
+	return &RefreshCoordinator{spacing: spacing, now: now}
+}
+
+// Request asks for a full reload from source. start means the caller starts
+// the reload now; a positive delay means the caller schedules a Fire after it;
+// neither means the request joined a reload that is running or scheduled.
+func (c *RefreshCoordinator) Request(source string) (start bool, delay time.Duration) {
+	if c.inFlight {
+		if source == RefreshMutation {
+			c.stale = true
+		}
+		return false, 0
+	}
+	if c.deferred {
+		return false, 0
+	}
+	if !c.lastStart.IsZero() {
+		if wait := c.lastStart.Add(c.spacing).Sub(c.now()); wait > 0 {
+			c.deferred = true
+			return false, wait
+		}
+	}
+	c.inFlight = true
+	c.lastStart = c.now()
+	return true, 0
+}
+
+// Fire runs a deferred reload once its delay has passed
+func (c *RefreshCoordinator) Fire() (start bool, delay time.Duration) {
+	c.deferred = false
+	return c.Request(RefreshDeferred)
+}
+
+// Done records that the running reload finished (successfully or not) and
+// returns the follow-up reload a mutation queued meanwhile, like Request
+func (c *RefreshCoordinator) Done() (start bool, delay time.Duration) {
+	c.inFlight = false
+	if !c.stale {
+		return false, 0
+	}
+	c.stale = false
+	return c.Request(RefreshMutation)
+}
+
+// InFlight reports whether a reload is running
+func (c *RefreshCoordinator) InFlight() bool {
+	return c.inFlight
+}
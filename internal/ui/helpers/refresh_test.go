@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable time source for coordinator tests
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestCoordinator() (*RefreshCoordinator, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	return NewRefreshCoordinator(0, clock.Now), clock
+}
+
+func TestRefreshConcurrentRequestsJoin(t *testing.T) {
+	c, _ := newTestCoordinator()
+
+	starts := 0
+	for _, source := range []string{RefreshPoll, RefreshManual, RefreshNavigation, RefreshPoll, RefreshManual} {
+		if start, delay := c.Request(source); start {
+			starts++
+		} else if delay != 0 {
+			t.Errorf("Expected %s to join the running reload, got a delay of %s", source, delay)
+		}
+	}
+	if starts != 1 || !c.InFlight() {
+		t.Fatalf("Expected exactly one reload started, got %d", starts)
+	}
+	if start, delay := c.Done(); start || delay != 0 {
+		t.Errorf("Expected no follow-up reload, got %v %s", start, delay)
+	}
+}
+
+func TestRefreshSpacing(t *testing.T) {
+	c, clock := newTestCoordinator()
+	c.Request(RefreshStartup)
+	c.Done()
+
+	clock.Advance(500 * time.Millisecond)
+	start, delay := c.Request(RefreshPoll)
+	if start || delay != 1500*time.Millisecond {
+		t.Fatalf("Expected the reload deferred by the rest of the spacing, got %v %s", start, delay)
+	}
+	// Further requests join the deferred reload
+	if start, delay := c.Request(RefreshManual); start || delay != 0 {
+		t.Errorf("Expected the manual refresh to join, got %v %s", start, delay)
+	}
+
+	clock.Advance(delay)
+	if start, _ := c.Fire(); !start {
+		t.Fatal("Expected the deferred reload to start when fired")
+	}
+	c.Done()
+
+	// After the spacing, a request starts immediately
+	clock.Advance(DefaultRefreshSpacing)
+	if start, _ := c.Request(RefreshPoll); !start {
+		t.Error("Expected an immediate reload after the spacing")
+	}
+}
+
+func TestRefreshMutationDuringReload(t *testing.T) {
+	c, clock := newTestCoordinator()
+	c.Request(RefreshPoll)
+
+	// The running reload may predate the mutation, so one more follows it
+	c.Request(RefreshMutation)
+	c.Request(RefreshMutation)
+	clock.Advance(3 * time.Second)
+	if start, delay := c.Done(); !start || delay != 0 {
+		t.Fatalf("Expected one follow-up reload, got %v %s", start, delay)
+	}
+	if start, delay := c.Done(); start || delay != 0 {
+		t.Errorf("Expected nothing after the follow-up, got %v %s", start, delay)
+	}
+}
+
+func TestRefreshConfiguredSpacing(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	c := NewRefreshCoordinator(10*time.Second, clock.Now)
+	c.Request(RefreshPoll)
+	c.Done()
+	clock.Advance(4 * time.Second)
+	if _, delay := c.Request(RefreshPoll); delay != 6*time.Second {
+		t.Errorf("Expected a 6s delay, got %s", delay)
+	}
+}
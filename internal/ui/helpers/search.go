@@ -6,7 +6,7 @@ import (
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 )
 
-// SearchTasks finds tasks matching the search query
+// SearchTasks finds tasks whose title or one of whose tags matches the search query
 // Returns matching indices and total matches
 func SearchTasks(tasks []archon.Task, searchQuery string) (matchingIndices []int, totalMatches int) {
 	if searchQuery == "" {
@@ -15,10 +15,9 @@ func SearchTasks(tasks []archon.Task, searchQuery string) (matchingIndices []int
 
 	searchQuery = strings.ToLower(strings.TrimSpace(searchQuery))
 
-	// Find all tasks that match the search query (title only)
+	// Find all tasks that match the search query (title and tags)
 	for i, task := range tasks {
-		titleMatch := strings.Contains(strings.ToLower(task.Title), searchQuery)
-		if titleMatch {
+		if strings.Contains(strings.ToLower(task.Title), searchQuery) || tagsMatch(task.Tags, searchQuery) {
 			matchingIndices = append(matchingIndices, i)
 		}
 	}
@@ -27,6 +26,16 @@ func SearchTasks(tasks []archon.Task, searchQuery string) (matchingIndices []int
 	return matchingIndices, totalMatches
 }
 
+// tagsMatch reports whether any tag contains lowerQuery
+func tagsMatch(tags []string, lowerQuery string) bool {
+	for _, tag := range tags {
+		if strings.Contains(strings.ToLower(tag), lowerQuery) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetNextMatch returns the index of the next search match
 func GetNextMatch(matchingIndices []int, currentIndex int) int {
 	if len(matchingIndices) == 0 {
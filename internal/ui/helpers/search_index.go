@@ -0,0 +1,230 @@
+package helpers
+
+import (
+	"hash/maphash"
+	"strings"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// MaxSearchIndexEntries bounds the memory used by the search index.
+// Tasks beyond the cap are still searchable through the unindexed path.
+const MaxSearchIndexEntries = 20000
+
+// searchFieldSeparator joins indexed fields so a query cannot match across field boundaries
+const searchFieldSeparator = "\n"
+
+// searchKeySeed seeds the hashes telling whether an entry is current
+var searchKeySeed = maphash.MakeSeed()
+
+// searchIndexEntry holds pre-lowercased searchable text for a single task
+type searchIndexEntry struct {
+	key   uint64 // Hash of the indexed fields the entry was built from (see searchKey)
+	title string // Lowercased title (incremental search matches titles and tags)
+	tags  string // Lowercased tags joined by searchFieldSeparator
+	text  string // Lowercased title, status, feature, ID and tags joined by searchFieldSeparator
+}
+
+// SearchIndexStats reports the size of the search index for debug logging
+type SearchIndexStats struct {
+	Entries    int    // Number of indexed tasks
+	Bytes      int    // Approximate memory used by indexed text
+	Generation uint64 // Incremented on every change to the index
+}
+
+// SearchIndex keeps pre-lowercased searchable text per task so searches do not
+// re-lowercase every field of every task on each keystroke.
+// The index is maintained incrementally: Sync only rebuilds entries whose
+// indexed fields changed. Freshness is not keyed on updated_at, which servers
+// may omit or keep across edits. It is checked once per Sync, not per query:
+// Search and Matches trust the entries, so tasks must reach them through the
+// list last synced (ProgramContext.SetTasks syncs every new list). A nil
+// *SearchIndex is valid and always uses the unindexed path.
+type SearchIndex struct {
+	entries    map[string]searchIndexEntry
+	bytes      int
+	generation uint64
+}
+
+// NewSearchIndex creates an empty search index
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{entries: make(map[string]searchIndexEntry)}
+}
+
+// Sync brings the index in line with tasks, re-indexing only tasks whose
+// indexed fields changed and dropping tasks that are gone. Returns the number
+// of entries added or rebuilt.
+func (idx *SearchIndex) Sync(tasks []archon.Task) int {
+	if idx == nil {
+		return 0
+	}
+
+	seen := make(map[string]struct{}, len(tasks))
+	updated := 0
+	for i := range tasks {
+		task := &tasks[i]
+		seen[task.ID] = struct{}{}
+
+		key := searchKey(task)
+		if entry, ok := idx.entries[task.ID]; ok {
+			if entry.key == key {
+				continue
+			}
+			idx.bytes -= entry.size()
+		} else if len(idx.entries) >= MaxSearchIndexEntries {
+			continue
+		}
+
+		entry := newSearchIndexEntry(task, key)
+		idx.entries[task.ID] = entry
+		idx.bytes += entry.size()
+		updated++
+	}
+
+	removed := 0
+	for id, entry := range idx.entries {
+		if _, ok := seen[id]; !ok {
+			idx.bytes -= entry.size()
+			delete(idx.entries, id)
+			removed++
+		}
+	}
+
+	if updated > 0 || removed > 0 {
+		idx.generation++
+	}
+	return updated
+}
+
+// Invalidate drops every entry so the next Sync rebuilds the whole index.
+// Use it when the set of indexed fields changes.
+func (idx *SearchIndex) Invalidate() {
+	if idx == nil {
+		return
+	}
+	idx.entries = make(map[string]searchIndexEntry)
+	idx.bytes = 0
+	idx.generation++
+}
+
+// Generation returns a counter that changes whenever the index content changes
+func (idx *SearchIndex) Generation() uint64 {
+	if idx == nil {
+		return 0
+	}
+	return idx.generation
+}
+
+// Stats returns the current index size for debug reporting
+func (idx *SearchIndex) Stats() SearchIndexStats {
+	if idx == nil {
+		return SearchIndexStats{}
+	}
+	return SearchIndexStats{
+		Entries:    len(idx.entries),
+		Bytes:      idx.bytes,
+		Generation: idx.generation,
+	}
+}
+
+// Search finds tasks whose title or tags match the query.
+// Results are identical to SearchTasks for the tasks last synced; tasks
+// missing from the index fall back to the unindexed comparison.
+func (idx *SearchIndex) Search(tasks []archon.Task, searchQuery string) (matchingIndices []int, totalMatches int) {
+	if idx == nil {
+		return SearchTasks(tasks, searchQuery)
+	}
+	if searchQuery == "" {
+		return nil, 0
+	}
+
+	searchQuery = strings.ToLower(strings.TrimSpace(searchQuery))
+
+	for i := range tasks {
+		entry, ok := idx.entries[tasks[i].ID]
+		if !ok || strings.Contains(searchQuery, searchFieldSeparator) {
+			if strings.Contains(strings.ToLower(tasks[i].Title), searchQuery) || tagsMatch(tasks[i].Tags, searchQuery) {
+				matchingIndices = append(matchingIndices, i)
+			}
+			continue
+		}
+		if strings.Contains(entry.title, searchQuery) || strings.Contains(entry.tags, searchQuery) {
+			matchingIndices = append(matchingIndices, i)
+		}
+	}
+
+	totalMatches = len(matchingIndices)
+	return matchingIndices, totalMatches
+}
+
+// Matches reports whether the task's title, status, feature, ID or tags contain
+// lowerQuery. lowerQuery must already be lowercased by the caller.
+func (idx *SearchIndex) Matches(task archon.Task, lowerQuery string) bool {
+	if idx != nil && !strings.Contains(lowerQuery, searchFieldSeparator) {
+		if entry, ok := idx.entries[task.ID]; ok {
+			return strings.Contains(entry.text, lowerQuery)
+		}
+	}
+	return MatchesTaskFields(task, lowerQuery)
+}
+
+// MatchesTaskFields is the unindexed field match used for search highlighting
+func MatchesTaskFields(task archon.Task, lowerQuery string) bool {
+	return strings.Contains(strings.ToLower(task.Title), lowerQuery) ||
+		strings.Contains(strings.ToLower(task.Status), lowerQuery) ||
+		(task.Feature != nil && strings.Contains(strings.ToLower(*task.Feature), lowerQuery)) ||
+		strings.Contains(strings.ToLower(task.ID), lowerQuery) ||
+		tagsMatch(task.Tags, lowerQuery)
+}
+
+// searchKey hashes the fields an entry is built from, so an entry is rebuilt
+// whenever one of them changes
+func searchKey(task *archon.Task) uint64 {
+	var h maphash.Hash
+	h.SetSeed(searchKeySeed)
+	write := func(field string) {
+		_, _ = h.WriteString(field)
+		_ = h.WriteByte(0) // Keeps "ab"+"c" apart from "a"+"bc"
+	}
+	write(task.Title)
+	write(task.Status)
+	write(task.ID)
+	if task.Feature != nil {
+		_ = h.WriteByte(1)
+		write(*task.Feature)
+	}
+	for _, tag := range task.Tags {
+		write(tag)
+	}
+	return h.Sum64()
+}
+
+// newSearchIndexEntry lowercases the searchable fields of a task once
+func newSearchIndexEntry(task *archon.Task, key uint64) searchIndexEntry {
+	title := strings.ToLower(task.Title)
+	feature := ""
+	if task.Feature != nil {
+		feature = strings.ToLower(*task.Feature)
+	}
+	tags := strings.ToLower(strings.Join(task.Tags, searchFieldSeparator))
+	text := strings.Join([]string{
+		title,
+		strings.ToLower(task.Status),
+		feature,
+		strings.ToLower(task.ID),
+		tags,
+	}, searchFieldSeparator)
+
+	return searchIndexEntry{
+		key: key,
+		// Title and tags are a prefix and suffix of text, so slicing shares memory instead of storing them twice
+		title: text[:len(title)],
+		tags:  text[len(text)-len(tags):],
+		text:  text,
+	}
+}
+
+// size approximates the memory held by an entry's text
+func (e searchIndexEntry) size() int {
+	return len(e.text)
+}
@@ -0,0 +1,202 @@
+package helpers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+func generateSearchTasks(count int) []archon.Task {
+	statuses := []string{archon.TaskStatusTodo, archon.TaskStatusDoing, archon.TaskStatusReview, archon.TaskStatusDone}
+	features := []string{"Auth", "Payments", "Search-UI", "Ünïcode"}
+	tags := [][]string{nil, {"Backend"}, {"needs-Review", "Backend"}}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tasks := make([]archon.Task, count)
+	for i := range tasks {
+		feature := features[i%len(features)]
+		tasks[i] = archon.Task{
+			ID:        fmt.Sprintf("task-%05d-ABCDEF", i),
+			Title:     fmt.Sprintf("Implement Feature %d for Module %d", i, i%37),
+			Status:    statuses[i%len(statuses)],
+			Feature:   &feature,
+			Tags:      tags[i%len(tags)],
+			UpdatedAt: archon.FlexibleTime{Time: base.Add(time.Duration(i) * time.Minute)},
+		}
+	}
+	return tasks
+}
+
+func TestSearchIndex_MatchesUnindexedPath(t *testing.T) {
+	tasks := generateSearchTasks(500)
+	idx := NewSearchIndex()
+	idx.Sync(tasks)
+
+	queries := []string{"feature 1", "MODULE 3", "  implement  ", "nothing-matches", "", "ü", "Feature 49", "backend", "REVIEW"}
+	for _, query := range queries {
+		wantIndices, wantTotal := SearchTasks(tasks, query)
+		gotIndices, gotTotal := idx.Search(tasks, query)
+		if gotTotal != wantTotal || !reflect.DeepEqual(gotIndices, wantIndices) {
+			t.Errorf("Query %q: indexed results %v (%d) differ from unindexed %v (%d)",
+				query, gotIndices, gotTotal, wantIndices, wantTotal)
+		}
+	}
+
+	if _, total := SearchTasks(tasks, "backend"); total != 333 {
+		t.Errorf("Expected tags to be searched, got %d matches for a tag on 333 tasks", total)
+	}
+
+	fieldQueries := []string{"doing", "payments", "task-0004", "ünïcode", "search-ui", "needs-review"}
+	for _, query := range fieldQueries {
+		for _, task := range tasks {
+			if idx.Matches(task, query) != MatchesTaskFields(task, query) {
+				t.Fatalf("Query %q: Matches differs from MatchesTaskFields for task %s", query, task.ID)
+			}
+		}
+	}
+}
+
+func TestSearchIndex_IncrementalSync(t *testing.T) {
+	tasks := generateSearchTasks(10)
+	idx := NewSearchIndex()
+
+	if updated := idx.Sync(tasks); updated != 10 {
+		t.Errorf("Expected 10 entries built on first sync, got %d", updated)
+	}
+	generation := idx.Generation()
+
+	// Unchanged refresh should not rebuild anything
+	if updated := idx.Sync(tasks); updated != 0 {
+		t.Errorf("Expected 0 entries rebuilt for unchanged tasks, got %d", updated)
+	}
+	if idx.Generation() != generation {
+		t.Error("Expected generation to stay the same when nothing changed")
+	}
+
+	// Only the task with a new updated_at is rebuilt
+	refreshed := append([]archon.Task(nil), tasks...)
+	refreshed[3].Title = "Renamed task"
+	refreshed[3].UpdatedAt = archon.FlexibleTime{Time: refreshed[3].UpdatedAt.Add(time.Hour)}
+	if updated := idx.Sync(refreshed); updated != 1 {
+		t.Errorf("Expected 1 entry rebuilt, got %d", updated)
+	}
+	if indices, _ := idx.Search(refreshed, "renamed"); !reflect.DeepEqual(indices, []int{3}) {
+		t.Errorf("Expected renamed task to match, got %v", indices)
+	}
+
+	// Edits keeping updated_at, or without one, are rebuilt too
+	refreshed[4].Tags = []string{"Frontend"}
+	refreshed[5].UpdatedAt = archon.FlexibleTime{}
+	refreshed[5].Title = "Untimed task"
+	if updated := idx.Sync(refreshed); updated != 2 {
+		t.Errorf("Expected 2 entries rebuilt, got %d", updated)
+	}
+	if indices, _ := idx.Search(refreshed, "frontend"); !reflect.DeepEqual(indices, []int{4}) {
+		t.Errorf("Expected the retagged task to match, got %v", indices)
+	}
+	if !idx.Matches(refreshed[5], "untimed") {
+		t.Error("Expected the task without updated_at to match its new title")
+	}
+
+	// Removed tasks are dropped from the index
+	idx.Sync(refreshed[:5])
+	if stats := idx.Stats(); stats.Entries != 5 {
+		t.Errorf("Expected 5 entries after removal, got %d", stats.Entries)
+	}
+
+	idx.Invalidate()
+	if stats := idx.Stats(); stats.Entries != 0 || stats.Bytes != 0 {
+		t.Errorf("Expected empty index after Invalidate, got %+v", stats)
+	}
+}
+
+func TestSearchIndex_UnindexedTaskFallsBack(t *testing.T) {
+	tasks := generateSearchTasks(3)
+	idx := NewSearchIndex()
+	idx.Sync(tasks)
+
+	// A task not synced yet is matched on its fields
+	added := append(tasks, archon.Task{ID: "late", Title: "Fresh title", Status: archon.TaskStatusTodo})
+	if indices, _ := idx.Search(added, "fresh"); !reflect.DeepEqual(indices, []int{3}) {
+		t.Errorf("Expected the unindexed task to match its fields, got %v", indices)
+	}
+	if !idx.Matches(added[3], "late") {
+		t.Error("Expected the unindexed task highlighted by its ID")
+	}
+}
+
+func TestSearchIndex_BoundedMemory(t *testing.T) {
+	tasks := generateSearchTasks(MaxSearchIndexEntries + 10)
+	idx := NewSearchIndex()
+	idx.Sync(tasks)
+
+	stats := idx.Stats()
+	if stats.Entries != MaxSearchIndexEntries {
+		t.Errorf("Expected index capped at %d entries, got %d", MaxSearchIndexEntries, stats.Entries)
+	}
+	if stats.Bytes <= 0 {
+		t.Error("Expected memory usage to be reported")
+	}
+
+	// Tasks past the cap are still found through the unindexed path
+	last := tasks[len(tasks)-1]
+	indices, _ := idx.Search(tasks, strings.ToLower(last.Title))
+	if len(indices) != 1 || indices[0] != len(tasks)-1 {
+		t.Errorf("Expected task past the cap to match, got %v", indices)
+	}
+}
+
+func TestSearchIndex_NilFallsBack(t *testing.T) {
+	var idx *SearchIndex
+	tasks := generateSearchTasks(5)
+
+	wantIndices, wantTotal := SearchTasks(tasks, "feature")
+	gotIndices, gotTotal := idx.Search(tasks, "feature")
+	if gotTotal != wantTotal || !reflect.DeepEqual(gotIndices, wantIndices) {
+		t.Errorf("Expected nil index to use unindexed search")
+	}
+	if !idx.Matches(tasks[0], "auth") {
+		t.Error("Expected nil index to match task fields")
+	}
+}
+
+// BenchmarkSearch compares indexed and unindexed search at the all-projects cap
+func BenchmarkSearch(b *testing.B) {
+	tasks := generateSearchTasks(MaxSearchIndexEntries)
+	idx := NewSearchIndex()
+	idx.Sync(tasks)
+
+	b.Run("Unindexed_20k", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SearchTasks(tasks, "Module 12")
+		}
+	})
+
+	b.Run("Indexed_20k", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			idx.Search(tasks, "Module 12")
+		}
+	})
+
+	b.Run("IndexedHighlight_20k", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range tasks {
+				idx.Matches(tasks[j], "payments")
+			}
+		}
+	})
+
+	b.Run("IncrementalSync_20k", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			idx.Sync(tasks)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package helpers
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultSnapshotBytes bounds the description text a snapshot keeps
+const DefaultSnapshotBytes = 32 << 10
+
+// DescriptionSnapshot is a task description as the user last saw it
+type DescriptionSnapshot struct {
+	Hash      [sha256.Size]byte // Of the whole description
+	Text      string            // The description, cut to the size bound
+	Truncated bool              // Text is only the start of the description
+}
+
+// DescriptionSnapshots remembers the descriptions of the tasks viewed this
+// session, so the details panel can tell when one changed since. It keeps at
+// most capacity tasks, dropping the least recently viewed; nothing is
+// persisted.
+//
+// Not safe for concurrent use; Bubble Tea calls Update from one goroutine.
+type DescriptionSnapshots struct {
+	capacity int
+	maxBytes int
+	order    *list.List // Of *snapshotEntry, most recently viewed first
+	entries  map[string]*list.Element
+}
+
+// snapshotEntry is one task's snapshot in the LRU list
+type snapshotEntry struct {
+	taskID   string
+	snapshot DescriptionSnapshot
+}
+
+// NewDescriptionSnapshots creates a store keeping capacity tasks (0 turns it
+// off) with texts cut to maxBytes (0 uses DefaultSnapshotBytes)
+func NewDescriptionSnapshots(capacity, maxBytes int) *DescriptionSnapshots {
+	if maxBytes <= 0 {
+		maxBytes = DefaultSnapshotBytes
+	}
+	return &DescriptionSnapshots{
+		capacity: max(capacity, 0),
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Enabled reports whether snapshots are kept at all
+func (s *DescriptionSnapshots) Enabled() bool {
+	return s != nil && s.capacity > 0
+}
+
+// Observe records that the user is viewing taskID with description. The first
+// view captures a snapshot; later views keep it and report whether the
+// description changed since.
+func (s *DescriptionSnapshots) Observe(taskID, description string) bool {
+	if !s.Enabled() || taskID == "" {
+		return false
+	}
+	if element, ok := s.entries[taskID]; ok {
+		s.order.MoveToFront(element)
+		return element.Value.(*snapshotEntry).snapshot.Hash != sha256.Sum256([]byte(description))
+	}
+	s.store(taskID, description)
+	return false
+}
+
+// Changed reports whether description differs from taskID's snapshot,
+// without touching the snapshot or its recency
+func (s *DescriptionSnapshots) Changed(taskID, description string) bool {
+	snapshot, ok := s.Get(taskID)
+	return ok && snapshot.Hash != sha256.Sum256([]byte(description))
+}
+
+// Get returns taskID's snapshot
+func (s *DescriptionSnapshots) Get(taskID string) (DescriptionSnapshot, bool) {
+	if s == nil {
+		return DescriptionSnapshot{}, false
+	}
+	element, ok := s.entries[taskID]
+	if !ok {
+		return DescriptionSnapshot{}, false
+	}
+	return element.Value.(*snapshotEntry).snapshot, true
+}
+
+// Acknowledge replaces taskID's snapshot with description, once the user has
+// seen what changed
+func (s *DescriptionSnapshots) Acknowledge(taskID, description string) {
+	if !s.Enabled() || taskID == "" {
+		return
+	}
+	if element, ok := s.entries[taskID]; ok {
+		s.order.Remove(element)
+		delete(s.entries, taskID)
+	}
+	s.store(taskID, description)
+}
+
+// Bound cuts description the way snapshots are cut, so a current description
+// can be compared with a truncated snapshot
+func (s *DescriptionSnapshots) Bound(description string) (text string, truncated bool) {
+	return truncateText(description, s.maxBytes)
+}
+
+// Len returns the number of tasks with a snapshot
+func (s *DescriptionSnapshots) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.order.Len()
+}
+
+// store adds a snapshot as the most recent one, evicting past the capacity
+func (s *DescriptionSnapshots) store(taskID, description string) {
+	text, truncated := truncateText(description, s.maxBytes)
+	s.entries[taskID] = s.order.PushFront(&snapshotEntry{
+		taskID: taskID,
+		snapshot: DescriptionSnapshot{
+			Hash:      sha256.Sum256([]byte(description)),
+			Text:      text,
+			Truncated: truncated,
+		},
+	})
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*snapshotEntry).taskID)
+	}
+}
+
+// truncateText cuts text to at most maxBytes, at the last line break when
+// there is one so diffs stay line-aligned, otherwise at a rune boundary
+func truncateText(text string, maxBytes int) (string, bool) {
+	if len(text) <= maxBytes {
+		return text, false
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	if newline := strings.LastIndexByte(text[:cut], '\n'); newline > 0 {
+		cut = newline + 1
+	}
+	return text[:cut], true
+}
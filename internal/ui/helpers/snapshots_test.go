@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescriptionSnapshotsLifecycle(t *testing.T) {
+	s := NewDescriptionSnapshots(10, 0)
+
+	// First view captures, later views compare
+	if s.Observe("1", "original") {
+		t.Error("Expected the first view not to report a change")
+	}
+	if s.Observe("1", "original") {
+		t.Error("Expected an unchanged description not to report a change")
+	}
+	if !s.Observe("1", "edited") || !s.Changed("1", "edited") {
+		t.Error("Expected the edited description to report a change")
+	}
+	if snapshot, _ := s.Get("1"); snapshot.Text != "original" {
+		t.Errorf("Expected viewing to keep the original snapshot, got %q", snapshot.Text)
+	}
+
+	// Acknowledging replaces the snapshot
+	s.Acknowledge("1", "edited")
+	if s.Observe("1", "edited") {
+		t.Error("Expected no change after acknowledging")
+	}
+	if snapshot, _ := s.Get("1"); snapshot.Text != "edited" {
+		t.Errorf("Expected the acknowledged text, got %q", snapshot.Text)
+	}
+	if s.Len() != 1 {
+		t.Errorf("Expected one snapshot, got %d", s.Len())
+	}
+}
+
+func TestDescriptionSnapshotsEvictLeastRecentlyViewed(t *testing.T) {
+	s := NewDescriptionSnapshots(2, 0)
+	s.Observe("a", "A")
+	s.Observe("b", "B")
+	s.Observe("a", "A") // a is now the most recent
+	s.Observe("c", "C")
+
+	if s.Len() != 2 {
+		t.Fatalf("Expected the cap of 2 snapshots, got %d", s.Len())
+	}
+	if _, ok := s.Get("b"); ok {
+		t.Error("Expected the least recently viewed task to be evicted")
+	}
+	for _, id := range []string{"a", "c"} {
+		if _, ok := s.Get(id); !ok {
+			t.Errorf("Expected %s to be kept", id)
+		}
+	}
+
+	// An evicted task starts over: its next view captures again
+	if s.Observe("b", "B changed") {
+		t.Error("Expected an evicted task to be captured again, not compared")
+	}
+}
+
+func TestDescriptionSnapshotsDisabled(t *testing.T) {
+	s := NewDescriptionSnapshots(0, 0)
+	if s.Enabled() || s.Observe("1", "x") || s.Observe("1", "y") || s.Len() != 0 {
+		t.Error("Expected a zero capacity to keep nothing")
+	}
+	var none *DescriptionSnapshots
+	if none.Enabled() || none.Observe("1", "x") || none.Changed("1", "x") {
+		t.Error("Expected a nil store to be a no-op")
+	}
+}
+
+func TestDescriptionSnapshotsTruncation(t *testing.T) {
+	s := NewDescriptionSnapshots(10, 16)
+
+	// Cut at the last line break within the bound
+	long := "first line\nsecond line\nthird line"
+	s.Observe("1", long)
+	snapshot, _ := s.Get("1")
+	if snapshot.Text != "first line\n" || !snapshot.Truncated {
+		t.Errorf("Expected a line-aligned prefix, got %q (truncated %v)", snapshot.Text, snapshot.Truncated)
+	}
+
+	// A change past the bound is still detected through the hash, although
+	// the bounded texts compare equal
+	edited := "first line\nsecond line\nfourth line"
+	if !s.Observe("1", edited) {
+		t.Error("Expected a change beyond the bound to be reported")
+	}
+	if current, truncated := s.Bound(edited); current != snapshot.Text || !truncated {
+		t.Errorf("Expected the current text bounded the same way, got %q", current)
+	}
+
+	// Without line breaks the cut stays on a rune boundary
+	text, truncated := s.Bound(strings.Repeat("é", 20))
+	if !truncated || text != strings.Repeat("é", 8) {
+		t.Errorf("Expected 8 whole runes, got %q", text)
+	}
+	if text, truncated := s.Bound("short"); text != "short" || truncated {
+		t.Errorf("Expected short text untouched, got %q", text)
+	}
+}
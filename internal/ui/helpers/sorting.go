@@ -1,6 +1,9 @@
 package helpers
 
 import (
+	"slices"
+	"time"
+
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
 )
@@ -11,7 +14,18 @@ type TaskFilters struct {
 	StatusFilters      map[string]bool
 	StatusFilterActive bool
 	FeatureFilters     map[string]bool
+	TagFilters         map[string]bool // Tags shown (nil = no tag filter)
 	ShowCompletedTasks bool
+	CreatedSince       *time.Time      // Only tasks created at or after this time (nil = no limit)
+	Hidden             map[string]bool // Task IDs never shown (done tasks hidden with X)
+	MinPriority        int             // Only tasks with at least this priority (task_order; 0 = all)
+	OnlyTasks          map[string]bool // Only these task IDs (nil = no restriction)
+
+	// Not a filter: local last-interaction times that order sorting.SortRecent
+	LastInteraction map[string]time.Time
+
+	// Not a filter: status precedence of the status+priority order (nil = sorting.DefaultStatusOrder)
+	StatusOrder []string
 }
 
 // FilterAndSortTasks applies all filters and sorts tasks
@@ -21,7 +35,15 @@ func FilterAndSortTasks(tasks []archon.Task, sortMode int, filters TaskFilters)
 	filteredTasks = applyProjectFilter(filteredTasks, filters.ProjectID)
 	filteredTasks = applyStatusFilter(filteredTasks, filters)
 	filteredTasks = applyFeatureFilter(filteredTasks, filters.FeatureFilters)
-	return sorting.SortTasks(filteredTasks, sortMode)
+	filteredTasks = applyTagFilter(filteredTasks, filters.TagFilters)
+	filteredTasks = applyCreatedFilter(filteredTasks, filters.CreatedSince)
+	filteredTasks = applyHiddenFilter(filteredTasks, filters.Hidden)
+	filteredTasks = applyMinPriorityFilter(filteredTasks, filters.MinPriority)
+	filteredTasks = applyOnlyTasksFilter(filteredTasks, filters.OnlyTasks)
+	if sortMode == sorting.SortRecent {
+		return sorting.SortTasksByInteraction(filteredTasks, filters.LastInteraction, filters.StatusOrder)
+	}
+	return sorting.SortTasksInStatusOrder(filteredTasks, sortMode, filters.StatusOrder)
 }
 
 // applyProjectFilter filters tasks by project ID
@@ -89,3 +111,79 @@ func applyFeatureFilter(tasks []archon.Task, featureFilters map[string]bool) []a
 	}
 	return filtered
 }
+
+// applyTagFilter filters tasks by tag, with the same three states as the feature filter.
+// A task is shown when any of its tags is selected; untagged tasks are always shown.
+func applyTagFilter(tasks []archon.Task, tagFilters map[string]bool) []archon.Task {
+	if tagFilters == nil {
+		return tasks
+	}
+
+	filtered := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if len(task.Tags) == 0 || slices.ContainsFunc(task.Tags, func(tag string) bool { return tagFilters[tag] }) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// applyCreatedFilter keeps tasks created at or after since
+func applyCreatedFilter(tasks []archon.Task, since *time.Time) []archon.Task {
+	if since == nil {
+		return tasks
+	}
+
+	filtered := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if !task.CreatedAt.Before(*since) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// applyHiddenFilter drops hidden tasks
+func applyHiddenFilter(tasks []archon.Task, hidden map[string]bool) []archon.Task {
+	if len(hidden) == 0 {
+		return tasks
+	}
+
+	filtered := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if !hidden[task.ID] {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// applyMinPriorityFilter drops tasks below minPriority
+func applyMinPriorityFilter(tasks []archon.Task, minPriority int) []archon.Task {
+	if minPriority <= 0 {
+		return tasks
+	}
+
+	filtered := make([]archon.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.TaskOrder >= minPriority {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// applyOnlyTasksFilter keeps the tasks in only
+func applyOnlyTasksFilter(tasks []archon.Task, only map[string]bool) []archon.Task {
+	if only == nil {
+		return tasks
+	}
+
+	filtered := make([]archon.Task, 0, len(only))
+	for _, task := range tasks {
+		if only[task.ID] {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"sort"
+
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+)
+
+// GetUniqueTags returns a sorted list of unique tags from tasks
+func GetUniqueTags(tasks []archon.Task) []string {
+	tagSet := make(map[string]bool)
+	for _, task := range tasks {
+		for _, tag := range task.Tags {
+			tagSet[tag] = true
+		}
+	}
+
+	tags := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// CountTasksByTag returns the number of tasks carrying each tag
+func CountTasksByTag(tasks []archon.Task) map[string]int {
+	counts := make(map[string]int)
+	for _, task := range tasks {
+		for _, tag := range task.Tags {
+			counts[tag]++
+		}
+	}
+	return counts
+}
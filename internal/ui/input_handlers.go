@@ -1,14 +1,24 @@
 package ui
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
-	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/bookmarklist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/help"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/projectlist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 )
 
@@ -115,6 +125,16 @@ func (m *MainModel) handleKeyPress(key string) tea.Cmd {
 		}
 	}
 
+	// Any key other than a second q cancels a pending double-press quit
+	if m.quitArmed && key != keys.KeyQ {
+		m.quitArmed = false
+	}
+
+	// Slot key completing a bookmark sequence ('m' or "'"), before any single-key binding
+	if m.bookmarkPrefix != "" {
+		return m.handleBookmarkSlotKey(key)
+	}
+
 	// 4. Application-level keys (work across all modes)
 	if cmd, handled := m.handleApplicationKey(key); handled {
 		return cmd
@@ -155,7 +175,7 @@ func (m *MainModel) handleProjectModeKeys(key string) tea.Cmd {
 	switch key {
 	case "q", keys.KeyEscape:
 		// Exit project mode - these are the only keys that should exit
-		return func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{ShouldLoadTasks: false} }
+		return func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{Canceled: true} }
 
 	case keys.KeyJ, keys.KeyArrowDown:
 		// Navigate down - route based on active panel
@@ -310,6 +330,12 @@ func (m *MainModel) handleApplicationKey(key string) (tea.Cmd, bool) {
 		return m.handleProjectModeKey(key)
 	case keys.KeyA:
 		return m.handleShowAllTasksKey(key)
+	case keys.KeyACap:
+		return m.handleAwayDigestKey(key)
+	case keys.KeyUCap:
+		return m.handleUnblockReportKey(key)
+	case keys.KeyBang:
+		return m.handleToggleHintsKey(key)
 	case keys.KeyEscape:
 		return m.handleEscapeKey(key)
 	case keys.KeyEnter:
@@ -347,6 +373,20 @@ func (m *MainModel) handleNavigationKey(key string) (tea.Cmd, bool) {
 		return m.handleHalfPageUpKey(key)
 	case keys.KeyCtrlD, keys.KeyPgDn:
 		return m.handleHalfPageDownKey(key)
+	case keys.KeyBracketLeft:
+		return m.handleGoToParentKey(key)
+	case keys.KeyBracketRight:
+		return m.handleGoToFirstChildKey(key)
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		return m.handleNumberKey(key)
+	case keys.KeyM:
+		return m.handleSetBookmarkKey(key)
+	case keys.KeyApostrophe:
+		return m.handleJumpBookmarkKey(key)
+	case keys.KeyMCap:
+		return m.handleListBookmarksKey(key)
+	case keys.KeyCtrlG:
+		return m.handleJumpClipboardKey(key)
 	default:
 		return nil, false
 	}
@@ -373,21 +413,68 @@ func (m *MainModel) handleSearchKey(key string) (tea.Cmd, bool) {
 func (m *MainModel) handleTaskKey(key string) (tea.Cmd, bool) {
 	switch key {
 	case keys.KeyT:
+		if len(m.programContext.MarkedTasks) > 0 {
+			return m.handleBulkStatusKey(key)
+		}
 		return m.handleTaskStatusChangeKey(key)
+	case keys.KeyV:
+		return m.handleVisualModeKey(key)
 	case keys.KeyE:
 		return m.handleTaskEditKey(key)
+	case keys.KeyI:
+		return m.handleTaskCreateKey(key)
+	case keys.KeyECap:
+		return m.handleScratchpadKey(key)
 	case keys.KeyD:
 		return m.handleTaskDeleteKey(key)
+	case keys.KeyCtrlZ:
+		return m.handleUndoKey(key)
 	case keys.KeyY:
 		return m.handleTaskIDCopyKey(key)
 	case keys.KeyYCap:
 		return m.handleTaskTitleCopyKey(key)
+	case keys.KeyC:
+		return m.handleTaskCommitRefCopyKey(key)
+	case keys.KeyB:
+		return m.handleTaskPathCopyKey(key)
+	case keys.KeyCCap:
+		return m.handleCopyCommandKey(key)
+	case keys.KeyO:
+		return m.handleOpenLinkKey(key)
 	case keys.KeyF:
 		return m.handleFeatureSelectionKey(key)
+	case keys.KeyFCap:
+		return m.handleQuickFeatureFilterKey(key)
+	case keys.KeyCtrlT:
+		return m.handleTagSelectionKey(key)
+	case keys.KeyTCap:
+		return m.handleCreatedTodayKey(key)
+	case keys.KeyX:
+		return m.handleToggleDescriptionKey(key)
+	case keys.KeyXCap:
+		return m.handleArchiveDoneKey(key)
+	case keys.KeyDCap:
+		return m.handleDescriptionDiffKey(key)
+	case keys.KeyRCap:
+		return m.handleReloadTaskKey(key)
+	case keys.KeyW:
+		return m.handleSprintFilterKey(key)
+	case keys.KeyWCap:
+		return m.handleSprintOverviewKey(key)
 	case keys.KeyS:
 		return m.handleSortModeKey(key)
 	case keys.KeySCap:
 		return m.handleSortModePreviousKey(key)
+	case keys.KeyHash:
+		return m.handleToggleCountKey(key)
+	case keys.KeyPCap:
+		return m.handleProjectLabelsKey(key)
+	case keys.KeyZ:
+		return m.handleToggleDetailsKey(key)
+	case keys.KeyPlus, keys.KeyMinus:
+		return m.handleMinPriorityKey(key)
+	case keys.KeyU:
+		return m.handleChangedOnlyKey(key)
 	default:
 		return nil, false
 	}
@@ -425,21 +512,74 @@ func (m *MainModel) handleQuitKey(key string) (tea.Cmd, bool) {
 			return func() tea.Msg { return help.HideHelpModalMsg{} }, true
 		case m.components.Modals.StatusModel.IsActive():
 			return func() tea.Msg { return status.HideStatusModalMsg{} }, true
+		case m.components.Modals.LinkPickerModel.IsActive():
+			return func() tea.Msg { return linkpicker.HideLinkPickerModalMsg{} }, true
+		case m.components.Modals.DigestModel.IsActive():
+			return func() tea.Msg { return digest.HideDigestModalMsg{} }, true
+		case m.components.Modals.BookmarkListModel.IsActive():
+			return func() tea.Msg { return bookmarklist.HideBookmarkListModalMsg{} }, true
+		case m.components.Modals.UnblockModel.IsActive():
+			return func() tea.Msg { return unblock.HideUnblockModalMsg{} }, true
+		case m.components.Modals.APIKeyModel.IsActive():
+			return func() tea.Msg { return apikey.HideAPIKeyModalMsg{} }, true
+		case m.components.Modals.DescDiffModel.IsActive():
+			return func() tea.Msg { return descdiff.HideDescDiffModalMsg{} }, true
 		case m.uiState.IsProjectView():
 			// Use message-based approach to deactivate project mode (no task loading needed)
-			return func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{ShouldLoadTasks: false} }, true
+			return func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{Canceled: true} }, true
 		case m.components.Modals.TaskEditModel.IsActive():
 			m.components.Modals.TaskEditModel.SetActive(false)
 			return nil, true
 		default:
 			return nil, true
 		}
-	} else {
-		// No modal active, show quit confirmation
+	}
+
+	// Nothing to close: quit as configured
+	switch m.programContext.Config.GetQuitBehavior() {
+	case configpkg.QuitImmediate:
+		return m.quitCmd(), true
+	case configpkg.QuitDoublePress:
+		return m.handleDoublePressQuit(), true
+	default:
 		return m.showQuitConfirmation(), true
 	}
 }
 
+// quitPressWindow is how long a first 'q' waits for the second (quit_behavior: double_press)
+const quitPressWindow = 1500 * time.Millisecond
+
+// quitWindowExpiredMsg disarms a first 'q' that was not followed by a second in time
+type quitWindowExpiredMsg struct {
+	generation int
+}
+
+// handleDoublePressQuit quits on the second 'q' within quitPressWindow, else arms and hints
+func (m *MainModel) handleDoublePressQuit() tea.Cmd {
+	if m.quitArmed {
+		m.quitArmed = false
+		return m.quitCmd()
+	}
+
+	m.quitArmed = true
+	m.quitGeneration++
+	generation := m.quitGeneration
+	return tea.Batch(
+		statusFeedback("Press q again to quit"),
+		tea.Tick(quitPressWindow, func(time.Time) tea.Msg {
+			return quitWindowExpiredMsg{generation: generation}
+		}),
+	)
+}
+
+// handleQuitWindowExpired disarms the pending quit unless a newer press re-armed it
+func (m *MainModel) handleQuitWindowExpired(msg quitWindowExpiredMsg) tea.Cmd {
+	if msg.generation == m.quitGeneration {
+		m.quitArmed = false
+	}
+	return nil
+}
+
 // HandleEmergencyQuitKey handles 'ctrl+c' key - emergency quit bypassing modals
 func (m *MainModel) handleEmergencyQuitKey(key string) (tea.Cmd, bool) {
 	if key == keys.KeyCtrlC {
@@ -453,6 +593,7 @@ func (m *MainModel) handleEmergencyQuitKey(key string) (tea.Cmd, bool) {
 //
 //nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
 func (m *MainModel) handleRefreshKey(key string) (tea.Cmd, bool) {
+	m.apiKeyPromptDismissed = false // Ask again if the key is still rejected
 	var cmds []tea.Cmd
 	if m.programContext.Error != "" {
 		// Retry last failed operation
@@ -468,7 +609,11 @@ func (m *MainModel) handleRefreshKey(key string) (tea.Cmd, bool) {
 			cmds = append(cmds, cmd)
 		}
 	}
-	cmds = append(cmds, projects.RefreshDataInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID))
+	// 'r' asks for what the server has now, not what the cache kept
+	if cacher, ok := m.programContext.ArchonClient.(interfaces.ResponseCacher); ok {
+		cacher.InvalidateCache()
+	}
+	cmds = append(cmds, m.requestTaskReload(helpers.RefreshManual), m.loadProjectsCmd())
 	return tea.Batch(cmds...), true
 }
 
@@ -485,14 +630,16 @@ func (m *MainModel) handleProjectModeKey(key string) (tea.Cmd, bool) {
 }
 
 // HandleShowAllTasksKey handles 'a' key - show all tasks
+// With ui.display.show_all_behavior "toggle", pressing 'a' on All Tasks returns to the remembered project
 //
 //nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
 func (m *MainModel) handleShowAllTasksKey(key string) (tea.Cmd, bool) {
 	// Show all tasks - works from any mode
-	m.setSelectedProject(nil)
+	target, loadingMessage := m.showAllTarget()
+	m.setSelectedProject(target)
 
 	var cmds []tea.Cmd
-	if cmd := m.setLoadingWithMessage(true, "Loading all tasks..."); cmd != nil {
+	if cmd := m.setLoadingWithMessage(true, loadingMessage); cmd != nil {
 		cmds = append(cmds, cmd)
 	}
 
@@ -503,15 +650,57 @@ func (m *MainModel) handleShowAllTasksKey(key string) (tea.Cmd, bool) {
 	return tea.Batch(cmds...), true
 }
 
+// HandleToggleHintsKey handles '!' key - show or hide the status bar shortcut hints
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleToggleHintsKey(key string) (tea.Cmd, bool) {
+	m.uiState.HideShortcutHints = !m.uiState.HideShortcutHints
+	if m.uiState.HideShortcutHints {
+		return statusFeedback("Shortcut hints hidden (! shows them again)"), true
+	}
+	return statusFeedback("Shortcut hints shown"), true
+}
+
+// showAllTarget decides which project 'a' switches to, remembering the project left in toggle mode
+func (m *MainModel) showAllTarget() (*string, string) {
+	current := m.programContext.SelectedProjectID
+	if m.programContext.Config == nil || m.programContext.Config.GetShowAllBehavior() != configpkg.ShowAllToggle {
+		m.showAllReturnProjectID = nil
+		return nil, "Loading all tasks..."
+	}
+
+	if current != nil {
+		id := *current
+		m.showAllReturnProjectID = &id
+		return nil, "Loading all tasks..."
+	}
+
+	// Already on All Tasks: return to the remembered project if it still exists
+	previous := m.showAllReturnProjectID
+	m.showAllReturnProjectID = nil
+	if previous == nil || !m.projectExists(*previous) {
+		return nil, "Loading all tasks..."
+	}
+	return previous, "Returning to project tasks..."
+}
+
 // HandleEscapeKey handles 'esc' key - general escape/cancel
 //
 //nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
 func (m *MainModel) handleEscapeKey(key string) (tea.Cmd, bool) {
 	if m.uiState.IsProjectView() {
 		// Use message-based approach to deactivate project mode (no task loading needed)
-		cmd := func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{ShouldLoadTasks: false} }
+		cmd := func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{Canceled: true} }
 		return cmd, true
 	}
+	if len(m.programContext.MarkedTasks) > 0 || m.visual != nil {
+		m.clearMarks()
+		return statusFeedback("Marks cleared"), true
+	}
+	if m.programContext.ChangedOnly {
+		m.setChangedOnly(false)
+		return statusFeedback("Showing all tasks"), true
+	}
 	return nil, false // Not handled in other contexts
 }
 
@@ -533,6 +722,11 @@ func (m *MainModel) handleConfirmKey(key string) (tea.Cmd, bool) {
 
 		return tea.Batch(cmds...), true
 	}
+
+	// Enter opens the selected task's details (details_panel "manual")
+	if m.uiState.IsTaskView() && m.programContext.Config.GetDetailsPanel() == configpkg.DetailsManual && m.GetSelectedTask() != nil {
+		return m.openDetails(), true
+	}
 	return nil, false // Not handled in other contexts
 }
 
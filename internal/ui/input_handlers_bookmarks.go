@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/bookmarklist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
+)
+
+// =============================================================================
+// BOOKMARKS
+// =============================================================================
+// Vim-style marks: 'm' + slot bookmarks the selected task, "'" + slot jumps
+// back to it, 'M' lists all bookmarks. Slots are a-z and 0-9.
+
+// loadBookmarks opens the bookmark store and reads saved bookmarks.
+// Bookmarks still work for the session when the file cannot be read.
+func loadBookmarks(logger interfaces.Logger) (*bookmarks.Store, bookmarks.Set) {
+	path, err := bookmarks.DefaultPath()
+	if err != nil {
+		logger.Warn("Bookmarks will not be saved", "error", err)
+		return nil, bookmarks.Set{}
+	}
+
+	store := bookmarks.NewStore(path)
+	set, err := store.Load()
+	if err != nil {
+		logger.Warn("Ignoring saved bookmarks", "path", path, "error", err)
+		return store, bookmarks.Set{}
+	}
+	return store, set
+}
+
+// HandleSetBookmarkKey handles 'm' key - wait for the slot to bookmark the selected task in
+func (m *MainModel) handleSetBookmarkKey(key string) (tea.Cmd, bool) {
+	if m.GetSelectedTask() == nil {
+		return statusFeedback("No task selected to bookmark"), true
+	}
+	m.bookmarkPrefix = key
+	return statusFeedback("Bookmark slot? (a-z, 0-9)"), true
+}
+
+// HandleJumpBookmarkKey handles "'" key - wait for the slot to jump to
+func (m *MainModel) handleJumpBookmarkKey(key string) (tea.Cmd, bool) {
+	if len(m.bookmarks) == 0 {
+		return statusFeedback("No bookmarks set - press m then a slot to add one"), true
+	}
+	m.bookmarkPrefix = key
+	return statusFeedback("Jump to bookmark? (a-z, 0-9)"), true
+}
+
+// HandleListBookmarksKey handles 'M' key - show the bookmark list
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleListBookmarksKey(key string) (tea.Cmd, bool) {
+	entries := m.bookmarks.Entries()
+	return func() tea.Msg {
+		return bookmarklist.ShowBookmarkListModalMsg{Entries: entries}
+	}, true
+}
+
+// handleBookmarkSlotKey completes a pending 'm' or "'" with the slot key
+func (m *MainModel) handleBookmarkSlotKey(key string) tea.Cmd {
+	prefix := m.bookmarkPrefix
+	m.bookmarkPrefix = ""
+
+	if key == keys.KeyEscape {
+		return nil
+	}
+	if !bookmarks.ValidSlot(key) {
+		return statusFeedback(fmt.Sprintf("Not a bookmark slot: %s (use a-z or 0-9)", key))
+	}
+	if prefix == keys.KeyM {
+		return m.setBookmark(key)
+	}
+	return m.jumpToBookmark(key)
+}
+
+// setBookmark stores the selected task in slot, replacing any previous bookmark there
+func (m *MainModel) setBookmark(slot string) tea.Cmd {
+	task := m.GetSelectedTask()
+	if task == nil {
+		return statusFeedback("No task selected to bookmark")
+	}
+
+	m.bookmarks[slot] = bookmarks.Bookmark{TaskID: task.ID, ProjectID: task.ProjectID, Title: task.Title}
+	return tea.Batch(
+		m.saveBookmarksCmd(),
+		statusFeedback(fmt.Sprintf("Bookmarked %q in '%s", task.Title, slot)),
+	)
+}
+
+// clearBookmark removes the bookmark in slot
+func (m *MainModel) clearBookmark(slot string) tea.Cmd {
+	if _, ok := m.bookmarks[slot]; !ok {
+		return nil
+	}
+	delete(m.bookmarks, slot)
+	return tea.Batch(
+		m.saveBookmarksCmd(),
+		statusFeedback(fmt.Sprintf("Removed bookmark '%s", slot)),
+	)
+}
+
+// saveBookmarksCmd writes a copy of the bookmarks in the background
+func (m *MainModel) saveBookmarksCmd() tea.Cmd {
+	store, logger := m.bookmarkStore, m.programContext.Logger
+	if store == nil {
+		return nil
+	}
+	set := make(bookmarks.Set, len(m.bookmarks))
+	for slot, bookmark := range m.bookmarks {
+		set[slot] = bookmark
+	}
+	return func() tea.Msg {
+		if err := store.Save(set); err != nil {
+			logger.Warn("Failed to save bookmarks", "error", err)
+		}
+		return nil
+	}
+}
+
+// jumpToBookmark selects the task in slot, clearing filters or switching
+// project when needed to reveal it
+func (m *MainModel) jumpToBookmark(slot string) tea.Cmd {
+	bookmark, ok := m.bookmarks[slot]
+	if !ok {
+		return statusFeedback(fmt.Sprintf("No bookmark in '%s", slot))
+	}
+
+	if index, ok := m.sortedTaskIndex(bookmark.TaskID); ok {
+		return m.setSelectedTask(index)
+	}
+
+	task := m.programContext.FindTask(bookmark.TaskID)
+	projectID := bookmark.ProjectID
+	if task != nil {
+		projectID = task.ProjectID
+	}
+
+	// Another project is selected: switch to the bookmark's project and select once loaded
+	if current := m.programContext.SelectedProjectID; current != nil && *current != projectID && m.projectExists(projectID) {
+		m.setSelectedProject(&projectID)
+		m.pendingBookmarkTaskID = bookmark.TaskID
+		return tea.Batch(
+			m.setLoadingWithMessage(true, "Loading bookmarked task..."),
+			m.requestTaskReload(helpers.RefreshNavigation),
+		)
+	}
+
+	if task == nil {
+		return statusFeedback(fmt.Sprintf("Bookmarked task %q no longer exists", bookmark.Title))
+	}
+
+	m.revealTask(*task)
+	m.findAndSelectTask(task.ID)
+	return statusFeedback("Filters cleared to show bookmarked task")
+}
+
+// finishBookmarkJump selects the bookmarked task once its project's tasks are loaded
+func (m *MainModel) finishBookmarkJump() tea.Cmd {
+	taskID := m.pendingBookmarkTaskID
+	if taskID == "" {
+		return nil
+	}
+	m.pendingBookmarkTaskID = ""
+
+	task := m.programContext.FindTask(taskID)
+	if task == nil {
+		return statusFeedback("Bookmarked task no longer exists")
+	}
+	if _, ok := m.sortedTaskIndex(taskID); !ok {
+		m.revealTask(*task)
+	}
+	m.findAndSelectTask(taskID)
+	return nil
+}
+
+// revealTask clears the client-side filters that hide task
+func (m *MainModel) revealTask(task archon.Task) {
+	ctx := m.programContext
+	if ctx.StatusFilterActive && !ctx.IsStatusVisible(task.Status) {
+		ctx.ResetStatusFilters()
+	}
+	if !ctx.StatusFilterActive && !ctx.ShowCompletedTasks && task.Status == archon.TaskStatusDone {
+		ctx.SetShowCompletedTasks(true)
+	}
+	if task.Feature != nil && *task.Feature != "" && ctx.FeatureFilters != nil && !ctx.FeatureFilters[*task.Feature] {
+		ctx.ResetFeatureFilters()
+	}
+	if ctx.CreatedSince != nil && task.CreatedAt.Before(*ctx.CreatedSince) {
+		ctx.CreatedSince = nil
+		if ctx.SortMode == sorting.SortTimeCreated {
+			ctx.SetSortMode(m.createdTodayPreviousSort)
+		}
+	}
+	if task.TaskOrder < ctx.MinPriority {
+		ctx.MinPriority = 0
+	}
+	if ctx.ChangedOnly && !ctx.RefreshChanges[task.ID] {
+		ctx.ChangedOnly = false
+	}
+	m.refreshUIAfterFilterChange()
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+)
+
+// =============================================================================
+// JUMP TO COPIED TASK
+// =============================================================================
+// ctrl+g reads the clipboard and selects the task it names: a raw task ID, a
+// task URL (the ID follows a "tasks" path segment or sits in a task/id query
+// parameter), or a commit reference copied with 'c' whose short ID starts a
+// loaded task's ID. Tasks that are not loaded are fetched with GetTask; the
+// project or filters hiding the task are switched or cleared as for bookmarks.
+
+var (
+	// taskIDPattern is what a task ID may look like; anything else is not looked up
+	taskIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	// commitRefPattern matches the default commit reference, "[ShortID] Title"
+	commitRefPattern = regexp.MustCompile(`^\[([A-Za-z0-9_-]+)\]`)
+
+	// uuidPattern finds Archon's UUID task IDs inside longer text
+	uuidPattern = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+)
+
+// clipboardReadMsg carries the clipboard text read for a jump
+type clipboardReadMsg struct {
+	text string
+	err  error
+}
+
+// HandleJumpClipboardKey handles ctrl+g - read the clipboard in the background
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleJumpClipboardKey(key string) (tea.Cmd, bool) {
+	cb := m.clipboard
+	return func() tea.Msg {
+		text, err := cb.Read()
+		return clipboardReadMsg{text: text, err: err}
+	}, true
+}
+
+// handleClipboardRead jumps to the task named by the clipboard text,
+// fetching it when it is not loaded
+func (m *MainModel) handleClipboardRead(msg clipboardReadMsg) tea.Cmd {
+	if msg.err != nil {
+		return statusFeedback(fmt.Sprintf("Cannot read the clipboard: %v", msg.err))
+	}
+	ref := taskRefFromText(msg.text)
+	if ref == "" {
+		return statusFeedback("No task ID or task URL on the clipboard")
+	}
+
+	if task := m.programContext.FindTask(ref); task != nil {
+		return m.jumpToTask(*task)
+	}
+	switch matches := m.tasksWithIDPrefix(ref); len(matches) {
+	case 0:
+	case 1:
+		return m.jumpToTask(matches[0])
+	default:
+		return statusFeedback(fmt.Sprintf("%s start with %s - copy the full ID", pluralTasks(len(matches)), ref))
+	}
+
+	m.pendingJumpTaskID = ref
+	return tea.Batch(
+		statusFeedback("Fetching task "+ref+"..."),
+		tasks.ReloadTask(m.programContext.ArchonClient, ref),
+	)
+}
+
+// tasksWithIDPrefix returns the loaded tasks whose ID starts with prefix
+func (m *MainModel) tasksWithIDPrefix(prefix string) []archon.Task {
+	var matches []archon.Task
+	for _, task := range m.programContext.Tasks {
+		if strings.HasPrefix(task.ID, prefix) {
+			matches = append(matches, task)
+		}
+	}
+	return matches
+}
+
+// jumpToTask selects task, switching to its project and clearing the
+// client-side filters that hide it
+func (m *MainModel) jumpToTask(task archon.Task) tea.Cmd {
+	if index, ok := m.sortedTaskIndex(task.ID); ok {
+		return tea.Batch(m.setSelectedTask(index), statusFeedback("Jumped to "+task.Title))
+	}
+
+	if current := m.programContext.SelectedProjectID; current != nil && *current != task.ProjectID {
+		if m.projectExists(task.ProjectID) {
+			projectID := task.ProjectID
+			m.setSelectedProject(&projectID)
+		} else {
+			m.setSelectedProject(nil)
+		}
+	}
+	m.revealTask(task)
+	if _, ok := m.sortedTaskIndex(task.ID); !ok {
+		return statusFeedback(fmt.Sprintf("%q is hidden by the search - clear it to see the task", task.Title))
+	}
+	m.findAndSelectTask(task.ID)
+	return statusFeedback("Jumped to " + task.Title)
+}
+
+// finishClipboardJump selects a task fetched for a clipboard jump, or says
+// why it could not be fetched. handled is false for other reloads.
+func (m *MainModel) finishClipboardJump(msg tasks.TaskReloadedMsg) (cmd tea.Cmd, handled bool) {
+	if msg.TaskID == "" || msg.TaskID != m.pendingJumpTaskID {
+		return nil, false
+	}
+	m.pendingJumpTaskID = ""
+
+	if msg.Error != nil {
+		if errors.Is(msg.Error, archon.ErrTaskNotFound) {
+			return statusFeedback("No task with ID " + msg.TaskID), true
+		}
+		m.programContext.Logger.Warn("Clipboard jump fetch failed", "task_id", msg.TaskID, "error", msg.Error)
+		return statusFeedback("Failed to fetch task: " + msg.Error.Error()), true
+	}
+
+	merged := m.handleTaskReloaded(msg)
+	task := m.programContext.FindTask(msg.TaskID)
+	if task == nil {
+		return merged, true // Dropped as invalid; merged carries the warning
+	}
+	return m.jumpToTask(*task), true
+}
+
+// taskRefFromText extracts a task ID, or a short ID prefix, from clipboard
+// text. It returns "" when the text names no task.
+func taskRefFromText(text string) string {
+	text = strings.TrimSpace(text)
+	if match := commitRefPattern.FindStringSubmatch(text); match != nil {
+		return match[1]
+	}
+	if u, err := url.Parse(text); err == nil && u.Scheme != "" && u.Host != "" {
+		text = taskIDFromURL(u)
+	} else if strings.ContainsAny(text, " \t\r\n") {
+		text = uuidPattern.FindString(text)
+	}
+	if !taskIDPattern.MatchString(text) {
+		return ""
+	}
+	return text
+}
+
+// taskIDFromURL finds the task ID in a task URL: the path segment after
+// "tasks", a task, task_id or id query parameter, or a UUID in the path
+func taskIDFromURL(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "tasks" && segments[i+1] != "" {
+			return segments[i+1]
+		}
+	}
+	query := u.Query()
+	for _, key := range []string{"task", "task_id", "id"} {
+		if id := query.Get(key); id != "" {
+			return id
+		}
+	}
+	return uuidPattern.FindString(u.Path)
+}
@@ -1,12 +1,17 @@
 package ui
 
 import (
+	"fmt"
+	"strconv"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/viewport"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/projectlist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/taskdetails"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/tasklist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 )
 
 // =============================================================================
@@ -36,7 +41,7 @@ func (m *MainModel) handleDownNavigationKey(key string) (tea.Cmd, bool) {
 func (m *MainModel) handleLeftNavigationKey(key string) (tea.Cmd, bool) {
 	if m.uiState.IsProjectView() {
 		// In project mode, h goes back - use message-based approach (no task loading needed)
-		cmd := func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{ShouldLoadTasks: false} }
+		cmd := func() tea.Msg { return projectmode.ProjectModeDeactivatedMsg{Canceled: true} }
 		return cmd, true
 	} else {
 		// In task view mode, h switches to left panel
@@ -169,6 +174,66 @@ func (m *MainModel) handleDownNavigation() tea.Cmd {
 	return nil
 }
 
+// HandleGoToParentKey handles '[' key - select the parent of the selected task
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleGoToParentKey(key string) (tea.Cmd, bool) {
+	if m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	selected := m.GetSelectedTask()
+	if selected == nil {
+		return statusFeedback("No task selected"), true
+	}
+	if selected.ParentTaskID == nil || *selected.ParentTaskID == "" {
+		return statusFeedback("Task has no parent"), true
+	}
+
+	parentID := *selected.ParentTaskID
+	if index, ok := m.sortedTaskIndex(parentID); ok {
+		return m.setSelectedTask(index), true
+	}
+	if m.programContext.FindTask(parentID) != nil {
+		return statusFeedback("Parent task is hidden by current filters"), true
+	}
+	return statusFeedback("Parent task is not loaded"), true
+}
+
+// HandleGoToFirstChildKey handles ']' key - select the first child of the selected task
+// "First" follows the current sort order, so the jump lands on the child shown highest in the list
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleGoToFirstChildKey(key string) (tea.Cmd, bool) {
+	if m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	selected := m.GetSelectedTask()
+	if selected == nil {
+		return statusFeedback("No task selected"), true
+	}
+
+	for i, task := range m.GetSortedTasks() {
+		if task.ParentTaskID != nil && *task.ParentTaskID == selected.ID {
+			return m.setSelectedTask(i), true
+		}
+	}
+	for _, task := range m.programContext.Tasks {
+		if task.ParentTaskID != nil && *task.ParentTaskID == selected.ID {
+			return statusFeedback("Child tasks are hidden by current filters"), true
+		}
+	}
+	return statusFeedback("Task has no children"), true
+}
+
+// statusFeedback returns a command that shows message in the status bar
+func statusFeedback(message string) tea.Cmd {
+	return func() tea.Msg {
+		return messages.StatusFeedbackMsg{Message: message}
+	}
+}
+
 // handleJumpToFirst handles 'gg' key - jump to first item in active panel
 func (m *MainModel) handleJumpToFirst() tea.Cmd {
 	if m.uiState.IsProjectView() {
@@ -306,3 +371,30 @@ func (m MainModel) getTaskListHalfPageSize() int {
 	}
 	return halfPage
 }
+
+// HandleNumberKey handles '1'-'9' - select the Nth visible task, or the task
+// bookmarked in that slot. Opt-in via ui.display.number_key_behavior; when off
+// the key is left unhandled
+func (m *MainModel) handleNumberKey(key string) (tea.Cmd, bool) {
+	if m.uiState.IsProjectView() || m.programContext.Config == nil {
+		return nil, false
+	}
+	behavior := m.programContext.Config.GetNumberKeyBehavior()
+	if behavior == configpkg.NumberKeysOff {
+		return nil, false
+	}
+
+	position, err := strconv.Atoi(key)
+	if err != nil || position < 1 {
+		return nil, false
+	}
+	if behavior == configpkg.NumberKeysBookmark {
+		return m.jumpToBookmark(key), true
+	}
+
+	visible := len(m.GetSortedTasks())
+	if position > visible {
+		return statusFeedback(fmt.Sprintf("Only %d task(s) visible", visible)), true
+	}
+	return m.setSelectedTask(position - 1), true
+}
@@ -2,14 +2,30 @@ package ui
 
 import (
 	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/cli"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/browser"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/feature"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/taskdetails"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
 )
 
 // =============================================================================
@@ -27,6 +43,9 @@ func (m *MainModel) handleTaskStatusChangeKey(key string) (tea.Cmd, bool) {
 		if selectedTask == nil {
 			return nil, false
 		}
+		if cmd := m.readOnlyFeedback(selectedTask); cmd != nil {
+			return cmd, true
+		}
 
 		// DEBUG: Log which task is being edited
 		fmt.Fprintf(os.Stderr, "[DEBUG] Opening status change modal: taskID=%s, title=%s, selectedIndex=%d\n",
@@ -47,6 +66,7 @@ func (m *MainModel) handleTaskStatusChangeKey(key string) (tea.Cmd, bool) {
 				CurrentFeature:    currentFeature,
 				FocusField:        taskedit.FieldStatus, // Start on status for quick status changes
 				AvailableFeatures: m.GetUniqueFeatures(),
+				ConfirmDiscard:    m.programContext.Config.ShouldConfirmDiscardEdits(),
 			}
 		}, true
 	}
@@ -63,6 +83,9 @@ func (m *MainModel) handleTaskEditKey(key string) (tea.Cmd, bool) {
 		if selectedTask == nil {
 			return nil, false
 		}
+		if cmd := m.readOnlyFeedback(selectedTask); cmd != nil {
+			return cmd, true
+		}
 
 		// Get current feature value (handle nil pointer)
 		currentFeature := ""
@@ -82,6 +105,9 @@ func (m *MainModel) handleTaskEditKey(key string) (tea.Cmd, bool) {
 		// Get available features for the modal
 		availableFeatures := m.GetUniqueFeatures()
 
+		// Resume unsaved values restored from a previous session, if any
+		draft := m.takeSessionDraft(selectedTask.ID)
+
 		// Show unified task properties modal, starting on first field
 		showMsg := func() tea.Msg {
 			return taskedit.ShowTaskEditModalMsg{
@@ -90,7 +116,10 @@ func (m *MainModel) handleTaskEditKey(key string) (tea.Cmd, bool) {
 				CurrentPriority:   selectedTask.TaskOrder,
 				CurrentFeature:    currentFeature,
 				FocusField:        taskedit.FieldStatus, // Start on first field
+				FocusIncomplete:   m.programContext.Config.GetEditFocus() == configpkg.EditFocusIncomplete,
 				AvailableFeatures: availableFeatures,
+				Draft:             draft,
+				ConfirmDiscard:    m.programContext.Config.ShouldConfirmDiscardEdits(),
 			}
 		}
 		return showMsg, true
@@ -114,6 +143,149 @@ func (m *MainModel) handleTaskTitleCopyKey(key string) (tea.Cmd, bool) {
 	return nil, false
 }
 
+// HandleTaskCommitRefCopyKey handles 'c' key - copy selected task as a commit reference
+func (m *MainModel) handleTaskCommitRefCopyKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyC || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	yankMsg := messages.YankCommitRefMsg{
+		Template:      export.DefaultCommitTemplate,
+		ShortIDLength: export.DefaultShortIDLength,
+	}
+	if cfg, ok := m.programContext.ConfigProvider.(*configpkg.Config); ok {
+		yankMsg.Template = cfg.GetCommitTemplate()
+		yankMsg.ShortIDLength = cfg.GetShortIDLength()
+	}
+	return func() tea.Msg { return yankMsg }, true
+}
+
+// HandleTaskPathCopyKey handles 'b' key - copy the selected task's parent→child title path
+func (m *MainModel) handleTaskPathCopyKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyB || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	return func() tea.Msg { return messages.YankPathMsg{} }, true
+}
+
+// HandleCopyCommandKey handles 'C' key - copy the current filters and sort as a
+// "lazyarchon list" command. Filters the CLI has no flag for are listed in a
+// trailing shell comment rather than silently dropped.
+func (m *MainModel) handleCopyCommandKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyCCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	ctx := m.programContext
+	filters := helpers.TaskFilters{
+		ProjectID:          ctx.SelectedProjectID,
+		StatusFilters:      ctx.StatusFilters,
+		StatusFilterActive: ctx.StatusFilterActive,
+		FeatureFilters:     ctx.FeatureFilters,
+		ShowCompletedTasks: ctx.ShowCompletedTasks,
+	}
+	command := cli.ListCommand(filters, ctx.SortMode, ctx.Config.IsCompletedTasksVisible())
+
+	var dropped []string
+	if ctx.FeatureFilters != nil && !slices.Contains(slices.Collect(maps.Values(ctx.FeatureFilters)), true) {
+		dropped = append(dropped, "no features selected")
+	}
+	if ctx.TagFilters != nil {
+		dropped = append(dropped, "tag filter")
+	}
+	if ctx.CreatedSince != nil {
+		dropped = append(dropped, "created-today view")
+	}
+	if ctx.MinPriority > 0 {
+		dropped = append(dropped, "minimum priority")
+	}
+	if ctx.ChangedOnly {
+		dropped = append(dropped, "changed-tasks view")
+	}
+	if len(ctx.HiddenTasks) > 0 {
+		dropped = append(dropped, "hidden done tasks")
+	}
+	if len(dropped) > 0 {
+		command += "  # not expressible: " + strings.Join(dropped, ", ")
+	}
+	return m.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: command, What: "list command"}), true
+}
+
+// HandleToggleDescriptionKey handles 'x' key - show more/less of a long description
+func (m *MainModel) handleToggleDescriptionKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyX || m.uiState.IsProjectView() || m.GetSelectedTask() == nil {
+		return nil, false
+	}
+
+	if m.programContext.Config.GetDescriptionMaxLines() == 0 {
+		return func() tea.Msg {
+			return messages.StatusFeedbackMsg{Message: "Descriptions are never collapsed (ui.display.description_max_lines)"}
+		}, true
+	}
+	return m.components.Layout.MainContent.Update(taskdetails.TaskDetailsToggleDescriptionMsg{}), true
+}
+
+// HandleReloadTaskKey handles 'R' key - fetch the selected task again without
+// reloading the whole list
+func (m *MainModel) handleReloadTaskKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyRCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	selectedTask := m.GetSelectedTask()
+	if selectedTask == nil {
+		return nil, false
+	}
+	m.touchTask(selectedTask.ID)
+	return tasks.ReloadTask(m.programContext.ArchonClient, selectedTask.ID), true
+}
+
+// HandleOpenLinkKey handles 'o' key - open a link matched by integrations.links rules
+// A single match opens directly; several matches open the link picker
+func (m *MainModel) handleOpenLinkKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyO || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	selectedTask := m.GetSelectedTask()
+	if selectedTask == nil {
+		return nil, false
+	}
+
+	if m.programContext.Links.Len() == 0 {
+		return func() tea.Msg {
+			return messages.StatusFeedbackMsg{Message: "No link rules configured (integrations.links)"}
+		}, true
+	}
+
+	matched := m.programContext.Links.MatchTask(*selectedTask)
+	switch len(matched) {
+	case 0:
+		return func() tea.Msg {
+			return messages.StatusFeedbackMsg{Message: "No links match this task"}
+		}, true
+	case 1:
+		m.touchTask(selectedTask.ID)
+		return openLink(matched[0]), true
+	default:
+		m.touchTask(selectedTask.ID)
+		return func() tea.Msg {
+			return linkpicker.ShowLinkPickerModalMsg{
+				Links:     matched,
+				TaskTitle: selectedTask.Title,
+			}
+		}, true
+	}
+}
+
+// openLink launches the browser for a link and reports the outcome
+func openLink(link links.Link) tea.Cmd {
+	return func() tea.Msg {
+		if err := browser.Open(link.URL); err != nil {
+			return messages.StatusFeedbackMsg{Message: fmt.Sprintf("Failed to open %s: %v", link.Name, err)}
+		}
+		return messages.StatusFeedbackMsg{Message: fmt.Sprintf("Opened %s: %s", link.Name, link.URL)}
+	}
+}
+
 // HandleFeatureSelectionKey handles 'f' key - open feature selection modal
 func (m *MainModel) handleFeatureSelectionKey(key string) (tea.Cmd, bool) {
 	if key == keys.KeyF && !m.uiState.IsProjectView() {
@@ -122,7 +294,16 @@ func (m *MainModel) handleFeatureSelectionKey(key string) (tea.Cmd, bool) {
 
 		// Get all features from current project (without feature filter applied)
 		// so user can see all available options to select/deselect
-		allProjectFeatures := m.GetFeaturesForProjectSelection()
+		projectTasks := m.tasksForFeatureSelection(m.programContext.SelectedProjectID)
+		allProjectFeatures := helpers.GetUniqueFeatures(projectTasks)
+
+		// With a project selected, 'w' in the modal widens the list to every project
+		var wideTasks []archon.Task
+		var wideFeatures []string
+		if m.programContext.SelectedProjectID != nil {
+			wideTasks = m.tasksForFeatureSelection(nil)
+			wideFeatures = helpers.GetUniqueFeatures(wideTasks)
+		}
 
 		// Transform featureFilters for modal display:
 		// - empty map: No filter active (show all) → display as all features selected
@@ -132,21 +313,173 @@ func (m *MainModel) handleFeatureSelectionKey(key string) (tea.Cmd, bool) {
 		if len(selectedFeatures) == 0 {
 			// Empty map means "no filter, show all" - represent in UI as all features selected
 			selectedFeatures = make(map[string]bool)
-			for _, feature := range allProjectFeatures {
+			for _, feature := range append(allProjectFeatures, wideFeatures...) {
 				selectedFeatures[feature] = true
 			}
 		}
 
+		// Tab inside the modal switches to assigning a feature to the selected task
+		var taskIDs []string
+		if selectedTask := m.GetSelectedTask(); selectedTask != nil {
+			taskIDs = []string{selectedTask.ID}
+		}
+
 		showMsg := feature.ShowFeatureModalMsg{
 			AllFeatures:          allProjectFeatures, // All project features (ignore current feature filter)
 			SelectedFeatures:     selectedFeatures,   // Never nil - always explicit selection state
 			FeatureColorsEnabled: true,               // Enable feature colors
+			TaskIDs:              taskIDs,
+			FeatureCounts:        helpers.CountTasksByFeature(projectTasks),
+		}
+		if wideFeatures != nil {
+			showMsg.ScopeName = m.programContext.GetCurrentProjectName()
+			showMsg.WideFeatures = wideFeatures
+			showMsg.WideFeatureCounts = helpers.CountTasksByFeature(wideTasks)
 		}
 		return func() tea.Msg { return showMsg }, true
 	}
 	return nil, false
 }
 
+// HandleQuickFeatureFilterKey handles 'F' key - filter to the selected task's feature
+// Pressing it again while that quick filter is active restores the previous feature filter.
+// Status filters and search are left untouched so the filters compose.
+func (m *MainModel) handleQuickFeatureFilterKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyFCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	if m.quickFeatureActive() {
+		return statusFeedback("Cleared feature filter: " + m.clearQuickFeature()), true
+	}
+
+	selectedTask := m.GetSelectedTask()
+	if selectedTask == nil {
+		return statusFeedback("No task selected"), true
+	}
+	if selectedTask.Feature == nil || *selectedTask.Feature == "" {
+		return statusFeedback("Selected task has no feature"), true
+	}
+
+	feature := *selectedTask.Feature
+	m.setQuickFeature(feature)
+	m.findAndSelectTask(selectedTask.ID)
+	return statusFeedback("Showing feature: " + feature), true
+}
+
+// handleTagSelectionKey handles ctrl+t - open the feature modal listing tags
+func (m *MainModel) handleTagSelectionKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyCtrlT || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	candidates := m.tasksForTagSelection()
+	allTags := helpers.GetUniqueTags(candidates)
+	if len(allTags) == 0 && m.programContext.TagFilters == nil {
+		return statusFeedback("No task has tags"), true
+	}
+
+	// No tag filter shows every tag as selected, as the feature modal does
+	selectedTags := m.programContext.TagFilters
+	if selectedTags == nil {
+		selectedTags = make(map[string]bool, len(allTags))
+		for _, tag := range allTags {
+			selectedTags[tag] = true
+		}
+	}
+
+	showMsg := feature.ShowFeatureModalMsg{
+		AllFeatures:      allTags,
+		SelectedFeatures: selectedTags,
+		FeatureCounts:    helpers.CountTasksByTag(candidates),
+		Tags:             true,
+	}
+	return func() tea.Msg { return showMsg }, true
+}
+
+// applyTagFilter filters to the tags chosen in the tag modal; choosing every tag clears the filter
+func (m *MainModel) applyTagFilter(selected map[string]bool) tea.Cmd {
+	filters := make(map[string]bool, len(selected))
+	for tag, visible := range selected {
+		if visible {
+			filters[tag] = true
+		}
+	}
+
+	allTags := helpers.GetUniqueTags(m.tasksForTagSelection())
+	if !slices.ContainsFunc(allTags, func(tag string) bool { return !filters[tag] }) {
+		filters = nil
+	}
+	m.programContext.TagFilters = filters
+	m.refreshUIAfterFilterChange()
+
+	if filters == nil {
+		return statusFeedback("Showing all tags")
+	}
+	return statusFeedback("Showing tags: " + m.programContext.GetTagFilterSummary())
+}
+
+// setQuickFeature filters to feature alone, remembering the filter to restore
+func (m *MainModel) setQuickFeature(feature string) {
+	if !m.quickFeatureActive() {
+		m.quickFeaturePrevious = m.programContext.FeatureFilters
+	}
+	m.quickFeature = feature
+	m.programContext.FeatureFilters = map[string]bool{feature: true}
+	m.programContext.FeatureFilterActive = true
+	m.refreshUIAfterFilterChange()
+}
+
+// clearQuickFeature restores the filter from before the quick filter and
+// returns the feature that was shown
+func (m *MainModel) clearQuickFeature() string {
+	feature := m.quickFeature
+	m.programContext.FeatureFilters = m.quickFeaturePrevious
+	m.programContext.FeatureFilterActive = len(m.quickFeaturePrevious) > 0
+	m.quickFeature, m.quickFeaturePrevious = "", nil
+	m.refreshUIAfterFilterChange()
+	return feature
+}
+
+// quickFeatureActive reports whether the current feature filter is still the one set by 'F'
+// Changing features in the modal replaces it, after which 'F' starts a new quick filter
+func (m *MainModel) quickFeatureActive() bool {
+	filters := m.programContext.FeatureFilters
+	return m.quickFeature != "" && len(filters) == 1 && filters[m.quickFeature]
+}
+
+// HandleCreatedTodayKey handles 'T' key - toggle the view of tasks created today
+// The view sorts newest-first and restores the previous sort mode when turned off,
+// unless the sort mode was changed in between. Archon tasks carry no creator field,
+// so the view shows every task created today rather than only the user's own.
+func (m *MainModel) handleCreatedTodayKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyTCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	if m.programContext.CreatedSince != nil {
+		m.programContext.CreatedSince = nil
+		if m.programContext.SortMode == sorting.SortTimeCreated {
+			m.programContext.SetSortMode(m.createdTodayPreviousSort)
+		}
+		m.refreshUIAfterFilterChange()
+		return statusFeedback("Showing all tasks"), true
+	}
+
+	now := m.programContext.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	m.programContext.CreatedSince = &startOfDay
+	m.createdTodayPreviousSort = m.programContext.SortMode
+	m.programContext.SetSortMode(sorting.SortTimeCreated)
+	m.refreshUIAfterFilterChange()
+
+	count := len(m.GetSortedTasks())
+	if count == 0 {
+		return statusFeedback("No tasks created today"), true
+	}
+	return statusFeedback(fmt.Sprintf("Showing %d task(s) created today, newest first", count)), true
+}
+
 // HandleSortModeKey handles 's' key - cycle sort mode forward
 //
 //nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
@@ -169,6 +502,92 @@ func (m *MainModel) handleSortModePreviousKey(key string) (tea.Cmd, bool) {
 	return nil, false
 }
 
+// HandleToggleCountKey handles '#' key - lead the status bar count of a
+// filtered list with the total instead of the shown tasks, or back
+func (m *MainModel) handleToggleCountKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyHash || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	m.uiState.CountTotalFirst = !m.uiState.CountTotalFirst
+	if m.uiState.CountTotalFirst {
+		return statusFeedback("Counting all tasks first"), true
+	}
+	return statusFeedback("Counting shown tasks first"), true
+}
+
+// HandleProjectLabelsKey handles 'P' key - show or hide the project of each
+// task row in All Tasks
+func (m *MainModel) handleProjectLabelsKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyPCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	m.uiState.HideProjectLabels = !m.uiState.HideProjectLabels
+	_ = m.updateTaskListComponents(m.GetSortedTasks()) // Redraw the rows
+	if m.uiState.HideProjectLabels {
+		return statusFeedback("Project labels hidden"), true
+	}
+	if m.programContext.SelectedProjectID != nil {
+		return statusFeedback("Project labels shown in All Tasks"), true
+	}
+	return statusFeedback("Project labels shown"), true
+}
+
+// HandleToggleDetailsKey handles 'z' key - hide the task details panel to give
+// the task list the full width, or bring it back
+func (m *MainModel) handleToggleDetailsKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyZ || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	hide := !m.uiState.HideDetails
+	cmd := m.setDetailsHidden(hide)
+	if hide {
+		return tea.Batch(cmd, statusFeedback("Details hidden (z shows them again)")), true
+	}
+	return tea.Batch(cmd, statusFeedback("Details shown")), true
+}
+
+// minPriorityLevels are the thresholds '+' and '-' step through: all tasks,
+// then medium and high priority, then high priority only
+var minPriorityLevels = []int{0, styling.PriorityMediumThreshold, styling.PriorityHighThreshold}
+
+// HandleMinPriorityKey handles '+' and '-' keys - raise or lower the minimum
+// priority shown to the next priority level
+func (m *MainModel) handleMinPriorityKey(key string) (tea.Cmd, bool) {
+	if (key != keys.KeyPlus && key != keys.KeyMinus) || m.uiState.IsProjectView() {
+		return nil, false
+	}
+
+	current, next := m.programContext.MinPriority, -1
+	if key == keys.KeyPlus {
+		for _, level := range minPriorityLevels {
+			if level > current {
+				next = level
+				break
+			}
+		}
+		if next < 0 {
+			return statusFeedback("Only high priority tasks are shown"), true
+		}
+	} else {
+		for _, level := range slices.Backward(minPriorityLevels) {
+			if level < current {
+				next = level
+				break
+			}
+		}
+		if next < 0 {
+			return statusFeedback("All priorities are shown"), true
+		}
+	}
+
+	m.programContext.MinPriority = next
+	m.refreshUIAfterFilterChange()
+	if next == 0 {
+		return statusFeedback("Showing all priorities"), true
+	}
+	return statusFeedback(fmt.Sprintf("Showing priority ≥%d: %s", next, pluralTasks(len(m.GetSortedTasks())))), true
+}
+
 // HandleTaskDeleteKey handles 'd' key - delete/archive task with confirmation
 func (m *MainModel) handleTaskDeleteKey(key string) (tea.Cmd, bool) {
 	if key == keys.KeyD && !m.uiState.IsProjectView() && len(m.programContext.Tasks) > 0 {
@@ -177,6 +596,9 @@ func (m *MainModel) handleTaskDeleteKey(key string) (tea.Cmd, bool) {
 		if selectedTask == nil {
 			return nil, false
 		}
+		if cmd := m.readOnlyFeedback(selectedTask); cmd != nil {
+			return cmd, true
+		}
 
 		// Store the task ID for the confirmation handler
 		m.pendingDeleteTaskID = selectedTask.ID
@@ -184,11 +606,34 @@ func (m *MainModel) handleTaskDeleteKey(key string) (tea.Cmd, bool) {
 		// Show confirmation modal
 		return func() tea.Msg {
 			return confirmation.ShowConfirmationModalMsg{
-				Message:     "Delete task '" + selectedTask.Title + "'? This cannot be undone.",
+				Message:     "Delete task '" + selectedTask.Title + "'? ctrl+z re-creates it with a new ID.",
 				ConfirmText: "Delete",
 				CancelText:  "Cancel",
+				Destructive: true,
 			}
 		}, true
 	}
 	return nil, false
 }
+
+// readOnlyFeedback returns a status feedback command when the task belongs to a read-only project.
+// Returns nil when the task may be mutated (including when permissions are unknown).
+func (m *MainModel) readOnlyFeedback(task *archon.Task) tea.Cmd {
+	if task == nil {
+		return nil
+	}
+	return m.readOnlyProjectFeedback(task.ProjectID)
+}
+
+// readOnlyProjectFeedback is readOnlyFeedback for a project, e.g. one a task is created in
+func (m *MainModel) readOnlyProjectFeedback(projectID string) tea.Cmd {
+	if !m.programContext.IsProjectReadOnly(projectID) {
+		return nil
+	}
+	if m.programContext.SafeMode {
+		return statusFeedback("Safe mode: task changes are disabled")
+	}
+	return func() tea.Msg {
+		return messages.StatusFeedbackMsg{Message: "Read-only project: task changes are disabled"}
+	}
+}
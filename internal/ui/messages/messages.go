@@ -106,10 +106,51 @@ type YankIDMsg struct{}
 // This message is sent when user presses 'Y' key
 type YankTitleMsg struct{}
 
+// YankCommitRefMsg requests the task list to copy the selected task as a commit reference
+// This message is sent when user presses 'c' key
+type YankCommitRefMsg struct {
+	Template      string // text/template commit reference format
+	ShortIDLength int    // Characters kept in {{.ShortID}}
+}
+
+// YankPathMsg requests the task list to copy the selected task's parent→child title path
+// This message is sent when user presses 'b' key
+type YankPathMsg struct {
+	Separator string // Joins titles; empty uses the export default
+}
+
+// CopyToClipboardMsg asks MainModel to copy text, confirming first when it is large
+// Components send this instead of writing to the clipboard themselves
+type CopyToClipboardMsg struct {
+	Text string // Text to copy
+	What string // What the text is, for feedback and file names (e.g., "task ID")
+}
+
 // StatusFeedbackMsg provides UI feedback from components
-// Components send this message to display status/success/error messages
+// Components send this message to display status/success/error messages.
+// Feedback tagged with an Operation reports one item of that operation (see
+// OperationStartedMsg): MainModel folds it into the operation's summary
+// instead of showing it on its own.
 type StatusFeedbackMsg struct {
-	Message string
+	Message   string
+	Operation string // Operation the feedback belongs to ("" = standalone)
+	Err       error  // Why the item failed (tagged feedback only)
+}
+
+// OperationStartedMsg announces an operation over many items, such as a batch
+// update. Its items then report through StatusFeedbackMsg tagged with ID.
+type OperationStartedMsg struct {
+	ID    string
+	Label string // What is being done, e.g. "Updating tasks"
+	Total int    // Items expected (0 = unknown)
+}
+
+// OperationProgressMsg carries the rolled-up summary of an operation to the
+// status bar, which shows it in place of the per-item feedback
+type OperationProgressMsg struct {
+	ID       string
+	Summary  string
+	Finished bool
 }
 
 // =============================================================================
@@ -143,5 +184,10 @@ var (
 	// User interaction messages
 	_ tea.Msg = YankIDMsg{}
 	_ tea.Msg = YankTitleMsg{}
+	_ tea.Msg = YankCommitRefMsg{}
+	_ tea.Msg = YankPathMsg{}
+	_ tea.Msg = CopyToClipboardMsg{}
 	_ tea.Msg = StatusFeedbackMsg{}
+	_ tea.Msg = OperationStartedMsg{}
+	_ tea.Msg = OperationProgressMsg{}
 )
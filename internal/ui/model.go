@@ -2,31 +2,49 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon/replay"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export/scheduled"
+	"github.com/yousfisaad/lazyarchon/v2/internal/links"
 	"github.com/yousfisaad/lazyarchon/v2/internal/logging"
+	"github.com/yousfisaad/lazyarchon/v2/internal/metrics"
+	"github.com/yousfisaad/lazyarchon/v2/internal/session"
 	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/styling"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clipboard"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/terminal"
+	"github.com/yousfisaad/lazyarchon/v2/internal/state"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/header"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/maincontent"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/layout/statusbar"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/bookmarklist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/feature"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/help"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/statusfilter"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/projectlist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/taskdetails"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/tasklist"
@@ -34,6 +52,7 @@ import (
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/factories"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/overlay"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
 	stylingprovider "github.com/yousfisaad/lazyarchon/v2/internal/ui/styling"
 )
@@ -96,8 +115,98 @@ type MainModel struct {
 	featureSelectedIndex int // Selected index in feature modal
 
 	// Confirmation dialogs
-	pendingDeleteTaskID string // Task ID awaiting deletion confirmation
-
+	pendingDeleteTaskID string                 // Task ID awaiting deletion confirmation
+	pendingDoneUpdate   *pendingTaskUpdate     // Update moving a task to done, awaiting confirmation
+	pendingCopy         *pendingCopy           // Large or failed clipboard copy, awaiting confirmation
+	pendingScratchpad   *pendingScratchpadEdit // Update from the YAML scratchpad, awaiting confirmation
+	pendingSprint       *pendingSprintStep     // Sprint overview or rollover preview, awaiting an answer
+	pendingArchive      *pendingArchive        // Done task cleanup, awaiting confirmation
+	pendingRecovery     *pendingRecovery       // Update of a task deleted remotely, awaiting re-create or copy
+
+	// Session persistence (nil sessionStore = disabled)
+	sessionStore      *session.Store    // Where session snapshots are written
+	pendingSession    *session.Snapshot // Snapshot found at startup, awaiting the restore prompt
+	sessionPromptOpen bool              // Whether the restore prompt is showing
+	restoringSession  *session.Snapshot // Accepted snapshot waiting for its project's tasks
+	restoreSkipped    []string          // Items already found missing while restoring
+	sessionDrafts     []session.Draft   // Restored edit drafts not reopened yet
+	lastSession       *session.Snapshot // Last snapshot written, to skip identical saves
+	sessionGeneration int               // Debounce counter for snapshot saves
+	tasksLoaded       bool              // Whether the first task load completed
+	projectsLoaded    bool              // Whether the first project load completed
+
+	clockSkewWarned bool // Whether the clock skew warning was already shown
+
+	// API key prompt after a 401 the client could not recover from
+	apiKeyPromptDismissed bool // Closed with Esc; stays closed until the next manual refresh
+
+	// Double-press quit (ui.display.quit_behavior: double_press)
+	quitArmed      bool // First q pressed, waiting for the second
+	quitGeneration int  // Invalidates expiry timers from earlier presses
+
+	// Idle confirmation and status modals (ui.display.modal_timeout)
+	modalTimeout           time.Duration // Wait of the open modal before it is canceled (0 = forever)
+	modalTimeoutGeneration int           // Invalidates idle timers from before the latest input
+
+	// Modals that opened while another was active (ui.display.modal_conflict: queue)
+	queuedModals []tea.Msg
+
+	// Update/View timings in debug/profiling mode (nil = not measured)
+	frames     *helpers.FrameStats
+	slowFrames *slowFrameLog // Writes slow frames to the HTTP capture (nil = not recording)
+
+	// Away digest (nil awayStore = disabled)
+	awayStore    *away.Store    // Where the last-seen baseline is written
+	awayBaseline *away.Baseline // Baseline from the previous run, then the latest capture
+	awayDigest   *away.Digest   // Digest computed at startup, reopened with 'A'
+	awayChecked  bool           // Whether the digest was computed for this run
+
+	// Bookmarks (vim-style marks)
+	bookmarkStore         *bookmarks.Store // Where bookmarks are saved (nil = not persisted)
+	bookmarks             bookmarks.Set    // Slot → bookmarked task
+	bookmarkPrefix        string           // 'm' or "'" while waiting for the slot key
+	pendingBookmarkTaskID string           // Task to select once a bookmark's project is loaded
+	pendingCreatedTaskID  string           // Task to select once the list is refreshed after creating it
+	pendingJumpTaskID     string           // Task to select once fetched for a clipboard jump (ctrl+g)
+	visual                *visualSelection // Marking tasks with j/k after 'v' (nil = not marking)
+
+	// Tasks as they were before a running batch, by batch ID (see snapshotForUndo)
+	undoBatches map[string][]archon.Task
+
+	// Sticky preferences (nil = not persisted)
+	stateStore     *state.Store     // Where the feature modal's last-applied selection is saved
+	savedSelection *state.Selection // Task selected at the last quit, reselected once tasks and projects load
+
+	// Project to return to when 'a' toggles back from All Tasks (show_all_behavior: toggle)
+	showAllReturnProjectID *string
+
+	// Quick feature filter ('F'): the feature shown and the filter to restore afterwards
+	quickFeature         string
+	quickFeaturePrevious map[string]bool
+
+	// Sort mode to restore when the created-today view ('T') is turned off
+	createdTodayPreviousSort int
+
+	// Scheduled exports (nil exportScheduler = none configured, see exports.scheduled)
+	exportScheduler *scheduled.Scheduler
+	exportStore     *scheduled.Store     // Where completed runs are remembered (nil = not saved)
+	exportLastRuns  map[string]time.Time // Last completed run per export name
+
+	// Size-aware clipboard shared by all copy actions (see ui.clipboard)
+	clipboard *clipboard.Clipboard
+
+	// Terminal window integration (nil = disabled, see ui.display.set_terminal_title)
+	terminal      *terminal.Output
+	terminalTitle string // Last title sent, to skip identical updates
+
+	// Prometheus metrics snapshot (nil = disabled, see integrations.metrics_listen)
+	metrics *metrics.Collector
+
+	// Merges task reload requests from all sources (see server.refresh_min_spacing)
+	refresh *helpers.RefreshCoordinator
+
+	// Operations over many items whose feedback is rolled up (see model_handlers_operations.go)
+	operations *helpers.OperationTracker
 }
 
 // =============================================================================
@@ -126,9 +235,40 @@ func NewModel(cfg *configpkg.Config) MainModel {
 	// Create concrete implementations for interface dependencies
 	client := archon.NewClient(cfg.GetServerURL(), cfg.GetAPIKey())
 	client.SetLogger(logger) // Inject logger for HTTP request/response logging
+	client.SetAPIKeySource(cfg.ResolveAPIKey)
+	client.SetCacheTTL(cfg.GetCacheTTL())
+	recorder := configureHTTPCapture(client, cfg, logger)
 
 	// Delegate to shared model creation logic
-	return createModelWithDependencies(client, cfg, styleContextProvider, logger)
+	model := createModelWithDependencies(client, cfg, styleContextProvider, logger)
+	model.recordSlowFrames(recorder)
+	return model
+}
+
+// configureHTTPCapture wraps the client transport for --record-http / --replay-http
+// and returns the recorder when recording
+func configureHTTPCapture(client *archon.Client, cfg *configpkg.Config, logger interfaces.Logger) *replay.Recorder {
+	switch {
+	case cfg.Development.ReplayHTTPDir != "":
+		replayer, err := replay.NewReplayer(cfg.Development.ReplayHTTPDir)
+		if err != nil {
+			logger.Error("HTTP replay disabled", "error", err)
+			return nil
+		}
+		client.SetTransport(replayer)
+		logger.Info("Replaying HTTP capture", "dir", cfg.Development.ReplayHTTPDir)
+
+	case cfg.Development.RecordHTTPDir != "":
+		recorder, err := replay.NewRecorder(cfg.Development.RecordHTTPDir, nil)
+		if err != nil {
+			logger.Error("HTTP recording disabled", "error", err)
+			return nil
+		}
+		client.SetTransport(recorder)
+		logger.Info("Recording HTTP capture", "dir", cfg.Development.RecordHTTPDir)
+		return recorder
+	}
+	return nil
 }
 
 // createModelWithDependencies contains the shared model creation logic
@@ -146,6 +286,19 @@ func createModelWithDependencies(
 	applyDefaultProjectID(programContext, config)
 	components := createComponents(componentContext)
 	model := buildModel(programContext, uiState, components, config)
+	if programContext.Config.IsSafeMode() {
+		startSafeMode(&model, logger)
+	} else {
+		model.sessionStore, model.pendingSession = loadSessionStore(programContext.Config, logger)
+		model.awayStore, model.awayBaseline = loadAwayStore(programContext.Config, logger)
+		model.bookmarkStore, model.bookmarks = loadBookmarks(logger)
+		model.stateStore, model.savedSelection = loadStateStore(programContext, uiState, logger)
+		model.exportScheduler, model.exportStore, model.exportLastRuns = loadScheduledExports(programContext.Config, logger)
+	}
+	model.clipboard = newClipboard(programContext.Config, nil)
+	model.frames = newFrameStats(programContext.Config)
+	model.refresh = helpers.NewRefreshCoordinator(programContext.Config.GetRefreshMinSpacing(), time.Now)
+	model.operations = helpers.NewOperationTracker()
 
 	// Wire up remaining parent-provided state accessors after model exists
 	// GetSortedTasks: Still a callback since it involves complex filtering in MainModel
@@ -182,8 +335,33 @@ func createContexts(
 		logger,
 	)
 
+	// Let the real API client report server time; test doubles simply never sample
+	if sampler, ok := client.(interface {
+		SetClockSkewEstimator(estimator *clock.SkewEstimator)
+	}); ok {
+		sampler.SetClockSkewEstimator(programContext.ClockSkew)
+	}
+
+	// Compile link rules once; invalid rules are already reported by config loading
+	engine, err := links.Compile(programContext.Config.GetLinkRules())
+	if err != nil {
+		logger.Warn("Ignoring invalid link rules", "error", err)
+	} else {
+		programContext.Links = engine
+	}
+
+	// Same for the sprint settings
+	resolver, err := programContext.Config.GetSprintResolver()
+	if err != nil {
+		logger.Warn("Ignoring invalid sprint settings", "error", err)
+	} else {
+		programContext.Sprints = resolver
+	}
+
 	// Create UI state for presentation concerns
 	uiState := context.NewUIState()
+	uiState.HideShortcutHints = !programContext.Config.ShouldShowShortcutHints()
+	uiState.HideProjectLabels = !programContext.Config.ShouldShowProjectLabels()
 
 	componentContext := &base.ComponentContext{
 		ProgramContext:       programContext,
@@ -201,6 +379,9 @@ func createContexts(
 func initializeContextState(programContext *context.ProgramContext, config interfaces.ConfigProvider) {
 	programContext.SetLoading(true, "Connecting to Archon server...")
 	setSortMode(programContext, config)
+	if concreteConfig, ok := config.(*configpkg.Config); ok {
+		programContext.MinPriority = concreteConfig.GetMinPriority()
+	}
 }
 
 // createComponents creates modal components
@@ -252,18 +433,8 @@ func setSortMode(programContext *context.ProgramContext, config interfaces.Confi
 		defaultSortMode = configProvider.GetDefaultSortMode()
 	}
 
-	// Set sort mode - use the same logic as factory
-	sortMode := sorting.SortStatusPriority // default
-	switch defaultSortMode {
-	case "status+priority":
-		sortMode = sorting.SortStatusPriority
-	case "priority":
-		sortMode = sorting.SortPriorityOnly
-	case "time":
-		sortMode = sorting.SortTimeCreated
-	case "alphabetical":
-		sortMode = sorting.SortAlphabetical
-	}
+	// Unknown names fall back to status+priority
+	sortMode, _ := sorting.ParseSortMode(defaultSortMode)
 	programContext.SetSortMode(sortMode)
 }
 
@@ -283,49 +454,111 @@ func applyDefaultProjectID(programContext *context.ProgramContext, config interf
 // Init initializes the application
 func (m MainModel) Init() tea.Cmd {
 	cmds := []tea.Cmd{
-		tasks.LoadTasksInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID),
-		projects.LoadProjectsInterface(m.programContext.ArchonClient),
+		m.requestTaskReload(helpers.RefreshStartup),
+		m.loadProjectsCmd(),
 		m.components.Layout.StatusBar.Init(), // Initialize StatusBar (starts spinner)
 		m.startPolling(),                     // Use HTTP polling for auto-refresh
+		m.startScheduledExports(),            // Run exports.scheduled jobs while the app is open
+		m.startInProjectMode(),               // ui.display.start_in_project_mode
 	}
 
 	return tea.Batch(cmds...)
 }
 
+// startInProjectMode opens the project picker as the first screen when configured
+func (m MainModel) startInProjectMode() tea.Cmd {
+	if !m.programContext.Config.ShouldStartInProjectMode() {
+		return nil
+	}
+	return func() tea.Msg { return projectmode.ProjectModeActivatedMsg{} }
+}
+
 // Update handles incoming events and updates the model
 // Uses pointer receiver to maintain component reference validity across updates
 //
 //nolint:ireturn // Required by Bubble Tea framework - must return tea.Model interface
 func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.frames == nil {
+		return m.withTerminalSync(m.withReadTracking(m.update(msg)))
+	}
+	defer m.observeFrame(helpers.FrameUpdate, msg, time.Now())
+	return m.withTerminalSync(m.withReadTracking(m.update(msg)))
+}
+
+// update routes a message to its handler
+//
+//nolint:ireturn // Returns tea.Model for Update
+func (m *MainModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		return m.handleWindowResize(msg)
 	case tea.KeyMsg:
-		return m.handleKeyInput(msg)
-	case tasks.TasksLoadedMsg, tasks.TaskUpdateMsg, tasks.TaskDeleteMsg:
-		return m.handleTaskMessages(msg)
+		return m.withSessionSave(m.handleKeyInput(msg))
+	case tasks.TasksLoadedMsg:
+		model, cmd := m.withSessionSave(m.handleTaskMessages(msg))
+		return model, tea.Batch(cmd, m.finishTaskReload(), m.promptForAPIKey(msg))
+	case refreshDueMsg:
+		return m, m.reloadCmd(m.refresh.Fire())
+	case tasks.TasksPageLoadedMsg:
+		return m, m.handleTasksPageLoaded(msg)
+	case tasklist.TaskListLoadMoreMsg:
+		return m, m.loadNextTaskPage()
+	case tasks.TaskUpdateMsg, tasks.TaskCreatedMsg, tasks.TaskReloadedMsg, tasks.TaskDeleteMsg, tasks.TaskFeatureProgressMsg, tasks.TasksFeatureUpdateMsg,
+		tasks.TaskStatusProgressMsg, tasks.TasksStatusUpdateMsg, tasks.TaskArchiveProgressMsg, tasks.TasksArchivedMsg, tasks.TasksSearchedMsg:
+		model, cmd := m.handleTaskMessages(msg)
+		return model, tea.Batch(cmd, m.promptForAPIKey(msg))
+	case sessionSaveMsg:
+		return m, m.handleSessionSave(msg)
+	case quitWindowExpiredMsg:
+		return m, m.handleQuitWindowExpired(msg)
+	case modalIdleMsg:
+		return m, m.handleModalIdle(msg)
 	case projects.ProjectsLoadedMsg:
-		return m.handleProjectMessages(msg)
+		model, cmd := m.handleProjectMessages(msg)
+		return model, tea.Batch(cmd, m.promptForAPIKey(msg))
+	case apikey.APIKeyEnteredMsg:
+		return m, m.handleAPIKeyEntered(msg)
+	case apikey.APIKeyCanceledMsg:
+		return m, m.handleAPIKeyCanceled()
 	case messages.PollingTickMsg:
 		return m.handlePollingTick()
+	case scheduledExportTickMsg:
+		return m, m.handleScheduledExportTick()
+	case scheduledExportDoneMsg:
+		return m, m.handleScheduledExportDone(msg)
+	case scratchpadEditedMsg:
+		return m, m.handleScratchpadEdited(msg)
+	case messages.OperationStartedMsg:
+		return m, m.handleOperationStarted(msg)
+	case messages.ModalStateMsg:
+		return m, tea.Batch(m.components.Update(msg), m.openQueuedModal(msg))
 	case help.ShowHelpModalMsg, help.HideHelpModalMsg, help.HelpModalShownMsg, help.HelpModalHiddenMsg,
 		status.ShowStatusModalMsg, status.HideStatusModalMsg, status.StatusModalShownMsg, status.StatusModalHiddenMsg,
 		confirmation.ShowConfirmationModalMsg, confirmation.HideConfirmationModalMsg, confirmation.ConfirmationModalShownMsg, confirmation.ConfirmationModalHiddenMsg,
 		taskedit.ShowTaskEditModalMsg, taskedit.HideTaskEditModalMsg, taskedit.TaskEditModalShownMsg, taskedit.TaskEditModalHiddenMsg,
-		feature.ShowFeatureModalMsg, feature.HideFeatureModalMsg, feature.FeatureModalShownMsg, feature.FeatureModalHiddenMsg:
+		feature.ShowFeatureModalMsg, feature.HideFeatureModalMsg, feature.FeatureModalShownMsg, feature.FeatureModalHiddenMsg,
+		linkpicker.ShowLinkPickerModalMsg, linkpicker.HideLinkPickerModalMsg, linkpicker.LinkPickerModalShownMsg, linkpicker.LinkPickerModalHiddenMsg,
+		digest.ShowDigestModalMsg, digest.HideDigestModalMsg, digest.DigestModalShownMsg, digest.DigestModalHiddenMsg,
+		bookmarklist.ShowBookmarkListModalMsg, bookmarklist.HideBookmarkListModalMsg, bookmarklist.BookmarkListModalShownMsg, bookmarklist.BookmarkListModalHiddenMsg,
+		unblock.ShowUnblockModalMsg, unblock.HideUnblockModalMsg, unblock.UnblockModalShownMsg, unblock.UnblockModalHiddenMsg,
+		apikey.ShowAPIKeyModalMsg, apikey.HideAPIKeyModalMsg, apikey.APIKeyModalShownMsg, apikey.APIKeyModalHiddenMsg,
+		descdiff.ShowDescDiffModalMsg, descdiff.HideDescDiffModalMsg, descdiff.DescDiffModalShownMsg, descdiff.DescDiffModalHiddenMsg:
 		return m.handleModalLifecycle(msg)
-	case status.StatusSelectedMsg, taskedit.TaskPropertiesUpdatedMsg, confirmation.ConfirmationSelectedMsg,
-		taskedit.FeatureSelectedMsg, feature.FeatureSelectionAppliedMsg, statusfilter.StatusFilterAppliedMsg:
+	case status.StatusSelectedMsg, taskedit.TaskPropertiesUpdatedMsg, taskedit.TaskCreateRequestedMsg, confirmation.ConfirmationSelectedMsg,
+		taskedit.FeatureSelectedMsg, feature.FeatureSelectionAppliedMsg, feature.TagSelectionAppliedMsg, feature.FeatureAssignedMsg, statusfilter.StatusFilterAppliedMsg,
+		linkpicker.LinkSelectedMsg, digest.DigestTaskChosenMsg, bookmarklist.BookmarkChosenMsg, bookmarklist.BookmarkClearedMsg,
+		unblock.UnblockTaskChosenMsg, descdiff.DescriptionAcknowledgedMsg:
 		return m.handleModalActions(msg)
 	case projectlist.ProjectListUpdateMsg, projectlist.ProjectListSelectMsg, projectlist.ProjectListScrollMsg,
 		projectlist.ProjectListSelectionChangedMsg, tasklist.TaskListSelectionChangedMsg,
-		messages.YankIDMsg, messages.YankTitleMsg, messages.StatusFeedbackMsg, messages.SearchStateChangedMsg:
+		messages.YankIDMsg, messages.YankTitleMsg, messages.YankCommitRefMsg, messages.YankPathMsg, messages.StatusFeedbackMsg, messages.SearchStateChangedMsg,
+		messages.CopyToClipboardMsg, clipboardCopiedMsg:
 		return m.handleComponentMessages(msg)
 	case projectmode.ProjectModeActivatedMsg, projectmode.ProjectModeDeactivatedMsg:
 		return m.handleProjectModeMessages(msg)
 	case base.ComponentMessage:
 		// Process the payload message that was wrapped by the component
-		return m.Update(msg.Payload)
+		return m.update(msg.Payload)
 	}
 
 	// Fallback: broadcast all other messages to component tree
@@ -406,15 +639,19 @@ func (m *MainModel) handleKeyInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.HasActiveModal() {
 		// Only process global emergency keys when modal is active
 		// This prevents navigation/task keys from leaking to underlying view
-		keyStr := msg.String()
-		if keyStr == keys.KeyCtrlC || keyStr == keys.KeyQuestion {
+		// ('?' is typed into the API key prompt rather than opening help)
+		keyStr := keys.Normalize(msg.String())
+		if keyStr == keys.KeyCtrlC || (keyStr == keys.KeyQuestion && !m.components.Modals.APIKeyModel.IsActive()) {
 			modelCmd = m.handleKeyPress(keyStr)
 		}
 		// All other keys are handled only by the modal (via componentCmd)
+		if m.timedModalActive() {
+			modelCmd = tea.Batch(modelCmd, m.armModalTimeout())
+		}
 	} else {
 		// No modal active - process all keys normally
 		// handleKeyPress updates model in-place with pointer receiver
-		modelCmd = m.handleKeyPress(msg.String())
+		modelCmd = m.handleKeyPress(keys.Normalize(msg.String()))
 	}
 	return m, tea.Batch(componentCmd, modelCmd)
 }
@@ -430,10 +667,31 @@ func (m *MainModel) handleComponentMessages(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case projectlist.ProjectListUpdateMsg, projectlist.ProjectListSelectMsg,
 		projectlist.ProjectListScrollMsg,
-		messages.YankIDMsg, messages.YankTitleMsg, messages.StatusFeedbackMsg:
+		messages.YankIDMsg, messages.YankTitleMsg, messages.YankCommitRefMsg, messages.YankPathMsg:
+		if selected := m.GetSelectedTask(); selected != nil && m.uiState.IsTaskView() && isYankMsg(msg) {
+			m.touchTask(selected.ID)
+		}
 		// Broadcast to components only (coordinators removed - state now in Model)
 		return m, m.components.Update(msg)
 
+	case messages.StatusFeedbackMsg:
+		if msg.Operation != "" {
+			return m, m.handleOperationFeedback(msg)
+		}
+		return m, m.components.Update(msg)
+
+	case messages.CopyToClipboardMsg:
+		return m, m.handleCopyToClipboard(msg)
+
+	case clipboardCopiedMsg:
+		return m, m.handleClipboardCopied(msg)
+
+	case clipboardReadMsg:
+		return m, m.handleClipboardRead(msg)
+
+	case taskUndoneMsg:
+		return m, m.handleTaskUndone(msg)
+
 	case messages.SearchStateChangedMsg:
 		// Update UIState's search state from broadcast (SINGLE SOURCE OF TRUTH)
 		m.uiState.SetSearchQuery(msg.Query)
@@ -472,6 +730,7 @@ func (m *MainModel) handleComponentMessages(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tasklist.TaskListSelectionChangedMsg:
 		// Sync UIState's selectedIndex with TaskList (SINGLE SOURCE OF TRUTH)
 		m.uiState.SelectedTaskIndex = msg.Index
+		m.extendMarks()
 
 		// Forward to MainContent component which will intercept and update TaskDetails
 		if m.components.Layout.MainContent != nil {
@@ -483,10 +742,12 @@ func (m *MainModel) handleComponentMessages(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 // View renders the complete UI using simple direct component rendering
-// High complexity (19) due to comprehensive modal overlay logic for 5+ modal types
-//
-//nolint:gocyclo // View requires checking all modal states for proper overlay rendering
+// The base layout is composed here; overlays (modals) are drawn by the overlay renderer
 func (m MainModel) View() string {
+	if m.frames != nil {
+		defer m.observeFrame(helpers.FrameView, nil, time.Now())
+	}
+
 	// Simple three-part layout: header + main + footer
 	// Components manage their own dimensions from WindowSizeMsg
 	var parts []string
@@ -509,71 +770,20 @@ func (m MainModel) View() string {
 	// Combine vertically using lipgloss
 	baseUI := lipgloss.JoinVertical(lipgloss.Left, parts...)
 
-	// Get screen dimensions for modal positioning
-	screenWidth := m.programContext.ScreenWidth
-	screenHeight := m.programContext.ScreenHeight
-
-	// Overlay modals on top of base UI using proper parent-child architecture
-	// Each modal is centered and rendered as an overlay, not a replacement
-
-	// Check if any modal is active and render it as an overlay
-	var activeModal string
-
-	// Help modal takes priority (usually opened with ?)
-	if m.components.Modals.HelpModel.IsActive() {
-		helpModalView := m.components.Modals.HelpModel.View()
-		if helpModalView != "" {
-			activeModal = helpModalView
-		}
-	}
-
-	// Status change modal
-	if activeModal == "" && m.components.Modals.StatusModel.IsActive() {
-		statusModalView := m.components.Modals.StatusModel.View()
-		if statusModalView != "" {
-			activeModal = statusModalView
-		}
-	}
-
-	// Confirmation modal
-	if activeModal == "" && m.components.Modals.ConfirmationModel.IsActive() {
-		confirmationModalView := m.components.Modals.ConfirmationModel.View()
-		if confirmationModalView != "" {
-			activeModal = confirmationModalView
-		}
-	}
-
-	// Task edit modal
-	if activeModal == "" && m.components.Modals.TaskEditModel.IsActive() {
-		taskEditModalView := m.components.Modals.TaskEditModel.View()
-		if taskEditModalView != "" {
-			activeModal = taskEditModalView
-		}
-	}
-
-	// Feature modal
-	if activeModal == "" && m.components.Modals.FeatureModel.IsActive() {
-		featureModalView := m.components.Modals.FeatureModel.View()
-		if featureModalView != "" {
-			activeModal = featureModalView
-		}
-	}
+	renderer := overlay.NewRenderer(m.programContext.ScreenWidth, m.programContext.ScreenHeight)
+	return renderer.Render(baseUI, m.overlayLayers())
+}
 
-	// If a modal is active, overlay it on top of baseUI
-	if activeModal != "" {
-		// Place the modal centered over the base UI
-		// This properly overlays the modal while keeping base content visible
-		return lipgloss.Place(
-			screenWidth, screenHeight,
-			lipgloss.Center, lipgloss.Center,
-			activeModal,
-			lipgloss.WithWhitespaceChars(" "),
-			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
-		)
+// overlayLayers returns the layers drawn over the base UI, bottom to top
+func (m MainModel) overlayLayers() []overlay.Layer {
+	return []overlay.Layer{
+		{
+			// Modals are centered and hide the base UI around them
+			Content:  m.components.Modals.ActiveView(),
+			Position: overlay.PositionCenter,
+			Backdrop: overlay.BackdropBlank,
+		},
 	}
-
-	// Return base UI without background - let terminal handle backgrounds naturally
-	return baseUI
 }
 
 // =============================================================================
@@ -663,9 +873,20 @@ func (m *MainModel) updateTasks(tasks []archon.Task) {
 		selectedTaskID = selectedTask.ID
 	}
 
-	m.programContext.SetTasks(tasks)
+	// Every task entering the UI goes through the sanitizer, so panels never see raw payloads
+	limits := archon.DefaultSanitizeLimits()
+	if cfg := m.programContext.Config; cfg != nil {
+		limits = cfg.GetSanitizeLimits()
+	}
+	m.programContext.SetTasks(archon.SanitizeTasks(tasks, limits))
 	m.programContext.SetConnected(true)
 	m.clearError()
+	m.programContext.ResetFailedAttempts()
+
+	// Follow the selected task when the new data moved it in the sort order
+	if i := slices.IndexFunc(m.GetSortedTasks(), func(task archon.Task) bool { return task.ID == selectedTaskID }); i >= 0 {
+		_ = m.setSelectedTask(i) // Components are refreshed below
+	}
 
 	// Log state change
 	m.programContext.Logger.LogStateChange("Model", "Tasks", oldTaskCount, len(tasks),
@@ -674,8 +895,12 @@ func (m *MainModel) updateTasks(tasks []archon.Task) {
 	// Refresh UI with new data (reuses common filter refresh logic)
 	m.refreshUIAfterFilterChange()
 
-	// Log performance
-	m.programContext.Logger.LogPerformance("UpdateTasks", startTime, "task_count", len(tasks))
+	// Log performance and search index size
+	indexStats := m.programContext.SearchIndex.Stats()
+	m.programContext.Logger.LogPerformance("UpdateTasks", startTime, "task_count", len(tasks),
+		"search_index_entries", indexStats.Entries, "search_index_bytes", indexStats.Bytes,
+		"search_index_generation", indexStats.Generation,
+		"clock_skew_ms", m.programContext.ClockSkew.Skew().Milliseconds())
 }
 
 // refreshUIAfterFilterChange refreshes the UI based on current data with new filters applied
@@ -938,7 +1163,13 @@ func (m MainModel) HasActiveModal() bool {
 		m.components.Modals.StatusModel.IsActive() ||
 		m.components.Modals.ConfirmationModel.IsActive() ||
 		m.components.Modals.FeatureModel.IsActive() ||
-		m.components.Modals.TaskEditModel.IsActive()
+		m.components.Modals.TaskEditModel.IsActive() ||
+		m.components.Modals.LinkPickerModel.IsActive() ||
+		m.components.Modals.DigestModel.IsActive() ||
+		m.components.Modals.BookmarkListModel.IsActive() ||
+		m.components.Modals.UnblockModel.IsActive() ||
+		m.components.Modals.APIKeyModel.IsActive() ||
+		m.components.Modals.DescDiffModel.IsActive()
 }
 
 // =============================================================================
@@ -958,13 +1189,53 @@ func (m MainModel) IsRightPanelActive() bool {
 // setActiveView sets the currently active panel
 // Components now read active state from UIState directly - no callbacks or messages needed
 func (m *MainModel) setActiveView(view ActiveView) tea.Cmd {
+	// Focusing hidden task details brings them back
+	var showCmd tea.Cmd
+	if view == RightPanel && m.uiState.IsTaskView() && m.uiState.HideDetails {
+		showCmd = m.setDetailsHidden(false)
+	}
+
 	// Update UIState (single source of truth)
 	m.uiState.SetActivePanel(context.ActivePanel(view))
 
+	// Focusing the details panel opens it (details_panel "manual")
+	if view == RightPanel && m.uiState.IsTaskView() {
+		return tea.Batch(showCmd, m.openDetails(), m.broadcastStatusBarState())
+	}
+
 	// Broadcast updated state to StatusBar (for active view indicator)
 	return m.broadcastStatusBarState()
 }
 
+// setDetailsHidden hides or shows the task details panel, lays the panels out
+// again for the new widths and saves the choice. Hiding the details moves the
+// focus to the task list.
+func (m *MainModel) setDetailsHidden(hidden bool) tea.Cmd {
+	m.uiState.HideDetails = hidden
+	if hidden {
+		m.uiState.SetActivePanel(context.LeftPanel)
+	}
+	_, resizeCmd := m.handleWindowResize(tea.WindowSizeMsg{
+		Width:  m.programContext.ScreenWidth,
+		Height: m.programContext.ScreenHeight,
+	})
+	return tea.Batch(resizeCmd, m.saveStateCmd(), m.broadcastStatusBarState())
+}
+
+// openDetails opens the selected task's details; with details_panel "manual"
+// the panel shows a placeholder until then
+func (m *MainModel) openDetails() tea.Cmd {
+	task := m.GetSelectedTask()
+	if task == nil || m.uiState.DetailsOpen(task.ID) {
+		return nil
+	}
+	m.uiState.OpenDetails(task.ID)
+	if m.programContext.Config.GetDetailsPanel() != configpkg.DetailsManual {
+		return nil
+	}
+	return m.updateTaskDetailsComponent()
+}
+
 // GetActiveViewName returns a human-readable name of the currently active view
 func (m MainModel) GetActiveViewName() string {
 	return m.uiState.GetActiveViewName()
@@ -992,7 +1263,8 @@ func (m *MainModel) cancelInlineSearch() tea.Cmd {
 func (m *MainModel) commitInlineSearch() tea.Cmd {
 	// Capture search input before state change clears it
 	searchQuery := m.uiState.CommitSearch()
-	return m.setSearchQuery(searchQuery) // Commit captured value to search query (app state)
+	cmd := m.setSearchQuery(searchQuery) // Commit captured value to search query (app state)
+	return tea.Batch(cmd, m.startServerSearch(m.uiState.SearchQuery))
 }
 
 // updateRealTimeSearch applies search filtering as user types
@@ -1006,6 +1278,9 @@ func (m *MainModel) setSearchQuery(query string) tea.Cmd {
 	query = strings.TrimSpace(query)
 	selectedTaskID := m.getSelectedTaskID()
 	oldQuery := m.uiState.SearchQuery
+	if query != oldQuery {
+		m.dropServerSearch()
+	}
 
 	m.updateSearchState(query)
 	m.findAndSelectTask(selectedTaskID)
@@ -1068,9 +1343,18 @@ func (m *MainModel) updateSearchMatches() {
 		return
 	}
 
-	// Use helper to find matching tasks
+	// Use the search index to find matching tasks
 	sortedTasks := m.GetSortedTasks()
-	indices, total := helpers.SearchTasks(
+	if m.programContext.ServerSearchResults != nil {
+		// The server matched every task listed, on fields the index may not cover
+		indices := make([]int, len(sortedTasks))
+		for i := range indices {
+			indices[i] = i
+		}
+		m.uiState.UpdateSearchMatches(indices, len(indices))
+		return
+	}
+	indices, total := m.programContext.SearchIndex.Search(
 		sortedTasks,
 		m.uiState.SearchQuery,
 	)
@@ -1100,6 +1384,7 @@ func (m *MainModel) previousSearchMatch() tea.Cmd {
 // ClearSearch clears the current search and broadcasts the change
 func (m *MainModel) clearSearch() tea.Cmd {
 	m.uiState.ClearSearch()
+	m.dropServerSearch()
 
 	// Broadcast the search state change - components will handle it themselves
 	return func() tea.Msg {
@@ -1114,6 +1399,31 @@ func (m *MainModel) clearSearch() tea.Cmd {
 // UI UTILITIES - SORTING, SCROLLING, CLIPBOARD
 // =============================================================================
 
+// touchTask records an interaction with a task for the recent sort mode.
+// In that mode the task moves up, so the selection is kept on the task it
+// was on.
+func (m *MainModel) touchTask(taskID string) {
+	selected := m.GetSelectedTask()
+	m.programContext.TouchTask(taskID)
+	if m.programContext.SortMode != sorting.SortRecent {
+		return
+	}
+	if selected != nil {
+		m.findAndSelectTask(selected.ID)
+	}
+	m.updateSearchMatches()
+}
+
+// isYankMsg reports whether msg copies something of the selected task
+func isYankMsg(msg tea.Msg) bool {
+	switch msg.(type) {
+	case messages.YankIDMsg, messages.YankTitleMsg, messages.YankCommitRefMsg, messages.YankPathMsg:
+		return true
+	default:
+		return false
+	}
+}
+
 // CycleSortMode cycles to the next sort mode
 func (m *MainModel) cycleSortMode() tea.Cmd {
 	// Remember currently selected task
@@ -1125,7 +1435,7 @@ func (m *MainModel) cycleSortMode() tea.Cmd {
 
 	// Cycle to next sort mode - ProgramContext.SortMode is the single source of truth
 	currentMode := m.programContext.SortMode
-	newMode := (currentMode + 1) % 4 // 4 sort modes: Status+Priority, Priority, Time, Alphabetical
+	newMode := (currentMode + 1) % sorting.SortModeCount // Status+Priority, Priority, Time, Alphabetical, Recent
 
 	// Log state change
 	m.programContext.Logger.LogStateChange("Model", "SortMode",
@@ -1155,7 +1465,7 @@ func (m *MainModel) cycleSortModePrevious() tea.Cmd {
 
 	// Cycle to previous sort mode - ProgramContext.SortMode is the single source of truth
 	currentMode := m.programContext.SortMode
-	newMode := (currentMode - 1 + 4) % 4 // 4 sort modes: wrap around
+	newMode := (currentMode - 1 + sorting.SortModeCount) % sorting.SortModeCount // Wrap around
 	m.programContext.SetSortMode(newMode)
 
 	// Find the same task in new sort order and select it
@@ -1187,14 +1497,26 @@ func (m MainModel) GetProjects() []archon.Project {
 // only that project's tasks are displayed. When nil (All Tasks), all tasks are shown.
 func (m MainModel) GetSortedTasks() []archon.Task {
 	filters := helpers.TaskFilters{
-		ProjectID:          m.programContext.SelectedProjectID,  // Global state (ProgramContext)
-		StatusFilters:      m.programContext.StatusFilters,      // User preference (ProgramContext)
-		StatusFilterActive: m.programContext.StatusFilterActive, // Computed from StatusFilters (ProgramContext)
-		FeatureFilters:     m.programContext.FeatureFilters,     // User preference (ProgramContext)
-		ShowCompletedTasks: m.programContext.ShowCompletedTasks, // User preference (ProgramContext)
+		ProjectID:          m.programContext.SelectedProjectID,       // Global state (ProgramContext)
+		StatusFilters:      m.programContext.StatusFilters,           // User preference (ProgramContext)
+		StatusFilterActive: m.programContext.StatusFilterActive,      // Computed from StatusFilters (ProgramContext)
+		FeatureFilters:     m.programContext.FeatureFilters,          // User preference (ProgramContext)
+		TagFilters:         m.programContext.TagFilters,              // User preference (ProgramContext)
+		ShowCompletedTasks: m.programContext.ShowCompletedTasks,      // User preference (ProgramContext)
+		CreatedSince:       m.programContext.CreatedSince,            // Created-today view (ProgramContext)
+		Hidden:             m.programContext.HiddenTasks,             // Done tasks hidden with X (ProgramContext)
+		MinPriority:        m.programContext.MinPriority,             // Priority threshold, +/- (ProgramContext)
+		OnlyTasks:          m.changedOnlyTasks(),                     // Changed-in-last-refresh view, u (ProgramContext)
+		LastInteraction:    m.programContext.LastInteraction,         // Recent sort mode (ProgramContext)
+		StatusOrder:        m.programContext.Config.GetStatusOrder(), // Status+priority precedence (Config)
+	}
+	// A server search lists the server's results instead of the loaded tasks
+	source := m.programContext.Tasks
+	if m.programContext.ServerSearchResults != nil {
+		source = m.programContext.ServerSearchResults
 	}
 	// ProgramContext.SortMode is the single source of truth for sort mode
-	return helpers.FilterAndSortTasks(m.programContext.Tasks, m.programContext.SortMode, filters)
+	return helpers.FilterAndSortTasks(source, m.programContext.SortMode, filters)
 }
 
 // GetSelectedTask returns the currently selected task or nil if none selected
@@ -1353,6 +1675,8 @@ func (m MainModel) GetSortModeName(mode int) string {
 		return "Created"
 	case sorting.SortAlphabetical:
 		return "Alpha"
+	case sorting.SortRecent:
+		return "Recent"
 	default:
 		return "Unknown"
 	}
@@ -1460,15 +1784,42 @@ func (m MainModel) GetVisibleFeatures() []string {
 // Respects: project selection, status filters, show completed setting
 // Ignores: feature filters (intentionally, so user can see all options to select/deselect)
 func (m MainModel) GetFeaturesForProjectSelection() []string {
+	return helpers.GetUniqueFeatures(m.tasksForFeatureSelection(m.programContext.SelectedProjectID))
+}
+
+// tasksForFeatureSelection returns the tasks of projectID (nil = every project)
+// the feature selection modal lists features of, ignoring feature filters
+func (m MainModel) tasksForFeatureSelection(projectID *string) []archon.Task {
 	filters := helpers.TaskFilters{
-		ProjectID:          m.programContext.SelectedProjectID,
+		ProjectID:          projectID,
 		StatusFilters:      m.programContext.StatusFilters,
 		StatusFilterActive: m.programContext.StatusFilterActive,
 		FeatureFilters:     nil, // Ignore feature filters for modal - show all project features
+		TagFilters:         m.programContext.TagFilters,
 		ShowCompletedTasks: m.programContext.ShowCompletedTasks,
+		CreatedSince:       m.programContext.CreatedSince,
+		Hidden:             m.programContext.HiddenTasks,
+		MinPriority:        m.programContext.MinPriority,
+		OnlyTasks:          m.changedOnlyTasks(),
 	}
-	tasksWithoutFeatureFilter := helpers.FilterAndSortTasks(m.programContext.Tasks, m.programContext.SortMode, filters)
-	return helpers.GetUniqueFeatures(tasksWithoutFeatureFilter)
+	return helpers.FilterAndSortTasks(m.programContext.Tasks, m.programContext.SortMode, filters)
+}
+
+// tasksForTagSelection returns the tasks of the selected project the tag
+// selection modal lists tags of, ignoring the tag filter
+func (m MainModel) tasksForTagSelection() []archon.Task {
+	filters := helpers.TaskFilters{
+		ProjectID:          m.programContext.SelectedProjectID,
+		StatusFilters:      m.programContext.StatusFilters,
+		StatusFilterActive: m.programContext.StatusFilterActive,
+		FeatureFilters:     m.programContext.FeatureFilters,
+		ShowCompletedTasks: m.programContext.ShowCompletedTasks,
+		CreatedSince:       m.programContext.CreatedSince,
+		Hidden:             m.programContext.HiddenTasks,
+		MinPriority:        m.programContext.MinPriority,
+		OnlyTasks:          m.changedOnlyTasks(),
+	}
+	return helpers.FilterAndSortTasks(m.programContext.Tasks, m.programContext.SortMode, filters)
 }
 
 // GetFeatureFilterSummary returns a summary of active feature filters
@@ -1507,6 +1858,11 @@ func (m MainModel) isRealtimeEnabled() bool {
 // startPolling starts the HTTP polling loop for auto-refresh
 // This is used when WebSocket is disabled (backend doesn't support it)
 func (m MainModel) startPolling() tea.Cmd {
+	// Safe mode never refreshes on its own; 'r' still reloads
+	if m.programContext.SafeMode {
+		return nil
+	}
+
 	// Get polling interval from config (default: 10 seconds)
 	interval := 10 * time.Second
 	if cfg, ok := m.programContext.ConfigProvider.(*configpkg.Config); ok {
@@ -1525,8 +1881,8 @@ func (m MainModel) startPolling() tea.Cmd {
 func (m *MainModel) handlePollingTick() (tea.Model, tea.Cmd) {
 	// Refresh tasks and projects via HTTP
 	return m, tea.Batch(
-		tasks.LoadTasksInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID),
-		projects.LoadProjectsInterface(m.programContext.ArchonClient),
+		m.requestTaskReload(helpers.RefreshPoll),
+		m.loadProjectsCmd(),
 		m.startPolling(), // Schedule next polling tick
 	)
 }
@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon/replay"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+)
+
+// =============================================================================
+// FRAME BUDGET
+// =============================================================================
+// In debug or profiling mode every Update and View call is timed. Calls over
+// development.frame_budget_ms are logged with the message type and the active
+// view or modal, and a summary of the slowest keys is logged on exit.
+
+// frameSummaryRows is how many keys and slow frames the exit summary lists
+const frameSummaryRows = 5
+
+// slowFramesFile collects the slow frames seen while recording, one JSON
+// object per line, so slow frames can be matched with the captured session
+const slowFramesFile = "slow_frames.jsonl"
+
+// slowFrameBuffer is how many slow frames can wait for the writer before new ones are dropped
+const slowFrameBuffer = 64
+
+// newFrameStats returns frame statistics when timing is enabled, nil otherwise
+func newFrameStats(cfg *configpkg.Config) *helpers.FrameStats {
+	if cfg == nil || !cfg.IsFrameTimingEnabled() {
+		return nil
+	}
+	return helpers.NewFrameStats(cfg.GetFrameBudget(), helpers.DefaultFrameWindow)
+}
+
+// observeFrame records one Update (msg != nil) or View call started at start
+func (m *MainModel) observeFrame(phase string, msg tea.Msg, start time.Time) {
+	var message string
+	if msg != nil {
+		message = fmt.Sprintf("%T", msg)
+	}
+	frame, slow := m.frames.Observe(phase, message, m.frameView(), time.Since(start))
+	if slow {
+		m.programContext.Logger.Warn("Slow frame", "phase", frame.Phase, "message", frame.Message,
+			"view", frame.View, "duration", frame.Duration, "budget", m.frames.Budget())
+	}
+}
+
+// frameView names what is on screen: the frontmost modal, else the task or project view
+func (m *MainModel) frameView() string {
+	if id := m.components.Modals.ActiveID(); id != "" {
+		return id
+	}
+	if m.uiState.IsProjectView() {
+		return "projects"
+	}
+	return "tasks"
+}
+
+// recordSlowFrames appends slow frames to the HTTP capture (--record-http),
+// so a replay of the capture can be matched with the frames that were slow.
+// Frames are written from a background goroutine so the disk IO never lands
+// in the frames being measured.
+func (m *MainModel) recordSlowFrames(recorder *replay.Recorder) {
+	if m.frames == nil || recorder == nil {
+		return
+	}
+	m.slowFrames = newSlowFrameLog(recorder, m.programContext.Logger)
+	m.frames.OnSlow = m.slowFrames.add
+}
+
+// FlushSlowFrames waits for recorded slow frames to reach the capture and
+// stops the writer; it does nothing when slow frames are not recorded
+func (m *MainModel) FlushSlowFrames() {
+	if m.slowFrames == nil {
+		return
+	}
+	m.slowFrames.close()
+	m.slowFrames = nil
+}
+
+// slowFrameLog hands slow frames to a goroutine that appends them to the capture
+type slowFrameLog struct {
+	frames  chan helpers.SlowFrame
+	done    chan struct{}
+	dropped int // Frames lost to a full buffer; only touched on the update goroutine
+	logger  interfaces.Logger
+}
+
+func newSlowFrameLog(recorder *replay.Recorder, logger interfaces.Logger) *slowFrameLog {
+	l := &slowFrameLog{
+		frames: make(chan helpers.SlowFrame, slowFrameBuffer),
+		done:   make(chan struct{}),
+		logger: logger,
+	}
+	go func() {
+		defer close(l.done)
+		for frame := range l.frames {
+			if err := recorder.AppendEvent(slowFramesFile, frame); err != nil {
+				logger.Warn("Failed to record slow frame", "error", err)
+			}
+		}
+	}()
+	return l
+}
+
+// add queues a frame without blocking; when the writer falls behind the frame is dropped
+func (l *slowFrameLog) add(frame helpers.SlowFrame) {
+	select {
+	case l.frames <- frame:
+	default:
+		l.dropped++
+	}
+}
+
+// close writes the queued frames and stops the writer
+func (l *slowFrameLog) close() {
+	close(l.frames)
+	<-l.done
+	if l.dropped > 0 {
+		l.logger.Warn("Dropped slow frames while recording", "count", l.dropped)
+	}
+}
+
+// LogFrameSummary logs the slowest message types and views and the worst
+// recent frames; it does nothing when frame timing is off
+func (m *MainModel) LogFrameSummary() {
+	if m.frames == nil {
+		return
+	}
+	logger := m.programContext.Logger
+	percentiles := m.frames.Percentiles()
+	for _, p := range percentiles[:min(frameSummaryRows, len(percentiles))] {
+		logger.Info("Frame timings", "key", p.Key, "count", p.Count,
+			"p50", p.P50, "p95", p.P95, "p99", p.P99, "max", p.Max)
+	}
+	for _, frame := range m.frames.Worst(frameSummaryRows) {
+		logger.Info("Slowest frame", "phase", frame.Phase, "message", frame.Message,
+			"view", frame.View, "duration", frame.Duration, "at", frame.At)
+	}
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+)
+
+// =============================================================================
+// API KEY PROMPT
+// =============================================================================
+// On a 401 the client re-reads the key from its sources and retries once.
+// When that still fails the request surfaces archon.ErrUnauthorized, and the
+// user is asked for a key. It is kept in memory for this session only. The
+// prompt opens at most once per failure streak: dismissing it keeps it closed
+// until the next manual refresh.
+
+// apiKeyPromptReason explains the prompt
+const apiKeyPromptReason = "The server rejected the API key and no new one was found in " +
+	"LAZYARCHON_API_KEY, server.api_key_command or the config file. " +
+	"The key entered here is kept until lazyarchon exits."
+
+// unauthorizedError returns the 401 carried by a load or mutation result, if any
+func unauthorizedError(msg tea.Msg) error {
+	var errs []error
+	switch msg := msg.(type) {
+	case tasks.TasksLoadedMsg:
+		errs = append(errs, msg.Error)
+	case projects.ProjectsLoadedMsg:
+		errs = append(errs, msg.Error)
+	case tasks.TaskUpdateMsg:
+		errs = append(errs, msg.Error)
+	case tasks.TaskDeleteMsg:
+		errs = append(errs, msg.Error)
+	case tasks.TasksSearchedMsg:
+		errs = append(errs, msg.Error)
+	case tasks.TasksFeatureUpdateMsg:
+		for _, err := range msg.Errors {
+			errs = append(errs, err)
+		}
+	case tasks.TasksArchivedMsg:
+		for _, err := range msg.Errors {
+			errs = append(errs, err)
+		}
+	}
+	for _, err := range errs {
+		if errors.Is(err, archon.ErrUnauthorized) {
+			return err
+		}
+	}
+	return nil
+}
+
+// promptForAPIKey opens the API key prompt when msg failed with a 401, unless
+// it was dismissed or a modal (the prompt included) is already showing
+func (m *MainModel) promptForAPIKey(msg tea.Msg) tea.Cmd {
+	if unauthorizedError(msg) == nil || m.apiKeyPromptDismissed || m.HasActiveModal() {
+		return nil
+	}
+	if _, ok := m.programContext.ArchonClient.(interfaces.APIKeySetter); !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		return apikey.ShowAPIKeyModalMsg{Reason: apiKeyPromptReason}
+	}
+}
+
+// handleAPIKeyEntered switches the client to the entered key and reloads
+func (m *MainModel) handleAPIKeyEntered(msg apikey.APIKeyEnteredMsg) tea.Cmd {
+	setter, ok := m.programContext.ArchonClient.(interfaces.APIKeySetter)
+	if !ok {
+		return nil
+	}
+	setter.SetAPIKey(msg.Key)
+	return tea.Batch(
+		statusFeedback("API key updated for this session; reloading"),
+		m.requestTaskReload(helpers.RefreshManual),
+		m.loadProjectsCmd(),
+	)
+}
+
+// handleAPIKeyCanceled keeps the prompt closed until the next manual refresh
+func (m *MainModel) handleAPIKeyCanceled() tea.Cmd {
+	m.apiKeyPromptDismissed = true
+	return statusFeedback("API key prompt dismissed; press r to retry")
+}
@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// ARCHIVE DONE TASKS
+// =============================================================================
+// 'X' cleans up after a sprint: it collects the done tasks in the current view
+// and, once confirmed, applies ui.display.archive_done. "archive" archives them
+// on the server one by one with a progress bar, skipping read-only projects;
+// tasks the server cannot archive are hidden locally instead. "hide" only
+// hides them until lazyarchon exits.
+
+// pendingArchive is the done task cleanup awaiting confirmation
+type pendingArchive struct {
+	taskIDs []string
+	hide    bool // Hide locally instead of archiving on the server
+}
+
+// handleArchiveDoneKey handles 'X' key - archive or hide the done tasks in view
+func (m *MainModel) handleArchiveDoneKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyXCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	pending := &pendingArchive{hide: m.programContext.Config.GetArchiveDone() == configpkg.ArchiveDoneHide}
+
+	var details []string
+	readOnly := 0
+	for _, task := range m.GetSortedTasks() {
+		if task.Status != archon.TaskStatusDone {
+			continue
+		}
+		if !pending.hide && m.programContext.IsProjectReadOnly(task.ProjectID) {
+			readOnly++
+			continue
+		}
+		if len(pending.taskIDs) < maxRolloverPreview {
+			details = append(details, "• "+task.Title)
+		}
+		pending.taskIDs = append(pending.taskIDs, task.ID)
+	}
+	if len(pending.taskIDs) == 0 {
+		if readOnly > 0 {
+			return statusFeedback("Read-only project: task changes are disabled"), true
+		}
+		return statusFeedback("No done tasks in view"), true
+	}
+	if more := len(pending.taskIDs) - len(details); more > 0 {
+		details = append(details, fmt.Sprintf("… and %d more", more))
+	}
+	if readOnly > 0 {
+		details = append(details, "", fmt.Sprintf("Skipping %s in read-only projects", pluralTasks(readOnly)))
+	}
+
+	showMsg := confirmation.ShowConfirmationModalMsg{
+		Message:     fmt.Sprintf("Archive %s?", pluralDone(len(pending.taskIDs))),
+		Details:     details,
+		ConfirmText: "Archive",
+		CancelText:  "Cancel",
+		Destructive: true,
+	}
+	if pending.hide {
+		showMsg.Message = fmt.Sprintf("Hide %s until lazyarchon exits?", pluralDone(len(pending.taskIDs)))
+		showMsg.ConfirmText = "Hide"
+		showMsg.Destructive = false
+	}
+	m.pendingArchive = pending
+	return func() tea.Msg { return showMsg }, true
+}
+
+// resolveArchiveConfirmation answers the done task cleanup prompt
+func (m *MainModel) resolveArchiveConfirmation(pending pendingArchive, confirmed bool) tea.Cmd {
+	if !confirmed {
+		return nil
+	}
+	if pending.hide {
+		m.hideTasks(pending.taskIDs)
+		return statusFeedback("Hid " + pluralDone(len(pending.taskIDs)) + " until exit")
+	}
+
+	// The status bar shows a progress bar while the tasks are archived one by one
+	batch := fmt.Sprintf("archive:%d", time.Now().UnixNano())
+	return tea.Batch(
+		m.handleOperationStarted(messages.OperationStartedMsg{ID: batch, Label: "Archiving done tasks", Total: len(pending.taskIDs)}),
+		tasks.ArchiveTasks(m.programContext.ArchonClient, batch, pending.taskIDs),
+	)
+}
+
+// handleArchiveProgress advances the progress of a batch archive and continues the batch
+func (m *MainModel) handleArchiveProgress(msg tasks.TaskArchiveProgressMsg) tea.Cmd {
+	item := msg.TaskID
+	if task := m.programContext.FindTask(msg.TaskID); task != nil {
+		item = task.Title
+	}
+	return tea.Batch(
+		m.handleOperationFeedback(messages.StatusFeedbackMsg{Message: item, Operation: msg.Batch, Err: msg.Error}),
+		msg.Next,
+	)
+}
+
+// handleTasksArchived reports a batch archive in one status message, hides the
+// tasks the server could not archive and refreshes the tasks once for the batch
+func (m *MainModel) handleTasksArchived(msg tasks.TasksArchivedMsg) tea.Cmd {
+	var unsupported []string
+	failed := make(map[string]error)
+	for taskID, err := range msg.Errors {
+		if errors.Is(err, archon.ErrNotSupported) {
+			unsupported = append(unsupported, taskID)
+		} else {
+			failed[taskID] = err
+		}
+	}
+	if len(msg.Archived) == 0 && len(unsupported) == 0 {
+		for taskID, err := range failed {
+			if cmd, handled := m.handleForbiddenMutation(taskID, err); handled {
+				return cmd
+			}
+			return statusFeedback("Failed to archive tasks: " + err.Error())
+		}
+		return nil
+	}
+
+	var summary []string
+	if len(msg.Archived) > 0 {
+		summary = append(summary, "Archived "+pluralDone(len(msg.Archived)))
+	}
+	if len(unsupported) > 0 {
+		m.hideTasks(unsupported)
+		summary = append(summary, "hid "+pluralTasks(len(unsupported))+" the server cannot archive")
+	}
+	text := strings.Join(summary, ", ")
+	text = strings.ToUpper(text[:1]) + text[1:]
+	if len(failed) > 0 {
+		text += fmt.Sprintf(" (%d failed)", len(failed))
+		for _, taskID := range slices.Sorted(maps.Keys(failed)) {
+			m.programContext.Logger.Warn("Archiving task failed", "task_id", taskID, "error", failed[taskID])
+		}
+	}
+	if len(msg.Archived) == 0 {
+		return statusFeedback(text)
+	}
+	return tea.Batch(
+		statusFeedback(text),
+		m.requestTaskReload(helpers.RefreshMutation),
+	)
+}
+
+// hideTasks hides tasks from the task list until exit
+func (m *MainModel) hideTasks(taskIDs []string) {
+	m.programContext.HideTasks(taskIDs)
+	m.refreshUIAfterFilterChange()
+}
+
+// pluralDone formats a done task count for the cleanup prompt and messages
+func pluralDone(n int) string {
+	if n == 1 {
+		return "1 done task"
+	}
+	return fmt.Sprintf("%d done tasks", n)
+}
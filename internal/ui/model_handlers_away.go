@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
+)
+
+// =============================================================================
+// AWAY DIGEST
+// =============================================================================
+// This file records when tasks were last seen and, after a long gap, shows
+// what changed for the user while lazyarchon was closed.
+
+// loadAwayStore opens the away baseline store and reads the previous baseline, if any.
+// Returns a nil store when the digest is disabled.
+func loadAwayStore(cfg *configpkg.Config, logger interfaces.Logger) (*away.Store, *away.Baseline) {
+	if cfg == nil || !cfg.IsAwayDigestEnabled() {
+		return nil, nil
+	}
+
+	path, err := away.DefaultPath()
+	if err != nil {
+		logger.Warn("Away digest disabled", "error", err)
+		return nil, nil
+	}
+
+	store := away.NewStore(path)
+	baseline, err := store.Load()
+	if err != nil {
+		// A corrupt or incompatible baseline is dropped; the next save replaces it
+		logger.Warn("Ignoring previous away baseline", "path", path, "error", err)
+		return store, nil
+	}
+	return store, baseline
+}
+
+// handleAwayBaseline computes the digest on the first task load, then records
+// the loaded tasks as seen. Skipped silently when there is no earlier baseline.
+func (m *MainModel) handleAwayBaseline() tea.Cmd {
+	if m.awayStore == nil {
+		return nil
+	}
+
+	now := m.programContext.Now()
+	var cmds []tea.Cmd
+	if !m.awayChecked {
+		m.awayChecked = true
+		cfg := m.programContext.Config
+		m.awayDigest = away.Compute(m.awayBaseline, m.programContext.Tasks, away.Options{
+			CurrentUser:      cfg.GetCurrentUser(),
+			DefaultProjectID: cfg.GetDefaultProjectID(),
+			Threshold:        cfg.GetAwayThreshold(),
+			Now:              now,
+		})
+		if m.awayDigest != nil {
+			cmds = append(cmds, m.showAwayDigest())
+		}
+	}
+
+	baseline := away.Capture(m.awayBaseline, m.programContext.Tasks, now)
+	m.awayBaseline = &baseline
+	store, logger := m.awayStore, m.programContext.Logger
+	cmds = append(cmds, func() tea.Msg {
+		if err := store.Save(baseline); err != nil {
+			logger.Warn("Failed to save away baseline", "error", err)
+		}
+		return nil
+	})
+	return tea.Batch(cmds...)
+}
+
+// showAwayDigest opens the digest, or points to the 'A' key when another prompt is showing
+func (m *MainModel) showAwayDigest() tea.Cmd {
+	if m.HasActiveModal() || m.sessionPromptOpen || m.pendingSession != nil {
+		return statusFeedback(fmt.Sprintf("%d update(s) while you were away - press A to review", len(m.awayDigest.Items)))
+	}
+	digestCopy := *m.awayDigest
+	return func() tea.Msg {
+		return digest.ShowDigestModalMsg{Digest: digestCopy}
+	}
+}
+
+// HandleAwayDigestKey handles 'A' key - show the away digest again
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleAwayDigestKey(key string) (tea.Cmd, bool) {
+	if m.awayDigest == nil {
+		return statusFeedback("Nothing changed for you while you were away"), true
+	}
+	return m.showAwayDigest(), true
+}
+
+// jumpToDigestTask selects a task chosen in the digest
+func (m *MainModel) jumpToDigestTask(taskID string) tea.Cmd {
+	if index, ok := m.sortedTaskIndex(taskID); ok {
+		return m.setSelectedTask(index)
+	}
+	if m.programContext.FindTask(taskID) != nil {
+		return statusFeedback("Task is hidden by current filters")
+	}
+	return statusFeedback("Task is not loaded")
+}
@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/taskdiff"
+)
+
+// =============================================================================
+// CHANGED IN THE LAST REFRESH
+// =============================================================================
+// Every refresh after the first load is compared with the tasks it replaces.
+// 'u' narrows the list to the tasks the latest refresh added or changed, on top
+// of the other filters, until 'u' or Esc is pressed or the next refresh arrives
+// with its own changes. Removed tasks are only counted: they are gone.
+
+// recordRefreshChanges remembers what a refresh added or changed and ends the
+// view of the previous refresh's changes
+func (m *MainModel) recordRefreshChanges(previous []archon.Task, firstLoad bool) {
+	ctx := m.programContext
+	ctx.RefreshChanges = nil
+	if !firstLoad {
+		for _, change := range taskdiff.Diff(previous, ctx.Tasks) {
+			if change.Kind == taskdiff.Removed {
+				continue
+			}
+			if ctx.RefreshChanges == nil {
+				ctx.RefreshChanges = make(map[string]bool)
+			}
+			ctx.RefreshChanges[change.Task.ID] = true
+		}
+	}
+	if ctx.ChangedOnly {
+		m.setChangedOnly(false)
+	}
+}
+
+// changedOnlyTasks returns the task IDs the changed-tasks view shows (nil = view off)
+func (m MainModel) changedOnlyTasks() map[string]bool {
+	if !m.programContext.ChangedOnly {
+		return nil
+	}
+	if m.programContext.RefreshChanges == nil {
+		return map[string]bool{}
+	}
+	return m.programContext.RefreshChanges
+}
+
+// setChangedOnly turns the changed-tasks view on or off
+func (m *MainModel) setChangedOnly(on bool) {
+	m.programContext.ChangedOnly = on
+	m.refreshUIAfterFilterChange()
+}
+
+// handleChangedOnlyKey handles 'u' key - show only the tasks changed by the last refresh
+func (m *MainModel) handleChangedOnlyKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyU || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	if m.programContext.ChangedOnly {
+		m.setChangedOnly(false)
+		return statusFeedback("Showing all tasks"), true
+	}
+	if len(m.programContext.RefreshChanges) == 0 {
+		return statusFeedback("Nothing was added or changed by the last refresh"), true
+	}
+
+	m.setChangedOnly(true)
+	shown := len(m.GetSortedTasks())
+	if hidden := len(m.programContext.RefreshChanges) - shown; hidden > 0 {
+		return statusFeedback(fmt.Sprintf("Showing %s changed by the last refresh (%d hidden by filters)", pluralTasks(shown), hidden)), true
+	}
+	return statusFeedback(fmt.Sprintf("Showing %s changed by the last refresh (u or Esc shows all)", pluralTasks(shown))), true
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clipboard"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// CLIPBOARD
+// =============================================================================
+// Components ask to copy with messages.CopyToClipboardMsg. Small payloads are
+// copied straight away; payloads above ui.clipboard.confirm_above, or too large
+// for the backend, ask first and offer saving to a file instead. A failed
+// copy offers the same fallback; when the system clipboard was found unusable
+// at startup (no clipboard tool or display, typical over SSH), the prompt says
+// why and shows the text so it can be selected in the terminal.
+
+// copyPreviewLimit is the longest copied text echoed back in the status bar
+const copyPreviewLimit = 80
+
+// copyPreviewLines is the number of lines shown when the clipboard is unavailable
+const copyPreviewLines = 8
+
+// clipboardCopiedMsg reports the outcome of a background clipboard write
+type clipboardCopiedMsg struct {
+	request messages.CopyToClipboardMsg
+	result  clipboard.Result
+	err     error
+}
+
+// pendingCopy is a copy waiting on the large-copy or failed-copy prompt
+type pendingCopy struct {
+	request messages.CopyToClipboardMsg
+	failed  bool // The write failed; the prompt's confirm choice saves to a file
+}
+
+// newClipboard builds the clipboard from configuration; a nil out disables OSC 52
+func newClipboard(cfg *config.Config, out io.Writer) *clipboard.Clipboard {
+	return clipboard.New(clipboard.Options{
+		Backend:      clipboard.Backend(cfg.GetClipboardBackend()),
+		MaxBytes:     cfg.GetClipboardMaxBytes(),
+		ConfirmAbove: cfg.GetClipboardConfirmAbove(),
+		SaveDir:      cfg.GetClipboardSaveDir(),
+		Output:       out,
+	})
+}
+
+// AttachClipboard lets clipboard writes use OSC 52 on out (see ui.clipboard.backend).
+// Call before the program starts.
+func (m *MainModel) AttachClipboard(out io.Writer) {
+	m.clipboard = newClipboard(m.programContext.Config, out)
+	if err := m.clipboard.Unavailable(); err != nil && m.clipboard.Backend() == clipboard.BackendSystem {
+		m.programContext.Logger.Info("System clipboard unavailable, copies will offer a file instead", "reason", err)
+	}
+}
+
+// handleCopyToClipboard copies small payloads and asks before copying large ones
+func (m *MainModel) handleCopyToClipboard(msg messages.CopyToClipboardMsg) tea.Cmd {
+	plan := m.clipboard.Plan(msg.Text)
+	if !plan.Confirm {
+		return m.copyCmd(msg)
+	}
+
+	m.pendingCopy = &pendingCopy{request: msg}
+	prompt := fmt.Sprintf("Copy %s %s to clipboard?", clipboard.FormatSize(plan.Size), msg.What)
+	if plan.Truncate {
+		prompt += fmt.Sprintf("\nOnly the first %s fits.", clipboard.FormatSize(plan.Limit))
+	}
+	return func() tea.Msg {
+		return confirmation.ShowConfirmationModalMsg{
+			Message:     prompt,
+			ConfirmText: "Copy",
+			CancelText:  "Cancel",
+			AltKey:      "s",
+			AltText:     "save to file",
+		}
+	}
+}
+
+// copyCmd writes to the clipboard in the background
+func (m *MainModel) copyCmd(request messages.CopyToClipboardMsg) tea.Cmd {
+	cb := m.clipboard
+	return func() tea.Msg {
+		result, err := cb.Write(request.Text)
+		return clipboardCopiedMsg{request: request, result: result, err: err}
+	}
+}
+
+// handleClipboardCopied reports a finished write, offering to save to a file when it failed
+func (m *MainModel) handleClipboardCopied(msg clipboardCopiedMsg) tea.Cmd {
+	what := msg.request.What
+	switch {
+	case errors.Is(msg.err, clipboard.ErrUnavailable):
+		m.pendingCopy = &pendingCopy{request: msg.request, failed: true}
+		details := append(copyPreview(msg.request.Text), "",
+			`Terminals with OSC 52 can copy over SSH: set ui.clipboard.backend to "osc52"`)
+		return func() tea.Msg {
+			return confirmation.ShowConfirmationModalMsg{
+				Message:     fmt.Sprintf("Cannot copy %s: %v.\nSelect it below or save it to a file.", what, msg.err),
+				Details:     details,
+				ConfirmText: "Save",
+				CancelText:  "Close",
+				Destructive: true, // Closing may drop the only copy, e.g. an edit of a deleted task
+			}
+		}
+	case msg.err != nil:
+		m.programContext.Logger.Warn("Clipboard write failed", "backend", msg.result.Backend, "error", msg.err)
+		m.pendingCopy = &pendingCopy{request: msg.request, failed: true}
+		return func() tea.Msg {
+			return confirmation.ShowConfirmationModalMsg{
+				Message:     fmt.Sprintf("Failed to copy %s.\nSave it to a file instead?", what),
+				ConfirmText: "Save",
+				CancelText:  "Cancel",
+				Destructive: true,
+			}
+		}
+	case msg.result.Truncated:
+		return statusFeedback(fmt.Sprintf("Copied only the first %s of %s %s - clipboard limit reached",
+			clipboard.FormatSize(msg.result.Written), clipboard.FormatSize(msg.result.Size), what))
+	case len(msg.request.Text) > copyPreviewLimit:
+		return statusFeedback(fmt.Sprintf("Copied %s (%s)", what, clipboard.FormatSize(msg.result.Size)))
+	default:
+		return statusFeedback(fmt.Sprintf("Copied %s: %s", what, msg.request.Text))
+	}
+}
+
+// copyPreview returns the first lines of text, each cut to copyPreviewLimit runes
+func copyPreview(text string) []string {
+	lines := strings.Split(text, "\n")
+	preview := make([]string, 0, copyPreviewLines+1)
+	for i, line := range lines {
+		if i == copyPreviewLines {
+			preview = append(preview, fmt.Sprintf("… %d more lines", len(lines)-i))
+			break
+		}
+		if runes := []rune(line); len(runes) > copyPreviewLimit {
+			line = string(runes[:copyPreviewLimit-1]) + "…"
+		}
+		preview = append(preview, line)
+	}
+	return preview
+}
+
+// resolveCopyConfirmation acts on the answer to a large-copy or failed-copy prompt
+func (m *MainModel) resolveCopyConfirmation(pending pendingCopy, answer confirmation.ConfirmationSelectedMsg) tea.Cmd {
+	switch {
+	case answer.Alternate, answer.Confirmed && pending.failed:
+		return m.saveCopyToFile(pending.request)
+	case answer.Confirmed:
+		return m.copyCmd(pending.request)
+	default:
+		return statusFeedback("Copy canceled")
+	}
+}
+
+// saveCopyToFile writes the payload to a file as the clipboard fallback
+func (m *MainModel) saveCopyToFile(request messages.CopyToClipboardMsg) tea.Cmd {
+	path, err := m.clipboard.SaveToFile(request.What, request.Text)
+	if err != nil {
+		return statusFeedback(fmt.Sprintf("Failed to save %s: %v", request.What, err))
+	}
+	return statusFeedback(fmt.Sprintf("Saved %s to %s", request.What, path))
+}
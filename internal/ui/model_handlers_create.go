@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+)
+
+// =============================================================================
+// TASK CREATION
+// =============================================================================
+// 'i' opens the task edit modal in create mode, with title and description
+// fields above the usual ones. Tasks are created in the selected project, so
+// All Tasks asks for a project first. Once the server has created the task the
+// list is refreshed and the new task selected, clearing filters that hide it.
+
+// handleTaskCreateKey handles 'i' key - open the new task form
+func (m *MainModel) handleTaskCreateKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyI || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	projectID := m.programContext.SelectedProjectID
+	if projectID == nil {
+		return statusFeedback("Select a project first (p): new tasks are created in the selected project"), true
+	}
+	if cmd := m.readOnlyProjectFeedback(*projectID); cmd != nil {
+		return cmd, true
+	}
+
+	return func() tea.Msg {
+		return taskedit.ShowTaskEditModalMsg{
+			Create:            true,
+			CurrentStatus:     archon.TaskStatusTodo,
+			AvailableFeatures: m.GetUniqueFeatures(),
+			ConfirmDiscard:    m.programContext.Config.ShouldConfirmDiscardEdits(),
+		}
+	}, true
+}
+
+// createTask sends the new task from the form to the server
+func (m *MainModel) createTask(msg taskedit.TaskCreateRequestedMsg) tea.Cmd {
+	projectID := m.programContext.SelectedProjectID
+	if projectID == nil {
+		return statusFeedback("Select a project first (p): new tasks are created in the selected project")
+	}
+	// Refuse the task if the project became read-only while the modal was open
+	if cmd := m.readOnlyProjectFeedback(*projectID); cmd != nil {
+		return cmd
+	}
+
+	createRequest := archon.CreateTaskRequest{
+		Title:       msg.Title,
+		Description: msg.Description,
+		ProjectID:   *projectID,
+		Status:      msg.Status,
+		Priority:    msg.Priority,
+	}
+	if msg.Feature != "" {
+		createRequest.Feature = &msg.Feature
+	}
+	return tea.Batch(
+		m.setLoadingWithMessage(true, "Creating task..."),
+		tasks.CreateTask(m.programContext.ArchonClient, createRequest),
+	)
+}
+
+// handleTaskCreated refreshes the list after a task was created, to select it
+func (m *MainModel) handleTaskCreated(msg tasks.TaskCreatedMsg) tea.Cmd {
+	if msg.Error != nil {
+		m.setLoading(false)
+		if errors.Is(msg.Error, archon.ErrForbidden) {
+			return m.forbiddenProjectFeedback(msg.ProjectID)
+		}
+		return m.setError("Failed to create task: " + msg.Error.Error())
+	}
+
+	m.pendingCreatedTaskID = msg.Task.ID
+	m.touchTask(msg.Task.ID)
+	return tea.Batch(
+		m.setLoadingWithMessage(true, "Refreshing tasks..."),
+		m.requestTaskReload(helpers.RefreshMutation),
+	)
+}
+
+// finishTaskCreate selects the created task once the refreshed tasks are loaded
+func (m *MainModel) finishTaskCreate() tea.Cmd {
+	taskID := m.pendingCreatedTaskID
+	m.pendingCreatedTaskID = ""
+
+	task := m.programContext.FindTask(taskID)
+	if task == nil {
+		return statusFeedback("Task created, but the refreshed list does not include it yet")
+	}
+	if _, ok := m.sortedTaskIndex(taskID); !ok {
+		m.revealTask(*task)
+	}
+	m.findAndSelectTask(taskID)
+	return statusFeedback("Created task: " + task.Title)
+}
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/scratchpad"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// EDITS OF REMOTELY DELETED TASKS
+// =============================================================================
+// A refresh can drop the task an open edit refers to because a teammate
+// deleted it. Saving would then fail with "task not found" and lose the edit,
+// so the edit stays open with a warning and saving copies it to the clipboard
+// instead:
+//   - Task edit modal: shows a banner; Enter copies the edited fields and closes
+//   - Scratchpad preview: asks again, offering to copy the scratchpad
+//   - Scratchpad still in the editor: copied when the editor exits
+//
+// An update the server rejects with "task not found" was made before any
+// refresh noticed the deletion. The task is dropped, open edits of it are
+// protected as above, and the change is offered for re-creating the task with
+// it applied, or for copying as a scratchpad.
+
+// pendingRecovery is an update of a task deleted remotely, awaiting re-create or copy
+type pendingRecovery struct {
+	task archon.Task // The deleted task with the update applied
+}
+
+// protectDeletedEdits checks open edits against the current task list after
+// tasks were removed from it
+func (m *MainModel) protectDeletedEdits() tea.Cmd {
+	var cmds []tea.Cmd
+
+	editModal := m.components.Modals.TaskEditModel
+	if id := editModal.EditingTaskID(); id != "" && !editModal.TaskDeleted() && m.programContext.FindTask(id) == nil {
+		editModal.MarkTaskDeleted()
+		cmds = append(cmds, statusFeedback("The task you are editing was deleted remotely"))
+	}
+
+	if pending := m.pendingScratchpad; pending != nil && !pending.deleted && m.programContext.FindTask(pending.taskID) == nil {
+		pending.deleted = true
+		message := "'" + pending.title + "' was deleted remotely.\nCopy your scratchpad edit?"
+		details := pending.preview
+		cmds = append(cmds, func() tea.Msg {
+			return confirmation.ShowConfirmationModalMsg{
+				Message:     message,
+				Details:     details,
+				ConfirmText: "Copy",
+				CancelText:  "Discard",
+				Destructive: true,
+			}
+		})
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// recoverDeletedUpdate handles an update that failed because the task no
+// longer exists, offering to re-create the task with the change
+func (m *MainModel) recoverDeletedUpdate(msg tasks.TaskUpdateMsg) tea.Cmd {
+	task := m.programContext.FindTask(msg.TaskID)
+	if task == nil || msg.Updates == nil {
+		return tea.Batch(m.protectDeletedEdits(), statusFeedback("The task was deleted remotely; your change was not saved"))
+	}
+	edited := applyUpdate(*task, *msg.Updates)
+	m.updateTasks(slices.DeleteFunc(slices.Clone(m.programContext.Tasks), func(task archon.Task) bool {
+		return task.ID == msg.TaskID
+	}))
+
+	m.pendingRecovery = &pendingRecovery{task: edited}
+	message := "'" + edited.Title + "' was deleted remotely before your change was saved.\nRe-create it as a new task with your change?"
+	return tea.Batch(m.protectDeletedEdits(), func() tea.Msg {
+		return confirmation.ShowConfirmationModalMsg{
+			Message:     message,
+			ConfirmText: "Re-create",
+			CancelText:  "Discard",
+			AltKey:      "c",
+			AltText:     "copy instead",
+			Destructive: true,
+		}
+	})
+}
+
+// resolveRecovery re-creates the deleted task with the change, or copies it
+func (m *MainModel) resolveRecovery(pending pendingRecovery, answer confirmation.ConfirmationSelectedMsg) tea.Cmd {
+	switch {
+	case answer.Confirmed:
+		client, logger, task := m.programContext.ArchonClient, m.programContext.Logger, pending.task
+		return func() tea.Msg {
+			id, err := recreateTask(client, task)
+			if id == "" {
+				return tasks.TaskCreatedMsg{ProjectID: task.ProjectID, Error: err}
+			}
+			if err != nil {
+				logger.Warn("Re-created task is missing fields", "task_id", id, "error", err)
+			}
+			created := task
+			created.ID = id
+			return tasks.TaskCreatedMsg{ProjectID: task.ProjectID, Task: &created}
+		}
+	case answer.Alternate:
+		content, err := scratchpad.Render(pending.task)
+		if err != nil {
+			return statusFeedback("Copy failed: " + err.Error())
+		}
+		return m.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: string(content), What: "edit of deleted task"})
+	default:
+		return statusFeedback("Edit of deleted task discarded")
+	}
+}
+
+// applyUpdate returns task with the fields set by updates
+func applyUpdate(task archon.Task, updates archon.UpdateTaskRequest) archon.Task {
+	if updates.Title != nil {
+		task.Title = *updates.Title
+	}
+	if updates.Description != nil {
+		task.Description = *updates.Description
+	}
+	if updates.Status != nil {
+		task.Status = *updates.Status
+	}
+	if updates.Assignee != nil {
+		task.Assignee = *updates.Assignee
+	}
+	if updates.TaskOrder != nil {
+		task.TaskOrder = *updates.TaskOrder
+	}
+	if updates.Feature != nil {
+		feature := *updates.Feature
+		task.Feature = &feature
+	}
+	if updates.Sources != nil {
+		task.Sources = *updates.Sources
+	}
+	if updates.CodeExamples != nil {
+		task.CodeExamples = *updates.CodeExamples
+	}
+	return task
+}
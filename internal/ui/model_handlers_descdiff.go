@@ -0,0 +1,55 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/taskdetails"
+)
+
+// =============================================================================
+// DESCRIPTION CHANGES
+// =============================================================================
+// The first time a task's details show in a session its description is
+// remembered (ProgramContext.DescriptionSnapshots). When the description later
+// differs, the details panel says so and 'D' shows a diff; Enter in the diff
+// makes the current description the one later changes are compared with.
+
+// HandleDescriptionDiffKey handles 'D' key - show how the selected task's
+// description changed since it was last viewed
+func (m *MainModel) handleDescriptionDiffKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyDCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	task := m.GetSelectedTask()
+	if task == nil {
+		return nil, false
+	}
+
+	snapshots := m.programContext.DescriptionSnapshots
+	if !snapshots.Enabled() {
+		return statusFeedback("Description snapshots are off (ui.display.description_snapshots)"), true
+	}
+	snapshot, ok := snapshots.Get(task.ID)
+	if !ok || !snapshots.Changed(task.ID, task.Description) {
+		return statusFeedback("Description unchanged since you viewed it"), true
+	}
+
+	current, truncated := snapshots.Bound(task.Description)
+	show := descdiff.ShowDescDiffModalMsg{
+		TaskID:    task.ID,
+		Title:     task.Title,
+		Old:       snapshot.Text,
+		New:       current,
+		Current:   task.Description,
+		Truncated: snapshot.Truncated || truncated,
+	}
+	return func() tea.Msg { return show }, true
+}
+
+// acknowledgeDescription remembers description as the version of taskID the
+// user has seen and clears the details panel's change hint
+func (m *MainModel) acknowledgeDescription(taskID, description string) tea.Cmd {
+	m.programContext.DescriptionSnapshots.Acknowledge(taskID, description)
+	return m.components.Layout.MainContent.Update(taskdetails.TaskDetailsSnapshotChangedMsg{TaskID: taskID})
+}
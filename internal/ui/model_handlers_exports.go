@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export/scheduled"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// =============================================================================
+// SCHEDULED EXPORTS
+// =============================================================================
+// Jobs from exports.scheduled are checked on a tick while the app runs. Each
+// run fetches fresh tasks, writes the export and is recorded in the background
+// task list; completed runs are remembered so catch_up jobs can run once at
+// startup when a scheduled time passed while the app was closed.
+
+// scheduledExportTickInterval is how often due exports are checked
+const scheduledExportTickInterval = 30 * time.Second
+
+// scheduledExportTickMsg triggers a check for due exports
+type scheduledExportTickMsg struct{}
+
+// scheduledExportDoneMsg reports a finished export run
+type scheduledExportDoneMsg struct {
+	runID   string // Background task ID
+	job     string
+	started time.Time
+	path    string
+	err     error
+}
+
+// loadScheduledExports compiles exports.scheduled and reads the run history.
+// Returns a nil scheduler when no exports are configured.
+func loadScheduledExports(cfg *config.Config, logger interfaces.Logger) (*scheduled.Scheduler, *scheduled.Store, map[string]time.Time) {
+	jobs, err := scheduled.Compile(cfg.GetScheduledExports())
+	if err != nil {
+		logger.Warn("Scheduled exports disabled", "error", err)
+		return nil, nil, nil
+	}
+	if len(jobs) == 0 {
+		return nil, nil, nil
+	}
+
+	lastRuns := map[string]time.Time{}
+	path, err := scheduled.DefaultPath()
+	if err != nil {
+		logger.Warn("Scheduled export history will not be saved", "error", err)
+		return scheduled.NewScheduler(jobs, lastRuns, time.Now), nil, lastRuns
+	}
+
+	store := scheduled.NewStore(path)
+	if loaded, err := store.Load(); err != nil {
+		logger.Warn("Ignoring scheduled export history", "path", path, "error", err)
+	} else {
+		lastRuns = loaded
+	}
+	return scheduled.NewScheduler(jobs, lastRuns, time.Now), store, lastRuns
+}
+
+// startScheduledExports checks for due exports right away, so missed runs
+// are caught up at startup, then on every tick
+func (m *MainModel) startScheduledExports() tea.Cmd {
+	if m.exportScheduler == nil {
+		return nil
+	}
+	return func() tea.Msg { return scheduledExportTickMsg{} }
+}
+
+// scheduledExportTick schedules the next check for due exports
+func (m *MainModel) scheduledExportTick() tea.Cmd {
+	return tea.Tick(scheduledExportTickInterval, func(time.Time) tea.Msg {
+		return scheduledExportTickMsg{}
+	})
+}
+
+// handleScheduledExportTick runs the exports that are due
+func (m *MainModel) handleScheduledExportTick() tea.Cmd {
+	if m.exportScheduler == nil {
+		return nil
+	}
+	cmds := []tea.Cmd{m.scheduledExportTick()}
+	for _, job := range m.exportScheduler.Due() {
+		cmds = append(cmds, m.runScheduledExport(job))
+	}
+	return tea.Batch(cmds...)
+}
+
+// runScheduledExport records the run and writes the export in the background
+func (m *MainModel) runScheduledExport(job scheduled.Job) tea.Cmd {
+	started := time.Now()
+	runID := fmt.Sprintf("export:%s:%d", job.Name, started.UnixNano())
+	m.programContext.RecordBackgroundTask(context.Task{
+		ID:        runID,
+		StartText: fmt.Sprintf("Exporting %s", job.Name),
+		State:     context.TaskStart,
+		StartTime: started,
+	})
+
+	client := m.programContext.ArchonClient
+	shortIDLength := m.programContext.Config.GetShortIDLength()
+	return func() tea.Msg {
+		done := scheduledExportDoneMsg{runID: runID, job: job.Name, started: started}
+
+		var projectID *string
+		if job.Filter.ProjectID != "" {
+			projectID = &job.Filter.ProjectID
+		}
+		resp, err := client.ListTasks(projectID, nil, true)
+		if err != nil {
+			done.err = fmt.Errorf("failed to fetch tasks: %w", err)
+			return done
+		}
+		done.path, done.err = job.Run(resp.Tasks, started, shortIDLength)
+		return done
+	}
+}
+
+// handleScheduledExportDone records the outcome of a run and reports it in the status bar
+func (m *MainModel) handleScheduledExportDone(msg scheduledExportDoneMsg) tea.Cmd {
+	finished := time.Now()
+	run := context.Task{ID: msg.runID, StartText: fmt.Sprintf("Exporting %s", msg.job), StartTime: msg.started, FinishedTime: &finished}
+	if existing := m.programContext.FindBackgroundTask(msg.runID); existing != nil {
+		run = *existing
+		run.FinishedTime = &finished
+	}
+
+	if msg.err != nil {
+		run.State, run.Error = context.TaskError, msg.err
+		m.programContext.RecordBackgroundTask(run)
+		m.programContext.Logger.Error("Scheduled export failed", "job", msg.job, "error", msg.err)
+		return statusFeedback(fmt.Sprintf("Scheduled export %q failed: %v", msg.job, msg.err))
+	}
+
+	run.State, run.FinishedText = context.TaskFinished, fmt.Sprintf("Exported %s to %s", msg.job, msg.path)
+	m.programContext.RecordBackgroundTask(run)
+	m.programContext.Logger.Info("Scheduled export written", "job", msg.job, "path", msg.path)
+
+	m.exportLastRuns[msg.job] = msg.started
+	return tea.Batch(m.saveExportHistoryCmd(), statusFeedback(run.FinishedText))
+}
+
+// saveExportHistoryCmd writes a copy of the run history in the background
+func (m *MainModel) saveExportHistoryCmd() tea.Cmd {
+	store, logger := m.exportStore, m.programContext.Logger
+	if store == nil {
+		return nil
+	}
+	lastRuns := make(map[string]time.Time, len(m.exportLastRuns))
+	for name, at := range m.exportLastRuns {
+		lastRuns[name] = at
+	}
+	return func() tea.Msg {
+		if err := store.Save(lastRuns); err != nil {
+			logger.Warn("Failed to save scheduled export history", "error", err)
+		}
+		return nil
+	}
+}
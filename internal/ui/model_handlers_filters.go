@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/state"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// =============================================================================
+// STICKY FEATURE FILTER
+// =============================================================================
+// The selection last applied in the feature modal is saved to the state file
+// and restored at startup. Features no task uses any more are pruned once tasks
+// load. The quick filter ('F') is transient and never saved.
+
+// loadStateStore opens the state file and applies the saved feature filter,
+// read tracking and layout, returning the remembered selection to apply once
+// tasks load. All still work for the session when the file cannot be read.
+func loadStateStore(ctx *context.ProgramContext, uiState *context.UIState, logger interfaces.Logger) (*state.Store, *state.Selection) {
+	path, err := state.DefaultPath()
+	if err != nil {
+		logger.Warn("Feature filter will not be remembered", "error", err)
+		return nil, nil
+	}
+
+	store := state.NewStore(path)
+	saved, err := store.Load()
+	if err != nil {
+		logger.Warn("Ignoring saved state", "path", path, "error", err)
+		return store, nil
+	}
+	if len(saved.FeatureFilter) > 0 {
+		ctx.FeatureFilters = make(map[string]bool, len(saved.FeatureFilter))
+		for _, feature := range saved.FeatureFilter {
+			ctx.FeatureFilters[feature] = true
+		}
+		ctx.FeatureFilterActive = true
+	}
+	ctx.ReadSince, ctx.LastSeen = saved.ReadSince, saved.LastSeen
+	uiState.HideDetails = saved.DetailsHidden
+	if saved.Selection == nil || saved.Selection.TaskID == "" || !ctx.Config.ShouldRememberSelection() {
+		return store, nil
+	}
+	return store, saved.Selection
+}
+
+// saveStateCmd writes the feature filter, read tracking, layout and selection to the state file in the background.
+// While the quick filter is on, the filter it replaced is saved instead.
+func (m *MainModel) saveStateCmd() tea.Cmd {
+	store, logger := m.stateStore, m.programContext.Logger
+	if store == nil {
+		return nil
+	}
+
+	filters := m.programContext.FeatureFilters
+	if m.quickFeatureActive() {
+		filters = m.quickFeaturePrevious
+	}
+	var selected []string
+	for feature, visible := range filters {
+		if visible {
+			selected = append(selected, feature)
+		}
+	}
+	sort.Strings(selected)
+
+	saved := state.State{
+		FeatureFilter: selected,
+		ReadSince:     m.programContext.ReadSince,
+		LastSeen:      maps.Clone(m.programContext.LastSeen),
+		DetailsHidden: m.uiState.HideDetails,
+		Selection:     m.selectionToSave(),
+	}
+	return func() tea.Msg {
+		if err := store.Save(saved); err != nil {
+			logger.Warn("Failed to save state", "error", err)
+		}
+		return nil
+	}
+}
+
+// pruneFeatureFilters drops filtered features that disappeared from the loaded tasks
+// and saves the result. An empty task list is not trusted to prune anything.
+func (m *MainModel) pruneFeatureFilters() tea.Cmd {
+	if len(m.programContext.Tasks) == 0 || m.quickFeatureActive() {
+		return nil
+	}
+
+	removed := m.programContext.PruneFeatureFilters()
+	if len(removed) == 0 {
+		return nil
+	}
+	m.programContext.Logger.Info("Pruned unused features from filter", "features", removed)
+	m.refreshUIAfterFilterChange()
+
+	message := fmt.Sprintf("Feature filter: removed %s (no longer used)", strings.Join(removed, ", "))
+	if !m.programContext.FeatureFilterActive {
+		message = fmt.Sprintf("Feature filter cleared: %s no longer used", strings.Join(removed, ", "))
+	}
+	return tea.Batch(m.saveStateCmd(), statusFeedback(message))
+}
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// =============================================================================
+// IDLE MODALS
+// =============================================================================
+// Confirmation and status modals left without input for ui.display.modal_timeout
+// are canceled as if Esc was pressed, so an unattended session does not stay
+// stuck on a question. Destructive confirmations wait for
+// ui.display.destructive_modal_timeout instead, which defaults to never.
+
+// modalIdleMsg cancels the open modal unless input arrived since its timer started
+type modalIdleMsg struct {
+	generation int
+}
+
+// timedModalActive reports whether a modal that can time out is open
+func (m *MainModel) timedModalActive() bool {
+	return m.components.Modals.ConfirmationModel.IsActive() || m.components.Modals.StatusModel.IsActive()
+}
+
+// armModalTimeout (re)starts the idle timer of the open modal, dropping any earlier one
+func (m *MainModel) armModalTimeout() tea.Cmd {
+	m.modalTimeoutGeneration++
+	if m.modalTimeout <= 0 || !m.timedModalActive() {
+		return nil
+	}
+	generation := m.modalTimeoutGeneration
+	return tea.Tick(m.modalTimeout, func(time.Time) tea.Msg {
+		return modalIdleMsg{generation: generation}
+	})
+}
+
+// handleModalIdle cancels the modal whose timer ran out without input
+func (m *MainModel) handleModalIdle(msg modalIdleMsg) tea.Cmd {
+	if msg.generation != m.modalTimeoutGeneration {
+		return nil
+	}
+	escape := tea.KeyMsg{Type: tea.KeyEsc}
+	switch {
+	case m.components.Modals.ConfirmationModel.IsActive():
+		return tea.Batch(m.components.Modals.ConfirmationModel.Update(escape), statusFeedback("Canceled an unanswered question"))
+	case m.components.Modals.StatusModel.IsActive():
+		return tea.Batch(m.components.Modals.StatusModel.Update(escape), statusFeedback("Closed the idle status picker"))
+	}
+	return nil
+}
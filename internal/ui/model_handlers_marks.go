@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/tasklist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// BULK STATUS CHANGE
+// =============================================================================
+// 'v' starts marking tasks like vim's visual mode: moving the cursor marks
+// every task between where 'v' was pressed and the cursor. 'v' again stops
+// extending and keeps the marks, so another range can be added. With tasks
+// marked, 't' picks one status for all marked tasks in view; they are updated
+// one by one with a progress bar, skipping read-only projects. Marks are kept
+// by task ID, so scrolling and sorting keep them, and are cleared when the
+// change finishes or with Esc.
+
+// visualSelection is the range being marked after 'v'
+type visualSelection struct {
+	anchorID string          // Task the range starts at
+	base     map[string]bool // Marks from before this range
+}
+
+// handleVisualModeKey handles 'v' key - start or stop marking tasks
+func (m *MainModel) handleVisualModeKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyV || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	if m.visual != nil {
+		m.visual = nil
+		return statusFeedback(fmt.Sprintf("Marked %s: t changes their status, Esc clears", pluralTasks(len(m.programContext.MarkedTasks)))), true
+	}
+	selected := m.GetSelectedTask()
+	if selected == nil {
+		return statusFeedback("No task selected"), true
+	}
+
+	m.visual = &visualSelection{anchorID: selected.ID, base: maps.Clone(m.programContext.MarkedTasks)}
+	m.extendMarks()
+	return statusFeedback("Visual mode: j/k mark tasks, t changes their status, Esc clears"), true
+}
+
+// extendMarks marks the tasks between the visual mode anchor and the cursor,
+// in the current order, on top of the marks made before
+func (m *MainModel) extendMarks() {
+	if m.visual == nil {
+		return
+	}
+	sortedTasks := m.GetSortedTasks()
+	cursor := m.uiState.SelectedTaskIndex
+	if cursor >= len(sortedTasks) {
+		return
+	}
+	anchor, ok := m.sortedTaskIndex(m.visual.anchorID)
+	if !ok {
+		// The anchor task left the view: start the range again at the cursor
+		anchor = cursor
+		m.visual.anchorID = sortedTasks[cursor].ID
+	}
+
+	marked := maps.Clone(m.visual.base)
+	if marked == nil {
+		marked = make(map[string]bool)
+	}
+	for _, task := range sortedTasks[min(anchor, cursor) : max(anchor, cursor)+1] {
+		marked[task.ID] = true
+	}
+	m.programContext.MarkedTasks = marked
+	m.redrawTaskList()
+}
+
+// clearMarks ends visual mode and unmarks every task
+func (m *MainModel) clearMarks() {
+	m.visual = nil
+	m.programContext.MarkedTasks = nil
+	m.redrawTaskList()
+}
+
+// redrawTaskList renders the task rows again, e.g. after marks changed
+func (m *MainModel) redrawTaskList() {
+	if m.components.Layout.MainContent != nil {
+		_ = m.components.Layout.MainContent.Update(tasklist.TaskListUpdateMsg{Tasks: m.GetSortedTasks()})
+	}
+}
+
+// handleBulkStatusKey handles 't' key with tasks marked - pick a status for all of them
+func (m *MainModel) handleBulkStatusKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyT || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	m.visual = nil
+
+	var taskIDs []string
+	readOnly := 0
+	for _, task := range m.GetSortedTasks() {
+		if !m.programContext.MarkedTasks[task.ID] {
+			continue
+		}
+		if m.programContext.IsProjectReadOnly(task.ProjectID) {
+			readOnly++
+			continue
+		}
+		taskIDs = append(taskIDs, task.ID)
+	}
+	if len(taskIDs) == 0 {
+		if readOnly > 0 {
+			return statusFeedback("Read-only project: task changes are disabled"), true
+		}
+		return statusFeedback("No marked tasks in view"), true
+	}
+
+	return func() tea.Msg { return status.ShowStatusModalMsg{TaskIDs: taskIDs} }, true
+}
+
+// setMarkedStatus sets the status picked for the marked tasks, one by one
+func (m *MainModel) setMarkedStatus(taskIDs []string, newStatus string) tea.Cmd {
+	assignees := make(map[string]string)
+	for _, taskID := range taskIDs {
+		if assignee := m.autoAssignee(taskID, newStatus); assignee != nil {
+			assignees[taskID] = *assignee
+		}
+	}
+
+	// The status bar shows a progress bar while the tasks are updated one by one
+	batch := fmt.Sprintf("status:%d", time.Now().UnixNano())
+	m.snapshotForUndo(batch, taskIDs)
+	return tea.Batch(
+		m.handleOperationStarted(messages.OperationStartedMsg{ID: batch, Label: "Moving tasks to " + newStatus, Total: len(taskIDs)}),
+		tasks.UpdateTasksStatus(m.programContext.ArchonClient, batch, taskIDs, newStatus, assignees),
+	)
+}
+
+// handleStatusProgress advances the progress of a bulk status change and continues the batch
+func (m *MainModel) handleStatusProgress(msg tasks.TaskStatusProgressMsg) tea.Cmd {
+	item := msg.TaskID
+	if task := m.programContext.FindTask(msg.TaskID); task != nil {
+		item = task.Title
+	}
+	return tea.Batch(
+		m.handleOperationFeedback(messages.StatusFeedbackMsg{Message: item, Operation: msg.Batch, Err: msg.Error}),
+		msg.Next,
+	)
+}
+
+// handleStatusUpdated reports a bulk status change in one status message,
+// clears the marks and refreshes the tasks once for the batch
+func (m *MainModel) handleStatusUpdated(msg tasks.TasksStatusUpdateMsg) tea.Cmd {
+	m.recordBatchUndo(msg.Batch, msg.Updated, func(task *archon.Task) {
+		if assignee := m.autoAssignee(task.ID, msg.Status); assignee != nil {
+			task.Assignee = *assignee
+		}
+		task.Status = msg.Status
+	})
+	m.clearMarks()
+	if len(msg.Updated) == 0 {
+		for taskID, err := range msg.Errors {
+			if cmd, handled := m.handleForbiddenMutation(taskID, err); handled {
+				return cmd
+			}
+			return statusFeedback("Failed to change status: " + err.Error())
+		}
+		return nil
+	}
+
+	for _, taskID := range msg.Updated {
+		m.touchTask(taskID)
+	}
+	summary := []string{pluralTasks(len(msg.Updated)) + " moved to " + msg.Status}
+	if len(msg.Errors) > 0 {
+		summary = append(summary, fmt.Sprintf("%d failed", len(msg.Errors)))
+		for _, taskID := range slices.Sorted(maps.Keys(msg.Errors)) {
+			m.programContext.Logger.Warn("Status update failed", "task_id", taskID, "error", msg.Errors[taskID])
+		}
+	}
+	return tea.Batch(
+		statusFeedback(strings.Join(summary, ", ")),
+		m.requestTaskReload(helpers.RefreshMutation),
+	)
+}
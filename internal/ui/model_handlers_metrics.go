@@ -0,0 +1,23 @@
+package ui
+
+import (
+	"github.com/yousfisaad/lazyarchon/v2/internal/metrics"
+)
+
+// =============================================================================
+// METRICS
+// =============================================================================
+// With integrations.metrics_listen set, main serves a Prometheus endpoint from
+// a metrics.Collector. The model times its API calls through the collector and
+// hands it a copy of the tasks and projects after every load; the HTTP server
+// only reads that copy.
+
+// AttachMetrics reports API calls and loaded data to collector.
+// Call before the program starts; a nil collector leaves metrics disabled.
+func (m *MainModel) AttachMetrics(collector *metrics.Collector) {
+	if collector == nil {
+		return
+	}
+	m.metrics = collector
+	m.programContext.ArchonClient = metrics.InstrumentClient(m.programContext.ArchonClient, collector)
+}
@@ -2,16 +2,24 @@ package ui
 
 import (
 	"fmt"
-	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/bookmarklist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/feature"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/help"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/statusfilter"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 )
 
 // =============================================================================
@@ -23,8 +31,24 @@ import (
 //
 //nolint:ireturn // Required by Bubble Tea framework - must return tea.Model interface
 func (m *MainModel) handleModalLifecycle(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// One modal at a time: ui.display.modal_conflict decides about the others
+	if target := m.modalShownBy(msg); target != nil && !target.IsActive() && m.HasActiveModal() {
+		return m, m.deferModal(msg)
+	}
+
 	// Relevant modal will handle its message
-	return m, m.components.Update(msg)
+	cmd := m.components.Update(msg)
+
+	// Confirmation and status modals are canceled when left unanswered
+	switch msg := msg.(type) {
+	case confirmation.ShowConfirmationModalMsg:
+		m.modalTimeout = m.programContext.Config.GetModalTimeout(msg.Destructive)
+		return m, tea.Batch(cmd, m.armModalTimeout())
+	case status.ShowStatusModalMsg:
+		m.modalTimeout = m.programContext.Config.GetModalTimeout(false)
+		return m, tea.Batch(cmd, m.armModalTimeout())
+	}
+	return m, cmd
 }
 
 // handleModalActions processes modal action messages that need parent handling
@@ -34,17 +58,35 @@ func (m *MainModel) handleModalLifecycle(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case status.StatusSelectedMsg:
+		if msg.TaskIDs != nil {
+			return m, m.setMarkedStatus(msg.TaskIDs, msg.Status)
+		}
 		// Legacy status modal handler - kept for backwards compatibility
 		// New code should use TaskPropertiesUpdatedMsg from taskedit modal
+		newStatus := msg.Status
+		assignee := m.autoAssignee(msg.TaskID, newStatus)
+		if cmd := m.confirmDoneCmd(msg.TaskID, archon.UpdateTaskRequest{Status: &newStatus, Assignee: assignee}); cmd != nil {
+			return m, cmd
+		}
+		if assignee != nil {
+			return m, tasks.UpdateTaskWithRequest(m.programContext.ArchonClient, msg.TaskID,
+				archon.UpdateTaskRequest{Status: &newStatus, Assignee: assignee})
+		}
 		return m, tasks.UpdateTaskStatusInterface(m.programContext.ArchonClient, msg.TaskID, msg.Status)
 
 	case taskedit.TaskPropertiesUpdatedMsg:
+		// Refuse the update if the project became read-only while the modal was open
+		if cmd := m.readOnlyFeedback(m.programContext.FindTask(msg.TaskID)); cmd != nil {
+			return m, cmd
+		}
+
 		// Handle unified task properties update (status, priority, feature)
 		updates := archon.UpdateTaskRequest{}
 		hasChanges := false
 
 		if msg.Status != nil {
 			updates.Status = msg.Status
+			updates.Assignee = m.autoAssignee(msg.TaskID, *msg.Status)
 			hasChanges = true
 		}
 		if msg.Priority != nil {
@@ -56,14 +98,13 @@ func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 			hasChanges = true
 		}
 
-		// DEBUG: Log which task is being updated via API
-		if hasChanges {
-			fmt.Fprintf(os.Stderr, "[DEBUG] Sending task update to API: taskID=%s, hasStatus=%v, hasPriority=%v, hasFeature=%v\n",
-				msg.TaskID, msg.Status != nil, msg.Priority != nil, msg.Feature != nil)
-		}
-
 		// Only send update if something changed
 		if hasChanges {
+			m.programContext.Logger.Debug("Sending task update", "task_id", msg.TaskID, "status", msg.Status != nil,
+				"priority", msg.Priority != nil, "feature", msg.Feature != nil, "auto_assign", updates.Assignee != nil)
+			if cmd := m.confirmDoneCmd(msg.TaskID, updates); cmd != nil {
+				return m, cmd
+			}
 			return m, tasks.UpdateTaskWithRequest(
 				m.programContext.ArchonClient,
 				msg.TaskID,
@@ -72,8 +113,52 @@ func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case taskedit.TaskCreateRequestedMsg:
+		return m, m.createTask(msg)
+
 	case confirmation.ConfirmationSelectedMsg:
 		// Handle confirmation selection
+		// Check if this answers the restore-previous-session prompt
+		if m.sessionPromptOpen {
+			return m, m.handleSessionRestoreAnswer(msg.Confirmed)
+		}
+
+		// Check if this answers the move-to-done prompt
+		if pending := m.pendingDoneUpdate; pending != nil {
+			m.pendingDoneUpdate = nil
+			return m, m.resolveDoneConfirmation(*pending, msg.Confirmed)
+		}
+
+		// Check if this answers a large-copy or failed-copy prompt
+		if pending := m.pendingCopy; pending != nil {
+			m.pendingCopy = nil
+			return m, m.resolveCopyConfirmation(*pending, msg)
+		}
+
+		// Check if this answers the scratchpad change preview
+		if pending := m.pendingScratchpad; pending != nil {
+			m.pendingScratchpad = nil
+			return m, m.resolveScratchpadConfirmation(*pending, msg.Confirmed)
+		}
+
+		// Check if this answers the prompt for an update of a deleted task
+		if pending := m.pendingRecovery; pending != nil {
+			m.pendingRecovery = nil
+			return m, m.resolveRecovery(*pending, msg)
+		}
+
+		// Check if this answers the sprint overview or rollover preview
+		if pending := m.pendingSprint; pending != nil {
+			m.pendingSprint = nil
+			return m, m.resolveSprintConfirmation(*pending, msg.Confirmed)
+		}
+
+		// Check if this answers the done task cleanup prompt
+		if pending := m.pendingArchive; pending != nil {
+			m.pendingArchive = nil
+			return m, m.resolveArchiveConfirmation(*pending, msg.Confirmed)
+		}
+
 		// Check if this is a task deletion confirmation
 		if m.pendingDeleteTaskID != "" {
 			taskID := m.pendingDeleteTaskID
@@ -87,9 +172,9 @@ func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		// Default confirmation (quit)
+		// Default confirmation (quit) - a deliberate quit is not offered for restore
 		if msg.Confirmed {
-			return m, tea.Quit
+			return m, m.quitCmd()
 		}
 		return m, nil
 
@@ -104,7 +189,31 @@ func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.programContext.FeatureFilters = msg.SelectedFeatures
 		m.programContext.FeatureFilterActive = len(msg.SelectedFeatures) > 0
 		m.refreshUIAfterFilterChange() // Refresh UI immediately with current data
-		return m, nil
+		return m, m.saveStateCmd()
+
+	case feature.TagSelectionAppliedMsg:
+		return m, m.applyTagFilter(msg.SelectedTags)
+
+	case feature.FeatureAssignedMsg:
+		return m, m.assignFeature(msg.TaskIDs, msg.Feature)
+
+	case linkpicker.LinkSelectedMsg:
+		return m, openLink(msg.Link)
+
+	case digest.DigestTaskChosenMsg:
+		return m, m.jumpToDigestTask(msg.TaskID)
+
+	case bookmarklist.BookmarkChosenMsg:
+		return m, m.jumpToBookmark(msg.Slot)
+
+	case bookmarklist.BookmarkClearedMsg:
+		return m, m.clearBookmark(msg.Slot)
+
+	case unblock.UnblockTaskChosenMsg:
+		return m, m.jumpToDigestTask(msg.TaskID)
+
+	case descdiff.DescriptionAcknowledgedMsg:
+		return m, m.acknowledgeDescription(msg.TaskID, msg.Description)
 
 	case statusfilter.StatusFilterAppliedMsg:
 		// Handle status filter application - update task filtering in ProgramContext
@@ -118,3 +227,120 @@ func (m *MainModel) handleModalActions(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 	return m, nil
 }
+
+// autoAssignee returns the current user when workflow.auto_assign applies to
+// moving the task to newStatus, or nil when the assignee should stay unchanged
+func (m *MainModel) autoAssignee(taskID, newStatus string) *string {
+	cfg := m.programContext.Config
+	task := m.programContext.FindTask(taskID)
+	if cfg == nil || task == nil || !cfg.ShouldAutoAssign(task.Status, newStatus) {
+		return nil
+	}
+
+	user := cfg.GetCurrentUser()
+	if task.Assignee == user {
+		return nil // Already assigned, nothing to change
+	}
+	return &user
+}
+
+// pendingTaskUpdate is a task update held back until the user confirms it
+type pendingTaskUpdate struct {
+	taskID  string
+	updates archon.UpdateTaskRequest
+}
+
+// confirmDoneCmd asks for confirmation when workflow.confirm_done applies to
+// the update's status change. Returns nil when the update can be sent directly.
+func (m *MainModel) confirmDoneCmd(taskID string, updates archon.UpdateTaskRequest) tea.Cmd {
+	cfg := m.programContext.Config
+	task := m.programContext.FindTask(taskID)
+	if cfg == nil || task == nil || updates.Status == nil || !cfg.ShouldConfirmDone(task.Status, *updates.Status) {
+		return nil
+	}
+
+	m.pendingDoneUpdate = &pendingTaskUpdate{taskID: taskID, updates: updates}
+	title := task.Title
+	return func() tea.Msg {
+		return confirmation.ShowConfirmationModalMsg{
+			Message:     "Mark task '" + title + "' as done?",
+			ConfirmText: "Done",
+			CancelText:  "Cancel",
+		}
+	}
+}
+
+// resolveDoneConfirmation sends the held update, or only its non-status
+// changes (priority, feature) when the user declined
+func (m *MainModel) resolveDoneConfirmation(pending pendingTaskUpdate, confirmed bool) tea.Cmd {
+	updates := pending.updates
+	if !confirmed {
+		updates.Status, updates.Assignee = nil, nil
+		if updates.TaskOrder == nil && updates.Feature == nil {
+			return statusFeedback("Status change canceled")
+		}
+	}
+	return tasks.UpdateTaskWithRequest(m.programContext.ArchonClient, pending.taskID, updates)
+}
+
+// =============================================================================
+// MODAL CONFLICTS
+// =============================================================================
+// Keys cannot open a modal over another, but '?' and background results (copy
+// confirmations, the API key prompt, the away digest) can. With
+// ui.display.modal_conflict "ignore" such a modal is dropped; with "queue" it
+// opens once the active modal closes. Showing the active modal again updates it.
+
+// modalShownBy returns the modal a show message opens, or nil for other messages
+//
+//nolint:ireturn // Modals share no concrete type
+func (m *MainModel) modalShownBy(msg tea.Msg) interface{ IsActive() bool } {
+	modals := m.components.Modals
+	switch msg.(type) {
+	case help.ShowHelpModalMsg:
+		return modals.HelpModel
+	case status.ShowStatusModalMsg:
+		return modals.StatusModel
+	case confirmation.ShowConfirmationModalMsg:
+		return modals.ConfirmationModel
+	case taskedit.ShowTaskEditModalMsg:
+		return modals.TaskEditModel
+	case feature.ShowFeatureModalMsg:
+		return modals.FeatureModel
+	case linkpicker.ShowLinkPickerModalMsg:
+		return modals.LinkPickerModel
+	case digest.ShowDigestModalMsg:
+		return modals.DigestModel
+	case bookmarklist.ShowBookmarkListModalMsg:
+		return modals.BookmarkListModel
+	case unblock.ShowUnblockModalMsg:
+		return modals.UnblockModel
+	case apikey.ShowAPIKeyModalMsg:
+		return modals.APIKeyModel
+	case descdiff.ShowDescDiffModalMsg:
+		return modals.DescDiffModel
+	default:
+		return nil
+	}
+}
+
+// deferModal queues or drops a modal that opened while another was active
+func (m *MainModel) deferModal(msg tea.Msg) tea.Cmd {
+	if m.programContext.Config.GetModalConflict() == configpkg.ModalConflictQueue {
+		m.queuedModals = append(m.queuedModals, msg)
+		m.programContext.Logger.Debug("Modal queued behind the active modal", "modal", fmt.Sprintf("%T", msg))
+		return nil
+	}
+	m.programContext.Logger.Debug("Modal ignored while another is active", "modal", fmt.Sprintf("%T", msg))
+	return statusFeedback("Another dialog is open")
+}
+
+// openQueuedModal opens the next queued modal once the active one has closed
+func (m *MainModel) openQueuedModal(msg messages.ModalStateMsg) tea.Cmd {
+	if msg.Active || len(m.queuedModals) == 0 || m.HasActiveModal() {
+		return nil
+	}
+	next := m.queuedModals[0]
+	m.queuedModals = m.queuedModals[1:]
+	return func() tea.Msg { return next }
+}
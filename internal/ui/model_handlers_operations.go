@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// OPERATIONS
+// =============================================================================
+// An operation over many items (a batch update) announces itself with
+// OperationStartedMsg, then reports each item through StatusFeedbackMsg tagged
+// with its ID. Instead of one status message per item, the status bar shows a
+// rolling summary ("Updating tasks… 32/50, 2 failed") and the background task
+// list gets one entry for the whole operation, with the failures listed.
+
+// operationTaskID is the background task entry of an operation
+func operationTaskID(id string) string {
+	return "operation:" + id
+}
+
+// handleOperationStarted starts rolling up the feedback of an operation
+func (m *MainModel) handleOperationStarted(msg messages.OperationStartedMsg) tea.Cmd {
+	op := m.operations.Start(msg.ID, msg.Label, msg.Total)
+	m.programContext.RecordBackgroundTask(context.Task{
+		ID:        operationTaskID(op.ID),
+		StartText: op.Label,
+		State:     context.TaskStart,
+		StartTime: time.Now(),
+	})
+	return m.components.Update(messages.OperationProgressMsg{ID: op.ID, Summary: op.Summary()})
+}
+
+// handleOperationFeedback folds one item's feedback into its operation.
+// Feedback for an operation that is not running shows on its own.
+func (m *MainModel) handleOperationFeedback(msg messages.StatusFeedbackMsg) tea.Cmd {
+	op, ok := m.operations.Record(msg.Operation, msg.Message, msg.Err)
+	if !ok {
+		standalone := messages.StatusFeedbackMsg{Message: msg.Message}
+		if msg.Err != nil {
+			standalone.Message += ": " + msg.Err.Error()
+		}
+		return m.components.Update(standalone)
+	}
+
+	if op.Finished() {
+		started := time.Now()
+		if existing := m.programContext.FindBackgroundTask(operationTaskID(op.ID)); existing != nil {
+			started = existing.StartTime
+		}
+		m.recordOperation(op, started)
+	}
+	return m.components.Update(messages.OperationProgressMsg{ID: op.ID, Summary: op.Summary(), Finished: op.Finished()})
+}
+
+// recordOperation records a finished operation in the background task list,
+// as an error listing the failed items when any failed
+func (m *MainModel) recordOperation(op helpers.Operation, started time.Time) {
+	finished := time.Now()
+	entry := context.Task{
+		ID:           operationTaskID(op.ID),
+		StartText:    op.Label,
+		FinishedText: op.Summary(),
+		State:        context.TaskFinished,
+		StartTime:    started,
+		FinishedTime: &finished,
+	}
+	if len(op.Failures) > 0 {
+		entry.State, entry.Error = context.TaskError, errors.New(op.FailureDetails())
+	}
+	m.programContext.RecordBackgroundTask(entry)
+}
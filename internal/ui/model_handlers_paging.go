@@ -0,0 +1,104 @@
+package ui
+
+import (
+	gocontext "context"
+	"errors"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// =============================================================================
+// TASK LIST PAGING
+// =============================================================================
+// With server.page_size set, task reloads fetch the first pages only and the
+// task list asks for the next page (TaskListLoadMoreMsg) once the selection
+// nears the last loaded task. Each page is its own request, merged into the
+// loaded tasks by ID; a failed page is requested again on the next move near
+// the end. Reloads fetch as many pages as were loaded, so refreshing does not
+// shrink the list back to the first page.
+//
+// With server.load_all_pages, each loaded page requests the next one right
+// away, so the first page shows immediately and the rest fills in behind it.
+//
+// Pages are requested with the context of the reload they continue, so the
+// next reload, or quitting, stops the page chain.
+
+// pagedReloadLimit returns how many tasks a reload fetches: every page loaded
+// so far, and at least one
+func (m *MainModel) pagedReloadLimit(pageSize int) int {
+	pages := max(1, (m.programContext.TaskPages.NextOffset+pageSize-1)/pageSize)
+	return pages * pageSize
+}
+
+// setTaskPages records the paging state of a full reload and goes on with
+// the remaining pages
+func (m *MainModel) setTaskPages(msg tasks.TasksLoadedMsg) tea.Cmd {
+	paging := &m.programContext.TaskPages
+	paging.NextOffset, paging.Total, paging.HasMore = len(msg.Tasks), msg.Total, msg.HasMore
+	paging.Loading = false // A page still on its way was cancelled by the reload
+	return m.loadRemainingPages()
+}
+
+// loadRemainingPages requests the next page without waiting for the selection
+// to near the end, when server.load_all_pages is set
+func (m *MainModel) loadRemainingPages() tea.Cmd {
+	if !m.programContext.Config.ShouldLoadAllPages() {
+		return nil
+	}
+	return m.loadNextTaskPage()
+}
+
+// loadNextTaskPage requests the page after the loaded tasks, unless one is
+// already on its way or none remain
+func (m *MainModel) loadNextTaskPage() tea.Cmd {
+	paging := &m.programContext.TaskPages
+	pageSize := m.programContext.Config.GetPageSize()
+	if pageSize <= 0 || !paging.CanLoadMore() {
+		return nil
+	}
+	paging.Loading = true
+	ctx := m.programContext.LoadContext(context.LoadTasks)
+	return tasks.LoadTasksPage(ctx, m.programContext.ArchonClient, paging.NextOffset, pageSize)
+}
+
+// handleTasksPageLoaded merges a page into the loaded tasks. Pages requested
+// before a reload changed the offsets are dropped.
+func (m *MainModel) handleTasksPageLoaded(msg tasks.TasksPageLoadedMsg) tea.Cmd {
+	if errors.Is(msg.Error, gocontext.Canceled) {
+		return nil // Stopped by a reload, which reset the paging state
+	}
+	paging := &m.programContext.TaskPages
+	paging.Loading = false
+	if msg.Error != nil {
+		m.programContext.Logger.Warn("Loading more tasks failed", "offset", msg.Offset, "error", msg.Error)
+		return statusFeedback("Failed to load more tasks: " + msg.Error.Error())
+	}
+	if msg.Offset != paging.NextOffset {
+		return nil
+	}
+	m.programContext.ObserveResponseMeta(msg.Meta)
+
+	loaded, invalid := m.dropInvalidTasks(msg.Tasks)
+	merged := slices.Clone(m.programContext.Tasks)
+	added := 0
+	for _, task := range loaded {
+		if i := slices.IndexFunc(merged, func(t archon.Task) bool { return t.ID == task.ID }); i >= 0 {
+			merged[i] = task
+			continue
+		}
+		merged = append(merged, task)
+		added++
+	}
+
+	paging.NextOffset += len(msg.Tasks)
+	paging.Total = msg.Total
+	// A page adding nothing new means the server ignores the offset; stop asking
+	paging.HasMore = msg.HasMore && added > 0
+	m.updateTasks(merged)
+	m.metrics.Refresh(m.programContext.Tasks, m.programContext.Projects)
+	return tea.Batch(invalid, m.loadRemainingPages())
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+)
+
+// =============================================================================
+// TASK RELOAD COORDINATION
+// =============================================================================
+// Every full task reload goes through MainModel.refresh, so a poll firing
+// right after 'r' or an edit joins the running reload instead of running
+// updateTasks twice back to back.
+//
+// Each task or project load cancels the one of the same kind still in flight
+// (ProgramContext.BeginLoad); the cancelled load's result is dropped.
+
+// refreshDueMsg fires when a reload deferred by the minimum spacing is due
+type refreshDueMsg struct{}
+
+// requestTaskReload asks for a full task reload from source; the returned
+// command is nil when the request joined a running or scheduled reload
+func (m *MainModel) requestTaskReload(source string) tea.Cmd {
+	start, delay := m.refresh.Request(source)
+	if !start && delay == 0 {
+		m.programContext.Logger.Debug("Task reload merged", "source", source)
+	}
+	return m.reloadCmd(start, delay)
+}
+
+// finishTaskReload records that a reload's result arrived and starts the
+// follow-up reload a mutation queued meanwhile, if any
+func (m *MainModel) finishTaskReload() tea.Cmd {
+	return m.reloadCmd(m.refresh.Done())
+}
+
+// reloadCmd turns a coordinator decision into a command: load the tasks now,
+// fire refreshDueMsg after delay, or nothing
+func (m *MainModel) reloadCmd(start bool, delay time.Duration) tea.Cmd {
+	switch {
+	case start:
+		ctx := m.programContext.BeginLoad(context.LoadTasks)
+		if pageSize := m.programContext.Config.GetPageSize(); pageSize > 0 {
+			return tasks.LoadTasksPaged(ctx, m.programContext.ArchonClient, m.pagedReloadLimit(pageSize))
+		}
+		return tasks.LoadTasksInterface(ctx, m.programContext.ArchonClient, m.programContext.SelectedProjectID)
+	case delay > 0:
+		return tea.Tick(delay, func(time.Time) tea.Msg { return refreshDueMsg{} })
+	}
+	return nil
+}
+
+// loadProjectsCmd reloads the projects, cancelling a project load still running
+func (m *MainModel) loadProjectsCmd() tea.Cmd {
+	return projects.LoadProjectsInterface(m.programContext.BeginLoad(context.LoadProjects), m.programContext.ArchonClient)
+}
+
+// CancelLoads abandons the task and project loads still in flight; call it
+// once the program has exited so their requests do not outlive the UI
+func (m *MainModel) CancelLoads() {
+	m.programContext.CancelLoads()
+}
@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/scratchpad"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+)
+
+// =============================================================================
+// YAML SCRATCHPAD
+// =============================================================================
+// 'E' writes the selected task's editable fields to a YAML file and suspends
+// the TUI while $VISUAL/$EDITOR runs on it. The saved file is parsed, diffed
+// against the task and previewed in a confirmation modal before being sent as
+// one update. Parse errors reopen the editor with the error at the top.
+//
+// The diff is taken against the task as it was when the editor opened, so a
+// poll that changed other fields meanwhile is not reverted. Fields both
+// edited and changed on the server are flagged in the preview.
+
+// pendingScratchpadEdit is a scratchpad update awaiting confirmation
+type pendingScratchpadEdit struct {
+	pendingTaskUpdate
+	title   string   // Task title, for the deleted-remotely prompt
+	content string   // The saved scratchpad, copied if the task is deleted before confirming
+	preview []string // Changed fields shown in the confirmation
+	deleted bool     // The task was deleted while the preview was open
+}
+
+// scratchpadEditedMsg reports that the editor on a scratchpad file exited
+type scratchpadEditedMsg struct {
+	taskID   string
+	path     string
+	original *archon.Task // Task the scratchpad was rendered from (nil = the loaded version)
+	err      error        // Editor failed to start or exited with an error
+}
+
+// handleScratchpadKey handles 'E' key - edit the selected task as YAML in $EDITOR
+func (m *MainModel) handleScratchpadKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyECap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	task := m.GetSelectedTask()
+	if task == nil {
+		return nil, false
+	}
+	if cmd := m.readOnlyFeedback(task); cmd != nil {
+		return cmd, true
+	}
+
+	content, err := scratchpad.Render(*task)
+	if err != nil {
+		return statusFeedback("Scratchpad failed: " + err.Error()), true
+	}
+	file, err := os.CreateTemp("", "lazyarchon-task-*.yaml")
+	if err != nil {
+		return statusFeedback("Scratchpad failed: " + err.Error()), true
+	}
+	defer file.Close()
+	if _, err := file.Write(content); err != nil {
+		_ = os.Remove(file.Name())
+		return statusFeedback("Scratchpad failed: " + err.Error()), true
+	}
+	original := *task
+	return editScratchpadCmd(task.ID, file.Name(), &original), true
+}
+
+// editScratchpadCmd suspends the TUI and runs the editor on path
+func editScratchpadCmd(taskID, path string, original *archon.Task) tea.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	args := strings.Fields(editor) // e.g. "code --wait"
+	if len(args) == 0 {
+		args = []string{defaultEditor}
+	}
+	cmd := exec.Command(args[0], append(args[1:], path)...) //nolint:gosec // the user's own editor
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return scratchpadEditedMsg{taskID: taskID, path: path, original: original, err: err}
+	})
+}
+
+// handleScratchpadEdited turns the saved scratchpad into a previewed update,
+// or reopens the editor when the file does not parse
+func (m *MainModel) handleScratchpadEdited(msg scratchpadEditedMsg) tea.Cmd {
+	discard := func(feedback string) tea.Cmd {
+		_ = os.Remove(msg.path)
+		return statusFeedback(feedback)
+	}
+	if msg.err != nil {
+		return discard("Editor failed, edit discarded: " + msg.err.Error())
+	}
+	content, err := os.ReadFile(msg.path)
+	if err != nil {
+		return discard("Scratchpad failed: " + err.Error())
+	}
+	task := m.programContext.FindTask(msg.taskID)
+	if task == nil {
+		// Deleted remotely while the editor was open; keep the edit on the clipboard
+		_ = os.Remove(msg.path)
+		return m.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: string(content), What: "scratchpad of deleted task"})
+	}
+
+	edit, err := scratchpad.Parse(content)
+	if errors.Is(err, scratchpad.ErrEmpty) {
+		return discard("Scratchpad empty; edit canceled")
+	}
+	if err != nil {
+		if writeErr := os.WriteFile(msg.path, scratchpad.WithError(content, err), 0o600); writeErr != nil {
+			return discard("Scratchpad failed: " + writeErr.Error())
+		}
+		return editScratchpadCmd(msg.taskID, msg.path, msg.original)
+	}
+
+	original := *task
+	if msg.original != nil {
+		original = *msg.original
+	}
+	updates, changes := scratchpad.Diff(original, edit)
+	if len(changes) == 0 {
+		return discard("No changes in scratchpad")
+	}
+	_ = os.Remove(msg.path)
+	if updates.Status != nil {
+		updates.Assignee = m.autoAssignee(task.ID, *updates.Status)
+	}
+
+	details := scratchpad.Preview(changes)
+	message := fmt.Sprintf("Apply %d change(s) to '%s'?", len(changes), task.Title)
+	if conflicts := scratchpad.Conflicts(original, *task, changes); len(conflicts) > 0 {
+		message = fmt.Sprintf("'%s' changed on the server while you edited.\nOverwrite its %s with yours?",
+			task.Title, strings.Join(conflicts, ", "))
+	}
+	m.pendingScratchpad = &pendingScratchpadEdit{
+		pendingTaskUpdate: pendingTaskUpdate{taskID: task.ID, updates: updates},
+		title:             task.Title,
+		content:           string(content),
+		preview:           details,
+	}
+	return func() tea.Msg {
+		return confirmation.ShowConfirmationModalMsg{
+			Message:     message,
+			Details:     details,
+			ConfirmText: "Apply",
+			CancelText:  "Discard",
+			Destructive: true,
+		}
+	}
+}
+
+// resolveScratchpadConfirmation sends the previewed update when confirmed, or
+// copies the scratchpad when the task was deleted in the meantime
+func (m *MainModel) resolveScratchpadConfirmation(pending pendingScratchpadEdit, confirmed bool) tea.Cmd {
+	if !confirmed {
+		return statusFeedback("Scratchpad edit discarded")
+	}
+	if m.programContext.FindTask(pending.taskID) == nil {
+		return m.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: pending.content, What: "scratchpad of deleted task"})
+	}
+	// Refuse the update if the project became read-only while the preview was open
+	if cmd := m.readOnlyFeedback(m.programContext.FindTask(pending.taskID)); cmd != nil {
+		return cmd
+	}
+	return tasks.UpdateTaskWithRequest(m.programContext.ArchonClient, pending.taskID, pending.updates)
+}
@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+)
+
+// =============================================================================
+// SERVER-SIDE SEARCH
+// =============================================================================
+// With ui.display.search_mode "server", committing a search with Enter also
+// sends it to the API, and the tasks the server returns replace the list until
+// the search changes or is cleared. Typing still highlights the loaded tasks.
+// A server without a search endpoint turns server search off until exit.
+
+// serverSearchEnabled reports whether committed searches go to the server
+func (m *MainModel) serverSearchEnabled() bool {
+	return m.programContext.Config.GetSearchMode() == configpkg.SearchServer && !m.programContext.ServerSearchOff
+}
+
+// startServerSearch sends a committed search to the server
+func (m *MainModel) startServerSearch(query string) tea.Cmd {
+	if query == "" || !m.serverSearchEnabled() {
+		return nil
+	}
+	return tea.Batch(
+		statusFeedback("Searching the server for "+query+"..."),
+		tasks.SearchTasks(m.programContext.ArchonClient, query, m.programContext.SelectedProjectID),
+	)
+}
+
+// handleTasksSearched lists the server's results, or keeps searching locally
+// when the server could not search
+func (m *MainModel) handleTasksSearched(msg tasks.TasksSearchedMsg) tea.Cmd {
+	if msg.Query != m.uiState.SearchQuery || !m.uiState.SearchActive {
+		return nil // The search changed while the server was busy
+	}
+	if errors.Is(msg.Error, archon.ErrNotSupported) {
+		m.programContext.ServerSearchOff = true
+		m.programContext.Logger.Info("Server search unavailable, searching locally", "error", msg.Error)
+		return statusFeedback("The server cannot search: using local search")
+	}
+	if msg.Error != nil {
+		m.programContext.Logger.Warn("Server search failed", "query", msg.Query, "error", msg.Error)
+		return statusFeedback("Server search failed, showing local matches: " + msg.Error.Error())
+	}
+
+	results, _ := m.dropInvalidTasks(msg.Tasks) // Dropped tasks are logged
+	if results == nil {
+		results = []archon.Task{} // nil means no server search
+	}
+	m.programContext.ServerSearchResults = results
+	m.refreshUIAfterFilterChange()
+	return statusFeedback("Server search: " + pluralResults(len(results)))
+}
+
+// dropServerSearch goes back to the loaded tasks after the search changed
+func (m *MainModel) dropServerSearch() {
+	if m.programContext.ServerSearchResults == nil {
+		return
+	}
+	m.programContext.ServerSearchResults = nil
+	m.refreshUIAfterFilterChange()
+}
+
+// pluralResults formats a search result count
+func pluralResults(n int) string {
+	if n == 1 {
+		return "1 result"
+	}
+	return fmt.Sprintf("%d results", n)
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/state"
+)
+
+// =============================================================================
+// REMEMBERED SELECTION
+// =============================================================================
+// With session.remember_selection, a deliberate quit saves the selected task
+// and its project to the state file, and the next start selects it again once
+// tasks and projects have loaded. A task that was deleted or is filtered out
+// leaves the usual first-task selection. After a crash the session restore
+// prompt takes precedence.
+
+// quitCmd quits after the cleanup of a deliberate quit: the crash-recovery
+// snapshot is removed and the selection saved for the next start
+func (m *MainModel) quitCmd() tea.Cmd {
+	return tea.Sequence(m.clearSessionCmd(), m.saveStateCmd(), tea.Quit)
+}
+
+// selectionToSave returns the selection the state file keeps (nil = none)
+func (m *MainModel) selectionToSave() *state.Selection {
+	if !m.programContext.Config.ShouldRememberSelection() {
+		return nil
+	}
+	if m.savedSelection != nil {
+		return m.savedSelection // Not applied yet: keep it for the next start
+	}
+	task := m.GetSelectedTask()
+	if task == nil {
+		return nil
+	}
+	selection := &state.Selection{TaskID: task.ID}
+	if projectID := m.programContext.SelectedProjectID; projectID != nil {
+		selection.ProjectID = *projectID
+	}
+	return selection
+}
+
+// restoreSelection selects the task remembered from the last quit once the
+// initial tasks and projects are available
+func (m *MainModel) restoreSelection() {
+	saved := m.savedSelection
+	if saved == nil || !m.tasksLoaded || !m.projectsLoaded {
+		return
+	}
+	m.savedSelection = nil
+	if m.pendingSession != nil || m.restoringSession != nil || m.pendingBookmarkTaskID != "" || m.uiState.IsProjectView() {
+		return // Restoring a crashed session, jumping to a bookmark or picking a project instead
+	}
+	if m.programContext.FindTask(saved.TaskID) == nil {
+		m.programContext.Logger.Debug("Remembered task no longer exists", "task_id", saved.TaskID)
+		return
+	}
+
+	if saved.ProjectID == "" {
+		m.setSelectedProject(nil)
+	} else if m.projectExists(saved.ProjectID) {
+		projectID := saved.ProjectID
+		m.setSelectedProject(&projectID)
+	}
+	// Filtered out tasks keep the first task selected
+	if _, ok := m.sortedTaskIndex(saved.TaskID); ok {
+		m.findAndSelectTask(saved.TaskID)
+	}
+	m.refreshUIAfterFilterChange()
+}
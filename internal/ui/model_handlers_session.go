@@ -0,0 +1,332 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
+	"github.com/yousfisaad/lazyarchon/v2/internal/session"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
+)
+
+// =============================================================================
+// SESSION PERSISTENCE
+// =============================================================================
+// This file snapshots transient UI state (selection, search, scroll offsets,
+// unsaved edit drafts) and offers to restore it after an unexpected exit.
+
+// sessionSaveDebounce is how long input must be quiet before a snapshot is written
+const sessionSaveDebounce = 500 * time.Millisecond
+
+// sessionSaveMsg fires after the debounce delay; stale generations are ignored
+type sessionSaveMsg struct {
+	generation int
+}
+
+// loadSessionStore opens the session store and reads a recent snapshot, if any.
+// Returns a nil store when session restore is disabled.
+func loadSessionStore(cfg *configpkg.Config, logger interfaces.Logger) (*session.Store, *session.Snapshot) {
+	if cfg == nil || !cfg.IsSessionRestoreEnabled() {
+		return nil, nil
+	}
+
+	path := cfg.GetSessionPath()
+	if path == "" {
+		defaultPath, err := session.DefaultPath()
+		if err != nil {
+			logger.Warn("Session restore disabled", "error", err)
+			return nil, nil
+		}
+		path = defaultPath
+	}
+
+	store := session.NewStore(path)
+	snapshot, err := store.Load(cfg.GetSessionMaxAge(), time.Now())
+	if err != nil {
+		// A corrupt or incompatible snapshot is dropped; the next save replaces it
+		logger.Warn("Ignoring previous session snapshot", "path", path, "error", err)
+		return store, nil
+	}
+	return store, snapshot
+}
+
+// withSessionSave schedules a debounced snapshot after a significant change
+//
+//nolint:ireturn // Required by Bubble Tea framework - must return tea.Model interface
+func (m *MainModel) withSessionSave(model tea.Model, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	// Never overwrite a snapshot the user has not decided to restore or discard yet
+	if m.sessionStore == nil || m.pendingSession != nil || m.restoringSession != nil {
+		return model, cmd
+	}
+
+	m.sessionGeneration++
+	generation := m.sessionGeneration
+	saveCmd := tea.Tick(sessionSaveDebounce, func(time.Time) tea.Msg {
+		return sessionSaveMsg{generation: generation}
+	})
+	return model, tea.Batch(cmd, saveCmd)
+}
+
+// handleSessionSave writes the snapshot once input has been quiet for the debounce delay
+func (m *MainModel) handleSessionSave(msg sessionSaveMsg) tea.Cmd {
+	if m.sessionStore == nil || msg.generation != m.sessionGeneration {
+		return nil
+	}
+
+	snapshot := m.captureSession()
+	if m.lastSession != nil && reflect.DeepEqual(*m.lastSession, snapshot) {
+		return nil
+	}
+	m.lastSession = &snapshot
+
+	store, logger := m.sessionStore, m.programContext.Logger
+	return func() tea.Msg {
+		if err := store.Save(snapshot); err != nil {
+			logger.Warn("Failed to save session snapshot", "error", err)
+		}
+		return nil
+	}
+}
+
+// clearSessionCmd removes the snapshot so a deliberate quit is not offered for restore
+func (m *MainModel) clearSessionCmd() tea.Cmd {
+	store, logger := m.sessionStore, m.programContext.Logger
+	if store == nil {
+		return nil
+	}
+	m.sessionStore = nil // Stop scheduling saves while quitting
+	return func() tea.Msg {
+		if err := store.Clear(); err != nil {
+			logger.Warn("Failed to clear session snapshot", "error", err)
+		}
+		return nil
+	}
+}
+
+// captureSession builds a snapshot of the current transient UI state
+func (m *MainModel) captureSession() session.Snapshot {
+	snapshot := session.Snapshot{
+		ViewMode:       session.ViewTasks,
+		ActivePanel:    session.PanelLeft,
+		SelectedTaskID: m.getSelectedTaskID(),
+		SortMode:       m.programContext.SortMode,
+	}
+
+	if projectID := m.programContext.SelectedProjectID; projectID != nil {
+		id := *projectID
+		snapshot.ProjectID = &id
+	}
+	if m.uiState.IsProjectView() {
+		snapshot.ViewMode = session.ViewProjects
+	}
+	if m.uiState.IsRightPanelActive() {
+		snapshot.ActivePanel = session.PanelRight
+	}
+	if m.uiState.SearchActive {
+		snapshot.SearchQuery = m.uiState.SearchQuery
+	}
+	snapshot.TaskListOffset, snapshot.TaskDetailsOffset = m.components.Layout.MainContent.ScrollOffsets()
+
+	// Restored drafts not yet reopened, plus the draft of an open edit modal
+	snapshot.Drafts = append(snapshot.Drafts, m.sessionDrafts...)
+	if draft, ok := m.components.Modals.TaskEditModel.Draft(); ok {
+		snapshot.Drafts = upsertDraft(snapshot.Drafts, session.Draft{
+			TaskID:   draft.TaskID,
+			Status:   draft.Status,
+			Priority: draft.Priority,
+			Feature:  draft.Feature,
+		})
+	}
+
+	return snapshot
+}
+
+// maybePromptSessionRestore offers to restore the previous session once
+// the initial tasks and projects are available
+func (m *MainModel) maybePromptSessionRestore() tea.Cmd {
+	if m.pendingSession == nil || m.sessionPromptOpen || !m.tasksLoaded || !m.projectsLoaded {
+		return nil
+	}
+	if m.HasActiveModal() {
+		return nil // Retry on the next load instead of stacking modals
+	}
+
+	m.sessionPromptOpen = true
+	savedAt := m.pendingSession.SavedAt.Local().Format("Jan 2 15:04")
+	return func() tea.Msg {
+		return confirmation.ShowConfirmationModalMsg{
+			Message:     fmt.Sprintf("Restore previous session from %s? (y/n)", savedAt),
+			ConfirmText: "Restore",
+			CancelText:  "Discard",
+			Destructive: true,
+		}
+	}
+}
+
+// handleSessionRestoreAnswer starts restoring or discards the pending snapshot
+func (m *MainModel) handleSessionRestoreAnswer(confirmed bool) tea.Cmd {
+	snapshot := m.pendingSession
+	m.pendingSession = nil
+	m.sessionPromptOpen = false
+
+	if !confirmed || snapshot == nil {
+		return nil // The next save replaces the discarded snapshot
+	}
+	return m.beginSessionRestore(snapshot)
+}
+
+// beginSessionRestore switches to the snapshot's project, loading its tasks first when needed
+func (m *MainModel) beginSessionRestore(snapshot *session.Snapshot) tea.Cmd {
+	var skipped []string
+	target := m.programContext.SelectedProjectID
+
+	if snapshot.ProjectID == nil {
+		target = nil
+	} else if m.projectExists(*snapshot.ProjectID) {
+		id := *snapshot.ProjectID
+		target = &id
+	} else {
+		skipped = append(skipped, "project")
+	}
+
+	if sameProject(target, m.programContext.SelectedProjectID) {
+		return m.finishSessionRestore(snapshot, skipped)
+	}
+
+	// Task selection, search and offsets are applied once the project's tasks arrive
+	m.setSelectedProject(target)
+	m.restoringSession = snapshot
+	m.restoreSkipped = skipped
+	m.setLoadingWithMessage(true, "Restoring session...")
+	return m.requestTaskReload(helpers.RefreshNavigation)
+}
+
+// finishSessionRestore applies everything that still exists and reports what was skipped
+func (m *MainModel) finishSessionRestore(snapshot *session.Snapshot, skipped []string) tea.Cmd {
+	m.restoringSession = nil
+	m.restoreSkipped = nil
+	var cmds []tea.Cmd
+
+	if snapshot.SortMode >= sorting.SortStatusPriority && snapshot.SortMode < sorting.SortModeCount {
+		m.programContext.SetSortMode(snapshot.SortMode)
+		m.refreshUIAfterFilterChange()
+	}
+
+	if snapshot.SearchQuery != "" {
+		cmds = append(cmds, m.setSearchQuery(snapshot.SearchQuery))
+	}
+
+	if snapshot.SelectedTaskID != "" {
+		if index, ok := m.sortedTaskIndex(snapshot.SelectedTaskID); ok {
+			cmds = append(cmds, m.setSelectedTask(index))
+			// Offsets only make sense relative to the same selection
+			m.components.Layout.MainContent.RestoreScrollOffsets(snapshot.TaskListOffset, snapshot.TaskDetailsOffset)
+		} else {
+			skipped = append(skipped, "selected task")
+		}
+	}
+
+	m.sessionDrafts = nil
+	for _, draft := range snapshot.Drafts {
+		if m.programContext.FindTask(draft.TaskID) == nil {
+			skipped = append(skipped, "unsaved edit")
+			continue
+		}
+		m.sessionDrafts = append(m.sessionDrafts, draft)
+	}
+
+	if snapshot.ViewMode == session.ViewProjects {
+		cmds = append(cmds, func() tea.Msg { return projectmode.ProjectModeActivatedMsg{} })
+	} else if snapshot.ActivePanel == session.PanelRight {
+		cmds = append(cmds, m.setActiveView(RightPanel))
+	}
+
+	feedback := "Session restored"
+	if len(m.sessionDrafts) > 0 {
+		feedback += " - press e to resume unsaved edits"
+	}
+	if len(skipped) > 0 {
+		feedback += fmt.Sprintf(" (no longer available: %s)", strings.Join(dedupe(skipped), ", "))
+	}
+	cmds = append(cmds, func() tea.Msg { return messages.StatusFeedbackMsg{Message: feedback} })
+
+	return tea.Batch(cmds...)
+}
+
+// takeSessionDraft returns and forgets the restored draft for taskID
+func (m *MainModel) takeSessionDraft(taskID string) *taskedit.Draft {
+	for i, draft := range m.sessionDrafts {
+		if draft.TaskID != taskID {
+			continue
+		}
+		m.sessionDrafts = append(m.sessionDrafts[:i], m.sessionDrafts[i+1:]...)
+		return &taskedit.Draft{
+			TaskID:   draft.TaskID,
+			Status:   draft.Status,
+			Priority: draft.Priority,
+			Feature:  draft.Feature,
+		}
+	}
+	return nil
+}
+
+// sortedTaskIndex returns the position of taskID in the current sort order
+func (m *MainModel) sortedTaskIndex(taskID string) (int, bool) {
+	for i, task := range m.GetSortedTasks() {
+		if task.ID == taskID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// projectExists reports whether projectID is among the loaded projects
+func (m *MainModel) projectExists(projectID string) bool {
+	for _, project := range m.programContext.Projects {
+		if project.ID == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+// sameProject compares two optional project IDs (nil = "All Tasks")
+func sameProject(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// upsertDraft replaces the draft for the same task or appends it
+func upsertDraft(drafts []session.Draft, draft session.Draft) []session.Draft {
+	for i := range drafts {
+		if drafts[i].TaskID == draft.TaskID {
+			drafts[i] = draft
+			return drafts
+		}
+	}
+	return append(drafts, draft)
+}
+
+// dedupe removes repeated entries while keeping order
+func dedupe(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	result := items[:0]
+	for _, item := range items {
+		if !seen[item] {
+			seen[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/sprints"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+)
+
+// =============================================================================
+// SPRINTS
+// =============================================================================
+// Tasks whose feature matches ui.sprint.pattern belong to that sprint:
+//   - 'w' filters to the current sprint (the quick feature filter, see 'F')
+//   - 'W' shows totals per sprint and a burndown of the current sprint, and
+//     offers to move the unfinished tasks of the current sprint to the next
+//     one after a preview of the tasks that will move
+
+// maxRolloverPreview is the number of task titles listed in the rollover preview
+const maxRolloverPreview = 10
+
+// pendingSprintStep is the sprint overview or rollover preview awaiting an answer
+type pendingSprintStep struct {
+	from, to string   // Current and next sprint ("" to = nothing to roll over)
+	taskIDs  []string // Unfinished tasks of the current sprint
+	preview  bool     // Whether the rollover preview (rather than the overview) is showing
+}
+
+// handleSprintFilterKey handles 'w' key - toggle the filter to the current sprint
+func (m *MainModel) handleSprintFilterKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyW || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	resolver := m.programContext.Sprints
+	if !resolver.Enabled() {
+		return statusFeedback("Sprints are off (set ui.sprint.pattern)"), true
+	}
+
+	current := resolver.Current(m.programContext.Tasks)
+	if m.quickFeatureActive() && m.quickFeature == current {
+		return statusFeedback("Cleared sprint filter: " + m.clearQuickFeature()), true
+	}
+	if current == "" {
+		return statusFeedback("No task is in a sprint"), true
+	}
+
+	m.setQuickFeature(current)
+	return statusFeedback("Showing sprint: " + current), true
+}
+
+// handleSprintOverviewKey handles 'W' key - show the sprint overview
+func (m *MainModel) handleSprintOverviewKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyWCap || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	resolver := m.programContext.Sprints
+	if !resolver.Enabled() {
+		return statusFeedback("Sprints are off (set ui.sprint.pattern)"), true
+	}
+
+	tasks := m.programContext.Tasks
+	current := resolver.Current(tasks)
+	if current == "" {
+		return statusFeedback("No task is in a sprint"), true
+	}
+
+	step := &pendingSprintStep{from: current}
+	for _, task := range resolver.Unfinished(tasks, current) {
+		step.taskIDs = append(step.taskIDs, task.ID)
+	}
+	if next, ok := sprints.Next(current); ok && len(step.taskIDs) > 0 {
+		step.to = next
+	}
+
+	details := sprintTotalsLines(resolver.Totals(tasks), current)
+	if days := resolver.Burndown(tasks, current, m.programContext.Now()); len(days) > 0 {
+		details = append(details, "",
+			fmt.Sprintf("Burndown since %s: %s %d open",
+				days[0].Date.Format("Jan 2"), burndownSparkline(days), days[len(days)-1].Remaining))
+	}
+
+	showMsg := confirmation.ShowConfirmationModalMsg{
+		Message:     "Sprints (current: " + current + ")",
+		Details:     details,
+		ConfirmText: "Close",
+		CancelText:  "Close",
+	}
+	if step.to != "" {
+		showMsg.ConfirmText = fmt.Sprintf("Roll over to %s", step.to)
+	}
+	m.pendingSprint = step
+	return func() tea.Msg { return showMsg }, true
+}
+
+// resolveSprintConfirmation answers the sprint overview or rollover preview
+func (m *MainModel) resolveSprintConfirmation(step pendingSprintStep, confirmed bool) tea.Cmd {
+	if !confirmed || step.to == "" {
+		return nil
+	}
+	if step.preview {
+		return m.assignFeature(step.taskIDs, step.to)
+	}
+
+	step.preview = true
+	m.pendingSprint = &step
+	var details []string
+	for i, taskID := range step.taskIDs {
+		if i == maxRolloverPreview {
+			details = append(details, fmt.Sprintf("… and %d more", len(step.taskIDs)-i))
+			break
+		}
+		if task := m.programContext.FindTask(taskID); task != nil {
+			details = append(details, "• "+task.Title)
+		}
+	}
+	showMsg := confirmation.ShowConfirmationModalMsg{
+		Message: fmt.Sprintf("Move %d unfinished task(s)\nfrom %s to %s?",
+			len(step.taskIDs), step.from, step.to),
+		Details:     details,
+		ConfirmText: "Move",
+		CancelText:  "Cancel",
+	}
+	return func() tea.Msg { return showMsg }
+}
+
+// sprintTotalsLines lists the totals of every sprint, marking the current one
+func sprintTotalsLines(totals []sprints.Totals, current string) []string {
+	width := 0
+	for _, t := range totals {
+		width = max(width, len(t.Sprint))
+	}
+	lines := make([]string, 0, len(totals))
+	for _, t := range totals {
+		marker := "  "
+		if t.Sprint == current {
+			marker = "▶ "
+		}
+		lines = append(lines, fmt.Sprintf("%s%-*s %3d tasks %3d open %3d done",
+			marker, width, t.Sprint, t.Total, t.Open, t.Done))
+	}
+	return lines
+}
+
+// sparkLevels are the bar heights of a burndown sparkline, lowest first
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// burndownSparkline draws the open tasks per day scaled to the busiest day
+func burndownSparkline(days []sprints.Day) string {
+	peak := 0
+	for _, day := range days {
+		peak = max(peak, day.Remaining)
+	}
+	var b strings.Builder
+	for _, day := range days {
+		level := 0
+		if peak > 0 {
+			level = day.Remaining * (len(sparkLevels) - 1) / peak
+		}
+		b.WriteRune(sparkLevels[level])
+	}
+	return b.String()
+}
@@ -1,13 +1,25 @@
 package ui
 
 import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
 	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clock"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/projectdetails"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/projectlist"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 )
 
 // =============================================================================
@@ -21,47 +33,160 @@ import (
 func (m *MainModel) handleTaskMessages(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tasks.TasksLoadedMsg:
+		if errors.Is(msg.Error, gocontext.Canceled) {
+			return m, nil // Superseded by a newer load
+		}
 		if msg.Error != nil {
+			// A failed refresh, malformed responses included, keeps the tasks shown
+			m.programContext.RecordFailedAttempt()
 			m.setError(msg.Error.Error())
 			m.setLoading(false)
 			return m, nil
 		}
-		m.updateTasks(msg.Tasks)
-		return m, nil
+		m.programContext.ObserveResponseMeta(msg.Meta)
+		pages := m.setTaskPages(msg)
+		loaded, invalid := m.dropInvalidTasks(msg.Tasks)
+		previous, firstLoad := m.programContext.Tasks, !m.tasksLoaded
+		m.updateTasks(loaded)
+		m.recordRefreshChanges(previous, firstLoad)
+		m.metrics.Refresh(m.programContext.Tasks, m.programContext.Projects)
+		safeMode := m.safeModeBanner()
+		m.tasksLoaded = true
+		pruned := m.pruneFeatureFilters()
+		m.restoreSelection()
+		skewWarning, awayDigest := m.clockSkewWarningCmd(), m.handleAwayBaseline()
+		deleted, tracking := m.protectDeletedEdits(), m.startReadTracking()
+		if snapshot := m.restoringSession; snapshot != nil {
+			return m, tea.Batch(safeMode, invalid, pages, pruned, skewWarning, awayDigest, deleted, tracking, m.finishSessionRestore(snapshot, m.restoreSkipped))
+		}
+		if m.pendingBookmarkTaskID != "" {
+			return m, tea.Batch(safeMode, invalid, pages, pruned, skewWarning, awayDigest, deleted, tracking, m.finishBookmarkJump())
+		}
+		if m.pendingCreatedTaskID != "" {
+			return m, tea.Batch(safeMode, invalid, pages, pruned, skewWarning, awayDigest, deleted, tracking, m.finishTaskCreate())
+		}
+		return m, tea.Batch(safeMode, invalid, pages, pruned, skewWarning, awayDigest, deleted, tracking, m.maybePromptSessionRestore())
 
 	case tasks.TaskUpdateMsg:
 		if msg.Error != nil {
+			if cmd, handled := m.handleForbiddenMutation(msg.TaskID, msg.Error); handled {
+				return m, cmd
+			}
+			if errors.Is(msg.Error, archon.ErrTaskNotFound) {
+				m.setLoading(false)
+				return m, m.recoverDeletedUpdate(msg)
+			}
 			m.setError(msg.Error.Error())
 			m.setLoading(false)
 			return m, nil
 		}
 		// Task updated successfully, refresh tasks to show changes
-		return m, tasks.LoadTasksInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID)
+		m.recordTaskUpdate(msg)
+		m.touchTask(msg.TaskID)
+		return m, m.requestTaskReload(helpers.RefreshMutation)
 
-	case tasks.TaskDeleteMsg:
-		if msg.Error != nil {
-			m.setError(msg.Error.Error())
-			m.setLoading(false)
-			return m, nil
+	case tasks.TaskCreatedMsg:
+		return m, m.handleTaskCreated(msg)
+
+	case tasks.TaskReloadedMsg:
+		if cmd, handled := m.finishClipboardJump(msg); handled {
+			return m, cmd
 		}
-		// Task deleted successfully, refresh tasks to reflect deletion
-		m.setLoadingWithMessage(true, "Refreshing tasks...")
-		return m, tasks.LoadTasksInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID)
+		return m, m.handleTaskReloaded(msg)
+
+	case tasks.TaskFeatureProgressMsg:
+		return m, m.handleFeatureProgress(msg)
+
+	case tasks.TasksFeatureUpdateMsg:
+		return m, m.handleFeatureAssigned(msg)
+
+	case tasks.TaskStatusProgressMsg:
+		return m, m.handleStatusProgress(msg)
+
+	case tasks.TasksStatusUpdateMsg:
+		return m, m.handleStatusUpdated(msg)
+
+	case tasks.TaskArchiveProgressMsg:
+		return m, m.handleArchiveProgress(msg)
+
+	case tasks.TasksArchivedMsg:
+		return m, m.handleTasksArchived(msg)
+
+	case tasks.TasksSearchedMsg:
+		return m, m.handleTasksSearched(msg)
+
+	case tasks.TaskDeleteMsg:
+		return m, m.handleTaskDeleted(msg)
 	}
 	return m, nil
 }
 
+// handleTaskDeleted drops a deleted task from the list at once, moving the
+// selection to the task above it, and refreshes the tasks. A task the server
+// no longer had counts as deleted.
+func (m *MainModel) handleTaskDeleted(msg tasks.TaskDeleteMsg) tea.Cmd {
+	if msg.Error != nil && !errors.Is(msg.Error, archon.ErrTaskNotFound) {
+		if cmd, handled := m.handleForbiddenMutation(msg.TaskID, msg.Error); handled {
+			return cmd
+		}
+		return m.setError("Failed to delete task: " + msg.Error.Error())
+	}
+
+	feedback := "Task deleted"
+	if msg.Error != nil {
+		feedback = "Task was already deleted"
+	}
+	if task := m.programContext.FindTask(msg.TaskID); task != nil {
+		feedback += ": " + task.Title
+		if msg.Error == nil {
+			m.recordTaskDelete(msg.TaskID)
+		}
+	}
+
+	// Keep the selection where the task was instead of jumping to the top
+	if index, ok := m.sortedTaskIndex(msg.TaskID); ok && index == m.uiState.SelectedTaskIndex {
+		sorted := m.GetSortedTasks()
+		switch {
+		case index > 0:
+			_ = m.setSelectedTask(index - 1) // Components are refreshed by updateTasks
+		case len(sorted) > 1:
+			_ = m.setSelectedTask(1)
+		}
+	}
+	m.updateTasks(slices.DeleteFunc(slices.Clone(m.programContext.Tasks), func(task archon.Task) bool {
+		return task.ID == msg.TaskID
+	}))
+
+	return tea.Batch(
+		statusFeedback(feedback),
+		m.requestTaskReload(helpers.RefreshMutation),
+	)
+}
+
 // handleProjectMessages processes project-related messages
 //
 //nolint:ireturn // Required by Bubble Tea framework - must return tea.Model interface
 func (m *MainModel) handleProjectMessages(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if msg, ok := msg.(projects.ProjectsLoadedMsg); ok {
+		if errors.Is(msg.Error, gocontext.Canceled) {
+			return m, nil // Superseded by a newer load
+		}
 		if msg.Error != nil {
 			m.setError(msg.Error.Error())
 			return m, nil
 		}
+		m.programContext.ObserveResponseMeta(msg.Meta)
 		m.updateProjects(msg.Projects)
-		return m, nil
+		m.metrics.Refresh(m.programContext.Tasks, m.programContext.Projects)
+		var picker tea.Cmd
+		if !m.projectsLoaded && m.uiState.IsProjectView() {
+			// Started in project mode before projects arrived: put the cursor
+			// on the default project and show its details
+			_, picker = m.handleProjectModeMessages(projectmode.ProjectModeActivatedMsg{})
+		}
+		m.projectsLoaded = true
+		m.restoreSelection()
+		return m, tea.Batch(picker, m.clockSkewWarningCmd(), m.maybePromptSessionRestore())
 	}
 	return m, nil
 }
@@ -112,12 +237,20 @@ func (m *MainModel) handleProjectModeMessages(msg tea.Msg) (tea.Model, tea.Cmd)
 
 		// Note: No need to send ProjectListSetActiveMsg - component reads active state via IsComponentActive() callback
 
+		// Cancelling may return to a fixed "home" instead of the previous selection
+		if msg.Canceled {
+			if target, ok := m.projectModeCancelTarget(); ok && !sameProject(target, m.programContext.SelectedProjectID) {
+				m.setSelectedProject(target)
+				msg.ShouldLoadTasks = true
+			}
+		}
+
 		// Broadcast updated state to StatusBar
 		statusBarCmd := m.broadcastStatusBarState()
 
 		// If task loading is requested, do it after deactivation
 		if msg.ShouldLoadTasks {
-			loadCmd := tasks.LoadTasksInterface(m.programContext.ArchonClient, m.programContext.SelectedProjectID)
+			loadCmd := m.requestTaskReload(helpers.RefreshNavigation)
 			return m, tea.Batch(statusBarCmd, loadCmd)
 		}
 		return m, statusBarCmd
@@ -129,6 +262,210 @@ func (m *MainModel) handleProjectModeMessages(msg tea.Msg) (tea.Model, tea.Cmd)
 // HELPER FUNCTIONS
 // =============================================================================
 
+// projectModeCancelTarget returns the project cancelling project mode selects
+// (nil = All Tasks); false keeps the current selection
+func (m *MainModel) projectModeCancelTarget() (*string, bool) {
+	cfg := m.programContext.Config
+	if cfg == nil {
+		return nil, false
+	}
+	switch cfg.GetProjectModeCancel() {
+	case configpkg.ProjectCancelAll:
+		return nil, true
+	case configpkg.ProjectCancelDefault:
+		if id := cfg.GetDefaultProjectID(); id != "" && m.projectExists(id) {
+			return &id, true
+		}
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// handleForbiddenMutation translates a 403 from a task mutation into a clear message
+// and flips the session-local read-only flag for the task's project.
+// Returns handled=false for any other error so the caller can fall back to setError.
+func (m *MainModel) handleForbiddenMutation(taskID string, err error) (tea.Cmd, bool) {
+	if !errors.Is(err, archon.ErrForbidden) {
+		return nil, false
+	}
+
+	m.setLoading(false)
+
+	if task := m.programContext.FindTask(taskID); task != nil {
+		return m.forbiddenProjectFeedback(task.ProjectID), true
+	}
+	return statusFeedback("Permission denied: this project is read-only for you"), true
+}
+
+// forbiddenProjectFeedback marks the project read-only for the session after a 403
+// and says so
+func (m *MainModel) forbiddenProjectFeedback(projectID string) tea.Cmd {
+	m.programContext.MarkProjectReadOnly(projectID)
+	message := "Permission denied: this project is read-only for you"
+	for _, project := range m.programContext.Projects {
+		if project.ID == projectID {
+			message = "Permission denied: project '" + project.Title + "' is read-only for you"
+			break
+		}
+	}
+
+	return func() tea.Msg {
+		return messages.StatusFeedbackMsg{Message: message}
+	}
+}
+
+// assignFeature sets feature ("" clears it) on the given tasks as one batch.
+// Tasks in read-only projects are left out.
+func (m *MainModel) assignFeature(taskIDs []string, feature string) tea.Cmd {
+	var writable []string
+	var readOnly *archon.Task
+	for _, taskID := range taskIDs {
+		task := m.programContext.FindTask(taskID)
+		if task == nil {
+			continue
+		}
+		if m.programContext.IsProjectReadOnly(task.ProjectID) {
+			readOnly = task
+			continue
+		}
+		writable = append(writable, taskID)
+	}
+	if len(writable) == 0 {
+		if readOnly != nil {
+			return m.readOnlyFeedback(readOnly)
+		}
+		return statusFeedback("No task selected")
+	}
+
+	// The status bar shows a progress bar while the tasks are updated one by one
+	batch := fmt.Sprintf("feature:%d", time.Now().UnixNano())
+	m.snapshotForUndo(batch, writable)
+	return tea.Batch(
+		m.handleOperationStarted(messages.OperationStartedMsg{ID: batch, Label: "Updating features", Total: len(writable)}),
+		tasks.UpdateTasksFeature(m.programContext.ArchonClient, batch, writable, feature),
+	)
+}
+
+// handleFeatureProgress advances the progress of a batch feature assignment
+// and continues the batch
+func (m *MainModel) handleFeatureProgress(msg tasks.TaskFeatureProgressMsg) tea.Cmd {
+	item := msg.TaskID
+	if task := m.programContext.FindTask(msg.TaskID); task != nil {
+		item = task.Title
+	}
+	return tea.Batch(
+		m.handleOperationFeedback(messages.StatusFeedbackMsg{Message: item, Operation: msg.Batch, Err: msg.Error}),
+		msg.Next,
+	)
+}
+
+// handleFeatureAssigned reports a batch feature assignment in one status message
+// and refreshes the tasks once for the whole batch. Its progress and failures
+// were already recorded as the batch went (see handleFeatureProgress).
+func (m *MainModel) handleFeatureAssigned(msg tasks.TasksFeatureUpdateMsg) tea.Cmd {
+	m.recordBatchUndo(msg.Batch, msg.Updated, func(task *archon.Task) {
+		task.Feature = &msg.Feature
+	})
+	if len(msg.Updated) == 0 {
+		for taskID, err := range msg.Errors {
+			if cmd, handled := m.handleForbiddenMutation(taskID, err); handled {
+				return cmd
+			}
+			return statusFeedback("Failed to update feature: " + err.Error())
+		}
+		return nil
+	}
+
+	for _, taskID := range msg.Updated {
+		m.touchTask(taskID)
+	}
+	summary := "Set feature '" + msg.Feature + "' on " + pluralTasks(len(msg.Updated))
+	if msg.Feature == "" {
+		summary = "Cleared feature on " + pluralTasks(len(msg.Updated))
+	}
+	if len(msg.Errors) > 0 {
+		summary += fmt.Sprintf(" (%d failed)", len(msg.Errors))
+		for _, taskID := range slices.Sorted(maps.Keys(msg.Errors)) {
+			m.programContext.Logger.Warn("Feature update failed", "task_id", taskID, "error", msg.Errors[taskID])
+		}
+	}
+	return tea.Batch(
+		statusFeedback(summary),
+		m.requestTaskReload(helpers.RefreshMutation),
+	)
+}
+
+// handleTaskReloaded merges a task fetched on its own into the task list by ID.
+// A task the server no longer has is dropped from the list.
+func (m *MainModel) handleTaskReloaded(msg tasks.TaskReloadedMsg) tea.Cmd {
+	merged := slices.Clone(m.programContext.Tasks)
+	index := slices.IndexFunc(merged, func(task archon.Task) bool { return task.ID == msg.TaskID })
+
+	if msg.Error != nil {
+		if !errors.Is(msg.Error, archon.ErrTaskNotFound) {
+			m.programContext.Logger.Warn("Task reload failed", "task_id", msg.TaskID, "error", msg.Error)
+			return statusFeedback("Failed to refresh task: " + msg.Error.Error())
+		}
+		if index >= 0 {
+			m.updateTasks(slices.Delete(merged, index, index+1))
+		}
+		if cmd := m.protectDeletedEdits(); cmd != nil {
+			return cmd
+		}
+		return statusFeedback("Task no longer exists")
+	}
+
+	if valid, invalid := m.dropInvalidTasks([]archon.Task{*msg.Task}); len(valid) == 0 {
+		return invalid // Keep the copy already shown
+	}
+	if index >= 0 {
+		merged[index] = *msg.Task
+	} else {
+		merged = append(merged, *msg.Task)
+	}
+	m.updateTasks(merged)
+	m.metrics.Refresh(m.programContext.Tasks, m.programContext.Projects)
+	return statusFeedback("Task refreshed")
+}
+
+// dropInvalidTasks keeps tasks without an ID or with an unknown status out of
+// the context, logging each and returning a warning with their count
+func (m *MainModel) dropInvalidTasks(loaded []archon.Task) ([]archon.Task, tea.Cmd) {
+	valid, dropped := archon.ValidateTasks(loaded)
+	if len(dropped) == 0 {
+		return valid, nil
+	}
+	for _, reason := range dropped {
+		m.programContext.Logger.Warn("Ignoring invalid task from server", "reason", reason)
+	}
+	return valid, statusFeedback(fmt.Sprintf("Ignored %s with invalid data from the server", pluralTasks(len(dropped))))
+}
+
+// pluralTasks formats a task count for status messages
+func pluralTasks(n int) string {
+	if n == 1 {
+		return "1 task"
+	}
+	return fmt.Sprintf("%d tasks", n)
+}
+
+// clockSkewWarningCmd warns once when the local clock disagrees with the server.
+// From then on relative times are computed from ProgramContext.Now().
+func (m *MainModel) clockSkewWarningCmd() tea.Cmd {
+	if m.clockSkewWarned || !m.programContext.ClockSkew.Significant() {
+		return nil
+	}
+	m.clockSkewWarned = true
+
+	skew := m.programContext.ClockSkew.Skew()
+	m.programContext.Logger.Warn("Local clock skew detected", "skew_ms", skew.Milliseconds())
+	message := clock.Describe(skew) + " — times shown relative to server"
+	return func() tea.Msg {
+		return messages.StatusFeedbackMsg{Message: message}
+	}
+}
+
 // findProjectIndexForCursor returns the cursor index that matches the current project filter state
 // Returns the project's index if a specific project is selected, or len(projects) for "All Tasks"
 func (m *MainModel) findProjectIndexForCursor() int {
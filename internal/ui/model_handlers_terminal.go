@@ -0,0 +1,47 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/terminal"
+)
+
+// =============================================================================
+// TERMINAL WINDOW INTEGRATION
+// =============================================================================
+// This file keeps the terminal window title and progress indicator in sync
+// with the model. Title changes go through tea.SetWindowTitle so the renderer
+// writes them between frames; progress goes through the shared terminal.Output.
+
+// AttachTerminal enables window title and progress updates on out.
+// Call before the program starts; a nil out leaves the integration disabled.
+func (m *MainModel) AttachTerminal(out *terminal.Output) {
+	m.terminal = out
+}
+
+// terminalTitleInfo describes the current view for the window title
+func (m *MainModel) terminalTitleInfo() terminal.TitleInfo {
+	info := terminal.TitleInfo{}
+	if project := m.GetSelectedProject(); project != nil {
+		info.Project = project.Title
+	}
+	_, info.Doing, _, _ = m.getTaskStatusCounts()
+	return info
+}
+
+// withTerminalSync updates the window title and progress indicator after a message is handled
+//
+//nolint:ireturn // Passes through the tea.Model from Update
+func (m *MainModel) withTerminalSync(model tea.Model, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	if m.terminal == nil {
+		return model, cmd
+	}
+
+	m.terminal.SetBusy(m.programContext.Loading)
+
+	title := terminal.ComposeTitle(m.terminalTitleInfo())
+	if title == m.terminalTitle {
+		return model, cmd
+	}
+	m.terminalTitle = title
+	return model, tea.Batch(cmd, tea.SetWindowTitle(title))
+}
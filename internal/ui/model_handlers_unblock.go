@@ -0,0 +1,36 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/deps"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
+)
+
+// =============================================================================
+// UNBLOCK REPORT
+// =============================================================================
+// 'U' ranks the visible tasks by how many open tasks are (transitively)
+// waiting on them, so finishing the top rows unblocks the most work.
+// Relations come from the task hierarchy, see package deps.
+
+// HandleUnblockReportKey handles 'U' key - show the unblock report
+//
+//nolint:unparam // key parameter intentionally unused - handler is dispatched by routing layer
+func (m *MainModel) handleUnblockReportKey(key string) (tea.Cmd, bool) {
+	if !m.uiState.IsTaskView() {
+		return nil, false
+	}
+
+	// Only visible tasks count, so the report follows the active project and filters
+	report := deps.FromTasks(m.GetSortedTasks()).Unblock()
+	markdown := export.FormatUnblockReport("What should I unblock?", report,
+		m.programContext.FindTask, m.programContext.Config.GetShortIDLength())
+
+	if len(report.Cycles) > 0 {
+		m.programContext.Logger.Warn("Task hierarchy contains cycles", "cycles", report.Cycles)
+	}
+	return func() tea.Msg {
+		return unblock.ShowUnblockModalMsg{Report: report, Export: markdown}
+	}, true
+}
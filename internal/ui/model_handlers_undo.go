@@ -0,0 +1,265 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+)
+
+// =============================================================================
+// UNDO
+// =============================================================================
+// Status, feature and priority changes and deletions are pushed to
+// ProgramContext.UndoStack once the server confirms them, with the tasks as
+// they were last loaded. ctrl+z pops the most recent entry and sends the
+// inverse request, setting back only the fields that changed. A deleted task
+// is re-created with CreateTask: it gets a new ID, and its parent and tags are
+// not restored. Batches from 'v' marks and the feature modal are one entry.
+
+// taskUndoneMsg reports the requests sent to revert an undo entry
+type taskUndoneMsg struct {
+	entry    context.UndoEntry
+	restored []string // IDs of the tasks re-created for a deletion
+	err      error
+}
+
+// handleUndoKey handles ctrl+z - revert the most recent undoable change
+func (m *MainModel) handleUndoKey(key string) (tea.Cmd, bool) {
+	if key != keys.KeyCtrlZ || m.uiState.IsProjectView() {
+		return nil, false
+	}
+	entry, ok := m.programContext.PopUndo()
+	if !ok {
+		return statusFeedback("Nothing to undo"), true
+	}
+	for _, change := range entry.Changes {
+		if cmd := m.readOnlyProjectFeedback(change.Before.ProjectID); cmd != nil {
+			return cmd, true
+		}
+	}
+	return tea.Batch(
+		statusFeedback("Undoing "+describeUndo(entry)+"..."),
+		undoCmd(m.programContext.ArchonClient, entry),
+	), true
+}
+
+// undoCmd sends the requests reverting entry. A failed task does not stop
+// the others.
+func undoCmd(client interfaces.ArchonClient, entry context.UndoEntry) tea.Cmd {
+	return func() tea.Msg {
+		result := taskUndoneMsg{entry: entry}
+		var errs []error
+		for _, change := range entry.Changes {
+			if entry.Kind != undoDeletion {
+				if _, err := client.UpdateTask(change.Before.ID, change.Revert); err != nil {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			id, err := recreateTask(client, change.Before)
+			if id != "" {
+				result.restored = append(result.restored, id)
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		result.err = errors.Join(errs...)
+		return result
+	}
+}
+
+// recreateTask creates a deleted task again from its last loaded version and
+// returns the new task's ID ("" when creating it failed)
+func recreateTask(client interfaces.ArchonClient, task archon.Task) (string, error) {
+	resp, err := client.CreateTask(archon.CreateTaskRequest{
+		Title:       task.Title,
+		Description: task.Description,
+		ProjectID:   task.ProjectID,
+		Status:      task.Status,
+		Priority:    task.TaskOrder,
+		Feature:     task.Feature,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The fields CreateTask does not take
+	var updates archon.UpdateTaskRequest
+	if task.Assignee != "" && task.Assignee != resp.Task.Assignee {
+		updates.Assignee = &task.Assignee
+	}
+	if len(task.Sources) > 0 {
+		updates.Sources = &task.Sources
+	}
+	if len(task.CodeExamples) > 0 {
+		updates.CodeExamples = &task.CodeExamples
+	}
+	if updates == (archon.UpdateTaskRequest{}) {
+		return resp.Task.ID, nil
+	}
+	_, err = client.UpdateTask(resp.Task.ID, updates)
+	return resp.Task.ID, err
+}
+
+// handleTaskUndone reports an undo and refreshes the tasks. An undo that
+// failed before re-creating anything goes back on the stack to be retried.
+func (m *MainModel) handleTaskUndone(msg taskUndoneMsg) tea.Cmd {
+	for _, change := range msg.entry.Changes {
+		m.touchTask(change.Before.ID)
+	}
+	for _, id := range msg.restored {
+		m.touchTask(id)
+	}
+	reload := m.requestTaskReload(helpers.RefreshMutation)
+
+	if msg.err != nil {
+		m.programContext.Logger.Warn("Undo failed", "kind", msg.entry.Kind, "error", msg.err)
+		if len(msg.restored) == 0 {
+			m.programContext.PushUndo(msg.entry)
+			return tea.Batch(statusFeedback("Undo failed, ctrl+z to retry: "+msg.err.Error()), reload)
+		}
+		return tea.Batch(statusFeedback("Undo incomplete: "+msg.err.Error()), reload)
+	}
+	return tea.Batch(statusFeedback("Undid: "+describeUndo(msg.entry)), reload)
+}
+
+// describeUndo says what reverting entry does, e.g.
+// "status change on 'Fix login bug' (doing → todo)"
+func describeUndo(entry context.UndoEntry) string {
+	if len(entry.Changes) != 1 {
+		return entry.Kind + " on " + pluralTasks(len(entry.Changes))
+	}
+	change := entry.Changes[0]
+	return fmt.Sprintf("%s on '%s' (%s)", entry.Kind, change.Before.Title, change.Summary)
+}
+
+// undoDeletion is the kind of undo entries recording a deletion
+const undoDeletion = "deletion"
+
+// recordTaskUpdate pushes an undo entry for a confirmed update of one task,
+// comparing the server's version with the one loaded before
+func (m *MainModel) recordTaskUpdate(msg tasks.TaskUpdateMsg) {
+	before := m.programContext.FindTask(msg.TaskID)
+	if before == nil || msg.Task == nil {
+		return
+	}
+	change, fields := undoChangeFor(*before, *msg.Task)
+	if len(fields) == 0 {
+		return // Nothing undo covers, e.g. a description edit
+	}
+	m.programContext.PushUndo(context.UndoEntry{
+		Kind:    strings.Join(fields, " and ") + " change",
+		Changes: []context.UndoChange{change},
+	})
+}
+
+// recordTaskDelete pushes an undo entry for a confirmed deletion
+func (m *MainModel) recordTaskDelete(taskID string) {
+	task := m.programContext.FindTask(taskID)
+	if task == nil {
+		return
+	}
+	m.programContext.PushUndo(context.UndoEntry{
+		Kind:    undoDeletion,
+		Changes: []context.UndoChange{{Before: *task, Summary: "re-created with a new ID"}},
+	})
+}
+
+// snapshotForUndo keeps the tasks a batch is about to change until it finishes
+func (m *MainModel) snapshotForUndo(batch string, taskIDs []string) {
+	var snapshot []archon.Task
+	for _, taskID := range taskIDs {
+		if task := m.programContext.FindTask(taskID); task != nil {
+			snapshot = append(snapshot, *task)
+		}
+	}
+	if m.undoBatches == nil {
+		m.undoBatches = make(map[string][]archon.Task)
+	}
+	m.undoBatches[batch] = snapshot
+}
+
+// recordBatchUndo pushes one undo entry for the tasks a batch updated; apply
+// makes a snapshot task into what the batch turned it into
+func (m *MainModel) recordBatchUndo(batch string, updated []string, apply func(task *archon.Task)) {
+	snapshot := m.undoBatches[batch]
+	delete(m.undoBatches, batch)
+
+	entry := context.UndoEntry{}
+	var fields []string
+	for _, before := range snapshot {
+		if !slices.Contains(updated, before.ID) {
+			continue
+		}
+		after := before
+		apply(&after)
+		change, changed := undoChangeFor(before, after)
+		if len(changed) == 0 {
+			continue
+		}
+		entry.Changes = append(entry.Changes, change)
+		fields = changed
+	}
+	if len(entry.Changes) == 0 {
+		return
+	}
+	entry.Kind = strings.Join(fields, " and ") + " change"
+	m.programContext.PushUndo(entry)
+}
+
+// undoChangeFor returns the change reverting before into after, and the
+// undoable fields that differ ("status", "feature", "priority"). An assignee
+// set along with the status is reverted with it.
+func undoChangeFor(before, after archon.Task) (context.UndoChange, []string) {
+	change := context.UndoChange{Before: before}
+	var fields, summary []string
+
+	if before.Status != after.Status {
+		change.Revert.Status = &before.Status
+		if before.Assignee != after.Assignee {
+			change.Revert.Assignee = &before.Assignee
+		}
+		fields = append(fields, "status")
+		summary = append(summary, after.Status+" → "+before.Status)
+	}
+	if oldFeature, newFeature := featureName(before.Feature), featureName(after.Feature); oldFeature != newFeature {
+		change.Revert.Feature = &oldFeature
+		fields = append(fields, "feature")
+		summary = append(summary, undoValue(newFeature)+" → "+undoValue(oldFeature))
+	}
+	if before.TaskOrder != after.TaskOrder {
+		change.Revert.TaskOrder = &before.TaskOrder
+		fields = append(fields, "priority")
+		summary = append(summary, strconv.Itoa(after.TaskOrder)+" → "+strconv.Itoa(before.TaskOrder))
+	}
+
+	change.Summary = strings.Join(summary, ", ")
+	return change, fields
+}
+
+// featureName returns a task's feature, "" when it has none
+func featureName(feature *string) string {
+	if feature == nil {
+		return ""
+	}
+	return *feature
+}
+
+// undoValue shows an empty value in an undo summary
+func undoValue(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	configpkg "github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+)
+
+// =============================================================================
+// UNREAD TASKS
+// =============================================================================
+// With ui.display.unread_indicator, tasks updated since their details were last
+// shown carry a dot, like unread mail. Showing the details (selecting the task,
+// or Enter/l with details_panel "manual") marks the task as seen. Tracking
+// starts on the first load, so tasks nobody touched since then count as seen;
+// both the start and the per-task seen times are kept in the state file.
+
+// startReadTracking starts unread tracking on the first load after it was enabled
+func (m *MainModel) startReadTracking() tea.Cmd {
+	ctx := m.programContext
+	if !ctx.Config.IsUnreadIndicatorEnabled() || !ctx.ReadSince.IsZero() {
+		return nil
+	}
+	ctx.ReadSince = ctx.Now()
+	return m.saveStateCmd()
+}
+
+// withReadTracking marks the task whose details are shown as seen after a message is handled
+//
+//nolint:ireturn // Passes through the tea.Model from Update
+func (m *MainModel) withReadTracking(model tea.Model, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	if !m.uiState.IsTaskView() {
+		return model, cmd
+	}
+	task := m.GetSelectedTask()
+	if task == nil {
+		return model, cmd
+	}
+	if m.programContext.Config.GetDetailsPanel() == configpkg.DetailsManual && !m.uiState.DetailsOpen(task.ID) {
+		return model, cmd
+	}
+	if !m.programContext.MarkSeen(*task) {
+		return model, cmd
+	}
+	return model, tea.Batch(cmd, m.saveStateCmd())
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+)
+
+// =============================================================================
+// SAFE MODE
+// =============================================================================
+// --safe-mode starts with built-in defaults (see config.SafeMode) and skips
+// every file the app would normally read at startup, so a corrupt state file
+// or broken config section cannot keep it from starting. Nothing is deleted:
+// the next normal start reads the files again.
+
+// SafeModeDisabled lists what --safe-mode turns off, in banner order
+var SafeModeDisabled = []string{
+	"config file (theme, keybindings, workflow, links, exports)",
+	"saved feature filter",
+	"saved bookmarks",
+	"session restore",
+	"away digest",
+	"scheduled exports",
+	"polling",
+	"HTTP record/replay",
+	"terminal title",
+	"task changes (read-only)",
+}
+
+// startSafeMode leaves every persistence store unset, which each subsystem
+// treats as disabled. Bookmarks still work for the session.
+func startSafeMode(model *MainModel, logger interfaces.Logger) {
+	model.programContext.SafeMode = true
+	model.bookmarks = bookmarks.Set{}
+	logger.Warn("Safe mode: nonessential subsystems disabled", "disabled", strings.Join(SafeModeDisabled, ", "))
+}
+
+// safeModeBanner lists the disabled subsystems once the first tasks are shown
+func (m *MainModel) safeModeBanner() tea.Cmd {
+	if !m.programContext.SafeMode || m.tasksLoaded {
+		return nil
+	}
+	return statusFeedback(fmt.Sprintf("Safe mode - disabled: %s", strings.Join(SafeModeDisabled, ", ")))
+}
@@ -1,11 +1,55 @@
 package ui
 
 import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
+	"github.com/yousfisaad/lazyarchon/v2/internal/archon/replay"
+	"github.com/yousfisaad/lazyarchon/v2/internal/away"
+	"github.com/yousfisaad/lazyarchon/v2/internal/bookmarks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projectmode"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/projects"
+	"github.com/yousfisaad/lazyarchon/v2/internal/domain/tasks"
+	"github.com/yousfisaad/lazyarchon/v2/internal/export/scheduled"
+	"github.com/yousfisaad/lazyarchon/v2/internal/metrics"
+	"github.com/yousfisaad/lazyarchon/v2/internal/session"
 	"github.com/yousfisaad/lazyarchon/v2/internal/shared/config"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/interfaces"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/clipboard"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/keys"
+	"github.com/yousfisaad/lazyarchon/v2/internal/shared/utils/terminal"
+	"github.com/yousfisaad/lazyarchon/v2/internal/sprints"
+	"github.com/yousfisaad/lazyarchon/v2/internal/state"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/base"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/apikey"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/confirmation"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/descdiff"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/digest"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/feature"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/help"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/linkpicker"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/status"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/taskedit"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/modals/unblock"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/components/tasklist"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/context"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/helpers"
+	"github.com/yousfisaad/lazyarchon/v2/internal/ui/messages"
 	"github.com/yousfisaad/lazyarchon/v2/internal/ui/sorting"
 )
 
@@ -27,6 +71,21 @@ func createTestConfig() *config.Config {
 	}
 }
 
+// TestMain points the user config and cache directories at a temporary
+// directory, so NewModel never reads or writes the developer's own state files
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "lazyarchon-ui-test-")
+	if err != nil {
+		panic(err)
+	}
+	for _, name := range []string{"HOME", "XDG_CONFIG_HOME", "XDG_CACHE_HOME", "AppData", "LocalAppData"} {
+		_ = os.Setenv(name, dir)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func TestNewModel(t *testing.T) {
 	model := NewModel(createTestConfig())
 
@@ -37,85 +96,2956 @@ func TestNewModel(t *testing.T) {
 		t.Errorf("Expected loading to be true")
 	}
 
-	if model.programContext.SortMode != sorting.SortStatusPriority {
-		t.Errorf("Expected sortMode to be SortStatusPriority (%d), got %d", sorting.SortStatusPriority, model.programContext.SortMode)
+	if model.programContext.SortMode != sorting.SortStatusPriority {
+		t.Errorf("Expected sortMode to be SortStatusPriority (%d), got %d", sorting.SortStatusPriority, model.programContext.SortMode)
+	}
+
+	if model.programContext.ArchonClient == nil {
+		t.Errorf("Expected ArchonClient to be initialized")
+	}
+}
+
+func TestGetSortedTasks(t *testing.T) {
+	model := NewModel(createTestConfig())
+
+	// Test with empty tasks
+	sorted := model.GetSortedTasks()
+	if len(sorted) != 0 {
+		t.Errorf("Expected empty slice, got %d tasks", len(sorted))
+	}
+
+	// Test with sample tasks
+	model.programContext.SetTasks([]archon.Task{
+		{Title: "Task A", Status: "todo", TaskOrder: 5},
+		{Title: "Task B", Status: "done", TaskOrder: 10},
+		{Title: "Task C", Status: "doing", TaskOrder: 3},
+	})
+
+	sorted = model.GetSortedTasks()
+	if len(sorted) != 3 {
+		t.Errorf("Expected 3 tasks, got %d", len(sorted))
+	}
+
+	// First task should be "todo" status (highest priority in status+priority sort)
+	if sorted[0].Status != "todo" {
+		t.Errorf("Expected first task status to be 'todo', got '%s'", sorted[0].Status)
+	}
+}
+
+func TestStatusOrder(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.StatusOrder = []string{"review", "doing"}
+	model := NewModel(cfg)
+	model.programContext.SetTasks([]archon.Task{
+		{ID: "a", Status: "todo", TaskOrder: 5},
+		{ID: "b", Status: "doing", TaskOrder: 3},
+		{ID: "c", Status: "review", TaskOrder: 1},
+		{ID: "d", Status: "done"},
+		{ID: "e", Status: "review", TaskOrder: 2},
+	})
+
+	// Listed statuses first, the rest after them in the default order
+	var ids []string
+	for _, task := range model.GetSortedTasks() {
+		ids = append(ids, task.ID)
+	}
+	if got := strings.Join(ids, ""); got != "ecbad" {
+		t.Errorf("Expected review, doing, then todo and done, got %s", got)
+	}
+}
+
+// TestSetSelectedProject - SKIPPED: Needs proper ProjectManager initialization with test projects
+// Consider rewriting to use component-based architecture
+// func TestSetSelectedProject(t *testing.T) {
+// 	model := NewModel(createTestConfig())
+//
+// 	// Test setting project ID
+// 	projectID := "test-project-123"
+// 	model.SetSelectedProject(&projectID)
+//
+// 	if model.programContext.SelectedProjectID == nil {
+// 		t.Error("Expected selectedProjectID to be set")
+// 	}
+//
+// 	if *model.programContext.SelectedProjectID != projectID {
+// 		t.Errorf("Expected selectedProjectID to be %s, got %s", projectID, *model.programContext.SelectedProjectID)
+// 	}
+//
+// 	// Test setting to nil (all tasks)
+// 	model.SetSelectedProject(nil)
+// 	if model.programContext.SelectedProjectID != nil {
+// 		t.Error("Expected selectedProjectID to be nil")
+// 	}
+// }
+
+func TestCycleSortMode(t *testing.T) {
+	model := NewModel(createTestConfig())
+
+	// Test cycling through sort modes
+	initialMode := model.programContext.SortMode
+	model.cycleSortMode()
+
+	if model.programContext.SortMode == initialMode {
+		t.Error("Expected sort mode to change")
+	}
+
+	// Cycle through all modes and verify we return to start
+	originalMode := model.programContext.SortMode
+	for i := 0; i < sorting.SortModeCount; i++ {
+		model.cycleSortMode()
+	}
+
+	if model.programContext.SortMode != originalMode {
+		t.Errorf("Expected to cycle back to original mode %d, got %d", originalMode, model.programContext.SortMode)
+	}
+}
+
+func TestReadOnlyProjectFromMetadata(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.updateProjects([]archon.Project{
+		{ID: "locked", Title: "Locked", Permission: archon.ProjectPermissionRead},
+		{ID: "open", Title: "Open", Permission: archon.ProjectPermissionWrite},
+	})
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "locked", Title: "Locked task", Status: "todo"},
+	})
+
+	if !model.programContext.IsProjectReadOnly("locked") {
+		t.Fatal("Expected project with read permission to be read-only")
+	}
+	if model.programContext.IsProjectReadOnly("open") {
+		t.Error("Expected project with write permission to be writable")
+	}
+
+	cmd, handled := model.handleTaskEditKey("e")
+	if !handled || cmd == nil {
+		t.Fatal("Expected edit key to be handled with feedback")
+	}
+	if _, ok := cmd().(messages.StatusFeedbackMsg); !ok {
+		t.Errorf("Expected StatusFeedbackMsg instead of opening modal, got %T", cmd())
+	}
+}
+
+func TestReadOnlyProjectLearnedFrom403(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.updateProjects([]archon.Project{{ID: "p1", Title: "Automation"}})
+	model.updateTasks([]archon.Task{{ID: "t1", ProjectID: "p1", Title: "Task", Status: "todo"}})
+
+	if model.programContext.IsProjectReadOnly("p1") {
+		t.Fatal("Expected project without permission metadata to be writable")
+	}
+
+	forbidden := fmt.Errorf("%w (status 403): denied", archon.ErrForbidden)
+	_, cmd := model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1", Error: forbidden})
+
+	if !model.programContext.IsProjectReadOnly("p1") {
+		t.Error("Expected 403 to flip the session read-only flag")
+	}
+	if model.programContext.Error != "" {
+		t.Errorf("Expected no blocking error, got %q", model.programContext.Error)
+	}
+	if cmd == nil {
+		t.Fatal("Expected feedback command")
+	}
+	feedback, ok := cmd().(messages.StatusFeedbackMsg)
+	if !ok || !strings.Contains(feedback.Message, "Automation") {
+		t.Errorf("Expected feedback naming the project, got %#v", cmd())
+	}
+}
+
+func TestAutoAssignOnStatusChange(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Workflow = config.WorkflowConfig{
+		CurrentUser: "alice",
+		AutoAssign:  config.AutoAssignConfig{Enabled: true, Transitions: []string{"*->doing"}},
+	}
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Unassigned", Status: "todo", Assignee: "User"},
+		{ID: "t2", Title: "Mine", Status: "todo", Assignee: "alice"},
+	})
+
+	if assignee := model.autoAssignee("t1", "doing"); assignee == nil || *assignee != "alice" {
+		t.Errorf("Expected todo->doing to assign alice, got %v", assignee)
+	}
+	if assignee := model.autoAssignee("t1", "review"); assignee != nil {
+		t.Errorf("Expected todo->review to keep assignee, got %q", *assignee)
+	}
+	if assignee := model.autoAssignee("t2", "doing"); assignee != nil {
+		t.Errorf("Expected no update when already assigned, got %q", *assignee)
+	}
+
+	cfg.Workflow.AutoAssign.Enabled = false
+	if assignee := model.autoAssignee("t1", "doing"); assignee != nil {
+		t.Errorf("Expected no auto-assign when disabled, got %q", *assignee)
+	}
+}
+
+func TestOpenLinkKey(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Integrations.Links = []config.LinkRuleConfig{
+		{Name: "Open PR search", Pattern: `auth-\d+`, URLTemplate: "https://github.com/org/repo/pulls?q={{.match}}"},
+		{Name: "Open branch", Pattern: `auth-\d+`, URLTemplate: "https://github.com/org/repo/tree/{{.match}}"},
+	}
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{{ID: "t1", Title: "Fix auth-12", Status: "todo"}})
+
+	cmd, handled := model.handleOpenLinkKey("o")
+	if !handled || cmd == nil {
+		t.Fatal("Expected open link key to be handled")
+	}
+	picker, ok := cmd().(linkpicker.ShowLinkPickerModalMsg)
+	if !ok {
+		t.Fatalf("Expected link picker for multiple matches, got %T", cmd())
+	}
+	if len(picker.Links) != 2 {
+		t.Errorf("Expected 2 links in picker, got %d", len(picker.Links))
+	}
+
+	// Without rules the key reports that nothing is configured
+	model = NewModel(createTestConfig())
+	model.updateTasks([]archon.Task{{ID: "t1", Title: "Fix auth-12", Status: "todo"}})
+	cmd, handled = model.handleOpenLinkKey("o")
+	if !handled || cmd == nil {
+		t.Fatal("Expected open link key to be handled without rules")
+	}
+	if _, ok := cmd().(messages.StatusFeedbackMsg); !ok {
+		t.Errorf("Expected StatusFeedbackMsg without rules, got %T", cmd())
+	}
+}
+
+// collectMsgs runs cmd and any batched commands, returning the produced messages
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMsgs(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+// sessionFeedback returns the status feedback produced by cmd, if any
+func sessionFeedback(cmd tea.Cmd) string {
+	for _, msg := range collectMsgs(cmd) {
+		if feedback, ok := msg.(messages.StatusFeedbackMsg); ok {
+			return feedback.Message
+		}
+	}
+	return ""
+}
+
+func TestSessionRestoreSkipsMissingEntities(t *testing.T) {
+	model := NewModel(createTestConfig())
+	gone := "deleted-project"
+	model.sessionStore = session.NewStore(filepath.Join(t.TempDir(), "session.json"))
+	model.pendingSession = &session.Snapshot{
+		SavedAt:        time.Now(),
+		ProjectID:      &gone,
+		SelectedTaskID: "deleted-task",
+		SearchQuery:    "login",
+		ActivePanel:    session.PanelRight,
+		Drafts: []session.Draft{
+			{TaskID: "t2", Status: "doing", Priority: 7, Feature: "auth"},
+			{TaskID: "deleted-task", Status: "done"},
+		},
+	}
+
+	// The prompt waits until both tasks and projects have loaded
+	_, cmd := model.handleProjectMessages(projects.ProjectsLoadedMsg{Projects: []archon.Project{{ID: "p1", Title: "Web"}}})
+	if cmd != nil {
+		t.Fatal("Expected no prompt before tasks load")
+	}
+	_, cmd = model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Fix login", Status: "todo"},
+		{ID: "t2", ProjectID: "p1", Title: "Write docs", Status: "todo"},
+	}})
+	if cmd == nil {
+		t.Fatal("Expected restore prompt after initial load")
+	}
+	if _, ok := cmd().(confirmation.ShowConfirmationModalMsg); !ok {
+		t.Fatalf("Expected confirmation prompt, got %T", cmd())
+	}
+
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	feedback := sessionFeedback(cmd)
+	for _, want := range []string{"Session restored", "project", "selected task", "unsaved edit"} {
+		if !strings.Contains(feedback, want) {
+			t.Errorf("Expected feedback to mention %q, got %q", want, feedback)
+		}
+	}
+
+	if model.programContext.SelectedProjectID != nil {
+		t.Errorf("Expected missing project to leave \"All Tasks\" selected, got %v", *model.programContext.SelectedProjectID)
+	}
+	if model.uiState.SearchQuery != "login" || model.uiState.TaskTotalMatches != 1 {
+		t.Errorf("Expected search to be restored with 1 match, got %q (%d)", model.uiState.SearchQuery, model.uiState.TaskTotalMatches)
+	}
+	if !model.IsRightPanelActive() {
+		t.Error("Expected details panel to be active again")
+	}
+
+	// Only the draft for a task that still exists is offered, and only once
+	if draft := model.takeSessionDraft("deleted-task"); draft != nil {
+		t.Errorf("Expected no draft for deleted task, got %+v", draft)
+	}
+	draft := model.takeSessionDraft("t2")
+	if draft == nil || draft.Status != "doing" || draft.Priority != 7 {
+		t.Fatalf("Expected draft for t2, got %+v", draft)
+	}
+	if model.takeSessionDraft("t2") != nil {
+		t.Error("Expected draft to be consumed")
+	}
+}
+
+func TestSessionRestoreSwitchesProject(t *testing.T) {
+	model := NewModel(createTestConfig())
+	projectID := "p2"
+	model.pendingSession = &session.Snapshot{ProjectID: &projectID, SelectedTaskID: "t3"}
+	model.updateProjects([]archon.Project{{ID: "p1", Title: "Web"}, {ID: "p2", Title: "API"}})
+	model.updateTasks([]archon.Task{{ID: "t1", ProjectID: "p1", Title: "Web task", Status: "todo"}})
+	model.sessionPromptOpen = true
+
+	// Selection is deferred until the restored project's tasks arrive
+	_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	if cmd == nil || model.restoringSession == nil {
+		t.Fatal("Expected tasks to be reloaded for the restored project")
+	}
+	if id := model.programContext.SelectedProjectID; id == nil || *id != "p2" {
+		t.Fatalf("Expected project p2 to be selected, got %v", id)
+	}
+
+	_, cmd = model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{
+		{ID: "t2", ProjectID: "p2", Title: "A", Status: "todo"},
+		{ID: "t3", ProjectID: "p2", Title: "B", Status: "todo"},
+	}})
+	if feedback := sessionFeedback(cmd); feedback != "Session restored" {
+		t.Errorf("Expected clean restore feedback, got %q", feedback)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t3" {
+		t.Errorf("Expected t3 to be selected, got %+v", selected)
+	}
+	if model.restoringSession != nil {
+		t.Error("Expected restore to be complete")
+	}
+}
+
+func TestSessionSaveAndDecline(t *testing.T) {
+	model := NewModel(createTestConfig())
+	store := session.NewStore(filepath.Join(t.TempDir(), "session.json"))
+	model.sessionStore = store
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "First", Status: "todo"},
+		{ID: "t2", Title: "Second", Status: "todo"},
+	})
+	_ = model.setSelectedTask(1)
+
+	// Only the latest debounced save writes a snapshot
+	model.withSessionSave(&model, nil)
+	model.withSessionSave(&model, nil)
+	if cmd := model.handleSessionSave(sessionSaveMsg{generation: 1}); cmd != nil {
+		t.Error("Expected stale save to be skipped")
+	}
+	collectMsgs(model.handleSessionSave(sessionSaveMsg{generation: 2}))
+
+	saved, err := store.Load(time.Hour, time.Now())
+	if err != nil || saved == nil {
+		t.Fatalf("Expected saved snapshot, got %v (err %v)", saved, err)
+	}
+	if saved.SelectedTaskID != "t2" {
+		t.Errorf("Expected selected task t2 in snapshot, got %q", saved.SelectedTaskID)
+	}
+
+	// Declining drops the snapshot without touching the current state
+	model.pendingSession = saved
+	model.sessionPromptOpen = true
+	_ = model.setSelectedTask(0)
+	_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: false})
+	if cmd != nil || model.pendingSession != nil {
+		t.Error("Expected declined restore to discard the snapshot")
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t1" {
+		t.Errorf("Expected selection to stay on t1, got %+v", selected)
+	}
+}
+
+func TestClockSkewWarningShownOnce(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.sessionStore, model.pendingSession = nil, nil
+	now := time.Now()
+	model.programContext.ClockSkew.Observe(now.Add(-40*time.Minute), now, now)
+
+	_, cmd := model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "First", Status: "todo"}}})
+	if feedback := sessionFeedback(cmd); !strings.Contains(feedback, "40m ahead of server") {
+		t.Errorf("Expected clock skew warning, got %q", feedback)
+	}
+	if got := model.programContext.Now(); now.Sub(got) < 39*time.Minute {
+		t.Errorf("Expected server-anchored time, got %v for local %v", got, now)
+	}
+
+	_, cmd = model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "First", Status: "todo"}}})
+	if feedback := sessionFeedback(cmd); feedback != "" {
+		t.Errorf("Expected warning only once, got %q", feedback)
+	}
+}
+
+func TestGoToParentAndFirstChild(t *testing.T) {
+	model := NewModel(createTestConfig())
+	parentID, childID := "parent", "child-b"
+	model.updateTasks([]archon.Task{
+		{ID: "parent", Title: "A parent", Status: "todo"},
+		{ID: "child-b", Title: "C child", Status: "todo", ParentTaskID: &parentID},
+		{ID: "child-a", Title: "B child", Status: "todo", ParentTaskID: &parentID},
+		{ID: "grandchild", Title: "D grandchild", Status: "done", ParentTaskID: &childID},
+	})
+	model.programContext.SetSortMode(sorting.SortAlphabetical)
+	model.refreshUIAfterFilterChange()
+
+	selectByID := func(id string) {
+		index, ok := model.sortedTaskIndex(id)
+		if !ok {
+			t.Fatalf("Task %s not visible", id)
+		}
+		_ = model.setSelectedTask(index)
+	}
+
+	// First child follows the sorted list order
+	selectByID("parent")
+	model.handleGoToFirstChildKey("]")
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "child-a" {
+		t.Errorf("Expected first child child-a, got %+v", selected)
+	}
+
+	model.handleGoToParentKey("[")
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "parent" {
+		t.Errorf("Expected parent, got %+v", selected)
+	}
+
+	cmd, _ := model.handleGoToParentKey("[")
+	if feedback := sessionFeedback(cmd); feedback != "Task has no parent" {
+		t.Errorf("Expected no-parent feedback, got %q", feedback)
+	}
+
+	// Children hidden by filters are reported rather than silently skipped
+	model.programContext.SetStatusFilter("done", false)
+	model.refreshUIAfterFilterChange()
+	selectByID("child-b")
+	cmd, _ = model.handleGoToFirstChildKey("]")
+	if feedback := sessionFeedback(cmd); feedback != "Child tasks are hidden by current filters" {
+		t.Errorf("Expected hidden-children feedback, got %q", feedback)
+	}
+}
+
+func TestShowAllToggleReturnsToProject(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.ShowAllBehavior = config.ShowAllToggle
+	model := NewModel(cfg)
+	projectID := "p1"
+	model.updateProjects([]archon.Project{{ID: projectID, Title: "Alpha"}})
+	model.setSelectedProject(&projectID)
+
+	model.handleShowAllTasksKey("a")
+	if model.programContext.SelectedProjectID != nil {
+		t.Fatal("Expected first press to show all tasks")
+	}
+
+	model.handleShowAllTasksKey("a")
+	if selected := model.programContext.SelectedProjectID; selected == nil || *selected != projectID {
+		t.Errorf("Expected second press to return to %s, got %v", projectID, selected)
+	}
+
+	// Reset behavior always lands on All Tasks
+	cfg.UI.Display.ShowAllBehavior = config.ShowAllReset
+	model.handleShowAllTasksKey("a")
+	model.handleShowAllTasksKey("a")
+	if model.programContext.SelectedProjectID != nil {
+		t.Error("Expected reset behavior to stay on all tasks")
+	}
+}
+
+func TestProjectModeCancelTarget(t *testing.T) {
+	cfg := createTestConfig()
+	home := "11111111-1111-1111-1111-111111111111"
+	cfg.UI.Display.DefaultProjectID = home
+	model := NewModel(cfg)
+	model.updateProjects([]archon.Project{{ID: home, Title: "Home"}, {ID: "p2", Title: "Other"}})
+
+	cancel := func(behavior string) *string {
+		cfg.UI.Display.ProjectModeCancel = behavior
+		other := "p2"
+		model.setSelectedProject(&other)
+		model.handleProjectModeMessages(projectmode.ProjectModeActivatedMsg{})
+		model.handleProjectModeMessages(projectmode.ProjectModeDeactivatedMsg{Canceled: true})
+		if model.uiState.IsProjectView() {
+			t.Errorf("%s: expected project mode left", behavior)
+		}
+		return model.programContext.SelectedProjectID
+	}
+
+	if selected := cancel(config.ProjectCancelPrevious); selected == nil || *selected != "p2" {
+		t.Errorf("Expected previous to keep p2, got %v", selected)
+	}
+	if selected := cancel(config.ProjectCancelAll); selected != nil {
+		t.Errorf("Expected all to show All Tasks, got %v", *selected)
+	}
+	if selected := cancel(config.ProjectCancelDefault); selected == nil || *selected != home {
+		t.Errorf("Expected default to return home, got %v", selected)
+	}
+
+	// A default project that no longer exists falls back to All Tasks
+	model.updateProjects([]archon.Project{{ID: "p2", Title: "Other"}})
+	if selected := cancel(config.ProjectCancelDefault); selected != nil {
+		t.Errorf("Expected All Tasks without the default project, got %v", *selected)
+	}
+}
+
+func TestShortIDDisplay(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Clipboard.ShortIDLength = 8
+	model := NewModel(cfg)
+	model.programContext.SetTasks([]archon.Task{
+		{ID: "550e8400-e29b-41d4-a716-446655440000", Title: "One"},
+		{ID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Title: "Two"},
+	})
+	ctx := model.programContext
+
+	if id := ctx.DisplayID("550e8400-e29b-41d4-a716-446655440000"); id != "550e8400-e29b-41d4-a716-446655440000" {
+		t.Errorf("Expected full IDs by default, got %q", id)
+	}
+
+	cfg.UI.Display.IDDisplay = config.IDDisplayShort
+	if id := ctx.DisplayID("550e8400-e29b-41d4-a716-446655440000"); id != "550e8400" {
+		t.Errorf("Expected the short ID, got %q", id)
+	}
+
+	// A task sharing the prefix lengthens it
+	ctx.SetTasks(append(ctx.Tasks, archon.Task{ID: "550e8400-f000-41d4-a716-446655440000", Title: "Three"}))
+	if id := ctx.DisplayID("550e8400-e29b-41d4-a716-446655440000"); id != "550e8400-e" {
+		t.Errorf("Expected a longer prefix after a collision, got %q", id)
+	}
+}
+
+func TestRecentSortMode(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.ArchonClient = &getTaskClient{}
+	model.updateTasks([]archon.Task{
+		{ID: "a", Title: "Alpha", Status: "todo", TaskOrder: 3},
+		{ID: "b", Title: "Beta", Status: "todo", TaskOrder: 2},
+		{ID: "c", Title: "Gamma", Status: "doing", TaskOrder: 1},
+		{ID: "d", Title: "Delta", Status: "done"},
+	})
+	order := func() string {
+		var ids []string
+		for _, task := range model.GetSortedTasks() {
+			ids = append(ids, task.ID)
+		}
+		return strings.Join(ids, "")
+	}
+
+	// Nothing touched yet: the status+priority order
+	model.programContext.SetSortMode(sorting.SortRecent)
+	if got := order(); got != "abcd" {
+		t.Fatalf("Expected the status+priority order, got %s", got)
+	}
+
+	// Touched tasks float up, most recent first, keeping the selection
+	model.findAndSelectTask("c")
+	model.handleReloadTaskKey(keys.KeyRCap)
+	model.findAndSelectTask("b")
+	model.handleComponentMessages(messages.YankIDMsg{})
+	if got := order(); got != "bcad" {
+		t.Errorf("Expected b then c first, got %s", got)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "b" {
+		t.Errorf("Expected the selection kept on b, got %+v", selected)
+	}
+
+	// Filters still apply, and other sort modes ignore interactions
+	model.programContext.SetStatusFilter("todo", false)
+	model.refreshUIAfterFilterChange()
+	if got := order(); got != "cd" {
+		t.Errorf("Expected the todo tasks filtered out, got %s", got)
+	}
+	model.programContext.SetStatusFilter("todo", true)
+	model.programContext.SetSortMode(sorting.SortPriorityOnly)
+	if got := order(); got != "abcd" {
+		t.Errorf("Expected priority order, got %s", got)
+	}
+}
+
+func TestFilteredTaskCount(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{
+		{ID: "a", Title: "Alpha", Status: "todo"},
+		{ID: "b", Title: "Beta", Status: "todo"},
+		{ID: "c", Title: "Gamma", Status: "doing"},
+	})
+	statusBar := model.components.Layout.StatusBar
+
+	// Unfiltered: the total alone
+	if view := statusBar.View(); !strings.Contains(view, "3 items") {
+		t.Errorf("Expected the total without a filter, got:\n%s", view)
+	}
+
+	model.programContext.SetStatusFilter("todo", false)
+	model.refreshUIAfterFilterChange()
+	if view := statusBar.View(); !strings.Contains(view, "1 of 3 tasks") {
+		t.Errorf("Expected the shown tasks first, got:\n%s", view)
+	}
+
+	cmd, _ := model.handleToggleCountKey(keys.KeyHash)
+	if feedback := sessionFeedback(cmd); feedback != "Counting all tasks first" {
+		t.Errorf("Expected toggle feedback, got %q", feedback)
+	}
+	if view := statusBar.View(); !strings.Contains(view, "3 tasks (1 shown)") {
+		t.Errorf("Expected the total first, got:\n%s", view)
+	}
+}
+
+func TestToggleShortcutHints(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.ShortcutHints = true
+	model := NewModel(cfg)
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{{ID: "a", Title: "Alpha", Status: "todo"}})
+	statusBar := model.components.Layout.StatusBar
+
+	if view := statusBar.View(); !strings.Contains(view, "?: help") {
+		t.Errorf("Expected shortcut hints by default, got:\n%s", view)
+	}
+
+	cmd, _ := model.handleToggleHintsKey(keys.KeyBang)
+	if feedback := sessionFeedback(cmd); feedback != "Shortcut hints hidden (! shows them again)" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if view := statusBar.View(); strings.Contains(view, "?: help") || !strings.Contains(view, "Sort: ") {
+		t.Errorf("Expected the counts without hints, got:\n%s", view)
+	}
+}
+
+func TestConnectionIndicatorOnlyWhenDisconnected(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.ConnectionIndicator = config.ConnectionIndicatorDisconnected
+	model := NewModel(cfg)
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{{ID: "a", Title: "Alpha", Status: "todo"}})
+	statusBar := model.components.Layout.StatusBar
+
+	model.programContext.Connected = true
+	if view := statusBar.View(); strings.Contains(view, "●") || !strings.Contains(view, "[Tasks] 1 items") {
+		t.Errorf("Expected no dot while connected, got:\n%s", view)
+	}
+	model.programContext.Connected = false
+	if view := statusBar.View(); !strings.Contains(view, "[Tasks] ○ 1 items") {
+		t.Errorf("Expected the disconnected dot, got:\n%s", view)
+	}
+}
+
+func TestFeatureModalScopedToProject(t *testing.T) {
+	model := NewModel(createTestConfig())
+	auth, billing := "auth", "billing"
+	model.updateProjects([]archon.Project{{ID: "p1", Title: "Backend"}, {ID: "p2", Title: "Web"}})
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Login", Status: "todo", Feature: &auth},
+		{ID: "t2", ProjectID: "p1", Title: "Logout", Status: "todo", Feature: &auth},
+		{ID: "t3", ProjectID: "p2", Title: "Invoice", Status: "todo", Feature: &billing},
+		{ID: "t4", ProjectID: "p2", Title: "Signup", Status: "todo", Feature: &auth},
+	})
+	project := "p1"
+	model.programContext.SelectedProjectID = &project
+
+	cmd, _ := model.handleFeatureSelectionKey(keys.KeyF)
+	showMsg, ok := cmd().(feature.ShowFeatureModalMsg)
+	if !ok {
+		t.Fatal("Expected f to open the feature modal")
+	}
+	if len(showMsg.AllFeatures) != 1 || showMsg.FeatureCounts["auth"] != 2 || showMsg.ScopeName != "Backend" {
+		t.Errorf("Expected the project's features and counts, got %+v", showMsg)
+	}
+	if len(showMsg.WideFeatures) != 2 || showMsg.WideFeatureCounts["auth"] != 3 || !showMsg.SelectedFeatures["billing"] {
+		t.Errorf("Expected every project's features to widen to, got %+v", showMsg)
+	}
+
+	// All Tasks has nothing wider
+	model.programContext.SelectedProjectID = nil
+	cmd, _ = model.handleFeatureSelectionKey(keys.KeyF)
+	if showMsg := cmd().(feature.ShowFeatureModalMsg); showMsg.WideFeatures != nil || len(showMsg.AllFeatures) != 2 {
+		t.Errorf("Expected no wider scope for All Tasks, got %+v", showMsg)
+	}
+}
+
+func TestProjectLabelsInAllTasks(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.ProjectLabels = true
+	model := NewModel(cfg)
+	model.setLoading(false)
+	model.updateProjects([]archon.Project{{ID: "p1", Title: "Backend"}, {ID: "p2", Title: "Web"}})
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Login", Status: "todo"},
+		{ID: "t2", ProjectID: "p2", Title: "Theme", Status: "todo"},
+	})
+	if view := model.View(); !strings.Contains(view, "Login (Backend)") || !strings.Contains(view, "Theme (Web)") {
+		t.Errorf("Expected project labels in All Tasks, got:\n%s", view)
+	}
+
+	cmd, _ := model.handleProjectLabelsKey(keys.KeyPCap)
+	if feedback := sessionFeedback(cmd); feedback != "Project labels hidden" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if view := model.View(); strings.Contains(view, "(Backend)") {
+		t.Errorf("Expected P to hide the labels, got:\n%s", view)
+	}
+
+	// A single project needs no labels
+	model.handleProjectLabelsKey(keys.KeyPCap)
+	project := "p1"
+	model.programContext.SelectedProjectID = &project
+	model.refreshUIAfterFilterChange()
+	if view := model.View(); strings.Contains(view, "(Backend)") || !strings.Contains(view, "Login") {
+		t.Errorf("Expected no labels within a project, got:\n%s", view)
+	}
+}
+
+func TestTagFilter(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Migrate schema", Status: "todo", Tags: []string{"db", "urgent"}},
+		{ID: "t2", Title: "Theme", Status: "todo", Tags: []string{"ui"}},
+		{ID: "t3", Title: "Untagged", Status: "todo"},
+	})
+	if view := model.View(); !strings.Contains(view, "[db] [urgent]") {
+		t.Errorf("Expected tag chips in the list, got:\n%s", view)
+	}
+
+	cmd, _ := model.handleTagSelectionKey(keys.KeyCtrlT)
+	showMsg, ok := cmd().(feature.ShowFeatureModalMsg)
+	if !ok || !showMsg.Tags || len(showMsg.AllFeatures) != 3 || !showMsg.SelectedFeatures["ui"] || showMsg.FeatureCounts["db"] != 1 {
+		t.Fatalf("Expected the tag modal with every tag selected, got %+v", showMsg)
+	}
+
+	// Untagged tasks stay visible, like tasks without a feature
+	_, cmd = model.handleModalActions(feature.TagSelectionAppliedMsg{SelectedTags: map[string]bool{"db": true, "ui": false}})
+	if feedback := sessionFeedback(cmd); feedback != "Showing tags: db" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	var shown []string
+	for _, task := range model.GetSortedTasks() {
+		shown = append(shown, task.ID)
+	}
+	if strings.Join(shown, ",") != "t1,t3" {
+		t.Errorf("Expected the db and the untagged task, got %v", shown)
+	}
+	if status := model.components.Layout.StatusBar.View(); !strings.Contains(status, "Tags: db") {
+		t.Errorf("Expected the tag filter in the status bar, got %q", status)
+	}
+
+	// Selecting every tag clears the filter
+	_, cmd = model.handleModalActions(feature.TagSelectionAppliedMsg{SelectedTags: map[string]bool{"db": true, "ui": true, "urgent": true}})
+	if model.programContext.TagFilters != nil || sessionFeedback(cmd) != "Showing all tags" {
+		t.Errorf("Expected the tag filter cleared, got %v", model.programContext.TagFilters)
+	}
+}
+
+func TestQuickFeatureFilterToggle(t *testing.T) {
+	model := NewModel(createTestConfig())
+	auth, billing := "auth", "billing"
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Login", Status: "todo", Feature: &auth},
+		{ID: "t2", Title: "Invoice", Status: "todo", Feature: &billing},
+		{ID: "t3", Title: "Logout", Status: "todo", Feature: &auth},
+	})
+	previous := map[string]bool{"auth": true, "billing": true}
+	model.programContext.FeatureFilters = previous
+	model.programContext.FeatureFilterActive = true
+	model.refreshUIAfterFilterChange()
+	model.findAndSelectTask("t3")
+
+	cmd, _ := model.handleQuickFeatureFilterKey("F")
+	if feedback := sessionFeedback(cmd); feedback != "Showing feature: auth" {
+		t.Errorf("Expected quick filter feedback, got %q", feedback)
+	}
+	if got := len(model.GetSortedTasks()); got != 2 {
+		t.Errorf("Expected 2 auth tasks, got %d", got)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t3" {
+		t.Errorf("Expected selection to stay on t3, got %+v", selected)
+	}
+
+	// Second press restores the earlier multi-feature filter
+	model.handleQuickFeatureFilterKey("F")
+	if got := len(model.GetSortedTasks()); got != 3 {
+		t.Errorf("Expected previous filter restored with 3 tasks, got %d", got)
+	}
+	if len(model.programContext.FeatureFilters) != 2 {
+		t.Errorf("Expected previous feature filter, got %v", model.programContext.FeatureFilters)
+	}
+}
+
+func TestTerminalTitleSync(t *testing.T) {
+	model := NewModel(createTestConfig())
+
+	// Disabled by default: no title commands
+	if _, cmd := model.withTerminalSync(&model, nil); cmd != nil {
+		t.Error("Expected no terminal commands without an attached terminal")
+	}
+
+	model.AttachTerminal(terminal.NewOutput(nil, terminal.Capabilities{}))
+	model.updateProjects([]archon.Project{{ID: "p1", Title: "ProjectX"}})
+	projectID := "p1"
+	model.setSelectedProject(&projectID)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Login", Status: "doing"},
+		{ID: "t2", ProjectID: "p1", Title: "Logout", Status: "todo"},
+	})
+
+	titleMsg := func(cmd tea.Cmd) tea.Msg {
+		for _, msg := range collectMsgs(cmd) {
+			if msg == tea.SetWindowTitle("lazyarchon — ProjectX · 1 doing")() {
+				return msg
+			}
+		}
+		return nil
+	}
+
+	_, cmd := model.withTerminalSync(&model, nil)
+	if titleMsg(cmd) == nil {
+		t.Errorf("Expected window title for ProjectX, got %v", collectMsgs(cmd))
+	}
+
+	// Unchanged title is not sent again
+	if _, cmd := model.withTerminalSync(&model, nil); cmd != nil {
+		t.Error("Expected no command when the title is unchanged")
+	}
+}
+
+func TestCreatedTodayToggle(t *testing.T) {
+	model := NewModel(createTestConfig())
+	now := time.Now()
+	model.updateTasks([]archon.Task{
+		{ID: "old", Title: "Old", Status: "todo", CreatedAt: archon.FlexibleTime{Time: now.Add(-48 * time.Hour)}},
+		{ID: "first", Title: "First", Status: "todo", CreatedAt: archon.FlexibleTime{Time: now.Add(-time.Millisecond)}},
+		{ID: "second", Title: "Second", Status: "doing", CreatedAt: archon.FlexibleTime{Time: now}},
+	})
+	model.programContext.SetSortMode(sorting.SortAlphabetical)
+
+	cmd, handled := model.handleCreatedTodayKey("T")
+	if !handled {
+		t.Fatal("Expected 'T' to be handled")
+	}
+	if feedback := sessionFeedback(cmd); feedback != "Showing 2 task(s) created today, newest first" {
+		t.Errorf("Expected created-today feedback, got %q", feedback)
+	}
+	sorted := model.GetSortedTasks()
+	if len(sorted) != 2 || sorted[0].ID != "second" || sorted[1].ID != "first" {
+		t.Errorf("Expected today's tasks newest first, got %+v", sorted)
+	}
+
+	// Second press restores the full list and the earlier sort mode
+	model.handleCreatedTodayKey("T")
+	if model.programContext.CreatedSince != nil || model.programContext.SortMode != sorting.SortAlphabetical {
+		t.Errorf("Expected filter cleared and alphabetical sort restored, got sort %d", model.programContext.SortMode)
+	}
+	if got := len(model.GetSortedTasks()); got != 3 {
+		t.Errorf("Expected all 3 tasks after toggling off, got %d", got)
+	}
+}
+
+func TestNumberKeyJump(t *testing.T) {
+	cfg := createTestConfig()
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "One", Status: "todo", TaskOrder: 30},
+		{ID: "t2", Title: "Two", Status: "todo", TaskOrder: 20},
+		{ID: "t3", Title: "Three", Status: "todo", TaskOrder: 10},
+	})
+
+	// Off by default: the key is left for other handlers
+	if _, handled := model.handleNumberKey("3"); handled {
+		t.Error("Expected number keys to be ignored unless enabled")
+	}
+
+	cfg.UI.Display.NumberKeyBehavior = config.NumberKeysJump
+	if _, handled := model.handleNumberKey("3"); !handled {
+		t.Fatal("Expected '3' to be handled when jump is enabled")
+	}
+	expected := model.GetSortedTasks()[2].ID
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != expected {
+		t.Errorf("Expected third visible task %s selected, got %+v", expected, selected)
+	}
+
+	cmd, _ := model.handleNumberKey("5")
+	if feedback := sessionFeedback(cmd); feedback != "Only 3 task(s) visible" {
+		t.Errorf("Expected out-of-range feedback, got %q", feedback)
+	}
+
+	// With bookmark, '1' jumps to the task bookmarked in slot 1
+	cfg.UI.Display.NumberKeyBehavior = config.NumberKeysBookmark
+	model.bookmarkStore = bookmarks.NewStore(filepath.Join(t.TempDir(), "bookmarks.json"))
+	model.bookmarks = bookmarks.Set{}
+	model.findAndSelectTask("t2")
+	model.handleKeyPress("m")
+	model.handleKeyPress("1")
+	model.findAndSelectTask("t3")
+	if _, handled := model.handleNumberKey("1"); !handled {
+		t.Fatal("Expected '1' to be handled when bookmark is enabled")
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t2" {
+		t.Errorf("Expected bookmarked task t2 selected, got %+v", selected)
+	}
+	cmd, _ = model.handleNumberKey("2")
+	if feedback := sessionFeedback(cmd); feedback != "No bookmark in '2" {
+		t.Errorf("Expected empty-slot feedback, got %q", feedback)
+	}
+}
+
+func TestAwayDigest(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Workflow.CurrentUser = "alice"
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Ship login", Status: "review", Assignee: "alice"},
+		{ID: "t2", Title: "Unrelated", Status: "todo", Assignee: "bob"},
+	})
+	store := away.NewStore(filepath.Join(t.TempDir(), "seen.json"))
+	model.awayStore = store
+	model.awayBaseline = &away.Baseline{
+		Version: away.SchemaVersion,
+		SeenAt:  time.Now().Add(-72 * time.Hour),
+		Tasks: map[string]away.TaskState{
+			"t1": {Status: "doing", Assignee: "alice"},
+			"t2": {Status: "todo", Assignee: "bob"},
+		},
+	}
+
+	var shown *digest.ShowDigestModalMsg
+	for _, msg := range collectMsgs(model.handleAwayBaseline()) {
+		if show, ok := msg.(digest.ShowDigestModalMsg); ok {
+			shown = &show
+		}
+	}
+	if shown == nil || len(shown.Digest.Items) != 1 || shown.Digest.Items[0].TaskID != "t1" {
+		t.Fatalf("Expected digest with t1 shown at startup, got %+v", shown)
+	}
+
+	// The baseline is saved on every load; the digest is only computed once
+	saved, err := store.Load()
+	if err != nil || saved == nil || saved.Tasks["t1"].Status != "review" {
+		t.Fatalf("Expected updated baseline saved, got %+v (err %v)", saved, err)
+	}
+	for _, msg := range collectMsgs(model.handleAwayBaseline()) {
+		if _, ok := msg.(digest.ShowDigestModalMsg); ok {
+			t.Error("Expected the digest to be shown only once per run")
+		}
+	}
+
+	model.jumpToDigestTask("t1")
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t1" {
+		t.Errorf("Expected t1 selected after jump, got %+v", selected)
+	}
+	if feedback := sessionFeedback(model.jumpToDigestTask("gone")); feedback != "Task is not loaded" {
+		t.Errorf("Expected not-loaded feedback, got %q", feedback)
+	}
+}
+
+func TestBookmarks(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "One", Status: "todo", TaskOrder: 20},
+		{ID: "t2", Title: "Two", Status: "doing", TaskOrder: 10},
+	})
+	store := bookmarks.NewStore(filepath.Join(t.TempDir(), "bookmarks.json"))
+	model.bookmarkStore, model.bookmarks = store, bookmarks.Set{}
+	model.setSelectedTask(1)
+	marked := model.GetSelectedTask().ID
+
+	// 'a' completes the sequence instead of showing all tasks
+	model.handleKeyPress("m")
+	collectMsgs(model.handleKeyPress("a"))
+	if model.bookmarks["a"].TaskID != marked {
+		t.Fatalf("Expected %s bookmarked in slot a, got %+v", marked, model.bookmarks)
+	}
+	if saved, err := store.Load(); err != nil || saved["a"].TaskID != marked {
+		t.Errorf("Expected bookmark saved, got %+v (err %v)", saved, err)
+	}
+
+	// Jumping clears a status filter hiding the bookmarked task
+	model.setSelectedTask(0)
+	hidden := model.GetSelectedTask().ID
+	if hidden == marked {
+		t.Fatal("Expected a different task selected before jumping")
+	}
+	model.programContext.SetStatusFilter(model.programContext.FindTask(marked).Status, false)
+	model.refreshUIAfterFilterChange()
+	model.handleKeyPress("'")
+	model.handleKeyPress("a")
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != marked {
+		t.Errorf("Expected bookmarked task %s selected, got %+v", marked, selected)
+	}
+	if model.programContext.StatusFilterActive {
+		t.Error("Expected the status filter hiding the bookmark to be cleared")
+	}
+
+	if feedback := sessionFeedback(model.jumpToBookmark("z")); feedback != "No bookmark in 'z" {
+		t.Errorf("Expected empty-slot feedback, got %q", feedback)
+	}
+}
+
+func TestJumpToClipboardTask(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.Projects = []archon.Project{{ID: "p1", Title: "Web"}, {ID: "p2", Title: "API"}}
+	model.updateTasks([]archon.Task{
+		{ID: "550e8400-e29b-41d4-a716-446655440000", ProjectID: "p1", Title: "Login", Status: "todo"},
+		{ID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", ProjectID: "p2", Title: "Rate limit", Status: "doing"},
+	})
+	client := &getTaskClient{task: archon.Task{ID: "fetched-1", ProjectID: "p1", Title: "Fetched", Status: "review"}}
+	model.programContext.ArchonClient = client
+	project := "p1"
+	model.setSelectedProject(&project)
+
+	clipboardText := ""
+	model.clipboard = clipboard.New(clipboard.Options{
+		Backend:     clipboard.BackendSystem,
+		WriteSystem: func(string) error { return nil },
+		ReadSystem:  func() (string, error) { return clipboardText, nil },
+	})
+	jump := func(text string) string {
+		t.Helper()
+		clipboardText = text
+		cmd, handled := model.handleNavigationKey(keys.KeyCtrlG)
+		if !handled {
+			t.Fatal("Expected ctrl+g to be handled")
+		}
+		var feedback string
+		for _, msg := range collectMsgs(cmd) {
+			for _, msg := range collectMsgs(model.handleClipboardRead(msg.(clipboardReadMsg))) {
+				switch msg := msg.(type) {
+				case tasks.TaskReloadedMsg:
+					_, cmd := model.Update(msg)
+					feedback = sessionFeedback(cmd)
+				case messages.StatusFeedbackMsg:
+					feedback = msg.Message
+				}
+			}
+		}
+		return feedback
+	}
+
+	// A task URL from another project switches to that project
+	if feedback := jump("http://localhost:3737/projects/p2/tasks/7c9e6679-7425-40de-944b-e07fc1f90ae7\n"); feedback != "Jumped to Rate limit" {
+		t.Errorf("Expected the jump feedback, got %q", feedback)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.Title != "Rate limit" {
+		t.Errorf("Expected Rate limit selected, got %+v", selected)
+	}
+	if id := model.programContext.SelectedProjectID; id == nil || *id != "p2" {
+		t.Errorf("Expected project p2 selected, got %v", id)
+	}
+
+	// A commit reference resolves its short ID against the loaded tasks
+	jump("[550e8400] Login")
+	if selected := model.GetSelectedTask(); selected == nil || selected.Title != "Login" {
+		t.Errorf("Expected Login selected from its short ID, got %+v", selected)
+	}
+
+	// An ID that is not loaded is fetched and merged
+	if feedback := jump("fetched-1"); feedback != "Jumped to Fetched" || len(client.gets) != 1 {
+		t.Errorf("Expected the task fetched and selected, got %q after gets %v", feedback, client.gets)
+	}
+
+	if feedback := jump("see the notes above"); feedback != "No task ID or task URL on the clipboard" {
+		t.Errorf("Expected the parse failure explained, got %q", feedback)
+	}
+
+	// A stale ID is reported as missing by a real server
+	server := archon.NewMockServer()
+	defer server.Close()
+	model.programContext.ArchonClient = archon.NewClient(server.URL, "test-key")
+	if feedback := jump("deleted-7"); feedback != "No task with ID deleted-7" {
+		t.Errorf("Expected the missing task reported, got %q", feedback)
+	}
+}
+
+func TestTaskRefFromText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"  550e8400-e29b-41d4-a716-446655440000\n", "550e8400-e29b-41d4-a716-446655440000"},
+		{"https://archon.example.com/projects/p1/tasks/t-42?tab=details", "t-42"},
+		{"https://archon.example.com/board?task=t-42", "t-42"},
+		{"https://archon.example.com/", ""},
+		{"[550e8400] Fix the login redirect", "550e8400"},
+		{"Blocked by 7c9e6679-7425-40de-944b-e07fc1f90ae7, see chat", "7c9e6679-7425-40de-944b-e07fc1f90ae7"},
+		{"../../api/projects", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := taskRefFromText(tt.text); got != tt.want {
+			t.Errorf("taskRefFromText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestConfirmDone(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Workflow.ConfirmDone = true
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Ship login", Status: "doing"},
+	})
+
+	// Moving to review proceeds without a prompt
+	if _, cmd := model.handleModalActions(status.StatusSelectedMsg{TaskID: "t1", Status: "review"}); cmd == nil || model.pendingDoneUpdate != nil {
+		t.Fatal("Expected the review transition to be sent without confirmation")
+	}
+
+	_, cmd := model.handleModalActions(status.StatusSelectedMsg{TaskID: "t1", Status: "done"})
+	shown := false
+	for _, msg := range collectMsgs(cmd) {
+		if show, ok := msg.(confirmation.ShowConfirmationModalMsg); ok && strings.Contains(show.Message, "Ship login") {
+			shown = true
+		}
+	}
+	if !shown || model.pendingDoneUpdate == nil {
+		t.Fatal("Expected a confirmation prompt before moving to done")
+	}
+
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: false})
+	if feedback := sessionFeedback(cmd); feedback != "Status change canceled" {
+		t.Errorf("Expected canceled feedback, got %q", feedback)
+	}
+	if model.pendingDoneUpdate != nil {
+		t.Error("Expected the pending update to be cleared")
+	}
+}
+
+func TestCopyToClipboard(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Clipboard.ConfirmAbove = 10
+	cfg.UI.Clipboard.SaveDir = t.TempDir()
+	model := NewModel(cfg)
+	var copied []string
+	model.clipboard = clipboard.New(clipboard.Options{
+		Backend:      clipboard.BackendSystem,
+		ConfirmAbove: cfg.GetClipboardConfirmAbove(),
+		SaveDir:      cfg.GetClipboardSaveDir(),
+		WriteSystem: func(text string) error {
+			copied = append(copied, text)
+			return nil
+		},
+	})
+
+	// Small payloads are copied straight away
+	for _, msg := range collectMsgs(model.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: "t1", What: "task ID"})) {
+		if feedback := sessionFeedback(model.handleClipboardCopied(msg.(clipboardCopiedMsg))); feedback != "Copied task ID: t1" {
+			t.Errorf("Unexpected feedback %q", feedback)
+		}
+	}
+	if len(copied) != 1 || copied[0] != "t1" {
+		t.Fatalf("Expected t1 copied, got %v", copied)
+	}
+
+	// Large payloads ask first and can be saved to a file instead
+	large := strings.Repeat("x", 11)
+	cmd := model.handleCopyToClipboard(messages.CopyToClipboardMsg{Text: large, What: "task description"})
+	show, ok := cmd().(confirmation.ShowConfirmationModalMsg)
+	if !ok || show.AltKey != "s" || !strings.Contains(show.Message, "Copy 11B task description") {
+		t.Fatalf("Expected a size prompt with a save option, got %+v", show)
+	}
+	if len(copied) != 1 {
+		t.Fatal("Expected nothing copied before confirmation")
+	}
+
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Alternate: true})
+	feedback := sessionFeedback(cmd)
+	path, found := strings.CutPrefix(feedback, "Saved task description to ")
+	if !found {
+		t.Fatalf("Expected saved feedback, got %q", feedback)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != large {
+		t.Errorf("Expected saved payload at %s, got %q (err %v)", path, data, err)
+	}
+	if model.pendingCopy != nil || len(copied) != 1 {
+		t.Error("Expected the prompt resolved without copying")
+	}
+
+	// Without a usable system clipboard the prompt says why and shows the text
+	unavailable := fmt.Errorf("%w: no X11 or Wayland display", clipboard.ErrUnavailable)
+	cmd = model.handleClipboardCopied(clipboardCopiedMsg{request: messages.CopyToClipboardMsg{Text: "t1", What: "task ID"}, err: unavailable})
+	show, ok = cmd().(confirmation.ShowConfirmationModalMsg)
+	if !ok || !strings.Contains(show.Message, "Cannot copy task ID: system clipboard unavailable: no X11 or Wayland display") ||
+		len(show.Details) == 0 || show.Details[0] != "t1" {
+		t.Fatalf("Expected the reason and the text, got %+v", show)
+	}
+	if !show.Destructive {
+		t.Error("Expected the save prompt kept open by the destructive timeout")
+	}
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	if feedback := sessionFeedback(cmd); !strings.HasPrefix(feedback, "Saved task ID to ") {
+		t.Errorf("Expected the text saved to a file, got %q", feedback)
+	}
+}
+
+func TestCopyListCommand(t *testing.T) {
+	model := NewModel(createTestConfig())
+	var copied []string
+	model.clipboard = clipboard.New(clipboard.Options{
+		Backend: clipboard.BackendSystem,
+		WriteSystem: func(text string) error {
+			copied = append(copied, text)
+			return nil
+		},
+	})
+	project := "p1"
+	ctx := model.programContext
+	ctx.SelectedProjectID = &project
+	ctx.StatusFilters, ctx.StatusFilterActive = map[string]bool{"doing": true}, true
+	ctx.SortMode = sorting.SortPriorityOnly
+
+	copyCommand := func() string {
+		t.Helper()
+		cmd, handled := model.handleCopyCommandKey(keys.KeyCCap)
+		if !handled {
+			t.Fatal("Expected C to be handled")
+		}
+		for _, msg := range collectMsgs(cmd) {
+			model.handleClipboardCopied(msg.(clipboardCopiedMsg))
+		}
+		return copied[len(copied)-1]
+	}
+	if got := copyCommand(); got != "lazyarchon list --project p1 --status doing --sort priority" {
+		t.Errorf("Unexpected command %q", got)
+	}
+
+	// Filters without a flag are named rather than dropped
+	ctx.TagFilters = map[string]bool{"backend": true}
+	if got := copyCommand(); !strings.HasSuffix(got, "--sort priority  # not expressible: tag filter") {
+		t.Errorf("Expected the tag filter noted, got %q", got)
+	}
+}
+
+// listTasksClient serves a fixed task list; other client methods are not used
+type listTasksClient struct {
+	interfaces.ArchonClient
+	tasks []archon.Task
+	err   error
+}
+
+func (c *listTasksClient) ListTasks(*string, *string, bool) (*archon.TasksResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &archon.TasksResponse{Tasks: c.tasks}, nil
+}
+
+func TestScheduledExportRun(t *testing.T) {
+	dir := t.TempDir()
+	cfg := createTestConfig()
+	cfg.Exports.Scheduled = []config.ScheduledExportConfig{{
+		Name:     "Standup",
+		Schedule: "weekdays@09:00",
+		Filter:   config.ExportFilterConfig{Statuses: []string{"doing"}},
+		Output:   filepath.Join(dir, "standup-{{.Date}}.md"),
+	}}
+	model := NewModel(cfg)
+	if model.exportScheduler == nil {
+		t.Fatal("Expected a scheduler for the configured export")
+	}
+	model.exportStore = scheduled.NewStore(filepath.Join(dir, "exports.json"))
+
+	client := &listTasksClient{tasks: []archon.Task{
+		{ID: "t1", Title: "Ship login", Status: "doing"},
+		{ID: "t2", Title: "Plan sprint", Status: "todo"},
+	}}
+	model.programContext.ArchonClient = client
+
+	job := model.exportScheduler.Jobs()[0]
+	cmd := model.runScheduledExport(job)
+	if len(model.programContext.BackgroundTasks) != 1 || model.programContext.BackgroundTasks[0].State != context.TaskStart {
+		t.Fatalf("Expected a started background task, got %+v", model.programContext.BackgroundTasks)
+	}
+
+	done, ok := cmd().(scheduledExportDoneMsg)
+	if !ok || done.err != nil {
+		t.Fatalf("Expected a successful run, got %+v", done)
+	}
+	feedback := sessionFeedback(model.handleScheduledExportDone(done))
+	if !strings.HasPrefix(feedback, "Exported Standup to ") {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+
+	run := model.programContext.BackgroundTasks[0]
+	if len(model.programContext.BackgroundTasks) != 1 || run.State != context.TaskFinished || run.FinishedTime == nil {
+		t.Errorf("Expected the run recorded as finished, got %+v", model.programContext.BackgroundTasks)
+	}
+	data, err := os.ReadFile(done.path)
+	if err != nil || !strings.Contains(string(data), "Ship login") || strings.Contains(string(data), "Plan sprint") {
+		t.Errorf("Expected only doing tasks exported, got %q (err %v)", data, err)
+	}
+	if _, ok := model.exportLastRuns["Standup"]; !ok {
+		t.Error("Expected the completed run to be remembered")
+	}
+
+	// A failed fetch is reported and recorded as an error
+	client.err = fmt.Errorf("server down")
+	done = model.runScheduledExport(job)().(scheduledExportDoneMsg)
+	if feedback := sessionFeedback(model.handleScheduledExportDone(done)); !strings.Contains(feedback, "server down") {
+		t.Errorf("Expected failure feedback, got %q", feedback)
+	}
+	if last := model.programContext.BackgroundTasks[len(model.programContext.BackgroundTasks)-1]; last.State != context.TaskError {
+		t.Errorf("Expected the failed run recorded as an error, got %+v", last)
+	}
+}
+
+func TestHostileTaskPayloads(t *testing.T) {
+	hostile := []string{
+		"\x1b[31mred\x1b[0m\x1b]0;pwned\x07",
+		"line\r\nbreaks\rand\nmore",
+		"nul\x00 del\x7f bidi \u202eevil\u202c",
+		"broken \xff\xfe utf8",
+		"emoji 👨‍👩‍👧 wide 漢字",
+		strings.Repeat("long title ", 500),
+	}
+	var tasks []archon.Task
+	for i, text := range hostile {
+		feature := text
+		tasks = append(tasks, archon.Task{
+			ID:          fmt.Sprintf("t%d", i),
+			Title:       text,
+			Description: strings.Repeat(text+"\n", 200000/len(text)+1), // ~200KB
+			Status:      "todo",
+			Assignee:    text,
+			Feature:     &feature,
+		})
+	}
+
+	cfg := createTestConfig()
+	cfg.UI.TextLimits.MaxDescriptionLength = 4096
+	model := NewModel(cfg)
+	model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	start := time.Now()
+	for i := range tasks {
+		// Each task alone so it is selected and rendered in the details panel too
+		model.updateTasks([]archon.Task{tasks[i]})
+		task := model.programContext.Tasks[0]
+		if len(task.Description) > 4096+64 || task.Raw == nil {
+			t.Errorf("task %d: expected a capped description with the raw payload kept, got %d bytes", i, len(task.Description))
+		}
+		if view := model.View(); strings.ContainsAny(view, "\x00\x07\u202e") {
+			t.Errorf("task %d: control characters reached the view", i)
+		}
+	}
+	model.updateTasks(tasks)
+	model.uiState.SearchQuery = "e"
+	_ = model.View()
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Rendering hostile payloads took %v", elapsed)
+	}
+}
+
+func TestToggleDetailsPanel(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.handleWindowResize(tea.WindowSizeMsg{Width: 80, Height: 12})
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{{ID: "a", Title: "Alpha", Status: "todo"}})
+	collectMsgs(model.setActiveView(RightPanel))
+
+	cmd, _ := model.handleToggleDetailsKey(keys.KeyZ)
+	collectMsgs(cmd)
+	if !model.uiState.IsLeftPanelActive() {
+		t.Error("Expected the focus moved to the task list")
+	}
+	if view := model.View(); strings.Contains(view, "Task Details") || !strings.Contains(view, "│Tasks:"+strings.Repeat(" ", 72)+"│") {
+		t.Errorf("Expected the task list across the whole width, got:\n%s", view)
+	}
+	if saved, err := model.stateStore.Load(); err != nil || !saved.DetailsHidden {
+		t.Fatalf("Expected the hidden details saved, got %+v (err %v)", saved, err)
+	}
+
+	// The next session starts without details; focusing them brings them back
+	model = NewModel(createTestConfig())
+	model.handleWindowResize(tea.WindowSizeMsg{Width: 80, Height: 12})
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{{ID: "a", Title: "Alpha", Status: "todo"}})
+	if view := model.View(); strings.Contains(view, "Task Details") {
+		t.Errorf("Expected the details hidden at startup, got:\n%s", view)
+	}
+	collectMsgs(model.setActiveView(RightPanel))
+	if view := model.View(); !strings.Contains(view, "Task Details") || model.uiState.HideDetails {
+		t.Errorf("Expected l to show the details again, got:\n%s", view)
+	}
+	if saved, _ := model.stateStore.Load(); saved.DetailsHidden {
+		t.Error("Expected the shown details saved")
+	}
+}
+
+func TestStickyFeatureFilter(t *testing.T) {
+	auth, ui := "auth", "ui"
+	loaded := []archon.Task{
+		{ID: "t1", Title: "Login", Status: "todo", Feature: &auth},
+		{ID: "t2", Title: "Theme", Status: "todo", Feature: &ui},
+	}
+
+	// Applying a selection in the feature modal saves it to the state file
+	model := NewModel(createTestConfig())
+	model.updateTasks(loaded)
+	_, cmd := model.handleModalActions(feature.FeatureSelectionAppliedMsg{SelectedFeatures: map[string]bool{"auth": true, "gone": true}})
+	collectMsgs(cmd)
+	if saved, err := model.stateStore.Load(); err != nil || !slices.Equal(saved.FeatureFilter, []string{"auth", "gone"}) {
+		t.Fatalf("Expected the selection saved, got %+v (err %v)", saved, err)
+	}
+
+	// A new session starts with it, and prunes features no task uses
+	model = NewModel(createTestConfig())
+	if !model.programContext.FeatureFilterActive || !model.programContext.FeatureFilters["gone"] {
+		t.Fatalf("Expected the saved filter restored at startup, got %v", model.programContext.FeatureFilters)
+	}
+	_, cmd = model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: loaded})
+	if feedback := sessionFeedback(cmd); !strings.Contains(feedback, "removed gone") {
+		t.Errorf("Expected pruning feedback, got %q", feedback)
+	}
+	if filters := model.programContext.FeatureFilters; len(filters) != 1 || !filters["auth"] {
+		t.Errorf("Expected only auth left in the filter, got %v", filters)
+	}
+	if sorted := model.GetSortedTasks(); len(sorted) != 1 || sorted[0].ID != "t1" {
+		t.Errorf("Expected only the auth task visible, got %+v", sorted)
+	}
+	if saved, _ := model.stateStore.Load(); !slices.Equal(saved.FeatureFilter, []string{"auth"}) {
+		t.Errorf("Expected the pruned filter saved, got %+v", saved)
+	}
+
+	// When every selected feature disappeared the filter is cleared rather than hiding everything
+	_, cmd = model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: loaded[1:]})
+	if feedback := sessionFeedback(cmd); !strings.Contains(feedback, "cleared") {
+		t.Errorf("Expected cleared feedback, got %q", feedback)
+	}
+	if model.programContext.FeatureFilterActive || len(model.GetSortedTasks()) != 1 {
+		t.Errorf("Expected no feature filter after pruning everything, got %v", model.programContext.FeatureFilters)
+	}
+}
+
+func TestUnblockReport(t *testing.T) {
+	auth, ui := "auth", "ui"
+	epic, story := "epic", "story"
+	model := NewModel(createTestConfig())
+	model.updateTasks([]archon.Task{
+		{ID: "epic", Title: "Auth epic", Status: "todo", Feature: &auth},
+		{ID: "story", Title: "Login form", Status: "todo", Feature: &auth, ParentTaskID: &epic},
+		{ID: "leaf", Title: "Write migration", Status: "doing", Feature: &auth, ParentTaskID: &story},
+		{ID: "theme", Title: "Dark theme", Status: "todo", Feature: &ui, ParentTaskID: &epic},
+	})
+
+	show := func() *unblock.ShowUnblockModalMsg {
+		cmd, handled := model.handleUnblockReportKey("U")
+		if !handled {
+			t.Fatal("Expected U to be handled in task view")
+		}
+		for _, msg := range collectMsgs(cmd) {
+			if shown, ok := msg.(unblock.ShowUnblockModalMsg); ok {
+				return &shown
+			}
+		}
+		return nil
+	}
+
+	shown := show()
+	if shown == nil || len(shown.Report.Entries) != 3 || shown.Report.Entries[0].TaskID != "leaf" {
+		t.Fatalf("Expected leaf ranked first of 3 blockers, got %+v", shown)
+	}
+	if !strings.Contains(shown.Export, "## Write migration (doing): unblocks 2") {
+		t.Errorf("Expected markdown export of the report, got:\n%s", shown.Export)
+	}
+
+	// Filtered-out tasks neither block nor count as blocked
+	model.programContext.FeatureFilters = map[string]bool{"auth": true}
+	model.programContext.FeatureFilterActive = true
+	model.refreshUIAfterFilterChange()
+	if shown := show(); shown == nil || len(shown.Report.Entries) != 2 {
+		t.Errorf("Expected the ui task excluded by the feature filter, got %+v", shown)
+	}
+
+	_, _ = model.handleModalActions(unblock.UnblockTaskChosenMsg{TaskID: "leaf"})
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "leaf" {
+		t.Errorf("Expected leaf selected after choosing it, got %+v", selected)
+	}
+}
+
+func TestDescriptionDiff(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.DescriptionSnapshots = 10
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{{ID: "t1", Title: "Auth page", Status: "todo", Description: "Ship the login page"}})
+	snapshots := model.programContext.DescriptionSnapshots
+
+	// Viewing the task captures its description
+	if snapshot, ok := snapshots.Get("t1"); !ok || snapshot.Text != "Ship the login page" {
+		t.Fatalf("Expected the viewed description captured, got %+v", snapshot)
+	}
+	if feedback := sessionFeedback(firstCmd(model.handleDescriptionDiffKey("D"))); !strings.Contains(feedback, "unchanged") {
+		t.Errorf("Expected unchanged feedback, got %q", feedback)
+	}
+
+	// An edit from elsewhere shows up as a change against the snapshot
+	model.updateTasks([]archon.Task{{ID: "t1", Title: "Auth page", Status: "todo", Description: "Ship the signup page"}})
+	if view := model.View(); !strings.Contains(view, "changed since you viewed it") {
+		t.Errorf("Expected the details panel to flag the change, got:\n%s", view)
+	}
+	cmd, handled := model.handleDescriptionDiffKey("D")
+	var show *descdiff.ShowDescDiffModalMsg
+	for _, msg := range collectMsgs(cmd) {
+		if msg, ok := msg.(descdiff.ShowDescDiffModalMsg); ok {
+			show = &msg
+		}
+	}
+	if !handled || show == nil || show.Old != "Ship the login page" || show.New != "Ship the signup page" {
+		t.Fatalf("Expected the diff between both versions, got %+v", show)
+	}
+
+	// Acknowledging replaces the snapshot and clears the hint
+	_, _ = model.handleModalActions(descdiff.DescriptionAcknowledgedMsg{TaskID: "t1", Description: show.Current})
+	if snapshots.Changed("t1", "Ship the signup page") {
+		t.Error("Expected the acknowledged description to be the new snapshot")
+	}
+	if view := model.View(); strings.Contains(view, "changed since you viewed it") {
+		t.Error("Expected the hint gone after acknowledging")
+	}
+}
+
+// firstCmd drops the handled flag of a key handler
+func firstCmd(cmd tea.Cmd, _ bool) tea.Cmd {
+	return cmd
+}
+
+func TestManualDetailsPanel(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.DetailsPanel = config.DetailsManual
+	cfg.UI.Display.DescriptionSnapshots = 10
+	model := NewModel(cfg)
+	model.updateTasks([]archon.Task{
+		{ID: "a", Title: "Alpha task", Status: "todo", TaskOrder: 2, Description: "first"},
+		{ID: "b", Title: "Beta task", Status: "todo", TaskOrder: 1, Description: "second"},
+	})
+	details := func() string { return model.components.Layout.MainContent.View() }
+
+	if view := details(); !strings.Contains(view, "Press Enter or l to show details") || strings.Contains(view, "first") {
+		t.Fatalf("Expected a placeholder before opening, got:\n%s", view)
+	}
+	if model.programContext.DescriptionSnapshots.Len() != 0 {
+		t.Error("Expected an unopened task not to count as viewed")
+	}
+
+	// Enter opens the selected task
+	cmd, handled := model.handleConfirmKey(keys.KeyEnter)
+	deliver(&model, cmd, func(tea.Msg) bool { return true })
+	if view := details(); !handled || !strings.Contains(view, "first") {
+		t.Fatalf("Expected Enter to show the details, got:\n%s", view)
+	}
+
+	// Moving on blanks the panel again, focusing it opens the new task
+	cmd, _ = model.handleDownNavigationKey(keys.KeyJ)
+	deliver(&model, cmd, func(tea.Msg) bool { return true })
+	if view := details(); !strings.Contains(view, "Press Enter or l") {
+		t.Errorf("Expected the placeholder after moving, got:\n%s", view)
+	}
+	cmd, _ = model.handleRightNavigationKey(keys.KeyL)
+	deliver(&model, cmd, func(tea.Msg) bool { return true })
+	if view := details(); !strings.Contains(view, "second") {
+		t.Errorf("Expected l to show the details, got:\n%s", view)
+	}
+
+	// The default follows the selection without opening
+	model = NewModel(createTestConfig())
+	model.updateTasks([]archon.Task{{ID: "a", Title: "Alpha task", Status: "todo", Description: "first"}})
+	if view := details(); !strings.Contains(view, "first") {
+		t.Errorf("Expected details without opening in auto mode, got:\n%s", view)
+	}
+	if _, handled := model.handleConfirmKey(keys.KeyEnter); handled {
+		t.Error("Expected Enter to stay unhandled in auto mode")
+	}
+}
+
+func TestOperationFeedbackRollsUp(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.setLoading(false)
+	statusBar := model.components.Layout.StatusBar
+	feedback := func(msg messages.StatusFeedbackMsg) {
+		model.Update(msg)
+	}
+
+	model.Update(messages.OperationStartedMsg{ID: "bulk", Label: "Updating tasks", Total: 3})
+	feedback(messages.StatusFeedbackMsg{Message: "Write docs", Operation: "bulk"})
+	if view := statusBar.View(); !strings.Contains(view, "Updating tasks [███░░░░░░░] 33% (1/3)") {
+		t.Errorf("Expected the rolling summary instead of per-task feedback, got %q", view)
+	}
+
+	// Untagged feedback interleaved with the operation still shows on its own
+	feedback(messages.StatusFeedbackMsg{Message: "Copied task ID"})
+	feedback(messages.StatusFeedbackMsg{Message: "Fix login", Operation: "bulk", Err: errors.New("forbidden")})
+	if view := statusBar.View(); !strings.Contains(view, "Copied task ID") {
+		t.Errorf("Expected untagged feedback to show, got %q", view)
+	}
+	if entry := model.programContext.FindBackgroundTask("operation:bulk"); entry == nil || entry.State != context.TaskStart {
+		t.Fatalf("Expected a running history entry, got %+v", entry)
+	}
+
+	feedback(messages.StatusFeedbackMsg{Message: "Ship it", Operation: "bulk", Err: errors.New("timeout")})
+	if view := statusBar.View(); !strings.Contains(view, "Updating tasks: 1 of 3 done, 2 failed") {
+		t.Errorf("Expected the final summary, got %q", view)
+	}
+	entry := model.programContext.FindBackgroundTask("operation:bulk")
+	if entry == nil || entry.State != context.TaskError || entry.FinishedText != "Updating tasks: 1 of 3 done, 2 failed" {
+		t.Fatalf("Expected one failed history entry with the summary, got %+v", entry)
+	}
+	if entry.Error.Error() != "Fix login: forbidden\nShip it: timeout" {
+		t.Errorf("Expected the failures listed, got %q", entry.Error)
+	}
+	if len(model.programContext.BackgroundTasks) != 1 {
+		t.Errorf("Expected one history entry for the operation, got %d", len(model.programContext.BackgroundTasks))
+	}
+
+	// Feedback for an operation that is no longer running shows on its own
+	feedback(messages.StatusFeedbackMsg{Message: "Late task", Operation: "bulk", Err: errors.New("gone")})
+	if view := statusBar.View(); !strings.Contains(view, "Late task: gone") {
+		t.Errorf("Expected late feedback to show on its own, got %q", view)
+	}
+}
+
+// getTaskClient serves one task and counts list requests
+type getTaskClient struct {
+	interfaces.ArchonClient
+	task  archon.Task
+	gets  []string
+	lists int
+}
+
+func (c *getTaskClient) GetTask(taskID string) (*archon.TaskResponse, error) {
+	c.gets = append(c.gets, taskID)
+	return &archon.TaskResponse{Task: c.task}, nil
+}
+
+func (c *getTaskClient) ListTasks(*string, *string, bool) (*archon.TasksResponse, error) {
+	c.lists++
+	return &archon.TasksResponse{}, nil
+}
+
+// pagedClient serves its tasks a page at a time, recording each request
+type pagedClient struct {
+	interfaces.ArchonClient
+	tasks    []archon.Task
+	requests []archon.ListTasksOptions
+}
+
+func (c *pagedClient) ListTasksPaged(opts archon.ListTasksOptions) (*archon.TasksResponse, error) {
+	c.requests = append(c.requests, opts)
+	end := min(len(c.tasks), opts.Offset+opts.Limit)
+	return &archon.TasksResponse{
+		Tasks:   c.tasks[opts.Offset:end],
+		Total:   len(c.tasks),
+		Offset:  opts.Offset,
+		HasMore: end < len(c.tasks),
+	}, nil
+}
+
+func TestTaskPaging(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Server.PageSize = 2
+	model := NewModel(cfg)
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	client := &pagedClient{}
+	for i := 1; i <= 5; i++ {
+		client.tasks = append(client.tasks, archon.Task{ID: fmt.Sprintf("t%d", i), Title: fmt.Sprintf("Task %d", i), Status: "todo", TaskOrder: 10 - i})
+	}
+	model.programContext.ArchonClient = client
+
+	// The first reload fetches a single page
+	model.Update(model.reloadCmd(true, 0)())
+	if len(model.programContext.Tasks) != 2 || !model.programContext.TaskPages.HasMore {
+		t.Fatalf("Expected the first page with more to come, got %d tasks and %+v", len(model.programContext.Tasks), model.programContext.TaskPages)
+	}
+
+	// Selecting near the end of the loaded tasks asks for the next page
+	var loadMore bool
+	for _, msg := range collectMsgs(model.setSelectedTask(1)) {
+		if _, ok := msg.(tasklist.TaskListLoadMoreMsg); ok {
+			loadMore = true
+		}
+	}
+	if !loadMore {
+		t.Fatal("Expected the task list to ask for more tasks")
+	}
+	_, cmd := model.Update(tasklist.TaskListLoadMoreMsg{})
+	if again := model.loadNextTaskPage(); again != nil {
+		t.Error("Expected no second request while a page is loading")
+	}
+	model.Update(cmd())
+	if len(model.programContext.Tasks) != 4 || model.programContext.TaskPages.NextOffset != 4 {
+		t.Fatalf("Expected the second page merged, got %d tasks and %+v", len(model.programContext.Tasks), model.programContext.TaskPages)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t2" {
+		t.Errorf("Expected the selection kept on t2, got %+v", selected)
+	}
+
+	// Positions count against the server's total, not the loaded tasks
+	if view := model.View(); !strings.Contains(view, "of 5 tasks") || !strings.Contains(view, "Task 2 of 5 selected") {
+		t.Errorf("Expected positions out of the server total, got:\n%s", view)
+	}
+
+	// A reload fetches every page loaded so far in one request
+	model.reloadCmd(true, 0)()
+	if last := client.requests[len(client.requests)-1]; last.Offset != 0 || last.Limit != 4 {
+		t.Errorf("Expected the reload to fetch the 4 loaded tasks, got %+v", last)
+	}
+}
+
+func TestLoadAllPages(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Server.PageSize = 2
+	cfg.Server.LoadAllPages = true
+	model := NewModel(cfg)
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	client := &pagedClient{}
+	for i := 1; i <= 5; i++ {
+		client.tasks = append(client.tasks, archon.Task{ID: fmt.Sprintf("t%d", i), Title: fmt.Sprintf("Task %d", i), Status: "todo", TaskOrder: 10 - i})
+	}
+	model.programContext.ArchonClient = client
+
+	// The first page shows before the others are requested
+	_, cmd := model.Update(model.reloadCmd(true, 0)())
+	if len(model.programContext.Tasks) != 2 || len(client.requests) != 1 {
+		t.Fatalf("Expected the first page shown after one request, got %d tasks after %d", len(model.programContext.Tasks), len(client.requests))
+	}
+
+	isPage := func(msg tea.Msg) bool {
+		_, ok := msg.(tasks.TasksPageLoadedMsg)
+		return ok
+	}
+
+	// A reload stops the page chain it interrupts and starts its own
+	stale := cmd
+	_, cmd = model.Update(model.reloadCmd(true, 0)())
+	if follow := deliver(&model, stale, isPage); len(model.programContext.Tasks) != 2 || slices.ContainsFunc(follow, func(c tea.Cmd) bool { return c != nil }) {
+		t.Fatalf("Expected the interrupted page dropped, got %d tasks", len(model.programContext.Tasks))
+	}
+
+	// Each page requests the next without any scrolling
+	for pending := []tea.Cmd{cmd}; len(pending) > 0; {
+		var next []tea.Cmd
+		for _, cmd := range pending {
+			next = append(next, deliver(&model, cmd, isPage)...)
+		}
+		pending = next
+	}
+	if len(model.programContext.Tasks) != 5 || model.programContext.TaskPages.HasMore {
+		t.Errorf("Expected all 5 tasks loaded, got %d and %+v", len(model.programContext.Tasks), model.programContext.TaskPages)
+	}
+	if len(client.requests) != 5 {
+		t.Errorf("Expected 2 reloads and 3 page requests, got %+v", client.requests)
+	}
+}
+
+func TestReloadSelectedTask(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.updateTasks([]archon.Task{
+		{ID: "a", Title: "Alpha", Status: "todo", TaskOrder: 2, Description: "old"},
+		{ID: "b", Title: "Beta", Status: "todo", TaskOrder: 1},
+	})
+	client := &getTaskClient{task: archon.Task{ID: "a", Title: "Alpha", Status: "doing", TaskOrder: 2, Description: "new"}}
+	model.programContext.ArchonClient = client
+
+	cmd := model.handleKeyPress("R")
+	if cmd == nil {
+		t.Fatal("Expected R to reload the selected task")
+	}
+	var feedback string
+	for _, follow := range deliver(&model, cmd, func(msg tea.Msg) bool {
+		_, ok := msg.(tasks.TaskReloadedMsg)
+		return ok
+	}) {
+		if f := sessionFeedback(follow); f != "" {
+			feedback = f
+		}
+	}
+
+	if !slices.Equal(client.gets, []string{"a"}) || client.lists != 0 {
+		t.Errorf("Expected one GetTask for a and no list reload, got %v / %d", client.gets, client.lists)
+	}
+	if task := model.programContext.FindTask("a"); task == nil || task.Status != "doing" || task.Description != "new" {
+		t.Errorf("Expected the reloaded task merged in, got %+v", task)
+	}
+	if len(model.programContext.Tasks) != 2 {
+		t.Errorf("Expected the other tasks kept, got %d", len(model.programContext.Tasks))
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "a" {
+		t.Errorf("Expected the selection to stay on a, got %+v", selected)
+	}
+	if feedback != "Task refreshed" {
+		t.Errorf("Expected refresh feedback, got %q", feedback)
+	}
+
+	// A task the server no longer has is dropped
+	model.handleTaskReloaded(tasks.TaskReloadedMsg{TaskID: "a", Error: archon.ErrTaskNotFound})
+	if model.programContext.FindTask("a") != nil || len(model.programContext.Tasks) != 1 {
+		t.Error("Expected a missing task to be dropped")
+	}
+	if feedback := sessionFeedback(model.handleTaskReloaded(tasks.TaskReloadedMsg{TaskID: "b", Error: errors.New("timeout")})); feedback != "Failed to refresh task: timeout" {
+		t.Errorf("Unexpected failure feedback %q", feedback)
+	}
+	if model.programContext.FindTask("b") == nil {
+		t.Error("Expected a failed reload to keep the task")
+	}
+}
+
+// dispatchedKeys returns the keys the named routers in file switch on, read
+// from the source so that a new case cannot be added without being seen
+func dispatchedKeys(t *testing.T, file string, routers ...string) map[string]bool {
+	t.Helper()
+	fset := token.NewFileSet()
+
+	// Values of the keys.Key* constants
+	constants, err := parser.ParseFile(fset, filepath.Join("..", "shared", "utils", "keys", "constants.go"), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	ast.Inspect(constants, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.ValueSpec); ok && len(spec.Names) == len(spec.Values) {
+			for i, name := range spec.Names {
+				if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					values[name.Name], _ = strconv.Unquote(lit.Value)
+				}
+			}
+		}
+		return true
+	})
+
+	source, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyValue := func(expr ast.Expr) (string, bool) {
+		switch expr := expr.(type) {
+		case *ast.SelectorExpr:
+			if pkg, ok := expr.X.(*ast.Ident); ok && pkg.Name == "keys" {
+				value, ok := values[expr.Sel.Name]
+				return value, ok
+			}
+		case *ast.BasicLit:
+			if expr.Kind == token.STRING {
+				value, err := strconv.Unquote(expr.Value)
+				return value, err == nil
+			}
+		}
+		return "", false
+	}
+
+	dispatched := map[string]bool{}
+	for _, decl := range source.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !slices.Contains(routers, fn.Name.Name) {
+			continue
+		}
+		routers = slices.DeleteFunc(routers, func(name string) bool { return name == fn.Name.Name })
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			var exprs []ast.Expr
+			switch node := node.(type) {
+			case *ast.CaseClause:
+				exprs = node.List
+			case *ast.BinaryExpr: // key == keys.KeyG
+				if ident, ok := node.X.(*ast.Ident); ok && ident.Name == "key" && node.Op == token.EQL {
+					exprs = []ast.Expr{node.Y}
+				}
+			}
+			for _, expr := range exprs {
+				if value, ok := keyValue(expr); ok {
+					dispatched[value] = true
+				}
+			}
+			return true
+		})
+	}
+	if len(routers) > 0 {
+		t.Fatalf("Routers not found in %s: %v", file, routers)
+	}
+	return dispatched
+}
+
+// TestDispatchedKeysHaveActionInfo keeps the help in step with the key
+// routers: every key the main view dispatches needs action metadata
+// (keys/actions.go), and every documented main view key must be dispatched
+func TestDispatchedKeysHaveActionInfo(t *testing.T) {
+	dispatched := dispatchedKeys(t, "input_handlers.go",
+		"handleGlobalKeys", "handleApplicationKey", "handleNavigationKey", "handleSearchKey",
+		"handleTaskKey", "handleHelpModalKey", "handleMultiKeySequence")
+
+	documented := map[string]string{}
+	for _, action := range keys.Actions() {
+		if !slices.Contains(action.Contexts, keys.ContextMain) {
+			continue
+		}
+		if action.Title == "" || action.Description == "" || action.Example == "" || len(action.Keys) == 0 {
+			t.Errorf("Action %s is missing metadata: %+v", action.ID, action)
+		}
+		for _, key := range action.Keys {
+			documented[key] = action.ID
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(dispatched)) {
+		if _, ok := documented[key]; !ok {
+			t.Errorf("Key %q is dispatched but has no action metadata in keys/actions.go", key)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(documented)) {
+		if !dispatched[key] {
+			t.Errorf("Key %q of action %s is documented but not dispatched", key, documented[key])
+		}
+	}
+}
+
+func TestDoublePressQuit(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.QuitBehavior = config.QuitDoublePress
+	model := NewModel(cfg)
+
+	// The batch holds the hint and the expiry timer; only the hint is run
+	batch, _ := model.handleKeyPress("q")().(tea.BatchMsg)
+	if len(batch) == 0 || sessionFeedback(batch[0]) != "Press q again to quit" || !model.quitArmed {
+		t.Fatalf("Expected the first q to arm with a hint, got %d command(s)", len(batch))
+	}
+	model.handleKeyPress("j")
+	if model.quitArmed {
+		t.Error("Expected another key to cancel the pending quit")
+	}
+
+	// Only the timer of the latest press disarms
+	model.handleKeyPress("q")
+	model.handleQuitWindowExpired(quitWindowExpiredMsg{generation: model.quitGeneration - 1})
+	if !model.quitArmed {
+		t.Error("Expected an earlier press's timer to be ignored")
+	}
+	model.handleQuitWindowExpired(quitWindowExpiredMsg{generation: model.quitGeneration})
+	if model.quitArmed {
+		t.Error("Expected the pending quit to expire")
+	}
+
+	model.handleKeyPress("q")
+	if !quitsProgram(model.handleKeyPress("q")) {
+		t.Error("Expected a second q in time to quit")
+	}
+
+	cfg.UI.Display.QuitBehavior = config.QuitImmediate
+	if !quitsProgram(model.handleKeyPress("q")) {
+		t.Error("Expected immediate quit behavior to quit on the first q")
+	}
+}
+
+func TestIdleModalTimeout(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.ModalTimeout = time.Minute
+	model := NewModel(cfg)
+	confirm := model.components.Modals.ConfirmationModel
+	run := func(cmd tea.Cmd) {
+		for _, msg := range collectMsgs(cmd) {
+			model.Update(msg)
+		}
+	}
+
+	model.Update(confirmation.ShowConfirmationModalMsg{Message: "Quit?"})
+	if !confirm.IsActive() || model.modalTimeout != time.Minute {
+		t.Fatalf("Expected the confirmation open with a timeout, got %v", model.modalTimeout)
+	}
+
+	// A key press restarts the wait, so the earlier timer is ignored
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	run(model.handleModalIdle(modalIdleMsg{generation: model.modalTimeoutGeneration - 1}))
+	if !confirm.IsActive() {
+		t.Fatal("Expected input to keep the confirmation open")
+	}
+	cmd := model.handleModalIdle(modalIdleMsg{generation: model.modalTimeoutGeneration})
+	if feedback := sessionFeedback(cmd); feedback != "Canceled an unanswered question" {
+		t.Errorf("Expected idle feedback, got %q", feedback)
+	}
+	run(cmd)
+	if confirm.IsActive() {
+		t.Error("Expected the idle confirmation canceled")
+	}
+
+	// Destructive confirmations wait forever by default
+	model.Update(confirmation.ShowConfirmationModalMsg{Message: "Delete?", Destructive: true})
+	if model.modalTimeout != 0 || model.armModalTimeout() != nil {
+		t.Errorf("Expected no timeout for a destructive confirmation, got %v", model.modalTimeout)
+	}
+}
+
+// quitsProgram reports whether cmd, possibly a sequence or batch, produces tea.QuitMsg
+func quitsProgram(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	msg := cmd()
+	if _, ok := msg.(tea.QuitMsg); ok {
+		return true
+	}
+	// tea.Sequence and tea.Batch results are slices of commands
+	if value := reflect.ValueOf(msg); value.Kind() == reflect.Slice {
+		for i := range value.Len() {
+			if inner, ok := value.Index(i).Interface().(tea.Cmd); ok && quitsProgram(inner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestSafeModeStartup(t *testing.T) {
+	// Corrupt every state file normal startup reads
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupt := map[string]string{}
+	for _, name := range []string{"state.json", "bookmarks.json"} {
+		path := filepath.Join(configDir, "lazyarchon", name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("{not json"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		corrupt[path] = "{not json"
+		t.Cleanup(func() { _ = os.Remove(path) })
+	}
+
+	// And break the config sections safe mode must ignore
+	loaded := createTestConfig()
+	loaded.UI.Theme.Name = "no-such-theme"
+	loaded.UI.Keybindings.Application.Quit = []string{""}
+	loaded.Integrations.Links = []config.LinkRuleConfig{{Name: "broken", Pattern: "([", URLTemplate: "{{.g1"}}
+	loaded.Exports.Scheduled = []config.ScheduledExportConfig{{Name: "broken", Schedule: "sometimes"}}
+	loaded.Session = config.SessionConfig{Restore: true, Path: filepath.Join(configDir, "missing", "session.json"), AwayDigest: true}
+
+	model := NewModel(config.SafeMode(loaded))
+	if !model.programContext.SafeMode {
+		t.Fatal("Expected the model to start in safe mode")
+	}
+	if model.stateStore != nil || model.bookmarkStore != nil || model.sessionStore != nil || model.awayStore != nil || model.exportScheduler != nil {
+		t.Error("Expected every persistence store disabled")
+	}
+	if model.startPolling() != nil {
+		t.Error("Expected polling disabled")
+	}
+	if model.Init() == nil {
+		t.Error("Expected the initial loads to still run")
+	}
+
+	// The first load lists what was disabled; the header keeps the marker
+	_, cmd := model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "Login", Status: "todo", ProjectID: "p1"}}})
+	if feedback := sessionFeedback(cmd); !strings.Contains(feedback, "saved bookmarks") || !strings.Contains(feedback, "polling") {
+		t.Errorf("Expected the safe mode banner, got %q", feedback)
+	}
+	if view := model.View(); !strings.Contains(view, "SAFE MODE") {
+		t.Errorf("Expected the header to show safe mode, got:\n%s", view)
+	}
+
+	// Tasks are read-only; bookmarks still work for the session without being saved
+	if feedback := sessionFeedback(model.handleKeyPress("t")); feedback != "Safe mode: task changes are disabled" {
+		t.Errorf("Expected read-only feedback, got %q", feedback)
+	}
+	model.handleKeyPress("m")
+	collectMsgs(model.handleKeyPress("a"))
+	if _, ok := model.bookmarks["a"]; !ok {
+		t.Error("Expected bookmarks to work in memory")
+	}
+
+	// Nothing on disk was touched
+	for path, content := range corrupt {
+		if data, err := os.ReadFile(path); err != nil || string(data) != content {
+			t.Errorf("Expected %s left as is, got %q (err %v)", path, data, err)
+		}
+	}
+}
+
+func TestStartInProjectMode(t *testing.T) {
+	cfg := createTestConfig()
+	if NewModel(cfg).startInProjectMode() != nil {
+		t.Error("Expected the task view first by default")
+	}
+
+	cfg.UI.Display.StartInProjectMode = true
+	cfg.UI.Display.DefaultProjectID = "p2"
+	model := NewModel(cfg)
+	cmd := model.startInProjectMode()
+	if cmd == nil {
+		t.Fatal("Expected project mode to be activated on startup")
+	}
+	model.update(cmd())
+	if !model.uiState.IsProjectView() {
+		t.Fatal("Expected the project picker as the first screen")
+	}
+
+	// Projects arrive after the picker opened; the cursor lands on the default project
+	model.handleProjectMessages(projects.ProjectsLoadedMsg{Projects: []archon.Project{
+		{ID: "p1", Title: "Web"},
+		{ID: "p2", Title: "Mobile"},
+	}})
+	if index := model.findProjectIndexForCursor(); index != 1 {
+		t.Errorf("Expected cursor on the default project, got index %d", index)
+	}
+	if view := model.View(); !strings.Contains(view, "Mobile") {
+		t.Errorf("Expected the default project details, got:\n%s", view)
+	}
+	if !model.uiState.IsProjectView() {
+		t.Error("Expected to stay in project mode after projects load")
+	}
+}
+
+func TestFrameBudget(t *testing.T) {
+	if model := NewModel(createTestConfig()); model.frames != nil {
+		t.Error("Expected no frame timing outside debug/profiling mode")
+	}
+
+	cfg := createTestConfig()
+	cfg.Development.EnableProfiling = true
+	model := NewModel(cfg)
+	if model.frames == nil || model.frames.Budget() != 16*time.Millisecond {
+		t.Fatal("Expected frame timing with the default 16ms budget in profiling mode")
+	}
+
+	// A budget no frame can meet sends every call down the slow path
+	model.frames = helpers.NewFrameStats(time.Nanosecond, 0)
+	var slow []helpers.SlowFrame
+	model.frames.OnSlow = func(frame helpers.SlowFrame) { slow = append(slow, frame) }
+
+	model.Update(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "Login", Status: "todo"}}})
+	model.update(help.ShowHelpModalMsg{})
+	model.View()
+
+	if len(slow) != 2 {
+		t.Fatalf("Expected the update and the render reported as slow, got %+v", slow)
+	}
+	if slow[0].Phase != helpers.FrameUpdate || slow[0].Message != "tasks.TasksLoadedMsg" || slow[0].View != "tasks" {
+		t.Errorf("Expected the slow update with its message type, got %+v", slow[0])
+	}
+	if slow[1].Phase != helpers.FrameView || slow[1].View != help.ComponentID {
+		t.Errorf("Expected the slow render attributed to the open modal, got %+v", slow[1])
+	}
+	if len(model.frames.Percentiles()) != 2 {
+		t.Errorf("Expected timings per message type and view, got %+v", model.frames.Percentiles())
+	}
+}
+
+func TestSlowFramesRecorded(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := replay.NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := createTestConfig()
+	cfg.Development.EnableProfiling = true
+	model := NewModel(cfg)
+	model.frames = helpers.NewFrameStats(time.Nanosecond, 0)
+	model.recordSlowFrames(recorder)
+
+	model.Update(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "Login", Status: "todo"}}})
+	model.View()
+	model.FlushSlowFrames()
+	model.FlushSlowFrames() // Flushing twice is harmless
+
+	data, err := os.ReadFile(filepath.Join(dir, slowFramesFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "tasks.TasksLoadedMsg") {
+		t.Errorf("Expected the slow update and render written after the flush, got %q", data)
+	}
+}
+
+// sentUpdates returns the last update the mock client received for each task
+func sentUpdates(client *archon.MockClient) map[string]archon.UpdateTaskRequest {
+	sent := make(map[string]archon.UpdateTaskRequest)
+	for _, call := range client.UpdateTaskCalls {
+		sent[call.TaskID] = call.Updates
+	}
+	return sent
+}
+
+// sentFeatures returns the last feature the mock client received for each task
+func sentFeatures(client *archon.MockClient) map[string]string {
+	features := make(map[string]string)
+	for taskID, updates := range sentUpdates(client) {
+		if updates.Feature != nil {
+			features[taskID] = *updates.Feature
+		}
+	}
+	return features
+}
+
+func TestAssignFeatureBatch(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.SetTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo"},
+		{ID: "t2", ProjectID: "p1", Title: "Two", Status: "todo"},
+		{ID: "t3", ProjectID: "locked", Title: "Three", Status: "todo"},
+	})
+	model.programContext.MarkProjectReadOnly("locked")
+	client := archon.NewMockClient()
+	client.SetUpdateTaskErrorFor("t2", fmt.Errorf("server down"))
+	model.programContext.ArchonClient = client
+
+	// Tasks are updated one by one behind a progress bar
+	model.setLoading(false)
+	statusBar := model.components.Layout.StatusBar
+	cmd := model.assignFeature([]string{"t1", "t2", "t3"}, "auth")
+	if view := statusBar.View(); !strings.Contains(view, "Updating features [░░░░░░░░░░] 0% (0/2)") {
+		t.Errorf("Expected an empty progress bar, got %q", view)
+	}
+	progress := featureProgress(t, collectMsgs(cmd))
+	_, cmd = model.handleTaskMessages(progress)
+	if view := statusBar.View(); !strings.Contains(view, "Updating features [█████░░░░░] 50% (1/2)") {
+		t.Errorf("Expected the bar half full, got %q", view)
+	}
+	result := finishFeatureBatch(t, &model, cmd)
+	if sent := sentFeatures(client); len(sent) != 2 || sent["t1"] != "auth" || sent["t2"] != "auth" {
+		t.Errorf("Expected t1 and t2 sent (t3 is read-only), got %v", sent)
+	}
+	if feedback := sessionFeedback(model.handleFeatureAssigned(result)); feedback != "Set feature 'auth' on 1 task (1 failed)" {
+		t.Errorf("Unexpected summary %q", feedback)
+	}
+	if history := model.programContext.BackgroundTasks; len(history) != 1 || history[0].Error == nil ||
+		!strings.HasPrefix(history[0].Error.Error(), "Two: ") {
+		t.Errorf("Expected one history entry listing the failed task, got %+v", history)
+	}
+
+	// "(none)" clears the feature
+	client.SetUpdateTaskErrorFor("t2", nil)
+	result = finishFeatureBatch(t, &model, model.assignFeature([]string{"t1", "t2"}, ""))
+	if sent := sentFeatures(client); sent["t1"] != "" || sent["t2"] != "" {
+		t.Errorf("Expected the features cleared, got %v", sent)
+	}
+	if feedback := sessionFeedback(model.handleFeatureAssigned(result)); feedback != "Cleared feature on 2 tasks" {
+		t.Errorf("Unexpected summary %q", feedback)
+	}
+
+	// Only read-only tasks: nothing is sent
+	if feedback := sessionFeedback(model.assignFeature([]string{"t3"}, "auth")); !strings.Contains(feedback, "Read-only project") {
+		t.Errorf("Expected read-only feedback, got %q", feedback)
+	}
+	if _, sent := client.LastUpdateTaskCall("t3"); sent {
+		t.Error("Expected nothing sent for the read-only task")
+	}
+}
+
+// featureProgress returns the batch feature progress message among msgs
+func featureProgress(t *testing.T, msgs []tea.Msg) tasks.TaskFeatureProgressMsg {
+	t.Helper()
+	for _, msg := range msgs {
+		if progress, ok := msg.(tasks.TaskFeatureProgressMsg); ok {
+			return progress
+		}
+	}
+	t.Fatalf("Expected a feature progress message, got %+v", msgs)
+	return tasks.TaskFeatureProgressMsg{}
+}
+
+// finishFeatureBatch delivers the progress of a batch feature assignment
+// until the batch reports its result
+func finishFeatureBatch(t *testing.T, model *MainModel, cmd tea.Cmd) tasks.TasksFeatureUpdateMsg {
+	t.Helper()
+	for cmd != nil {
+		msgs := collectMsgs(cmd)
+		for _, msg := range msgs {
+			if result, ok := msg.(tasks.TasksFeatureUpdateMsg); ok {
+				return result
+			}
+		}
+		_, cmd = model.handleTaskMessages(featureProgress(t, msgs))
+	}
+	t.Fatal("Expected the batch to report its result")
+	return tasks.TasksFeatureUpdateMsg{}
+}
+
+func TestSprintFilterAndRollover(t *testing.T) {
+	model := NewModel(createTestConfig())
+	sprint := func(name string) *string { return &name }
+	model.programContext.SetTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Old", Status: "done", Feature: sprint("sprint-9")},
+		{ID: "t2", ProjectID: "p1", Title: "Open", Status: "todo", Feature: sprint("sprint-10")},
+		{ID: "t3", ProjectID: "p1", Title: "Shipped", Status: "done", Feature: sprint("sprint-10")},
+		{ID: "t4", ProjectID: "p1", Title: "Other", Status: "todo", Feature: sprint("auth")},
+	})
+	client := archon.NewMockClient()
+	model.programContext.ArchonClient = client
+
+	// Off until a pattern is configured
+	if cmd, _ := model.handleSprintFilterKey(keys.KeyW); !strings.Contains(sessionFeedback(cmd), "Sprints are off") {
+		t.Error("Expected feedback that sprints are off")
+	}
+	resolver, err := sprints.NewResolver(sprints.FieldFeature, `^sprint-\d+$`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	model.programContext.Sprints = resolver
+
+	// 'w' filters to the latest sprint and restores the filter when pressed again
+	if cmd, _ := model.handleSprintFilterKey(keys.KeyW); sessionFeedback(cmd) != "Showing sprint: sprint-10" {
+		t.Errorf("Unexpected feedback %q", sessionFeedback(cmd))
+	}
+	if filters := model.programContext.FeatureFilters; len(filters) != 1 || !filters["sprint-10"] {
+		t.Errorf("Expected the sprint-10 filter, got %v", filters)
+	}
+	model.handleSprintFilterKey(keys.KeyW)
+	if model.programContext.FeatureFilterActive {
+		t.Error("Expected the filter restored")
+	}
+
+	// 'W' shows the totals and offers to roll over the open task
+	cmd, _ := model.handleSprintOverviewKey(keys.KeyWCap)
+	overview, ok := cmd().(confirmation.ShowConfirmationModalMsg)
+	if !ok || overview.ConfirmText != "Roll over to sprint-11" {
+		t.Fatalf("Expected the overview offering a rollover, got %+v", overview)
+	}
+	if details := strings.Join(overview.Details, "\n"); !strings.Contains(details, "▶ sprint-10   2 tasks   1 open   1 done") ||
+		!strings.Contains(details, "Burndown since") {
+		t.Errorf("Expected totals and a burndown, got %q", details)
+	}
+
+	// Confirming previews the tasks that move; confirming again moves them
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	preview, ok := cmd().(confirmation.ShowConfirmationModalMsg)
+	if !ok || !strings.Contains(preview.Message, "from sprint-10 to sprint-11") ||
+		len(preview.Details) != 1 || preview.Details[0] != "• Open" {
+		t.Fatalf("Expected a preview of the open task, got %+v", preview)
+	}
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	finishFeatureBatch(t, &model, cmd)
+	if sent := sentFeatures(client); len(sent) != 1 || sent["t2"] != "sprint-11" {
+		t.Errorf("Expected only t2 moved to sprint-11, got %v", sent)
+	}
+
+	// Closing the overview changes nothing
+	cmd, _ = model.handleSprintOverviewKey(keys.KeyWCap)
+	collectMsgs(cmd)
+	if _, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{}); cmd != nil || model.pendingSprint != nil {
+		t.Error("Expected cancel to close the overview")
+	}
+}
+
+// archiveClient archives tasks, answering like a server that cannot for unsupported ones
+type archiveClient struct {
+	listTasksClient
+	archived    []string
+	unsupported map[string]bool
+}
+
+func (c *archiveClient) DeleteTask(taskID string) error {
+	if c.unsupported[taskID] {
+		return fmt.Errorf("deleting tasks is %w (status 405)", archon.ErrNotSupported)
+	}
+	c.archived = append(c.archived, taskID)
+	return nil
+}
+
+func TestArchiveDoneTasks(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.ShowCompletedTasks = true
+	model.programContext.SetTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Shipped", Status: "done"},
+		{ID: "t2", ProjectID: "p1", Title: "Open", Status: "todo"},
+		{ID: "t3", ProjectID: "p2", Title: "Legacy", Status: "done"},
+		{ID: "t4", ProjectID: "locked", Title: "Theirs", Status: "done"},
+	})
+	model.programContext.MarkProjectReadOnly("locked")
+	client := &archiveClient{unsupported: map[string]bool{"t3": true}}
+	model.programContext.ArchonClient = client
+
+	// X asks first, listing the done tasks and the read-only ones skipped
+	cmd, _ := model.handleArchiveDoneKey(keys.KeyXCap)
+	prompt, ok := cmd().(confirmation.ShowConfirmationModalMsg)
+	if !ok || prompt.Message != "Archive 2 done tasks?" || !prompt.Destructive ||
+		strings.Join(prompt.Details, "\n") != "• Shipped\n• Legacy\n\nSkipping 1 task in read-only projects" {
+		t.Fatalf("Expected an archive prompt for t1 and t3, got %+v", prompt)
+	}
+
+	// Confirming archives them one by one; t3 cannot be archived and is hidden instead
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	var result tasks.TasksArchivedMsg
+	for done := false; !done; {
+		for _, msg := range collectMsgs(cmd) {
+			switch msg := msg.(type) {
+			case tasks.TaskArchiveProgressMsg:
+				_, cmd = model.handleTaskMessages(msg)
+			case tasks.TasksArchivedMsg:
+				result, done = msg, true
+			}
+		}
+	}
+	if len(client.archived) != 1 || client.archived[0] != "t1" {
+		t.Errorf("Expected only t1 archived, got %v", client.archived)
+	}
+	if feedback := sessionFeedback(model.handleTasksArchived(result)); feedback != "Archived 1 done task, hid 1 task the server cannot archive" {
+		t.Errorf("Unexpected summary %q", feedback)
+	}
+	for _, task := range model.GetSortedTasks() {
+		if task.ID == "t3" {
+			t.Error("Expected t3 hidden from the list")
+		}
+	}
+
+	// With archive_done "hide" nothing is sent to the server
+	model.programContext.Config.UI.Display.ArchiveDone = config.ArchiveDoneHide
+	cmd, _ = model.handleArchiveDoneKey(keys.KeyXCap)
+	if prompt, ok := cmd().(confirmation.ShowConfirmationModalMsg); !ok || prompt.ConfirmText != "Hide" {
+		t.Fatalf("Expected a hide prompt, got %+v", prompt)
+	}
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	if feedback := sessionFeedback(cmd); feedback != "Hid 2 done tasks until exit" || len(client.archived) != 1 {
+		t.Errorf("Expected t1 and t4 hidden locally, got %q and %v archived", feedback, client.archived)
+	}
+	if cmd, _ := model.handleArchiveDoneKey(keys.KeyXCap); sessionFeedback(cmd) != "No done tasks in view" {
+		t.Errorf("Expected nothing left to clean up, got %q", sessionFeedback(cmd))
+	}
+}
+
+// TestMalformedResponsesKeepTasks feeds damaged server responses through the
+// real client and checks what reaches the UI
+func TestDuplicateTaskIDs(t *testing.T) {
+	model := NewModel(createTestConfig())
+	updated := func(hour int) archon.FlexibleTime {
+		return archon.FlexibleTime{Time: time.Date(2025, 3, 10, hour, 0, 0, 0, time.UTC)}
+	}
+	model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{
+		{ID: "a", Title: "Alpha", Status: "todo", UpdatedAt: updated(9)},
+		{ID: "b", Title: "Beta (stale)", Status: "todo", UpdatedAt: updated(9)},
+		{ID: "c", Title: "Gamma", Status: "doing", UpdatedAt: updated(9)},
+		{ID: "b", Title: "Beta", Status: "review", UpdatedAt: updated(11)},
+	}})
+
+	if got := len(model.programContext.Tasks); got != 3 {
+		t.Fatalf("Expected one copy per ID, got %d tasks", got)
+	}
+	if task := model.programContext.FindTask("b"); task == nil || task.Title != "Beta" {
+		t.Errorf("Expected the most recently updated copy kept, got %+v", task)
+	}
+
+	// Selecting by ID lands on the one remaining copy, wherever it sorts
+	model.findAndSelectTask("b")
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "b" || selected.Status != "review" {
+		t.Errorf("Expected b selected, got %+v", selected)
+	}
+	if got := len(model.GetSortedTasks()); got != 3 {
+		t.Errorf("Expected 3 rows in the list, got %d", got)
+	}
+}
+
+func TestUnreadTasks(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.UnreadIndicator = true
+	model := NewModel(cfg)
+	model.programContext.ReadSince, model.programContext.LastSeen = time.Time{}, nil
+	at := func(d time.Duration) archon.FlexibleTime {
+		return archon.FlexibleTime{Time: model.programContext.Now().Add(d)}
+	}
+	load := func(loaded ...archon.Task) {
+		_, cmd := model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: loaded})
+		collectMsgs(cmd)
+	}
+	unread := func(id string) bool {
+		return model.programContext.IsUnread(*model.programContext.FindTask(id))
+	}
+
+	// The first load starts tracking: nothing counts as unread yet
+	load(archon.Task{ID: "t1", Title: "Login", Status: "todo", UpdatedAt: at(-time.Hour)},
+		archon.Task{ID: "t2", Title: "Theme", Status: "todo", UpdatedAt: at(-time.Hour)})
+	if model.programContext.ReadSince.IsZero() || unread("t1") || unread("t2") {
+		t.Fatalf("Expected tracking started with every task seen, since %v", model.programContext.ReadSince)
+	}
+
+	// A task updated afterwards is unread until its details are shown
+	load(archon.Task{ID: "t1", Title: "Login", Status: "todo", UpdatedAt: at(-time.Hour)},
+		archon.Task{ID: "t2", Title: "Theme", Status: "doing", UpdatedAt: at(time.Hour)},
+		archon.Task{ID: "t3", Title: "New", Status: "todo", UpdatedAt: at(time.Hour)})
+	if unread("t1") || !unread("t2") || !unread("t3") {
+		t.Fatal("Expected the updated and the new task unread")
+	}
+	model.findAndSelectTask("t2")
+	_, cmd := model.withReadTracking(&model, nil)
+	collectMsgs(cmd)
+	if unread("t2") {
+		t.Error("Expected showing the details to mark the task seen")
+	}
+	saved, err := model.stateStore.Load()
+	if err != nil || saved.ReadSince.IsZero() || saved.LastSeen["t2"].IsZero() {
+		t.Errorf("Expected read tracking saved, got %+v (err %v)", saved, err)
+	}
+
+	// With details_panel "manual" only opening the details counts
+	cfg.UI.Display.DetailsPanel = config.DetailsManual
+	model.findAndSelectTask("t3")
+	model.withReadTracking(&model, nil)
+	if !unread("t3") {
+		t.Error("Expected the task unread while its details are closed")
+	}
+	model.openDetails()
+	model.withReadTracking(&model, nil)
+	if unread("t3") {
+		t.Error("Expected opening the details to mark the task seen")
+	}
+
+	// The dot can be turned off
+	cfg.UI.Display.UnreadIndicator = false
+	model.programContext.LastSeen = nil
+	if unread("t2") {
+		t.Error("Expected no unread tasks with the indicator off")
+	}
+}
+
+func TestSupersededLoadDropped(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.sessionStore, model.pendingSession = nil, nil
+	client := &listTasksClient{tasks: []archon.Task{{ID: "old", Title: "Old", Status: "todo"}}}
+	model.programContext.ArchonClient = client
+
+	stale := model.reloadCmd(true, 0)
+	client.tasks = []archon.Task{{ID: "new", Title: "New", Status: "todo"}}
+	fresh := model.reloadCmd(true, 0)
+
+	// The fresh load lands first; the stale one arriving late is dropped
+	model.Update(fresh())
+	msg := stale()
+	if loaded, ok := msg.(tasks.TasksLoadedMsg); !ok || !errors.Is(loaded.Error, gocontext.Canceled) {
+		t.Fatalf("Expected the superseded load cancelled, got %+v", msg)
+	}
+	model.Update(msg)
+	if model.programContext.FindTask("new") == nil || model.programContext.FindTask("old") != nil {
+		t.Errorf("Expected the fresh tasks kept, got %+v", model.programContext.Tasks)
+	}
+	if model.programContext.Error != "" {
+		t.Errorf("Expected no error for a cancelled load, got %q", model.programContext.Error)
+	}
+}
+
+func TestMalformedResponsesKeepTasks(t *testing.T) {
+	server := archon.SetupMockServerWithData()
+	defer server.Close()
+	client := archon.NewClient(server.URL, "test-key")
+	model := NewModel(createTestConfig())
+	model.sessionStore, model.pendingSession = nil, nil
+	model.programContext.ArchonClient = client
+
+	load := func() tea.Cmd {
+		_, cmd := model.handleTaskMessages(tasks.LoadTasksInterface(gocontext.Background(), client, nil)())
+		return cmd
+	}
+	load()
+	before := slices.Clone(model.programContext.Tasks)
+	if len(before) == 0 || model.programContext.Error != "" {
+		t.Fatalf("Expected tasks from the intact server, got %d (%s)", len(before), model.programContext.Error)
+	}
+
+	faults := map[string]archon.Corruption{
+		"truncated":      archon.TruncatedBody,
+		"half-written":   archon.HalfWrittenBody,
+		"duplicated key": archon.DuplicatedKey("tasks"),
+		"wrong type":     archon.WrongType("status"),
+	}
+	for name, corrupt := range faults {
+		server.SetCorruption("tasks", corrupt)
+		load()
+		if !reflect.DeepEqual(model.programContext.Tasks, before) {
+			t.Errorf("%s: expected the shown tasks kept, got %d tasks", name, len(model.programContext.Tasks))
+		}
+		if !strings.Contains(model.programContext.Error, "malformed response") {
+			t.Errorf("%s: expected a failed refresh, got error %q", name, model.programContext.Error)
+		}
+	}
+	server.SetCorruption("tasks", nil)
+
+	// A damaged single-task reload is a failure, not a deletion
+	taskID := before[0].ID
+	server.SetCorruption("task_by_id", archon.DuplicatedKey("task"))
+	cmd := model.handleTaskReloaded(tasks.ReloadTask(client, taskID)().(tasks.TaskReloadedMsg))
+	if feedback := sessionFeedback(cmd); !strings.HasPrefix(feedback, "Failed to refresh task") {
+		t.Errorf("Expected a failed reload, got %q", feedback)
+	}
+	if model.programContext.FindTask(taskID) == nil {
+		t.Error("Expected the task kept after a damaged reload")
+	}
+	server.SetCorruption("task_by_id", nil)
+
+	// Tasks the UI cannot place are dropped with a counted warning
+	server.AddTask(archon.Task{ID: "odd", Title: "Odd", Status: "blocked"})
+	if feedback := sessionFeedback(load()); feedback != "Ignored 1 task with invalid data from the server" {
+		t.Errorf("Expected a warning for the invalid task, got %q", feedback)
+	}
+	if model.programContext.FindTask("odd") != nil || len(model.programContext.Tasks) != len(before) {
+		t.Error("Expected only the invalid task left out")
+	}
+}
+
+func TestMetricsRefreshOnLoad(t *testing.T) {
+	model := NewModel(createTestConfig())
+	collector := metrics.NewCollector(metrics.BuildInfo{Version: "test"}, 0)
+	model.AttachMetrics(collector)
+
+	model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "One", Status: "doing"},
+	}})
+	var scrape strings.Builder
+	if _, err := collector.WriteTo(&scrape); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(scrape.String(), `lazyarchon_tasks{project_id="p1",status="doing",feature="none"} 1`) {
+		t.Errorf("Expected loaded tasks in the metrics snapshot, got:\n%s", scrape.String())
+	}
+}
+
+func TestScratchpadEdit(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.SetTasks([]archon.Task{{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo", TaskOrder: 5}})
+	client := archon.NewMockClient()
+	model.programContext.ArchonClient = client
+	path := filepath.Join(t.TempDir(), "task.yaml")
+	edited := func(content string) tea.Cmd {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return model.handleScratchpadEdited(scratchpadEditedMsg{taskID: "t1", path: path})
+	}
+
+	// An unsupported field reopens the editor with the error on top
+	if cmd := edited("title: One\nlabels: [bug]\n"); cmd == nil {
+		t.Fatal("Expected the editor to reopen")
+	}
+	content, err := os.ReadFile(path)
+	if err != nil || !strings.HasPrefix(string(content), "# error: line 2: labels is not supported by the Archon server") {
+		t.Fatalf("Expected the error at the top of the kept file, got %q (%v)", content, err)
+	}
+	if model.pendingScratchpad != nil {
+		t.Fatal("Expected nothing pending after a parse error")
+	}
+
+	// A valid edit is previewed, then sent as one update on confirm
+	var preview *confirmation.ShowConfirmationModalMsg
+	for _, msg := range collectMsgs(edited("title: One more\nstatus: doing\npriority: 5\n")) {
+		if show, ok := msg.(confirmation.ShowConfirmationModalMsg); ok {
+			preview = &show
+		}
+	}
+	if preview == nil || !reflect.DeepEqual(preview.Details, []string{"title: One → One more", "status: todo → doing"}) {
+		t.Fatalf("Expected a field preview, got %+v", preview)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the scratchpad file removed, got %v", err)
+	}
+	_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	collectMsgs(cmd)
+	if updates, ok := client.LastUpdateTaskCall("t1"); client.GetUpdateTaskCallCount() != 1 || !ok ||
+		*updates.Title != "One more" || *updates.Status != "doing" || updates.TaskOrder != nil {
+		t.Fatalf("Expected title and status in one update, got %+v", client.UpdateTaskCalls)
+	}
+
+	// Unchanged and emptied documents send nothing
+	if feedback := sessionFeedback(edited("title: One\n")); feedback != "No changes in scratchpad" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if feedback := sessionFeedback(edited("# nothing\n")); feedback != "Scratchpad empty; edit canceled" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if client.GetUpdateTaskCallCount() != 1 || model.pendingScratchpad != nil {
+		t.Error("Expected no update")
+	}
+
+	// An editor exiting with an error discards the edit
+	if err := os.WriteFile(path, []byte("title: Lost\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cmd = model.handleScratchpadEdited(scratchpadEditedMsg{taskID: "t1", path: path, err: errors.New("exit status 1")})
+	if feedback := sessionFeedback(cmd); feedback != "Editor failed, edit discarded: exit status 1" || model.pendingScratchpad != nil {
+		t.Errorf("Expected the edit discarded, got %q", feedback)
+	}
+
+	// A poll that changed the task meanwhile is not reverted, and fields
+	// changed on both sides are flagged
+	original := archon.Task{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo", TaskOrder: 5}
+	model.programContext.SetTasks([]archon.Task{{ID: "t1", ProjectID: "p1", Title: "One", Status: "doing", TaskOrder: 9}})
+	if err := os.WriteFile(path, []byte("title: One\nstatus: review\npriority: 5\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	preview = nil
+	for _, msg := range collectMsgs(model.handleScratchpadEdited(scratchpadEditedMsg{taskID: "t1", path: path, original: &original})) {
+		if show, ok := msg.(confirmation.ShowConfirmationModalMsg); ok {
+			preview = &show
+		}
+	}
+	if preview == nil || preview.Message != "'One' changed on the server while you edited.\nOverwrite its status with yours?" {
+		t.Fatalf("Expected the conflict flagged, got %+v", preview)
+	}
+	if pending := model.pendingScratchpad; pending == nil || pending.updates.TaskOrder != nil || *pending.updates.Status != "review" {
+		t.Errorf("Expected only the edited status sent, got %+v", pending)
+	}
+}
+
+// TestEditsSurviveRemoteDeletion deletes the edited task mid-edit for each
+// way of editing a task
+func TestEditsSurviveRemoteDeletion(t *testing.T) {
+	model := NewModel(createTestConfig())
+	var copied []string
+	model.clipboard = clipboard.New(clipboard.Options{
+		Backend: clipboard.BackendSystem,
+		WriteSystem: func(text string) error {
+			copied = append(copied, text)
+			return nil
+		},
+	})
+	client := archon.NewMockClient()
+	model.programContext.ArchonClient = client
+	one := archon.Task{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo"}
+	two := archon.Task{ID: "t2", ProjectID: "p1", Title: "Two", Status: "todo"}
+	load := func(tasksLeft ...archon.Task) tea.Cmd {
+		_, cmd := model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: tasksLeft})
+		return cmd
+	}
+	load(one, two)
+
+	// Task edit modal: stays open with a warning
+	editModal := model.components.Modals.TaskEditModel
+	editModal.Update(taskedit.ShowTaskEditModalMsg{TaskID: "t1", CurrentStatus: "todo"})
+	load(two)
+	if !editModal.IsActive() || !editModal.TaskDeleted() {
+		t.Fatal("Expected the open edit modal kept and marked deleted")
+	}
+	editModal.Update(taskedit.HideTaskEditModalMsg{})
+
+	// Scratchpad preview: asks again and copies the edit on confirm
+	path := filepath.Join(t.TempDir(), "task.yaml")
+	if err := os.WriteFile(path, []byte("title: Two more\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	collectMsgs(model.handleScratchpadEdited(scratchpadEditedMsg{taskID: "t2", path: path}))
+	var prompt *confirmation.ShowConfirmationModalMsg
+	for _, msg := range collectMsgs(load()) {
+		if show, ok := msg.(confirmation.ShowConfirmationModalMsg); ok {
+			prompt = &show
+		}
+	}
+	if prompt == nil || !strings.Contains(prompt.Message, "'Two' was deleted remotely") || prompt.ConfirmText != "Copy" {
+		t.Fatalf("Expected the preview to offer a copy, got %+v", prompt)
+	}
+	_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	collectMsgs(cmd)
+	if client.GetUpdateTaskCallCount() != 0 || len(copied) != 1 || copied[0] != "title: Two more\n" {
+		t.Fatalf("Expected the scratchpad copied and no update, got %v (updates %+v)", copied, client.UpdateTaskCalls)
+	}
+
+	// Scratchpad still in the editor: copied when it exits
+	if err := os.WriteFile(path, []byte("title: Gone\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	collectMsgs(model.handleScratchpadEdited(scratchpadEditedMsg{taskID: "t2", path: path}))
+	if len(copied) != 2 || copied[1] != "title: Gone\n" {
+		t.Errorf("Expected the scratchpad copied, got %v", copied)
+	}
+}
+
+// TestUpdateOfDeletedTaskRecovers saves a change to a task a teammate deleted
+// before any refresh noticed
+func TestUpdateOfDeletedTaskRecovers(t *testing.T) {
+	server := archon.NewMockServer()
+	defer server.Close()
+	client := archon.NewClient(server.URL, "test-key")
+	model := NewModel(createTestConfig())
+	model.programContext.ArchonClient = client
+	var copied []string
+	model.clipboard = clipboard.New(clipboard.Options{
+		Backend: clipboard.BackendSystem,
+		WriteSystem: func(text string) error {
+			copied = append(copied, text)
+			return nil
+		},
+	})
+	one := archon.Task{ID: "t1", ProjectID: "p1", Title: "Write docs", Description: "Long text", Status: "todo", TaskOrder: 10}
+	two := archon.Task{ID: "t2", ProjectID: "p1", Title: "Fix login", Status: "todo", TaskOrder: 20}
+	server.AddTask(one)
+	server.AddTask(two)
+	model.updateTasks([]archon.Task{one, two})
+	saveDeleted := func(taskID string) confirmation.ShowConfirmationModalMsg {
+		t.Helper()
+		if err := client.DeleteTask(taskID); err != nil {
+			t.Fatal(err)
+		}
+		doing := "doing"
+		_, cmd := model.handleModalActions(taskedit.TaskPropertiesUpdatedMsg{TaskID: taskID, Status: &doing})
+		failed, ok := cmd().(tasks.TaskUpdateMsg)
+		if !ok || !errors.Is(failed.Error, archon.ErrTaskNotFound) {
+			t.Fatalf("Expected the update to find no task, got %+v", failed)
+		}
+		_, cmd = model.handleTaskMessages(failed)
+		for _, msg := range collectMsgs(cmd) {
+			if show, ok := msg.(confirmation.ShowConfirmationModalMsg); ok {
+				return show
+			}
+		}
+		t.Fatal("Expected a prompt to recover the change")
+		return confirmation.ShowConfirmationModalMsg{}
+	}
+
+	// The change is offered for re-creating the task, which gets a new ID
+	prompt := saveDeleted("t1")
+	if prompt.ConfirmText != "Re-create" || prompt.AltKey != "c" || !prompt.Destructive ||
+		!strings.Contains(prompt.Message, "'Write docs' was deleted remotely") {
+		t.Errorf("Unexpected prompt %+v", prompt)
+	}
+	if model.programContext.FindTask("t1") != nil || model.programContext.Error != "" {
+		t.Errorf("Expected t1 dropped without an error, got %q", model.programContext.Error)
+	}
+	_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+	created, ok := cmd().(tasks.TaskCreatedMsg)
+	if !ok || created.Error != nil || created.Task.ID == "t1" {
+		t.Fatalf("Expected the task re-created, got %+v", created)
+	}
+	if resp, err := client.GetTask(created.Task.ID); err != nil || resp.Task.Title != "Write docs" ||
+		resp.Task.Description != "Long text" || resp.Task.Status != "doing" {
+		t.Errorf("Expected the task re-created with the change, got %+v (%v)", resp, err)
 	}
 
-	if model.programContext.ArchonClient == nil {
-		t.Errorf("Expected ArchonClient to be initialized")
+	// Or copied as a scratchpad
+	saveDeleted("t2")
+	_, cmd = model.handleModalActions(confirmation.ConfirmationSelectedMsg{Alternate: true})
+	collectMsgs(cmd)
+	if len(copied) != 1 || !strings.Contains(copied[0], "title: Fix login") || !strings.Contains(copied[0], "status: doing") {
+		t.Errorf("Expected the edited task copied, got %v", copied)
 	}
 }
 
-func TestGetSortedTasks(t *testing.T) {
+func TestTaskReloadsCoalesce(t *testing.T) {
 	model := NewModel(createTestConfig())
+	client := archon.NewMockClient()
+	client.SetListTasksResponse(&archon.TasksResponse{Tasks: []archon.Task{{ID: "t1", Title: "One", Status: "todo"}}}, nil)
+	model.programContext.ArchonClient = client
 
-	// Test with empty tasks
-	sorted := model.GetSortedTasks()
-	if len(sorted) != 0 {
-		t.Errorf("Expected empty slice, got %d tasks", len(sorted))
+	// A poll, 'r', a bookmark jump and a confirmed edit arrive together
+	var cmds []tea.Cmd
+	for _, source := range []string{helpers.RefreshPoll, helpers.RefreshManual, helpers.RefreshNavigation} {
+		cmds = append(cmds, model.requestTaskReload(source))
 	}
+	_, cmd := model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1"})
+	cmds = append(cmds, cmd)
 
-	// Test with sample tasks
-	model.programContext.SetTasks([]archon.Task{
-		{Title: "Task A", Status: "todo", TaskOrder: 5},
-		{Title: "Task B", Status: "done", TaskOrder: 10},
-		{Title: "Task C", Status: "doing", TaskOrder: 3},
-	})
-
-	sorted = model.GetSortedTasks()
-	if len(sorted) != 3 {
-		t.Errorf("Expected 3 tasks, got %d", len(sorted))
+	var loaded []tea.Msg
+	for _, cmd := range cmds {
+		loaded = append(loaded, collectMsgs(cmd)...)
+	}
+	if client.GetListTasksCallCount() != 1 || len(loaded) != 1 {
+		t.Fatalf("Expected exactly one ListTasks call, got %d", client.GetListTasksCallCount())
 	}
 
-	// First task should be "todo" status (highest priority in status+priority sort)
-	if sorted[0].Status != "todo" {
-		t.Errorf("Expected first task status to be 'todo', got '%s'", sorted[0].Status)
+	// The edit landed while the reload was running, so one follow-up is
+	// scheduled once it finishes, after the minimum spacing
+	model.Update(loaded[0])
+	if model.refresh.InFlight() {
+		t.Error("Expected the reload finished")
+	}
+	if start, delay := model.refresh.Request(helpers.RefreshPoll); start || delay != 0 {
+		t.Errorf("Expected later requests to join the scheduled follow-up, got %v %s", start, delay)
+	}
+	if client.GetListTasksCallCount() != 1 {
+		t.Errorf("Expected no further ListTasks call yet, got %d", client.GetListTasksCallCount())
 	}
 }
 
-// TestSetSelectedProject - SKIPPED: Needs proper ProjectManager initialization with test projects
-// Consider rewriting to use component-based architecture
-// func TestSetSelectedProject(t *testing.T) {
-// 	model := NewModel(createTestConfig())
-//
-// 	// Test setting project ID
-// 	projectID := "test-project-123"
-// 	model.SetSelectedProject(&projectID)
-//
-// 	if model.programContext.SelectedProjectID == nil {
-// 		t.Error("Expected selectedProjectID to be set")
-// 	}
-//
-// 	if *model.programContext.SelectedProjectID != projectID {
-// 		t.Errorf("Expected selectedProjectID to be %s, got %s", projectID, *model.programContext.SelectedProjectID)
-// 	}
-//
-// 	// Test setting to nil (all tasks)
-// 	model.SetSelectedProject(nil)
-// 	if model.programContext.SelectedProjectID != nil {
-// 		t.Error("Expected selectedProjectID to be nil")
-// 	}
-// }
+// keyedClient records the API key set after a 401
+type keyedClient struct {
+	listTasksClient
+	apiKey string
+}
 
-func TestCycleSortMode(t *testing.T) {
+func (c *keyedClient) SetAPIKey(apiKey string) { c.apiKey = apiKey }
+
+// deliver feeds the messages produced by cmd back into the model, unwrapping
+// component messages, and returns the follow-up commands
+func deliver(model *MainModel, cmd tea.Cmd, keep func(tea.Msg) bool) []tea.Cmd {
+	var next []tea.Cmd
+	for _, msg := range collectMsgs(cmd) {
+		if wrapped, ok := msg.(base.ComponentMessage); ok {
+			msg = wrapped.Payload
+		}
+		if keep(msg) {
+			_, follow := model.Update(msg)
+			next = append(next, follow)
+		}
+	}
+	return next
+}
+
+func TestAPIKeyPromptAfterUnauthorized(t *testing.T) {
 	model := NewModel(createTestConfig())
+	client := &keyedClient{}
+	model.programContext.ArchonClient = client
+	unauthorized := tasks.TasksLoadedMsg{Error: fmt.Errorf("%w (status 401): expired", archon.ErrUnauthorized)}
+	isPrompt := func(msg tea.Msg) bool {
+		switch msg.(type) {
+		case apikey.ShowAPIKeyModalMsg, apikey.APIKeyEnteredMsg, apikey.HideAPIKeyModalMsg, apikey.APIKeyCanceledMsg:
+			return true
+		}
+		return false
+	}
+	typeKey := func(msg tea.KeyMsg) {
+		_, cmd := model.Update(msg)
+		deliver(&model, cmd, isPrompt)
+	}
 
-	// Test cycling through sort modes
-	initialMode := model.programContext.SortMode
-	model.cycleSortMode()
+	_, cmd := model.Update(unauthorized)
+	deliver(&model, cmd, isPrompt)
+	if !model.components.Modals.APIKeyModel.IsActive() {
+		t.Fatal("Expected the API key prompt after a 401")
+	}
 
-	if model.programContext.SortMode == initialMode {
-		t.Error("Expected sort mode to change")
+	// A second 401 while it is open does not stack another prompt
+	_, cmd = model.Update(projects.ProjectsLoadedMsg{Error: unauthorized.Error})
+	for _, msg := range collectMsgs(cmd) {
+		if _, ok := msg.(apikey.ShowAPIKeyModalMsg); ok {
+			t.Error("Expected no second prompt while one is open")
+		}
 	}
 
-	// Cycle through all modes and verify we return to start
-	originalMode := model.programContext.SortMode
-	for i := 0; i < 4; i++ {
-		model.cycleSortMode()
+	// 'q' and '?' are part of the key, not quit/help
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k?q")})
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	if model.components.Modals.HelpModel.IsActive() {
+		t.Error("Expected '?' to be typed, not to open help")
+	}
+	typeKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if client.apiKey != "k?q?" {
+		t.Errorf("Expected the typed key to be set on the client, got %q", client.apiKey)
+	}
+	if model.components.Modals.APIKeyModel.IsActive() {
+		t.Error("Expected the prompt closed after Enter")
 	}
 
-	if model.programContext.SortMode != originalMode {
-		t.Errorf("Expected to cycle back to original mode %d, got %d", originalMode, model.programContext.SortMode)
+	// Dismissing keeps it closed until the next manual refresh
+	_, cmd = model.Update(unauthorized)
+	deliver(&model, cmd, isPrompt)
+	typeKey(tea.KeyMsg{Type: tea.KeyEscape})
+	_, cmd = model.Update(tasks.TaskUpdateMsg{TaskID: "t1", Error: unauthorized.Error})
+	deliver(&model, cmd, isPrompt)
+	if model.components.Modals.APIKeyModel.IsActive() {
+		t.Error("Expected no prompt after it was dismissed")
+	}
+	model.handleRefreshKey(keys.KeyR)
+	_, cmd = model.Update(unauthorized)
+	deliver(&model, cmd, isPrompt)
+	if !model.components.Modals.APIKeyModel.IsActive() {
+		t.Error("Expected the prompt again after a manual refresh")
 	}
 }
 
@@ -229,3 +3159,658 @@ func TestCycleSortMode(t *testing.T) {
 // 		}
 // 	})
 // }
+
+// searchClient answers server searches with fixed results
+type searchClient struct {
+	interfaces.ArchonClient
+	results []archon.Task
+	queries []string
+}
+
+func (c *searchClient) SearchTasks(query string, _ *string) (*archon.TasksResponse, error) {
+	c.queries = append(c.queries, query)
+	return &archon.TasksResponse{Tasks: c.results}, nil
+}
+
+// searchedMsg commits the search typed as query and returns the server search it started, if any
+func searchedMsg(model *MainModel, query string) *tasks.TasksSearchedMsg {
+	model.uiState.ActivateSearch()
+	model.uiState.SearchInput = query
+	for _, msg := range collectMsgs(model.commitInlineSearch()) {
+		if searched, ok := msg.(tasks.TasksSearchedMsg); ok {
+			return &searched
+		}
+	}
+	return nil
+}
+
+func TestServerSearch(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.SearchMode = config.SearchServer
+	model := NewModel(cfg)
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{
+		{ID: "t1", Title: "Login page", Status: "todo"},
+		{ID: "t2", Title: "Theme", Status: "todo"},
+	})
+	client := &searchClient{results: []archon.Task{
+		{ID: "t2", Title: "Theme", Status: "todo", Description: "login colors"},
+		{ID: "t9", Title: "Archived login work", Status: "done"},
+	}}
+	model.programContext.ArchonClient = client
+	statusBar := model.components.Layout.StatusBar
+
+	msg := searchedMsg(&model, "login")
+	if msg == nil || len(client.queries) != 1 {
+		t.Fatal("Expected Enter to search on the server")
+	}
+	_, cmd := model.handleTaskMessages(*msg)
+	if feedback := sessionFeedback(cmd); feedback != "Server search: 2 results" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if sorted := model.GetSortedTasks(); len(sorted) != 2 || sorted[0].ID != "t2" || sorted[1].ID != "t9" {
+		t.Errorf("Expected the server results listed, got %+v", sorted)
+	}
+	if view := statusBar.View(); !strings.Contains(view, "Server search: Match 1/2") {
+		t.Errorf("Expected the server search marked, got:\n%s", view)
+	}
+
+	// Clearing the search lists the loaded tasks again
+	model.clearSearch()
+	if sorted := model.GetSortedTasks(); len(sorted) != 2 || sorted[0].ID != "t1" {
+		t.Errorf("Expected the loaded tasks back, got %+v", sorted)
+	}
+}
+
+func TestServerSearchFallsBackToLocal(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.SearchMode = config.SearchServer
+	model := NewModel(cfg)
+	model.setLoading(false)
+	model.updateTasks([]archon.Task{{ID: "t1", Title: "Login page", Status: "todo"}})
+	model.programContext.ArchonClient = &listTasksClient{}
+
+	msg := searchedMsg(&model, "login")
+	if msg == nil || !errors.Is(msg.Error, archon.ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported from a client without search, got %+v", msg)
+	}
+	_, cmd := model.handleTaskMessages(*msg)
+	if feedback := sessionFeedback(cmd); feedback != "The server cannot search: using local search" {
+		t.Errorf("Unexpected feedback %q", feedback)
+	}
+	if view := model.components.Layout.StatusBar.View(); !strings.Contains(view, "Local search: Match 1/1") {
+		t.Errorf("Expected local matches marked, got:\n%s", view)
+	}
+	if msg := searchedMsg(&model, "page"); msg != nil {
+		t.Error("Expected no more server searches this session")
+	}
+}
+
+func TestFailedAttemptsShownWithError(t *testing.T) {
+	model := NewModel(createTestConfig())
+	statusBar := model.components.Layout.StatusBar
+	failed := tasks.TasksLoadedMsg{Error: errors.New("connection refused")}
+
+	model.handleTaskMessages(failed)
+	if view := statusBar.View(); !strings.Contains(view, "[Tasks] Error: connection refused") {
+		t.Errorf("Expected a plain first error, got:\n%s", view)
+	}
+
+	// Retrying keeps counting until the tasks load
+	model.handleRefreshKey(keys.KeyR)
+	model.handleTaskMessages(failed)
+	model.handleRefreshKey(keys.KeyR)
+	model.handleTaskMessages(failed)
+	if view := statusBar.View(); !strings.Contains(view, "Error (failed 3 times): connection refused") {
+		t.Errorf("Expected the attempts counted, got:\n%s", view)
+	}
+
+	model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{{ID: "t1", Title: "Alpha", Status: "todo"}}})
+	model.handleTaskMessages(failed)
+	if view := statusBar.View(); strings.Contains(view, "failed") {
+		t.Errorf("Expected the count reset once tasks loaded, got:\n%s", view)
+	}
+}
+
+func TestFailedAttemptsCountTaskLoadsOnly(t *testing.T) {
+	model := NewModel(createTestConfig())
+	statusBar := model.components.Layout.StatusBar
+	refused := errors.New("connection refused")
+
+	// Startup with the server down: both loads fail, but it is one attempt
+	model.handleTaskMessages(tasks.TasksLoadedMsg{Error: refused})
+	model.handleProjectMessages(projects.ProjectsLoadedMsg{Error: refused})
+	if view := statusBar.View(); strings.Contains(view, "failed") {
+		t.Errorf("Expected no attempt count after one attempt, got:\n%s", view)
+	}
+
+	// Failed mutations are not load attempts either
+	model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1", Error: refused})
+	if model.programContext.FailedAttempts != 1 {
+		t.Errorf("Expected 1 failed attempt, got %d", model.programContext.FailedAttempts)
+	}
+}
+
+func TestModalConflict(t *testing.T) {
+	confirm := confirmation.ShowConfirmationModalMsg{Message: "Copy 2 MB?", ConfirmText: "Copy", CancelText: "Cancel"}
+
+	// Default: a modal opening over another is dropped
+	model := NewModel(createTestConfig())
+	model.update(help.ShowHelpModalMsg{})
+	_, cmd := model.update(confirm)
+	if model.components.Modals.ConfirmationModel.IsActive() {
+		t.Fatal("Expected the confirmation dropped while help is open")
+	}
+	if got := sessionFeedback(cmd); got != "Another dialog is open" {
+		t.Errorf("Expected feedback about the open dialog, got %q", got)
+	}
+	_, cmd = model.update(help.HideHelpModalMsg{})
+	for _, msg := range collectMsgs(cmd) {
+		_, cmd := model.update(msg)
+		if msgs := collectMsgs(cmd); len(msgs) > 0 {
+			t.Errorf("Expected nothing reopened after help closed, got %+v", msgs)
+		}
+	}
+
+	// queue: it opens once help closes
+	cfg := createTestConfig()
+	cfg.UI.Display.ModalConflict = config.ModalConflictQueue
+	model = NewModel(cfg)
+	model.update(help.ShowHelpModalMsg{})
+	if _, cmd := model.update(confirm); cmd != nil {
+		t.Errorf("Expected the confirmation queued silently, got %+v", collectMsgs(cmd))
+	}
+	if model.components.Modals.ConfirmationModel.IsActive() {
+		t.Fatal("Expected the confirmation to wait for help to close")
+	}
+	_, cmd = model.update(help.HideHelpModalMsg{})
+	for _, msg := range collectMsgs(cmd) {
+		_, cmd := model.update(msg)
+		for _, msg := range collectMsgs(cmd) {
+			model.update(msg)
+		}
+	}
+	if !model.components.Modals.ConfirmationModel.IsActive() || model.components.Modals.HelpModel.IsActive() {
+		t.Error("Expected the queued confirmation open after help closed")
+	}
+	if len(model.queuedModals) != 0 {
+		t.Errorf("Expected the queue empty, got %d", len(model.queuedModals))
+	}
+}
+
+func TestRememberedSelection(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Session.RememberSelection = true
+	load := func(selection *state.Selection) *MainModel {
+		model := NewModel(cfg)
+		model.savedSelection = selection
+		model.handleProjectMessages(projects.ProjectsLoadedMsg{Projects: []archon.Project{{ID: "p1"}, {ID: "p2"}}})
+		model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: []archon.Task{
+			{ID: "t1", ProjectID: "p1", Status: "todo", TaskOrder: 3},
+			{ID: "t2", ProjectID: "p2", Status: "todo", TaskOrder: 2},
+			{ID: "t3", ProjectID: "p2", Status: "todo", TaskOrder: 1},
+		}})
+		return &model
+	}
+
+	// The task and its project are selected again after the initial load
+	model := load(&state.Selection{TaskID: "t3", ProjectID: "p2"})
+	if projectID := model.programContext.SelectedProjectID; projectID == nil || *projectID != "p2" {
+		t.Errorf("Expected project p2 selected again, got %v", projectID)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t3" {
+		t.Fatalf("Expected t3 selected again, got %+v", selected)
+	}
+	if saved := model.selectionToSave(); saved == nil || *saved != (state.Selection{TaskID: "t3", ProjectID: "p2"}) {
+		t.Errorf("Expected the selection saved for the next start, got %+v", saved)
+	}
+
+	// A deleted task leaves the usual first task selection
+	model = load(&state.Selection{TaskID: "gone", ProjectID: "p2"})
+	if model.programContext.SelectedProjectID != nil {
+		t.Errorf("Expected All Tasks kept, got %v", *model.programContext.SelectedProjectID)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "t1" {
+		t.Errorf("Expected the first task selected, got %+v", selected)
+	}
+
+	// Turned off, nothing is saved
+	cfg.Session.RememberSelection = false
+	if saved := model.selectionToSave(); saved != nil {
+		t.Errorf("Expected no selection saved when turned off, got %+v", saved)
+	}
+}
+
+func TestMinPriorityFilter(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.UI.Display.MinPriority = 50
+	model := NewModel(cfg)
+	model.programContext.ArchonClient = &getTaskClient{}
+	model.updateTasks([]archon.Task{
+		{ID: "high", Status: "todo", TaskOrder: 90},
+		{ID: "medium", Status: "doing", TaskOrder: 60},
+		{ID: "low", Status: "todo", TaskOrder: 10},
+		{ID: "medium-done", Status: "done", TaskOrder: 55},
+	})
+	shown := func() string {
+		var ids []string
+		for _, task := range model.GetSortedTasks() {
+			ids = append(ids, task.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	// The configured threshold applies from the start and shows in the status bar
+	if got := shown(); got != "high,medium,medium-done" {
+		t.Fatalf("Expected the low priority task hidden, got %s", got)
+	}
+	model.handleWindowResize(tea.WindowSizeMsg{Width: 160, Height: 12})
+	if status := model.components.Layout.StatusBar.View(); !strings.Contains(status, "Priority: ≥50") {
+		t.Errorf("Expected the threshold in the status bar, got %q", status)
+	}
+
+	// '+' raises it to high priority, composing with the status filter
+	cmd, _ := model.handleMinPriorityKey(keys.KeyPlus)
+	if got := sessionFeedback(cmd); got != "Showing priority ≥80: 1 task" {
+		t.Errorf("Expected feedback with the new threshold, got %q", got)
+	}
+	if cmd, _ := model.handleMinPriorityKey(keys.KeyPlus); sessionFeedback(cmd) != "Only high priority tasks are shown" {
+		t.Errorf("Expected the highest level to stay, got %q", sessionFeedback(cmd))
+	}
+	model.handleMinPriorityKey(keys.KeyMinus)
+	model.programContext.SetStatusFilter("done", false)
+	model.refreshUIAfterFilterChange()
+	if got := shown(); got != "high,medium" {
+		t.Errorf("Expected the threshold and status filter combined, got %s", got)
+	}
+
+	// '-' down to 0 shows every priority again
+	cmd, _ = model.handleMinPriorityKey(keys.KeyMinus)
+	if got := sessionFeedback(cmd); got != "Showing all priorities" || model.programContext.MinPriority != 0 {
+		t.Errorf("Expected all priorities shown, got %q", got)
+	}
+	if got := shown(); got != "high,low,medium" {
+		t.Errorf("Expected the low priority task back, got %s", got)
+	}
+}
+
+func TestChangedOnlyView(t *testing.T) {
+	model := NewModel(createTestConfig())
+	model.programContext.ArchonClient = &getTaskClient{}
+	load := func(tasksList ...archon.Task) {
+		model.handleTaskMessages(tasks.TasksLoadedMsg{Tasks: tasksList})
+	}
+	shown := func() string {
+		var ids []string
+		for _, task := range model.GetSortedTasks() {
+			ids = append(ids, task.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	// The first load has nothing to compare with
+	load(archon.Task{ID: "t1", Title: "One", Status: "todo"}, archon.Task{ID: "t2", Title: "Two", Status: "todo"},
+		archon.Task{ID: "t3", Title: "Three", Status: "todo"})
+	if cmd, _ := model.handleChangedOnlyKey(keys.KeyU); sessionFeedback(cmd) != "Nothing was added or changed by the last refresh" {
+		t.Errorf("Expected nothing changed after the first load, got %q", sessionFeedback(cmd))
+	}
+
+	// A poll changes t2, adds t4 and removes t3
+	load(archon.Task{ID: "t1", Title: "One", Status: "todo"}, archon.Task{ID: "t2", Title: "Two", Status: "doing"},
+		archon.Task{ID: "t4", Title: "Four", Status: "todo"})
+	cmd, _ := model.handleChangedOnlyKey(keys.KeyU)
+	if got := sessionFeedback(cmd); got != "Showing 2 tasks changed by the last refresh (u or Esc shows all)" {
+		t.Errorf("Expected the changed count, got %q", got)
+	}
+	if got := shown(); got != "t4,t2" {
+		t.Errorf("Expected only the changed tasks, got %s", got)
+	}
+	model.handleWindowResize(tea.WindowSizeMsg{Width: 160, Height: 12})
+	if status := model.components.Layout.StatusBar.View(); !strings.Contains(status, "Changed: last refresh") {
+		t.Errorf("Expected the view in the status bar, got %q", status)
+	}
+
+	// Composes with the other filters
+	model.programContext.SetStatusFilter("doing", false)
+	model.refreshUIAfterFilterChange()
+	if got := shown(); got != "t4" {
+		t.Errorf("Expected the status filter applied to the changed tasks, got %s", got)
+	}
+	model.programContext.SetStatusFilter("doing", true)
+
+	// Esc shows all tasks again
+	if cmd, handled := model.handleEscapeKey(keys.KeyEscape); !handled || sessionFeedback(cmd) != "Showing all tasks" {
+		t.Errorf("Expected Esc to end the view, got %q", sessionFeedback(cmd))
+	}
+	if got := shown(); got != "t1,t4,t2" {
+		t.Errorf("Expected all tasks after Esc, got %s", got)
+	}
+
+	// The next poll ends the view and records its own changes
+	model.handleChangedOnlyKey(keys.KeyU)
+	load(archon.Task{ID: "t1", Title: "One!", Status: "todo"}, archon.Task{ID: "t2", Title: "Two", Status: "doing"},
+		archon.Task{ID: "t4", Title: "Four", Status: "todo"})
+	if model.programContext.ChangedOnly || shown() != "t1,t4,t2" {
+		t.Errorf("Expected the next poll to end the view, got %s", shown())
+	}
+	model.handleChangedOnlyKey(keys.KeyU)
+	if got := shown(); got != "t1" {
+		t.Errorf("Expected the latest poll's changes, got %s", got)
+	}
+}
+
+// createClient adds the tasks it creates to its list
+type createClient struct {
+	listTasksClient
+	created []archon.CreateTaskRequest
+}
+
+func (c *createClient) CreateTask(req archon.CreateTaskRequest) (*archon.TaskResponse, error) {
+	c.created = append(c.created, req)
+	task := archon.Task{ID: "new", ProjectID: req.ProjectID, Title: req.Title, Status: req.Status, TaskOrder: req.Priority, Feature: req.Feature}
+	c.tasks = append(c.tasks, task)
+	return &archon.TaskResponse{Task: task}, nil
+}
+
+func TestCreateTask(t *testing.T) {
+	model := NewModel(createTestConfig())
+	client := &createClient{listTasksClient: listTasksClient{tasks: []archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo"},
+	}}}
+	model.programContext.ArchonClient = client
+	model.updateTasks(client.tasks)
+	findMsg := func(cmd tea.Cmd, match func(tea.Msg) bool) tea.Msg {
+		for _, msg := range collectMsgs(cmd) {
+			if match(msg) {
+				return msg
+			}
+		}
+		t.Fatal("Expected the message not found")
+		return nil
+	}
+
+	// All Tasks has no project to create the task in
+	if cmd, _ := model.handleTaskCreateKey(keys.KeyI); !strings.Contains(sessionFeedback(cmd), "Select a project first") {
+		t.Errorf("Expected a project required, got %q", sessionFeedback(cmd))
+	}
+
+	project := "p1"
+	model.setSelectedProject(&project)
+	cmd, _ := model.handleTaskCreateKey(keys.KeyI)
+	if show, ok := cmd().(taskedit.ShowTaskEditModalMsg); !ok || !show.Create || show.CurrentStatus != "todo" {
+		t.Fatalf("Expected the new task form, got %+v", show)
+	}
+
+	// The form's values are sent for the selected project
+	model.programContext.SetStatusFilter("doing", false)
+	model.refreshUIAfterFilterChange()
+	cmd = model.createTask(taskedit.TaskCreateRequestedMsg{Title: "Write docs", Description: "All of them", Status: "doing", Priority: 40, Feature: "docs"})
+	created := findMsg(cmd, func(msg tea.Msg) bool { _, ok := msg.(tasks.TaskCreatedMsg); return ok })
+	want := archon.CreateTaskRequest{Title: "Write docs", Description: "All of them", ProjectID: "p1", Status: "doing", Priority: 40}
+	if len(client.created) != 1 || client.created[0].Feature == nil || *client.created[0].Feature != "docs" {
+		t.Fatalf("Expected the task created with its feature, got %+v", client.created)
+	}
+	if got := client.created[0]; got.Title != want.Title || got.Description != want.Description || got.ProjectID != want.ProjectID ||
+		got.Status != want.Status || got.Priority != want.Priority {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+
+	// The list is refreshed and the new task selected, even though the status filter hid it
+	_, cmd = model.handleTaskMessages(created)
+	loaded := findMsg(cmd, func(msg tea.Msg) bool { _, ok := msg.(tasks.TasksLoadedMsg); return ok })
+	_, cmd = model.handleTaskMessages(loaded)
+	if got := sessionFeedback(cmd); got != "Created task: Write docs" {
+		t.Errorf("Expected the creation confirmed, got %q", got)
+	}
+	if selected := model.GetSelectedTask(); selected == nil || selected.ID != "new" {
+		t.Errorf("Expected the created task selected, got %+v", selected)
+	}
+
+	// Read-only projects refuse new tasks
+	model.programContext.MarkProjectReadOnly("p1")
+	if cmd, _ := model.handleTaskCreateKey(keys.KeyI); sessionFeedback(cmd) != "Read-only project: task changes are disabled" {
+		t.Errorf("Expected the read-only project refused, got %q", sessionFeedback(cmd))
+	}
+}
+
+func TestBulkStatusChange(t *testing.T) {
+	model := NewModel(createTestConfig())
+	client := archon.NewMockClient()
+	client.SetUpdateTaskErrorFor("t4", errors.New("server error"))
+	model.programContext.ArchonClient = client
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo", TaskOrder: 90},
+		{ID: "t2", ProjectID: "p1", Title: "Two", Status: "todo", TaskOrder: 80},
+		{ID: "t3", ProjectID: "locked", Title: "Three", Status: "todo", TaskOrder: 70},
+		{ID: "t4", ProjectID: "p1", Title: "Four", Status: "todo", TaskOrder: 60},
+		{ID: "t5", ProjectID: "p1", Title: "Five", Status: "todo", TaskOrder: 50},
+	})
+	model.programContext.MarkProjectReadOnly("locked")
+	moveTo := func(index int) {
+		model.setSelectedTask(index)
+		model.Update(tasklist.TaskListSelectionChangedMsg{Index: index})
+	}
+	marked := func() string {
+		return strings.Join(slices.Sorted(maps.Keys(model.programContext.MarkedTasks)), ",")
+	}
+
+	// v marks the range between where it started and the cursor, shrinking when moving back
+	moveTo(1)
+	model.handleVisualModeKey(keys.KeyV)
+	moveTo(3)
+	if got := marked(); got != "t2,t3,t4" {
+		t.Errorf("Expected t2-t4 marked, got %s", got)
+	}
+	moveTo(2)
+	if got := marked(); got != "t2,t3" {
+		t.Errorf("Expected the range to shrink, got %s", got)
+	}
+
+	// v again keeps the marks; moving no longer marks, and a new range adds to them
+	model.handleVisualModeKey(keys.KeyV)
+	moveTo(4)
+	model.handleVisualModeKey(keys.KeyV)
+	moveTo(3)
+	if got := marked(); got != "t2,t3,t4,t5" {
+		t.Errorf("Expected a second range added, got %s", got)
+	}
+	model.handleWindowResize(tea.WindowSizeMsg{Width: 160, Height: 12})
+	if status := model.components.Layout.StatusBar.View(); !strings.Contains(status, "Marked: 4") {
+		t.Errorf("Expected the mark count in the status bar, got %q", status)
+	}
+
+	// Marks are kept by ID when sorting changes
+	model.programContext.SetSortMode(sorting.SortAlphabetical)
+	model.refreshUIAfterFilterChange()
+	if got := marked(); got != "t2,t3,t4,t5" {
+		t.Errorf("Expected marks kept after sorting, got %s", got)
+	}
+
+	// t picks one status for the marked tasks, skipping the read-only project
+	cmd, _ := model.handleTaskKey(keys.KeyT)
+	show, ok := cmd().(status.ShowStatusModalMsg)
+	if !ok || strings.Join(show.TaskIDs, ",") != "t5,t4,t2" {
+		t.Fatalf("Expected the status modal for t5, t4 and t2, got %+v", show)
+	}
+	_, cmd = model.handleModalActions(status.StatusSelectedMsg{Status: "done", TaskIDs: show.TaskIDs})
+	var result tasks.TasksStatusUpdateMsg
+	for done := false; !done; {
+		for _, msg := range collectMsgs(cmd) {
+			switch msg := msg.(type) {
+			case tasks.TaskStatusProgressMsg:
+				_, cmd = model.handleTaskMessages(msg)
+			case tasks.TasksStatusUpdateMsg:
+				result, done = msg, true
+			}
+		}
+	}
+	if sent := sentUpdates(client); len(sent) != 3 || *sent["t2"].Status != "done" || *sent["t4"].Status != "done" || *sent["t5"].Status != "done" {
+		t.Errorf("Expected t2, t4 and t5 sent to done, got %v", sent)
+	}
+	if got := sessionFeedback(model.handleStatusUpdated(result)); got != "2 tasks moved to done, 1 failed" {
+		t.Errorf("Unexpected summary %q", got)
+	}
+	if marked() != "" || model.visual != nil {
+		t.Errorf("Expected the marks cleared once done, got %s", marked())
+	}
+	if stack := model.programContext.UndoStack; len(stack) != 1 || len(stack[0].Changes) != 2 || describeUndo(stack[0]) != "status change on 2 tasks" {
+		t.Errorf("Expected one undo entry for the updated tasks, got %+v", stack)
+	}
+
+	// Esc clears marks too
+	model.handleVisualModeKey(keys.KeyV)
+	if cmd, handled := model.handleEscapeKey(keys.KeyEscape); !handled || sessionFeedback(cmd) != "Marks cleared" || marked() != "" {
+		t.Errorf("Expected Esc to clear the marks, got %q", sessionFeedback(cmd))
+	}
+}
+
+func TestUndo(t *testing.T) {
+	model := NewModel(createTestConfig())
+	client := archon.NewMockClient()
+	client.SetCreateTaskResponse(&archon.TaskResponse{Task: archon.Task{ID: "new"}}, nil)
+	model.programContext.ArchonClient = client
+	feature := "auth"
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "Fix login bug", Status: "todo", TaskOrder: 50, Feature: &feature},
+		{ID: "t2", ProjectID: "p1", Title: "Write docs", Status: "review", Assignee: "alice", TaskOrder: 10},
+	})
+	undo := func() (string, taskUndoneMsg) {
+		t.Helper()
+		cmd, handled := model.handleTaskKey(keys.KeyCtrlZ)
+		if !handled {
+			t.Fatal("Expected ctrl+z handled")
+		}
+		var feedback string
+		var undone taskUndoneMsg
+		for _, msg := range collectMsgs(cmd) {
+			switch msg := msg.(type) {
+			case messages.StatusFeedbackMsg:
+				feedback = msg.Message
+			case taskUndoneMsg:
+				undone = msg
+			}
+		}
+		return feedback, undone
+	}
+
+	if got, _ := undo(); got != "Nothing to undo" {
+		t.Errorf("Expected nothing to undo, got %q", got)
+	}
+
+	// A confirmed status change is reverted, only for the field that changed
+	model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1", Task: &archon.Task{ID: "t1", Status: "doing", TaskOrder: 50, Feature: &feature}})
+	got, undone := undo()
+	if got != "Undoing status change on 'Fix login bug' (doing → todo)..." {
+		t.Errorf("Unexpected feedback %q", got)
+	}
+	if revert, _ := client.LastUpdateTaskCall("t1"); revert.Status == nil || *revert.Status != "todo" || revert.TaskOrder != nil || revert.Feature != nil {
+		t.Errorf("Expected only the status set back, got %+v", revert)
+	}
+	if got := sessionFeedback(model.handleTaskUndone(undone)); got != "Undid: status change on 'Fix login bug' (doing → todo)" {
+		t.Errorf("Unexpected feedback %q", got)
+	}
+
+	// Updates changing nothing undo covers are not recorded
+	model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1", Task: &archon.Task{ID: "t1", Status: "todo", TaskOrder: 50, Feature: &feature, Description: "New"}})
+	if len(model.programContext.UndoStack) != 0 {
+		t.Errorf("Expected a description edit not recorded, got %+v", model.programContext.UndoStack)
+	}
+
+	// A deleted task is re-created with its fields; the assignee follows in an update
+	model.handleTaskMessages(tasks.TaskDeleteMsg{TaskID: "t2"})
+	got, undone = undo()
+	if got != "Undoing deletion on 'Write docs' (re-created with a new ID)..." {
+		t.Errorf("Unexpected feedback %q", got)
+	}
+	if created := client.CreateTaskCalls; len(created) != 1 || created[0].Title != "Write docs" || created[0].Status != "review" || created[0].Priority != 10 {
+		t.Errorf("Expected the task re-created, got %+v", created)
+	}
+	if revert, _ := client.LastUpdateTaskCall("new"); revert.Assignee == nil || *revert.Assignee != "alice" {
+		t.Errorf("Expected the assignee restored, got %+v", revert)
+	}
+	if len(undone.restored) != 1 || undone.restored[0] != "new" {
+		t.Errorf("Expected the new task reported, got %v", undone.restored)
+	}
+
+	// A failed undo goes back on the stack
+	model.handleTaskMessages(tasks.TaskUpdateMsg{TaskID: "t1", Task: &archon.Task{ID: "t1", Status: "todo", TaskOrder: 90}})
+	client.SetUpdateTaskResponse(nil, errors.New("server error"))
+	_, undone = undo()
+	if got := sessionFeedback(model.handleTaskUndone(undone)); !strings.HasPrefix(got, "Undo failed, ctrl+z to retry") {
+		t.Errorf("Expected the failure reported, got %q", got)
+	}
+	if len(model.programContext.UndoStack) != 1 || model.programContext.UndoStack[0].Kind != "feature and priority change" {
+		t.Errorf("Expected the entry kept for a retry, got %+v", model.programContext.UndoStack)
+	}
+
+	// The stack keeps the latest 20 entries
+	for i := range 25 {
+		model.programContext.PushUndo(context.UndoEntry{Kind: strconv.Itoa(i)})
+	}
+	if stack := model.programContext.UndoStack; len(stack) != 20 || stack[0].Kind != "5" {
+		t.Errorf("Expected the oldest entries dropped, got %d entries", len(stack))
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	model := NewModel(createTestConfig())
+	client := &archiveClient{unsupported: map[string]bool{"t4": true}}
+	model.programContext.ArchonClient = client
+	model.updateTasks([]archon.Task{
+		{ID: "t1", ProjectID: "p1", Title: "One", Status: "todo", TaskOrder: 90},
+		{ID: "t2", ProjectID: "p1", Title: "Two", Status: "todo", TaskOrder: 80},
+		{ID: "t3", ProjectID: "p1", Title: "Three", Status: "todo", TaskOrder: 70},
+		{ID: "t4", ProjectID: "p1", Title: "Four", Status: "todo", TaskOrder: 60},
+	})
+	deleteSelected := func() string {
+		t.Helper()
+		if _, handled := model.handleTaskDeleteKey(keys.KeyD); !handled {
+			t.Fatal("Expected d handled")
+		}
+		_, cmd := model.handleModalActions(confirmation.ConfirmationSelectedMsg{Confirmed: true})
+		deleted, ok := cmd().(tasks.TaskDeleteMsg)
+		if !ok {
+			t.Fatalf("Expected the task deleted, got %T", cmd())
+		}
+		_, cmd = model.handleTaskMessages(deleted)
+		return sessionFeedback(cmd)
+	}
+	selected := func() string {
+		if task := model.GetSelectedTask(); task != nil {
+			return task.ID
+		}
+		return ""
+	}
+
+	// The selection moves to the task above instead of the top
+	model.setSelectedTask(2)
+	if got := deleteSelected(); got != "Task deleted: Three" {
+		t.Errorf("Unexpected feedback %q", got)
+	}
+	if len(client.archived) != 1 || client.archived[0] != "t3" || model.programContext.FindTask("t3") != nil {
+		t.Errorf("Expected t3 deleted and dropped, got %v", client.archived)
+	}
+	if got := selected(); got != "t2" {
+		t.Errorf("Expected t2 selected, got %s", got)
+	}
+
+	// Deleting the first task selects the next one
+	model.setSelectedTask(0)
+	deleteSelected()
+	if got := selected(); got != "t2" {
+		t.Errorf("Expected t2 selected after deleting the first task, got %s", got)
+	}
+
+	// Failures keep the task and say why
+	model.setSelectedTask(1)
+	deleteSelected()
+	if model.programContext.FindTask("t4") == nil || !strings.HasPrefix(model.programContext.Error, "Failed to delete task:") {
+		t.Errorf("Expected t4 kept with an error, got %q", model.programContext.Error)
+	}
+
+	// A task the server no longer has is dropped as deleted
+	_, cmd := model.handleTaskMessages(tasks.TaskDeleteMsg{TaskID: "t2", Error: archon.ErrTaskNotFound})
+	if got := sessionFeedback(cmd); got != "Task was already deleted: Two" || model.programContext.FindTask("t2") != nil {
+		t.Errorf("Expected t2 dropped, got %q", got)
+	}
+}
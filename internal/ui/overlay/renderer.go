@@ -0,0 +1,185 @@
+// Package overlay composes the base UI with layers drawn on top of it,
+// such as modals.
+//
+// Each layer declares where it is placed and what happens to everything
+// beneath it (kept, dimmed or blanked). Layers are drawn in ascending Z
+// order; layers with equal Z keep the order they were passed in.
+package overlay
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Position is how a layer is placed on screen
+type Position int
+
+const (
+	PositionCenter     Position = iota // Centered on screen
+	PositionAnchored                   // At the layer's X/Y offset
+	PositionFullScreen                 // Top-left corner, covering the whole screen
+)
+
+// Backdrop is what a layer does to the layers beneath it
+type Backdrop int
+
+const (
+	BackdropNone  Backdrop = iota // Lower layers stay visible around the layer
+	BackdropDim                   // Lower layers are drawn dimmed
+	BackdropBlank                 // Lower layers are replaced by blank space
+)
+
+// Layer is one piece of content drawn over the base UI
+type Layer struct {
+	Content  string
+	Position Position
+	Backdrop Backdrop
+	Z        int // Higher draws on top
+
+	// Offsets for PositionAnchored; negative values count from the right/bottom edge
+	// (-1 places the layer flush with that edge)
+	X, Y int
+}
+
+// Renderer composes layers onto a screen of fixed size
+type Renderer struct {
+	width  int
+	height int
+
+	dimLine func(line string) string // Restyles one plain-text line of a dimmed lower layer
+}
+
+// dimStyle renders dimmed lines faint
+var dimStyle = lipgloss.NewStyle().Faint(true)
+
+// NewRenderer creates a renderer for a width x height screen
+func NewRenderer(width, height int) *Renderer {
+	return &Renderer{
+		width:   max(width, 0),
+		height:  max(height, 0),
+		dimLine: func(line string) string { return dimStyle.Render(line) },
+	}
+}
+
+// Render draws layers over base and returns the final screen.
+// Layers with empty content are skipped; with no layers base is returned unchanged.
+func (r *Renderer) Render(base string, layers []Layer) string {
+	ordered := make([]Layer, 0, len(layers))
+	for _, layer := range layers {
+		if layer.Content != "" {
+			ordered = append(ordered, layer)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Z < ordered[j].Z })
+
+	screen := base
+	for _, layer := range ordered {
+		screen = r.draw(screen, layer)
+	}
+	return screen
+}
+
+// draw places one layer over screen
+func (r *Renderer) draw(screen string, layer Layer) string {
+	if layer.Backdrop == BackdropBlank && layer.Position != PositionAnchored {
+		// Same output as placing the layer alone on a blank screen
+		hPos, vPos := lipgloss.Center, lipgloss.Center
+		if layer.Position == PositionFullScreen {
+			hPos, vPos = lipgloss.Left, lipgloss.Top
+		}
+		return lipgloss.Place(r.width, r.height, hPos, vPos, layer.Content, blankWhitespace()...)
+	}
+
+	switch layer.Backdrop {
+	case BackdropBlank:
+		screen = lipgloss.Place(r.width, r.height, lipgloss.Left, lipgloss.Top, "", blankWhitespace()...)
+	case BackdropDim:
+		screen = r.dim(screen)
+	case BackdropNone:
+	}
+
+	x, y := r.origin(layer)
+	return r.composite(screen, layer.Content, x, y)
+}
+
+// blankWhitespace styles the space around blanked layers
+func blankWhitespace() []lipgloss.WhitespaceOption {
+	return []lipgloss.WhitespaceOption{
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
+	}
+}
+
+// origin returns the top-left cell of the layer, kept on screen where it fits.
+// Centering matches lipgloss.Place: odd gaps leave the extra cell on the right/bottom.
+func (r *Renderer) origin(layer Layer) (x, y int) {
+	width, height := lipgloss.Size(layer.Content)
+
+	switch layer.Position {
+	case PositionCenter:
+		x, y = (r.width-width)/2, (r.height-height)/2
+	case PositionAnchored:
+		x, y = layer.X, layer.Y
+		if x < 0 {
+			x = r.width - width + x + 1
+		}
+		if y < 0 {
+			y = r.height - height + y + 1
+		}
+		x = min(x, r.width-width)
+		y = min(y, r.height-height)
+	case PositionFullScreen:
+	}
+
+	return max(x, 0), max(y, 0)
+}
+
+// dim replaces every line of screen with its dimmed plain text
+func (r *Renderer) dim(screen string) string {
+	lines := strings.Split(screen, "\n")
+	for i, line := range lines {
+		lines[i] = r.dimLine(ansi.Strip(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// composite draws content over screen with its top-left corner at (x, y).
+// The result has exactly height lines; content past the edges is cut off.
+func (r *Renderer) composite(screen, content string, x, y int) string {
+	lines := strings.Split(screen, "\n")
+	for len(lines) < r.height {
+		lines = append(lines, "")
+	}
+	lines = lines[:r.height]
+
+	for i, contentLine := range strings.Split(content, "\n") {
+		row := y + i
+		if row >= r.height {
+			break
+		}
+		lines[row] = r.splice(lines[row], contentLine, x)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// splice replaces the cells of line starting at column x with segment.
+// Styles are reset at both seams so neither side bleeds into the other.
+func (r *Renderer) splice(line, segment string, x int) string {
+	segment = ansi.Truncate(segment, r.width-x, "")
+	end := x + ansi.StringWidth(segment)
+
+	left := ansi.Truncate(line, x, "")
+	left += strings.Repeat(" ", x-ansi.StringWidth(left))
+	right := ansi.TruncateLeft(line, end, "")
+
+	if left != ansi.Strip(left) {
+		left += ansi.ResetStyle
+	}
+	if segment != ansi.Strip(segment) && right != "" {
+		segment += ansi.ResetStyle
+	}
+	return left + segment + right
+}
@@ -0,0 +1,153 @@
+package overlay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// screen builds a width x height block filled with ch
+func screen(ch string, width, height int) string {
+	lines := make([]string, height)
+	for i := range lines {
+		lines[i] = strings.Repeat(ch, width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRender_NoLayersReturnsBase(t *testing.T) {
+	base := screen(".", 10, 4)
+	renderer := NewRenderer(10, 4)
+
+	if got := renderer.Render(base, nil); got != base {
+		t.Errorf("Expected base unchanged, got %q", got)
+	}
+	if got := renderer.Render(base, []Layer{{Content: "", Backdrop: BackdropBlank}}); got != base {
+		t.Errorf("Expected empty layer to be skipped, got %q", got)
+	}
+}
+
+func TestRender_BlankCenterMatchesPlace(t *testing.T) {
+	modal := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Render("Help\nmodal")
+
+	// Includes screens smaller than the modal
+	sizes := [][2]int{{80, 24}, {81, 25}, {12, 5}, {4, 2}, {0, 0}}
+	for _, size := range sizes {
+		width, height := size[0], size[1]
+		expected := lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, modal,
+			lipgloss.WithWhitespaceChars(" "),
+			lipgloss.WithWhitespaceForeground(lipgloss.Color("0")),
+		)
+		got := NewRenderer(width, height).Render(screen(".", width, height), []Layer{
+			{Content: modal, Position: PositionCenter, Backdrop: BackdropBlank},
+		})
+		if got != expected {
+			t.Errorf("%dx%d: expected output identical to lipgloss.Place\ngot:\n%s\nexpected:\n%s", width, height, got, expected)
+		}
+	}
+}
+
+func TestOrigin(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int
+		height    int
+		layer     Layer
+		expectedX int
+		expectedY int
+	}{
+		{"center even gap", 10, 6, Layer{Content: "ab\ncd", Position: PositionCenter}, 4, 2},
+		{"center odd gap leaves extra right and bottom", 11, 7, Layer{Content: "ab\ncd", Position: PositionCenter}, 4, 2},
+		{"center larger than screen", 3, 1, Layer{Content: "abcdef\nx", Position: PositionCenter}, 0, 0},
+		{"anchored top left", 10, 6, Layer{Content: "ab", Position: PositionAnchored, X: 1, Y: 2}, 1, 2},
+		{"anchored bottom right", 10, 6, Layer{Content: "ab\ncd", Position: PositionAnchored, X: -1, Y: -1}, 8, 4},
+		{"anchored inset from bottom right", 10, 6, Layer{Content: "ab", Position: PositionAnchored, X: -2, Y: -3}, 7, 3},
+		{"anchored clamped on screen", 10, 6, Layer{Content: "abcd", Position: PositionAnchored, X: 9, Y: 9}, 6, 5},
+		{"anchored on tiny screen", 2, 1, Layer{Content: "abcd\nef", Position: PositionAnchored, X: -1, Y: -1}, 0, 0},
+		{"full screen", 10, 6, Layer{Content: "ab", Position: PositionFullScreen}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := NewRenderer(tt.width, tt.height).origin(tt.layer)
+			if x != tt.expectedX || y != tt.expectedY {
+				t.Errorf("Expected origin (%d,%d), got (%d,%d)", tt.expectedX, tt.expectedY, x, y)
+			}
+		})
+	}
+}
+
+func TestRender_CompositeKeepsLowerLayers(t *testing.T) {
+	renderer := NewRenderer(6, 3)
+	got := renderer.Render(screen(".", 6, 3), []Layer{
+		{Content: "ab\ncd", Position: PositionAnchored, X: -1, Y: -1},
+	})
+	expected := "......\n....ab\n....cd"
+	if got != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, got)
+	}
+
+	// Short base lines are padded up to the layer; content past the edge is cut
+	got = renderer.Render("..", []Layer{
+		{Content: "w", Position: PositionAnchored, X: 4, Y: 1},
+		{Content: "abcdefgh", Position: PositionAnchored, Y: 2},
+	})
+	if expected := "..\n    w\nabcdef"; got != expected {
+		t.Errorf("Expected padded and truncated rows %q, got %q", expected, got)
+	}
+}
+
+func TestRender_LayerOrdering(t *testing.T) {
+	renderer := NewRenderer(5, 1)
+	base := screen(".", 5, 1)
+
+	// Higher Z draws on top regardless of slice order
+	got := renderer.Render(base, []Layer{
+		{Content: "TOP", Position: PositionAnchored, X: 1, Z: 2},
+		{Content: "low", Position: PositionAnchored, X: 0, Z: 1},
+	})
+	if got != "lTOP." {
+		t.Errorf("Expected higher Z on top, got %q", got)
+	}
+
+	// Equal Z keeps the given order: the later layer wins
+	got = renderer.Render(base, []Layer{
+		{Content: "aaa", Position: PositionAnchored},
+		{Content: "bb", Position: PositionAnchored},
+	})
+	if got != "bba.." {
+		t.Errorf("Expected later layer on top for equal Z, got %q", got)
+	}
+}
+
+func TestRender_Dimming(t *testing.T) {
+	renderer := NewRenderer(6, 3)
+	renderer.dimLine = func(line string) string { return strings.ToLower(line) }
+
+	base := lipgloss.NewStyle().Bold(true).Render("ABCDEF") + "\nABCDEF\nABCDEF"
+	got := renderer.Render(base, []Layer{
+		{Content: "XY", Position: PositionCenter, Backdrop: BackdropDim},
+	})
+	expected := "abcdef\nabXYef\nabcdef"
+	if got != expected {
+		t.Errorf("Expected dimmed plain base with layer on top\ngot\n%q\nexpected\n%q", got, expected)
+	}
+
+	// Only layers below a dimming layer are dimmed
+	got = renderer.Render(screen("A", 6, 3), []Layer{
+		{Content: "XY", Position: PositionAnchored, Backdrop: BackdropDim},
+		{Content: "ZZ", Position: PositionAnchored, Y: 2, Z: 1},
+	})
+	if expected := "XYaaaa\naaaaaa\nZZaaaa"; got != expected {
+		t.Errorf("Expected upper layer undimmed, got %q", got)
+	}
+
+	// Anchored blank backdrop clears everything below
+	got = NewRenderer(4, 2).Render(screen("A", 4, 2), []Layer{
+		{Content: "X", Position: PositionAnchored, X: -1, Backdrop: BackdropBlank},
+	})
+	if strings.Contains(got, "A") || !strings.Contains(got, "X") {
+		t.Errorf("Expected blanked base, got %q", got)
+	}
+}
@@ -1,8 +1,10 @@
 package sorting
 
 import (
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/yousfisaad/lazyarchon/v2/internal/archon"
 )
@@ -13,6 +15,10 @@ const (
 	SortPriorityOnly   = 1 // Priority only
 	SortTimeCreated    = 2 // Creation time (newest first)
 	SortAlphabetical   = 3 // Alphabetical by title
+	SortRecent         = 4 // Local last interaction (newest first), then status+priority
+
+	// SortModeCount is the number of sort modes, for cycling through them
+	SortModeCount = 5
 )
 
 // Sort mode names for UI display
@@ -21,6 +27,7 @@ var sortModeNames = []string{
 	"priority",
 	"time",
 	"alphabetical",
+	"recent",
 }
 
 // GetSortModeName returns the display name for a sort mode
@@ -31,8 +38,33 @@ func GetSortModeName(sortMode int) string {
 	return "unknown"
 }
 
+// ParseSortMode returns the sort mode for a display name such as "priority"
+func ParseSortMode(name string) (int, bool) {
+	for mode, modeName := range sortModeNames {
+		if modeName == name {
+			return mode, true
+		}
+	}
+	return SortStatusPriority, false
+}
+
+// DefaultStatusOrder is the status precedence of SortStatusPriority when none is configured
+var DefaultStatusOrder = []string{
+	archon.TaskStatusTodo,   // Needs action
+	archon.TaskStatusDoing,  // Work in progress
+	archon.TaskStatusReview, // Waiting for review
+	archon.TaskStatusDone,   // Completed
+}
+
 // SortTasks sorts tasks based on the specified sort mode
 func SortTasks(tasks []archon.Task, sortMode int) []archon.Task {
+	return SortTasksInStatusOrder(tasks, sortMode, nil)
+}
+
+// SortTasksInStatusOrder sorts tasks like SortTasks, ordering statuses by
+// statusOrder wherever status comes first; statuses it does not list sort last
+// (nil = DefaultStatusOrder)
+func SortTasksInStatusOrder(tasks []archon.Task, sortMode int, statusOrder []string) []archon.Task {
 	if len(tasks) == 0 {
 		return tasks
 	}
@@ -43,26 +75,48 @@ func SortTasks(tasks []archon.Task, sortMode int) []archon.Task {
 
 	switch sortMode {
 	case SortStatusPriority:
-		sortByStatusPriority(sortedTasks)
+		sortByStatusPriority(sortedTasks, statusOrder)
 	case SortPriorityOnly:
 		sortByPriority(sortedTasks)
 	case SortTimeCreated:
 		sortByTimeCreated(sortedTasks)
 	case SortAlphabetical:
 		sortByAlphabetical(sortedTasks)
+	case SortRecent:
+		sortByStatusPriority(sortedTasks, statusOrder) // No interactions known here; see SortTasksByInteraction
 	}
 
 	return sortedTasks
 }
 
+// SortTasksByInteraction sorts tasks for SortRecent: tasks with a local
+// interaction time come first, most recent first, and the rest follow in
+// status+priority order
+func SortTasksByInteraction(tasks []archon.Task, touched map[string]time.Time, statusOrder []string) []archon.Task {
+	sortedTasks := SortTasksInStatusOrder(tasks, SortStatusPriority, statusOrder)
+	sort.SliceStable(sortedTasks, func(i, j int) bool { //nolint:varnamelen // i, j are idiomatic for sort functions
+		timeI, touchedI := touched[sortedTasks[i].ID]
+		timeJ, touchedJ := touched[sortedTasks[j].ID]
+		if touchedI != touchedJ {
+			return touchedI
+		}
+		return touchedI && timeI.After(timeJ)
+	})
+	return sortedTasks
+}
+
 // sortByStatusPriority sorts tasks by status first, then by priority or edit time
+// - statuses: in statusOrder (DefaultStatusOrder when empty), unlisted ones last
 // - todo/review/doing tasks: sorted by priority (TaskOrder, higher first)
 // - done tasks: sorted by edit time (UpdatedAt, most recent first)
-func sortByStatusPriority(tasks []archon.Task) {
+func sortByStatusPriority(tasks []archon.Task, statusOrder []string) {
+	if len(statusOrder) == 0 {
+		statusOrder = DefaultStatusOrder
+	}
 	sort.Slice(tasks, func(i, j int) bool { //nolint:varnamelen // i, j are idiomatic for sort functions
 		// First, sort by status priority
-		statusI := getStatusWeight(tasks[i].Status)
-		statusJ := getStatusWeight(tasks[j].Status)
+		statusI := getStatusWeight(tasks[i].Status, statusOrder)
+		statusJ := getStatusWeight(tasks[j].Status, statusOrder)
 		if statusI != statusJ {
 			return statusI < statusJ
 		}
@@ -99,18 +153,14 @@ func sortByAlphabetical(tasks []archon.Task) {
 }
 
 // getStatusWeight returns the priority weight for a task status
-// Lower numbers = higher priority (appear first)
-func getStatusWeight(status string) int {
-	switch status {
-	case archon.TaskStatusTodo:
-		return 0 // Highest priority - needs action
-	case archon.TaskStatusDoing:
-		return 1 // Second priority - work in progress
-	case archon.TaskStatusReview:
-		return 2 // Third priority - waiting for review
-	case archon.TaskStatusDone:
-		return 3 // Lowest priority - completed
-	default:
-		return 4 // Unknown status goes to end
+// Lower numbers = higher priority (appear first). Statuses statusOrder leaves
+// out go after it in their default order, and unknown statuses go to the end
+func getStatusWeight(status string, statusOrder []string) int {
+	if weight := slices.Index(statusOrder, status); weight >= 0 {
+		return weight
+	}
+	if weight := slices.Index(DefaultStatusOrder, status); weight >= 0 {
+		return len(statusOrder) + weight
 	}
+	return len(statusOrder) + len(DefaultStatusOrder)
 }
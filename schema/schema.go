@@ -0,0 +1,14 @@
+// Package schema embeds the JSON Schema of the config file, generated from
+// the config structs. Regenerate it after changing them:
+//
+//	go generate ./schema
+package schema
+
+import _ "embed"
+
+//go:generate go run ../internal/shared/config/schemagen -src ../internal/shared/config/config.go -o config.schema.json
+
+// Config is the JSON Schema of config.yaml, printed by "lazyarchon config schema"
+//
+//go:embed config.schema.json
+var Config []byte